@@ -6,7 +6,9 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/configreload"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	. "github.com/onsi/gomega"
 )
@@ -23,21 +25,25 @@ func TestInjections(t *testing.T) {
 
 	var bootList []environments.BootService
 	env.MustResolve(&bootList)
-	Expect(len(bootList)).To(Equal(5))
+	Expect(len(bootList)).To(Equal(7))
 
 	_, ok := bootList[0].(signalbus.SignalBus)
 	Expect(ok).To(Equal(true))
-	_, ok = bootList[1].(*server.ApiServer)
+	_, ok = bootList[1].(*configreload.ConfigReloader)
 	Expect(ok).To(Equal(true))
-	_, ok = bootList[2].(*server.MetricsServer)
+	_, ok = bootList[2].(*tracing.TracerProvider)
 	Expect(ok).To(Equal(true))
-	_, ok = bootList[3].(*server.HealthCheckServer)
+	_, ok = bootList[3].(*server.ApiServer)
 	Expect(ok).To(Equal(true))
-	_, ok = bootList[4].(*workers.LeaderElectionManager)
+	_, ok = bootList[4].(*server.MetricsServer)
+	Expect(ok).To(Equal(true))
+	_, ok = bootList[5].(*server.HealthCheckServer)
+	Expect(ok).To(Equal(true))
+	_, ok = bootList[6].(*workers.LeaderElectionManager)
 	Expect(ok).To(Equal(true))
 
 	var workerList []workers.Worker
 	env.MustResolve(&workerList)
-	Expect(workerList).To(HaveLen(8))
+	Expect(workerList).To(HaveLen(11))
 
 }
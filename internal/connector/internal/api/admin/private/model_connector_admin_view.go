@@ -15,17 +15,19 @@ import (
 
 // ConnectorAdminView struct for ConnectorAdminView
 type ConnectorAdminView struct {
-	Id              string                `json:"id,omitempty"`
-	Kind            string                `json:"kind,omitempty"`
-	Href            string                `json:"href,omitempty"`
-	Owner           string                `json:"owner,omitempty"`
-	CreatedAt       time.Time             `json:"created_at,omitempty"`
-	ModifiedAt      time.Time             `json:"modified_at,omitempty"`
-	Name            string                `json:"name"`
-	ConnectorTypeId string                `json:"connector_type_id"`
-	NamespaceId     string                `json:"namespace_id"`
-	Channel         Channel               `json:"channel,omitempty"`
-	DesiredState    ConnectorDesiredState `json:"desired_state"`
-	ResourceVersion int64                 `json:"resource_version,omitempty"`
-	Status          ConnectorStatusStatus `json:"status,omitempty"`
+	Id              string                 `json:"id,omitempty"`
+	Kind            string                 `json:"kind,omitempty"`
+	Href            string                 `json:"href,omitempty"`
+	Owner           string                 `json:"owner,omitempty"`
+	CreatedAt       time.Time              `json:"created_at,omitempty"`
+	ModifiedAt      time.Time              `json:"modified_at,omitempty"`
+	Name            string                 `json:"name"`
+	ConnectorTypeId string                 `json:"connector_type_id"`
+	NamespaceId     string                 `json:"namespace_id"`
+	Channel         Channel                `json:"channel,omitempty"`
+	DesiredState    ConnectorDesiredState  `json:"desired_state"`
+	RestartPolicy   ConnectorRestartPolicy `json:"restart_policy,omitempty"`
+	Schedule        ConnectorSchedule      `json:"schedule,omitempty"`
+	ResourceVersion int64                  `json:"resource_version,omitempty"`
+	Status          ConnectorStatusStatus  `json:"status,omitempty"`
 }
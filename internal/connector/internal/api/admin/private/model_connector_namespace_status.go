@@ -11,8 +11,9 @@ package private
 
 // ConnectorNamespaceStatus struct for ConnectorNamespaceStatus
 type ConnectorNamespaceStatus struct {
-	State              ConnectorNamespaceState `json:"state"`
-	Version            string                  `json:"version,omitempty"`
-	ConnectorsDeployed int32                   `json:"connectors_deployed"`
-	Error              string                  `json:"error,omitempty"`
+	State              ConnectorNamespaceState     `json:"state"`
+	Version            string                      `json:"version,omitempty"`
+	ConnectorsDeployed int32                       `json:"connectors_deployed"`
+	Resources          ConnectorNamespaceResources `json:"resources,omitempty"`
+	Error              string                      `json:"error,omitempty"`
 }
@@ -0,0 +1,20 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorRestartPolicy Defines if and how a connector is restarted when it fails.
+type ConnectorRestartPolicy struct {
+	// Restart policy type, `never` (the default) leaves failed connectors as they are, `on-failure` restarts failed connectors up to `max_retries` times.
+	Type string `json:"type,omitempty"`
+	// Maximum number of consecutive restarts of a failed connector.
+	MaxRetries int32 `json:"max_retries,omitempty"`
+	// Delay in seconds before the first restart of a failed connector, doubled for every following restart.
+	BackoffSeconds int32 `json:"backoff_seconds,omitempty"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorSchedule Cron expressions in UTC used to pause (stop) and resume a connector, e.g. `0 20 * * 1-5` to pause a connector every weekday at 20:00.
+type ConnectorSchedule struct {
+	Pause  string `json:"pause,omitempty"`
+	Resume string `json:"resume,omitempty"`
+}
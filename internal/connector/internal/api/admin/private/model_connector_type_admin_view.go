@@ -9,6 +9,10 @@
 
 package private
 
+import (
+	"time"
+)
+
 // ConnectorTypeAdminView Holds the connector type
 type ConnectorTypeAdminView struct {
 	Id   string `json:"id,omitempty"`
@@ -31,4 +35,10 @@ type ConnectorTypeAdminView struct {
 	Schema map[string]interface{} `json:"schema"`
 	// A json schema that can be used to validate a ConnectorRequest connector field.
 	JsonSchema map[string]interface{} `json:"json_schema"`
+	// Whether the connector type is deprecated. Connectors of a deprecated type should be migrated to the connector type in replaced_by.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// The id of the connector type replacing a deprecated connector type.
+	ReplacedBy string `json:"replaced_by,omitempty"`
+	// The end of life of a deprecated connector type. New connectors of this type can not be created after this date.
+	EndOfLife time.Time `json:"end_of_life,omitempty"`
 }
@@ -0,0 +1,26 @@
+/*
+ * Connector Service Fleet Manager Admin APIs
+ *
+ * Connector Service Fleet Manager Admin is a Rest API to manage connector clusters.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConnectorTypeDeprecation A deprecated connector type and the connectors that need to be migrated
+type ConnectorTypeDeprecation struct {
+	ConnectorTypeId string `json:"connector_type_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Version         string `json:"version,omitempty"`
+	// The id of the connector type connectors should be migrated to
+	ReplacedBy string    `json:"replaced_by,omitempty"`
+	EndOfLife  time.Time `json:"end_of_life,omitempty"`
+	// Connectors still using the deprecated connector type
+	Connectors []ConnectorAdminView `json:"connectors,omitempty"`
+}
@@ -1,8 +1,11 @@
 package dbapi
 
 import (
+	"time"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/cron"
 )
 
 type ConnectorDesiredState string
@@ -33,6 +36,21 @@ var ValidDesiredStates = []string{
 	string(ConnectorDeleted),
 }
 
+type ConnectorRestartPolicyType string
+
+const (
+	ConnectorRestartPolicyNever     ConnectorRestartPolicyType = "never"
+	ConnectorRestartPolicyOnFailure ConnectorRestartPolicyType = "on-failure"
+
+	// maxConnectorRestartBackoff caps the exponential restart backoff of failed connectors
+	maxConnectorRestartBackoff = time.Hour
+)
+
+var ValidRestartPolicyTypes = []string{
+	string(ConnectorRestartPolicyNever),
+	string(ConnectorRestartPolicyOnFailure),
+}
+
 var AgentConnectorStatusPhase = []string{
 	string(ConnectorStatusPhaseProvisioning),
 	string(ConnectorStatusPhaseDeprovisioning),
@@ -62,6 +80,8 @@ type Connector struct {
 	Kafka           KafkaConnectionSettings          `gorm:"embedded;embeddedPrefix:kafka_"`
 	SchemaRegistry  SchemaRegistryConnectionSettings `gorm:"embedded;embeddedPrefix:schema_registry_"`
 	ServiceAccount  ServiceAccount                   `gorm:"embedded;embeddedPrefix:service_account_"`
+	RestartPolicy   ConnectorRestartPolicy           `gorm:"embedded;embeddedPrefix:restart_policy_"`
+	Schedule        ConnectorSchedule                `gorm:"embedded;embeddedPrefix:schedule_"`
 
 	Status ConnectorStatus `gorm:"foreignKey:ID"`
 }
@@ -70,10 +90,93 @@ type ConnectorStatus struct {
 	db.Model
 	NamespaceID *string
 	Phase       ConnectorStatusPhase
+
+	// RetryCount and NextRetryAt track restarts of failed connectors with an on-failure restart policy
+	RetryCount  int32
+	NextRetryAt *time.Time
+	// NextScheduledAction is the desired state the connector is moved to at NextScheduledAt by its schedule
+	NextScheduledAction ConnectorDesiredState
+	NextScheduledAt     *time.Time
+}
+
+// ConnectorRestartPolicy defines if and how a failed connector is restarted
+type ConnectorRestartPolicy struct {
+	Type           ConnectorRestartPolicyType
+	MaxRetries     int32
+	BackoffSeconds int32
+}
+
+// RestartOnFailure returns true if a failed connector should be restarted after retryCount restarts
+func (p ConnectorRestartPolicy) RestartOnFailure(retryCount int32) bool {
+	return p.Type == ConnectorRestartPolicyOnFailure && retryCount < p.MaxRetries
+}
+
+// Backoff returns the delay before restarting a failed connector after retryCount restarts,
+// the delay starts with BackoffSeconds and is doubled for every restart up to an hour
+func (p ConnectorRestartPolicy) Backoff(retryCount int32) time.Duration {
+	backoff := time.Duration(p.BackoffSeconds) * time.Second
+	for i := int32(0); i < retryCount && backoff < maxConnectorRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxConnectorRestartBackoff {
+		backoff = maxConnectorRestartBackoff
+	}
+	return backoff
+}
+
+// ConnectorSchedule holds cron expressions in UTC to pause and resume a connector
+type ConnectorSchedule struct {
+	Pause  string
+	Resume string
+}
+
+// IsEmpty returns true if neither a pause nor a resume schedule is set
+func (s ConnectorSchedule) IsEmpty() bool {
+	return s.Pause == "" && s.Resume == ""
+}
+
+// Next returns the next scheduled desired state of the connector after now and when it is due,
+// a nil time is returned if nothing is scheduled
+func (s ConnectorSchedule) Next(now time.Time) (ConnectorDesiredState, *time.Time, error) {
+	var action ConnectorDesiredState
+	var next *time.Time
+	for _, scheduled := range []struct {
+		expr   string
+		action ConnectorDesiredState
+	}{{s.Pause, ConnectorStopped}, {s.Resume, ConnectorReady}} {
+		if scheduled.expr == "" {
+			continue
+		}
+		schedule, err := cron.Parse(scheduled.expr)
+		if err != nil {
+			return "", nil, err
+		}
+		t := schedule.Next(now.UTC())
+		if !t.IsZero() && (next == nil || t.Before(*next)) {
+			action = scheduled.action
+			next = &t
+		}
+	}
+	return action, next, nil
 }
 
 type ConnectorList []*Connector
 
+// ConnectorRevision holds an accepted configuration of a connector with its secrets stripped
+type ConnectorRevision struct {
+	db.Model
+	ConnectorID      string
+	Revision         int64
+	ConnectorVersion int64
+	ConnectorTypeId  string
+	ConnectorSpec    api.JSON `gorm:"type:jsonb"`
+	DesiredState     ConnectorDesiredState
+	Channel          string
+	Author           string
+}
+
+type ConnectorRevisionList []*ConnectorRevision
+
 type ConnectorWithConditions struct {
 	Connector
 	Conditions api.JSON `gorm:"type:jsonb"`
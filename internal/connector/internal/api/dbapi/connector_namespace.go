@@ -64,8 +64,17 @@ type ConnectorNamespaceStatus struct {
 	Phase ConnectorNamespacePhaseEnum `gorm:"not null;index"`
 	// the version of the agent
 	Version            string
-	ConnectorsDeployed int32         `gorm:"-:all"` // gorm ignored field set using query from connector_deployments table
-	Conditions         ConditionList `gorm:"type:jsonb"`
+	ConnectorsDeployed int32                       `gorm:"-:all"` // gorm ignored field set using query from connector_deployments table
+	Resources          ConnectorNamespaceResources `gorm:"-:all"` // gorm ignored field set using connector catalog resource footprints
+	Conditions         ConditionList               `gorm:"type:jsonb"`
+}
+
+// ConnectorNamespaceResources is the memory and CPU used by connectors in a namespace
+type ConnectorNamespaceResources struct {
+	MemoryRequests string
+	MemoryLimits   string
+	CPURequests    string
+	CPULimits      string
 }
 
 type ConnectorNamespaceList []*ConnectorNamespace
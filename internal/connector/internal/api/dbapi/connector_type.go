@@ -2,6 +2,7 @@ package dbapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"time"
 
@@ -27,6 +28,11 @@ type ConnectorType struct {
 	// connector capabilities used to understand what features a connector support
 	Capabilities []ConnectorTypeCapability `gorm:"foreignKey:ConnectorTypeID"`
 	Checksum     *string
+
+	// deprecation metadata from the connector catalog
+	Deprecated bool
+	ReplacedBy *string
+	EndOfLife  *time.Time
 }
 
 type ConnectorTypeList []*ConnectorType
@@ -62,6 +68,14 @@ type ConnectorCatalogEntry struct {
 	Channels      map[string]*ConnectorShardMetadata
 }
 
+// ConnectorTypeDeprecation holds a deprecated connector type and the connectors still using it
+type ConnectorTypeDeprecation struct {
+	ConnectorType *ConnectorType
+	Connectors    ConnectorList
+}
+
+type ConnectorTypeDeprecationList []ConnectorTypeDeprecation
+
 func (ct *ConnectorType) ChannelNames() []string {
 	channels := make([]string, len(ct.Channels))
 	for i := 0; i < len(channels); i++ {
@@ -125,3 +139,27 @@ func (ct *ConnectorType) SetSchema(schema map[string]interface{}) error {
 	ct.JsonSchema, err = json.Marshal(schema)
 	return err
 }
+
+// IsEndOfLife returns true if the connector type is deprecated and its end of life date has passed
+func (ct *ConnectorType) IsEndOfLife(now time.Time) bool {
+	return ct.Deprecated && ct.EndOfLife != nil && !now.Before(*ct.EndOfLife)
+}
+
+// DeprecationWarning returns a user facing warning for a deprecated connector type, or an empty string
+func (ct *ConnectorType) DeprecationWarning(now time.Time) string {
+	if !ct.Deprecated {
+		return ""
+	}
+	warning := fmt.Sprintf("connector type %s is deprecated", ct.ID)
+	if ct.EndOfLife != nil {
+		if ct.IsEndOfLife(now) {
+			warning += fmt.Sprintf(" and reached its end of life on %s", ct.EndOfLife.Format(time.RFC3339))
+		} else {
+			warning += fmt.Sprintf(" and will reach its end of life on %s", ct.EndOfLife.Format(time.RFC3339))
+		}
+	}
+	if ct.ReplacedBy != nil && *ct.ReplacedBy != "" {
+		warning += fmt.Sprintf(", please migrate to connector type %s", *ct.ReplacedBy)
+	}
+	return warning
+}
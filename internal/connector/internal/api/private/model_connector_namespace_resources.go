@@ -0,0 +1,22 @@
+/*
+ * Connector Service Fleet Manager Private APIs
+ *
+ * Connector Service Fleet Manager apis that are used by internal services.
+ *
+ * API version: 0.0.3
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConnectorNamespaceResources Memory and CPU used by connectors in a namespace, computed from connector type resource footprints
+type ConnectorNamespaceResources struct {
+	// Memory quota for limits or requests
+	MemoryRequests string `json:"memory_requests,omitempty"`
+	// Memory quota for limits or requests
+	MemoryLimits string `json:"memory_limits,omitempty"`
+	// CPU quota for limits or requests
+	CpuRequests string `json:"cpu_requests,omitempty"`
+	// CPU quota for limits or requests
+	CpuLimits string `json:"cpu_limits,omitempty"`
+}
@@ -26,10 +26,14 @@ type Connector struct {
 	NamespaceId     string                           `json:"namespace_id"`
 	Channel         Channel                          `json:"channel,omitempty"`
 	DesiredState    ConnectorDesiredState            `json:"desired_state"`
+	RestartPolicy   ConnectorRestartPolicy           `json:"restart_policy,omitempty"`
+	Schedule        ConnectorSchedule                `json:"schedule,omitempty"`
 	ResourceVersion int64                            `json:"resource_version,omitempty"`
 	Kafka           KafkaConnectionSettings          `json:"kafka"`
 	ServiceAccount  ServiceAccount                   `json:"service_account"`
 	SchemaRegistry  SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
 	Connector       map[string]interface{}           `json:"connector"`
 	Status          ConnectorStatusStatus            `json:"status,omitempty"`
+	// Warnings about the connector that require user action, e.g. the connector type is deprecated.
+	Warnings []string `json:"warnings,omitempty"`
 }
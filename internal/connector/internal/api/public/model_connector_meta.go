@@ -15,13 +15,15 @@ import (
 
 // ConnectorMeta struct for ConnectorMeta
 type ConnectorMeta struct {
-	Owner           string                `json:"owner,omitempty"`
-	CreatedAt       time.Time             `json:"created_at,omitempty"`
-	ModifiedAt      time.Time             `json:"modified_at,omitempty"`
-	Name            string                `json:"name"`
-	ConnectorTypeId string                `json:"connector_type_id"`
-	NamespaceId     string                `json:"namespace_id"`
-	Channel         Channel               `json:"channel,omitempty"`
-	DesiredState    ConnectorDesiredState `json:"desired_state"`
-	ResourceVersion int64                 `json:"resource_version,omitempty"`
+	Owner           string                 `json:"owner,omitempty"`
+	CreatedAt       time.Time              `json:"created_at,omitempty"`
+	ModifiedAt      time.Time              `json:"modified_at,omitempty"`
+	Name            string                 `json:"name"`
+	ConnectorTypeId string                 `json:"connector_type_id"`
+	NamespaceId     string                 `json:"namespace_id"`
+	Channel         Channel                `json:"channel,omitempty"`
+	DesiredState    ConnectorDesiredState  `json:"desired_state"`
+	RestartPolicy   ConnectorRestartPolicy `json:"restart_policy,omitempty"`
+	Schedule        ConnectorSchedule      `json:"schedule,omitempty"`
+	ResourceVersion int64                  `json:"resource_version,omitempty"`
 }
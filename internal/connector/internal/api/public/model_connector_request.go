@@ -16,6 +16,8 @@ type ConnectorRequest struct {
 	NamespaceId     string                           `json:"namespace_id"`
 	Channel         Channel                          `json:"channel,omitempty"`
 	DesiredState    ConnectorDesiredState            `json:"desired_state"`
+	RestartPolicy   ConnectorRestartPolicy           `json:"restart_policy,omitempty"`
+	Schedule        ConnectorSchedule                `json:"schedule,omitempty"`
 	Kafka           KafkaConnectionSettings          `json:"kafka"`
 	ServiceAccount  ServiceAccount                   `json:"service_account"`
 	SchemaRegistry  SchemaRegistryConnectionSettings `json:"schema_registry,omitempty"`
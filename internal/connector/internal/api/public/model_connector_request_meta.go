@@ -11,9 +11,11 @@ package public
 
 // ConnectorRequestMeta struct for ConnectorRequestMeta
 type ConnectorRequestMeta struct {
-	Name            string                `json:"name"`
-	ConnectorTypeId string                `json:"connector_type_id"`
-	NamespaceId     string                `json:"namespace_id"`
-	Channel         Channel               `json:"channel,omitempty"`
-	DesiredState    ConnectorDesiredState `json:"desired_state"`
+	Name            string                 `json:"name"`
+	ConnectorTypeId string                 `json:"connector_type_id"`
+	NamespaceId     string                 `json:"namespace_id"`
+	Channel         Channel                `json:"channel,omitempty"`
+	DesiredState    ConnectorDesiredState  `json:"desired_state"`
+	RestartPolicy   ConnectorRestartPolicy `json:"restart_policy,omitempty"`
+	Schedule        ConnectorSchedule      `json:"schedule,omitempty"`
 }
@@ -0,0 +1,30 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// ConnectorRevision An accepted configuration of a connector, connector secrets are not included
+type ConnectorRevision struct {
+	Id       string `json:"id,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+	Revision int64  `json:"revision,omitempty"`
+	// The connector resource version the configuration was applied with
+	ConnectorResourceVersion int64                  `json:"connector_resource_version,omitempty"`
+	ConnectorTypeId          string                 `json:"connector_type_id,omitempty"`
+	Channel                  Channel                `json:"channel,omitempty"`
+	DesiredState             ConnectorDesiredState  `json:"desired_state,omitempty"`
+	Connector                map[string]interface{} `json:"connector,omitempty"`
+	// The user that applied the configuration
+	Author    string    `json:"author,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
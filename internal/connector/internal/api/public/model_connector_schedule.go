@@ -0,0 +1,16 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorSchedule Cron expressions in UTC used to pause (stop) and resume a connector, e.g. `0 20 * * 1-5` to pause a connector every weekday at 20:00.
+type ConnectorSchedule struct {
+	Pause  string `json:"pause,omitempty"`
+	Resume string `json:"resume,omitempty"`
+}
@@ -9,8 +9,19 @@
 
 package public
 
+import (
+	"time"
+)
+
 // ConnectorStatusStatus struct for ConnectorStatusStatus
 type ConnectorStatusStatus struct {
 	State ConnectorState `json:"state,omitempty"`
 	Error string         `json:"error,omitempty"`
+	// Number of restarts of the failed connector according to its restart policy.
+	RetryCount int32 `json:"retry_count,omitempty"`
+	// Time of the next restart of the failed connector.
+	NextRetryAt         time.Time             `json:"next_retry_at,omitempty"`
+	NextScheduledAction ConnectorDesiredState `json:"next_scheduled_action,omitempty"`
+	// Time of the next scheduled pause or resume of the connector.
+	NextScheduledAt time.Time `json:"next_scheduled_at,omitempty"`
 }
@@ -0,0 +1,17 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorValidationResult The result of a dry-run validation of a connector request
+type ConnectorValidationResult struct {
+	// True if the connector request has no violations
+	Valid      bool                           `json:"valid"`
+	Violations []ConnectorValidationViolation `json:"violations"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorValidationViolation A violation of a connector request field
+type ConnectorValidationViolation struct {
+	// JSON pointer to the invalid connector request field
+	Path string `json:"path"`
+	// Error code of the violation
+	Code string `json:"code,omitempty"`
+	// Human readable description of the violation
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// ConnectorWarnings struct for ConnectorWarnings
+type ConnectorWarnings struct {
+	// Warnings about the connector that require user action, e.g. the connector type is deprecated.
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Connector Service Fleet Manager
+ *
+ * Connector Service Fleet Manager is a Rest API to manage connectors.
+ *
+ * API version: 0.1.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// Operation struct for Operation
+type Operation struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	// The asynchronous action tracked by the operation: kafka_deletion, cluster_scale_up, connector_upgrade or connector_deletion
+	OperationKind string          `json:"operation_kind"`
+	Target        ObjectReference `json:"target"`
+	// pending until a worker picks the operation up, then running until it succeeded or failed
+	State string `json:"state"`
+	// Percentage of the operation done
+	Progress int32 `json:"progress,omitempty"`
+	// Reason of the failure of a failed operation, or last error encountered by a running operation which is retried
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Time a worker started carrying out the operation
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// Time the operation succeeded or failed
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
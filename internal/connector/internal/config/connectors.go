@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
@@ -33,14 +34,51 @@ type ConnectorsConfig struct {
 
 var _ environments.ConfigModule = &ConnectorsConfig{}
 
+// ShardMetadataResourcesKey is the shard metadata property declaring the connector resource footprint
+const ShardMetadataResourcesKey = "resources"
+
 type ConnectorChannelConfig struct {
 	Revision      int64                  `json:"revision,omitempty"`
 	ShardMetadata map[string]interface{} `json:"shard_metadata,omitempty"`
+
+	// resource footprint parsed from shard metadata, used to enforce namespace resource quotas
+	Resources *ConnectorResources `json:"-"`
+}
+
+// readResources parses the connector resource footprint from the channel shard metadata
+func (c *ConnectorChannelConfig) readResources() error {
+	value, ok := c.ShardMetadata[ShardMetadataResourcesKey]
+	if !ok {
+		return nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.DisallowUnknownFields()
+	resources := ConnectorResources{}
+	if err := decoder.Decode(&resources); err != nil {
+		return err
+	}
+	for name, q := range resources.quantities() {
+		if q.Sign() < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	c.Resources = &resources
+	return nil
 }
 
 type ConnectorCatalogEntry struct {
 	Channels      map[string]ConnectorChannelConfig `json:"channels,omitempty"`
 	ConnectorType public.ConnectorType              `json:"connector_type"`
+
+	// deprecation metadata, connectors of a deprecated type should be migrated to the replacement type
+	// and no new connectors can be created once the type has reached its end of life date
+	Deprecated bool       `json:"deprecated,omitempty"`
+	ReplacedBy string     `json:"replaced_by,omitempty"`
+	EndOfLife  *time.Time `json:"end_of_life,omitempty"`
 }
 
 func NewConnectorsConfig() *ConnectorsConfig {
@@ -90,6 +128,13 @@ func (c *ConnectorsConfig) ReadFiles() error {
 				return err
 			}
 
+			for name, channel := range entry.Channels {
+				if err := channel.readResources(); err != nil {
+					return gherrors.Errorf("error reading resources of channel %s in catalog file %s: %s", name, path, err)
+				}
+				entry.Channels[name] = channel
+			}
+
 			// compute checksum for catalog entry to look for updates
 			sum, err := checksum(entry)
 			if err != nil {
@@ -115,6 +160,10 @@ func (c *ConnectorsConfig) ReadFiles() error {
 		}
 	}
 
+	if err := validateDeprecations(values, typesLoaded); err != nil {
+		return err
+	}
+
 	sort.Slice(values, func(i, j int) bool {
 		return values[i].ConnectorType.Id < values[j].ConnectorType.Id
 	})
@@ -125,6 +174,39 @@ func (c *ConnectorsConfig) ReadFiles() error {
 	return nil
 }
 
+// GetConnectorResources returns the resource footprint of connectors of the connector type and channel,
+// or nil if the catalog doesn't declare one
+func (c *ConnectorsConfig) GetConnectorResources(connectorTypeId string, channel string) *ConnectorResources {
+	for i := range c.CatalogEntries {
+		if c.CatalogEntries[i].ConnectorType.Id == connectorTypeId {
+			if ccc, ok := c.CatalogEntries[i].Channels[channel]; ok {
+				return ccc.Resources
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func validateDeprecations(entries []ConnectorCatalogEntry, typesLoaded map[string]string) error {
+	for _, entry := range entries {
+		id := entry.ConnectorType.Id
+		if !entry.Deprecated {
+			if entry.ReplacedBy != "" || entry.EndOfLife != nil {
+				return fmt.Errorf("connector type '%s' defined in '%s' has deprecation metadata but is not deprecated", id, typesLoaded[id])
+			}
+			continue
+		}
+		if entry.ReplacedBy == id {
+			return fmt.Errorf("connector type '%s' defined in '%s' can not be replaced by itself", id, typesLoaded[id])
+		}
+		if _, found := typesLoaded[entry.ReplacedBy]; entry.ReplacedBy != "" && !found {
+			return fmt.Errorf("connector type '%s' defined in '%s' is replaced by unknown connector type '%s'", id, typesLoaded[id], entry.ReplacedBy)
+		}
+	}
+	return nil
+}
+
 func checksum(spec interface{}) (string, error) {
 	h := sha1.New()
 	err := json.NewEncoder(h).Encode(spec)
@@ -1,5 +1,11 @@
 package config
 
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
 // NamespaceQuota has resource limits for namespaces
 type NamespaceQuota struct {
 	Connectors     int32  `yaml:"connectors,omitempty"`
@@ -9,6 +15,105 @@ type NamespaceQuota struct {
 	CPULimits      string `yaml:"cpu-limits,omitempty"`
 }
 
+// HasResourceQuota returns true if the quota limits memory or CPU usage
+func (q NamespaceQuota) HasResourceQuota() bool {
+	return q.MemoryRequests != "" || q.MemoryLimits != "" || q.CPURequests != "" || q.CPULimits != ""
+}
+
+// Validate checks that memory and CPU quotas are valid resource quantities
+func (q NamespaceQuota) Validate() error {
+	quotas := q.resourceQuotas()
+	for _, name := range resourceQuotaNames {
+		value := quotas[name]
+		if value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("invalid %s quota '%s': %s", name, value, err)
+		}
+	}
+	return nil
+}
+
+// Exceeded returns an error describing the first memory or CPU quota exceeded by usage, if any
+func (q NamespaceQuota) Exceeded(usage NamespaceResourceUsage) error {
+	quotas := q.resourceQuotas()
+	used := usage.resources()
+	for _, name := range resourceQuotaNames {
+		value := quotas[name]
+		if value == "" {
+			continue
+		}
+		quota, err := resource.ParseQuantity(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s quota '%s': %s", name, value, err)
+		}
+		if u := used[name]; u.Cmp(quota) > 0 {
+			return fmt.Errorf("%s of %s exceeds quota of %s", name, u.String(), quota.String())
+		}
+	}
+	return nil
+}
+
+var resourceQuotaNames = []string{"memory-requests", "memory-limits", "cpu-requests", "cpu-limits"}
+
+func (q NamespaceQuota) resourceQuotas() map[string]string {
+	return map[string]string{
+		"memory-requests": q.MemoryRequests,
+		"memory-limits":   q.MemoryLimits,
+		"cpu-requests":    q.CPURequests,
+		"cpu-limits":      q.CPULimits,
+	}
+}
+
+// ConnectorResources is the resource footprint of a single connector,
+// declared in the "resources" property of a connector type channel shard metadata
+type ConnectorResources struct {
+	MemoryRequests resource.Quantity `json:"memory_requests,omitempty"`
+	MemoryLimits   resource.Quantity `json:"memory_limits,omitempty"`
+	CPURequests    resource.Quantity `json:"cpu_requests,omitempty"`
+	CPULimits      resource.Quantity `json:"cpu_limits,omitempty"`
+}
+
+func (r ConnectorResources) quantities() map[string]resource.Quantity {
+	return map[string]resource.Quantity{
+		"memory_requests": r.MemoryRequests,
+		"memory_limits":   r.MemoryLimits,
+		"cpu_requests":    r.CPURequests,
+		"cpu_limits":      r.CPULimits,
+	}
+}
+
+// NamespaceResourceUsage is the sum of resource footprints of connectors in a namespace
+type NamespaceResourceUsage struct {
+	MemoryRequests resource.Quantity
+	MemoryLimits   resource.Quantity
+	CPURequests    resource.Quantity
+	CPULimits      resource.Quantity
+}
+
+// Add adds the resource footprint of count connectors to the usage
+func (u *NamespaceResourceUsage) Add(r *ConnectorResources, count int64) {
+	if r == nil {
+		return
+	}
+	for i := int64(0); i < count; i++ {
+		u.MemoryRequests.Add(r.MemoryRequests)
+		u.MemoryLimits.Add(r.MemoryLimits)
+		u.CPURequests.Add(r.CPURequests)
+		u.CPULimits.Add(r.CPULimits)
+	}
+}
+
+func (u NamespaceResourceUsage) resources() map[string]resource.Quantity {
+	return map[string]resource.Quantity{
+		"memory-requests": u.MemoryRequests,
+		"memory-limits":   u.MemoryLimits,
+		"cpu-requests":    u.CPURequests,
+		"cpu-limits":      u.CPULimits,
+	}
+}
+
 // Quotas has limits for various resource types, e.g. namespaces
 // other resource limits can be added in the future,
 // e.g clusters with limits on namespaces, connectors with limits on catalogs, etc.
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/profiles"
 	"os"
+	"sync/atomic"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/spf13/pflag"
@@ -14,6 +15,9 @@ type ConnectorsQuotaConfig struct {
 	ConnectorsQuotaMap        ConnectorsQuotaProfileMap
 	ConnectorsQuotaConfigFile string
 	EvalNamespaceQuotaProfile string
+
+	// reloadedQuotaMap holds the ConnectorsQuotaProfileMap read by Reload
+	reloadedQuotaMap atomic.Value
 }
 
 func NewConnectorsQuotaConfig() *ConnectorsQuotaConfig {
@@ -30,10 +34,27 @@ func (c *ConnectorsQuotaConfig) AddFlags(fs *pflag.FlagSet) {
 }
 
 func (c *ConnectorsQuotaConfig) ReadFiles() (err error) {
-	err = readQuotaConfigFile(c.ConnectorsQuotaConfigFile, c.ConnectorsQuotaMap)
+	return c.readQuotaProfiles(c.ConnectorsQuotaMap)
+}
+
+func (c *ConnectorsQuotaConfig) ConfigFiles() []string {
+	return []string{c.ConnectorsQuotaConfigFile}
+}
+
+func (c *ConnectorsQuotaConfig) Reload() error {
+	quotaMap := make(ConnectorsQuotaProfileMap)
+	if err := c.readQuotaProfiles(quotaMap); err != nil {
+		return err
+	}
+	c.reloadedQuotaMap.Store(quotaMap)
+	return nil
+}
+
+func (c *ConnectorsQuotaConfig) readQuotaProfiles(quotaMap ConnectorsQuotaProfileMap) (err error) {
+	err = readQuotaConfigFile(c.ConnectorsQuotaConfigFile, quotaMap)
 
 	if err == nil {
-		if _, ok := c.ConnectorsQuotaMap[c.EvalNamespaceQuotaProfile]; !ok {
+		if _, ok := quotaMap[c.EvalNamespaceQuotaProfile]; !ok {
 			err = fmt.Errorf("configuration file '%s' is missing evaluation namespace quota profile '%s'",
 				c.ConnectorsQuotaConfigFile, c.EvalNamespaceQuotaProfile)
 		}
@@ -57,6 +78,9 @@ func readQuotaConfigFile(file string, val ConnectorsQuotaProfileMap) error {
 	err = yaml.UnmarshalStrict([]byte(fileContents), &quotaList)
 	if err == nil {
 		for _, profile := range quotaList {
+			if err := profile.Quotas.NamespaceQuota.Validate(); err != nil {
+				return fmt.Errorf("quota profile '%s' has %s", profile.Name, err)
+			}
 			val[profile.Name] = profile.Quotas
 		}
 	}
@@ -65,11 +89,18 @@ func readQuotaConfigFile(file string, val ConnectorsQuotaProfileMap) error {
 }
 
 func (c *ConnectorsQuotaConfig) GetNamespaceQuota(profileName string) (NamespaceQuota, bool) {
-	profile, ok := c.ConnectorsQuotaMap[profileName]
+	profile, ok := c.getQuotaMap()[profileName]
 	return profile.NamespaceQuota, ok
 }
 
 func (c *ConnectorsQuotaConfig) GetEvalNamespaceQuota() (NamespaceQuota, bool) {
-	profile, ok := c.ConnectorsQuotaMap[c.EvalNamespaceQuotaProfile]
+	profile, ok := c.getQuotaMap()[c.EvalNamespaceQuotaProfile]
 	return profile.NamespaceQuota, ok
 }
+
+func (c *ConnectorsQuotaConfig) getQuotaMap() ConnectorsQuotaProfileMap {
+	if quotaMap, ok := c.reloadedQuotaMap.Load().(ConnectorsQuotaProfileMap); ok {
+		return quotaMap
+	}
+	return c.ConnectorsQuotaMap
+}
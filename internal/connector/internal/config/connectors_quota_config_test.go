@@ -0,0 +1,53 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectorsQuotaConfig_Reload(t *testing.T) {
+	g := NewWithT(t)
+
+	file := filepath.Join(t.TempDir(), "connectors-quota-configuration.yaml")
+	g.Expect(ioutil.WriteFile(file, []byte(`
+- profile-name: evaluation-profile
+  quotas:
+    namespace-quota:
+      connectors: 4
+`), 0600)).To(Succeed())
+
+	c := NewConnectorsQuotaConfig()
+	c.ConnectorsQuotaConfigFile = file
+	g.Expect(c.ReadFiles()).To(Succeed())
+	g.Expect(c.ConfigFiles()).To(Equal([]string{file}))
+	quota, ok := c.GetEvalNamespaceQuota()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(quota.Connectors).To(Equal(int32(4)))
+
+	// the new profiles are swapped in
+	g.Expect(ioutil.WriteFile(file, []byte(`
+- profile-name: evaluation-profile
+  quotas:
+    namespace-quota:
+      connectors: 2
+- profile-name: default-profile
+`), 0600)).To(Succeed())
+	g.Expect(c.Reload()).To(Succeed())
+	quota, ok = c.GetEvalNamespaceQuota()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(quota.Connectors).To(Equal(int32(2)))
+	_, ok = c.GetNamespaceQuota("default-profile")
+	g.Expect(ok).To(BeTrue())
+
+	// profiles missing the evaluation profile are rejected, and the profiles in use are kept
+	g.Expect(ioutil.WriteFile(file, []byte(`
+- profile-name: default-profile
+`), 0600)).To(Succeed())
+	g.Expect(c.Reload()).ToNot(Succeed())
+	quota, ok = c.GetEvalNamespaceQuota()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(quota.Connectors).To(Equal(int32(2)))
+}
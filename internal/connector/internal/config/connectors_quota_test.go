@@ -0,0 +1,74 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNamespaceQuota_Exceeded(t *testing.T) {
+	footprint := &ConnectorResources{
+		MemoryRequests: resource.MustParse("256Mi"),
+		MemoryLimits:   resource.MustParse("512Mi"),
+		CPURequests:    resource.MustParse("250m"),
+		CPULimits:      resource.MustParse("500m"),
+	}
+	quota := NamespaceQuota{
+		Connectors:     4,
+		MemoryRequests: "1Gi",
+		MemoryLimits:   "2Gi",
+		CPURequests:    "1",
+		CPULimits:      "2",
+	}
+
+	tests := []struct {
+		name       string
+		quota      NamespaceQuota
+		connectors int64
+		err        string
+	}{
+		{
+			name:       "within quota",
+			quota:      quota,
+			connectors: 4,
+		},
+		{
+			name:       "memory requests exceeded",
+			quota:      quota,
+			connectors: 5,
+			err:        "memory-requests of 1280Mi exceeds quota of 1Gi",
+		},
+		{
+			name:       "cpu limits exceeded",
+			quota:      NamespaceQuota{CPULimits: "1"},
+			connectors: 3,
+			err:        "cpu-limits of 1500m exceeds quota of 1",
+		},
+		{
+			name:       "no resource quota",
+			quota:      NamespaceQuota{Connectors: 1},
+			connectors: 10,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			usage := NamespaceResourceUsage{}
+			usage.Add(footprint, tt.connectors)
+			err := tt.quota.Exceeded(usage)
+			if tt.err == "" {
+				g.Expect(err).To(gomega.BeNil())
+			} else {
+				g.Expect(err).To(gomega.MatchError(tt.err))
+			}
+		})
+	}
+}
+
+func TestNamespaceQuota_Validate(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(NamespaceQuota{MemoryRequests: "1Gi", CPULimits: "500m"}.Validate()).To(gomega.BeNil())
+	g.Expect(NamespaceQuota{CPURequests: "one"}.Validate()).To(gomega.MatchError(gomega.HavePrefix("invalid cpu-requests quota 'one'")))
+}
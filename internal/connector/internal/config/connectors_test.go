@@ -79,6 +79,38 @@ func TestConnectorsConfig_ReadFiles(t *testing.T) {
 			wantErr: true,
 			err:     ".*error unmarshaling catalog file .+/internal/connector/test/bad-connector-catalog/bad-connector-type.json: invalid character 'b' looking for beginning of value$",
 		},
+		{
+			name: "deprecated catalog",
+			fields: fields{
+				CatalogChecksums:     make(map[string]string),
+				ConnectorCatalogDirs: []string{"./internal/connector/test/deprecated-connector-catalog"}},
+			wantErr:       false,
+			connectorsIDs: []string{"log_sink_0.1", "log_sink_0.2"},
+		},
+		{
+			name: "bad deprecated catalog",
+			fields: fields{
+				CatalogChecksums:     make(map[string]string),
+				ConnectorCatalogDirs: []string{"./internal/connector/test/bad-deprecated-connector-catalog"}},
+			wantErr: true,
+			err:     "^connector type 'log_sink_0.1' defined in '.+/log_sink_0.1.json' is replaced by unknown connector type 'log_sink_0.3'$",
+		},
+		{
+			name: "resources catalog",
+			fields: fields{
+				CatalogChecksums:     make(map[string]string),
+				ConnectorCatalogDirs: []string{"./internal/connector/test/resources-connector-catalog"}},
+			wantErr:       false,
+			connectorsIDs: []string{"log_sink_0.2"},
+		},
+		{
+			name: "bad resources catalog",
+			fields: fields{
+				CatalogChecksums:     make(map[string]string),
+				ConnectorCatalogDirs: []string{"./internal/connector/test/bad-resources-connector-catalog"}},
+			wantErr: true,
+			err:     "^error listing connector catalogs in .+: error reading resources of channel stable in catalog file .+/log_sink_0.2.json: quantities must match the regular expression .+$",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x77\xdb\x36\xf2\xe8\xff\xfe\x14\xb8\xca\x6f\x4f\xfa\x08\x65\x49\x96\x5f\x3a\xb7\xbb\xc7\x75\x9c\xd4\x6d\xe2\xa4\xb6\xd3\x34\x9b\x93\x2b\x43\x24\x24\x21\x26\x01\x1a\x00\x95\x28\xbb\xf7\xbb\xff\x0e\x00\x3e\x40\x12\xa4\x28\x59\xb1\x9d\x56\x3a\x67\xb7\x31\x09\x0c\x66\x06\x83\x79\x61\x00\xd2\x10\x11\x18\xe2\x01\xd8\x69\x77\xda\x1d\xf0\x08\x10\x84\x3c\x20\xa6\x98\x03\xc8\xc1\x18\x33\x2e\x80\x8f\x09\x02\x82\x02\xe8\xfb\xf4\x13\xe0\x34\x40\xe0\xf4\xe9\x09\x97\x8f\xae\x09\xfd\xa4\x5b\xcb\x0e\x04\xc4\xe0\x80\x47\xdd\x28\x40\x44\xb4\xb7\x1e\x81\x23\xdf\x07\x88\x78\x21\xc5\x44\x70\xe0\xa1\x31\x26\xc8\x03\x53\xc4\x10\xf8\x84\x7d\x1f\x8c\x10\xf0\x30\x77\xe9\x0c\x31\x38\xf2\x11\x18\xcd\xe5\x48\x20\xe2\x88\xf1\x36\x38\x1d\x03\xa1\xda\xca\x01\x62\xec\x28\xb8\x46\x28\xd4\x98\xa4\x90\xb7\x1e\x81\x56\xc8\xf0\x0c\x0a\xd4\x7a\x02\xa0\x27\xa9\x40\x81\x6c\x2c\xa6\x08\xb4\x5c\x4a\x08\x72\x05\x65\xc3\x60\x12\x08\x27\x6e\xd9\x9e\xc3\xc0\x6f\x81\x31\xf6\xd1\x16\x26\x63\x3a\xd8\x02\x40\x60\xe1\xa3\x01\x38\x4e\x3a\x80\x0b\xc4\x66\xd8\x45\xe0\x99\x8f\x90\x00\x2f\x21\x81\x13\xc4\xb6\x00\x98\x21\xc6\x31\x25\x03\xd0\x69\x77\xdb\x9d\x2d\x00\x3c\xc4\x5d\x86\x43\xa1\x1e\x2e\xe8\xaf\xe9\x39\x47\x5c\x80\xa3\xd7\xa7\x12\xcd\x40\xbd\x00\x29\xa2\xbc\xbd\xc5\x11\x93\x83\x48\xac\x1c\x10\x31\x7f\x00\xa6\x42\x84\x7c\xb0\xbd\x0d\x43\xdc\x96\xcc\xe6\x53\x3c\x16\x6d\x97\x06\x5b\x00\x14\x10\x78\x09\x31\x01\xdf\x85\x8c\x7a\x91\x2b\x9f\x7c\x0f\x34\x38\x3b\x30\x2e\xe0\x04\x2d\x02\x79\x21\xe0\x04\x93\x89\x15\xd0\x60\x7b\xdb\xa7\x2e\xf4\xa7\x94\x8b\xc1\x41\xa7\xd3\x29\x77\x4f\xdf\x67\x3d\xb7\xcb\xad\xdc\x88\x31\x44\x04\xf0\x68\x00\x31\xd9\x12\x70\x12\x33\x80\xc0\x20\x37\x2f\x97\xf3\x10\xf1\x72\xff\x56\xcb\xd6\xba\x71\x43\x70\xec\x47\x5c\xa0\x25\x3a\xc4\xf3\x6b\x6d\xbf\x15\x42\x31\x55\xf8\x3f\x92\xff\x03\xd6\x6e\x8f\xb6\xb6\x00\x68\xc9\x69\xd8\xce\x8b\xe9\xf6\xac\xdb\x1a\x28\xb8\x13\x24\xf4\x3f\x00\x48\x18\xa2\x7f\x4e\x05\x22\x40\xae\x45\x06\x25\x22\xa7\xde\x40\xf6\xff\x43\x8b\xeb\x4b\x24\xa0\x07\x05\x8c\x5b\xf1\x28\x08\x20\x9b\x0f\xc0\x39\x12\x11\x23\x5c\xad\x96\x58\xb2\x41\x90\x6f\x9b\x23\xae\x41\x7b\x86\x78\x48\x09\x47\x06\xba\xad\x5e\xa7\xd3\xca\xfe\x04\x52\xdc\x05\x22\xc2\x7c\x04\x00\x0c\x43\x1f\xbb\x0a\xf9\xed\x8f\x9c\x92\xfc\x5b\x00\xb8\x3b\x45\x01\x2c\x3e\x05\xe0\x7f\x18\x1a\x0f\xc0\xe3\x47\xdb\x2e\x0d\x42\x4a\x10\x11\x7c\x5b\xb7\xe5\xdb\x05\xf2\x1f\x1b\x9d\x73\x74\xfd\x51\xa4\x25\x9d\xbb\xb2\xe4\xd5\x4d\xdc\xf6\x35\x1c\x5f\xc3\x61\xf6\x5c\xc8\x4e\xdb\xff\xc9\x3f\x18\x62\xef\xff\xc7\xfc\x08\x21\x83\x01\x12\xf1\x7a\xd7\x73\xab\x45\xad\xd4\x65\xcb\x8a\xf9\xe5\x14\x01\xec\x01\xaa\x34\x66\xd6\x09\xc8\x4e\x5b\xd5\xac\x93\xaf\x07\x80\x0b\x86\xc9\x24\x7d\x8c\xc9\x00\x48\xd1\x4d\x1f\x30\x74\x13\x61\x86\xbc\x01\x10\x2c\x42\xcd\x65\x32\x5b\xa4\x00\x70\xe4\x46\x0c\x8b\xb9\xd9\xf2\x67\x04\x19\x62\x03\xf0\x1e\x7c\xa8\x90\xdb\x14\x96\x04\xf5\xf3\xfc\xf4\x69\x51\x72\x9f\x23\x01\x60\x81\x5e\x69\x45\x52\x3e\xe5\xb8\xb4\xb0\xf5\x3d\x49\x6d\xcb\x2a\xb5\x39\xe2\x5b\x85\xae\xe8\x33\x0c\x42\xdf\x44\x34\xf9\xe5\xba\x9d\xe8\x66\xe5\x56\xf6\xa1\x13\xa8\xdb\x36\x20\xad\xaa\x65\x73\x59\x12\x39\x10\x40\xe1\x4e\xa5\xb9\x90\xe2\x28\xe5\x07\x29\xcd\x1f\xb3\xb4\xdf\xe9\xde\x0f\x4b\x4f\x18\xa3\xac\x39\x2b\xfb\x9d\xee\xaa\x0c\xcc\xba\x56\xb2\xed\x28\x12\x53\x20\xe8\x35\x22\xd2\x21\xc0\x64\x06\x7d\x63\x79\xb7\xfa\x9d\xfe\x37\xc2\xa4\xfe\xea\x4c\xea\x2f\x62\xd2\x19\xcd\x64\xa9\x20\x63\xe8\x33\xe6\x82\x1b\x0c\xeb\xde\xd3\x42\xbd\x43\x86\x75\x3b\x8b\x18\x76\x9c\x67\x92\x47\x11\x27\x8f\x85\x66\x16\x80\x64\x1e\x50\x96\x59\x84\xd6\xee\x7d\x29\xb7\x25\x79\xb6\xdb\xe9\xac\xca\xb3\xac\x6b\x25\xcf\xde\x10\xf4\x39\x44\xae\x40\x1e\x40\x12\x2f\x40\x5d\xe5\x89\x7a\x4b\xdb\xf8\x65\x5c\xb6\x35\x9b\x47\x5e\xe5\xd5\x41\xe0\xcb\xb9\xa7\xe3\xc2\x02\xe2\x75\xae\xdd\xa2\x4e\x65\x8f\x45\xa2\x6c\x9b\x88\xac\xe5\x76\x08\x27\xc6\x24\x2c\x6c\xce\xf1\x97\x65\x9a\x53\xe6\x21\xf6\xf3\x7c\x99\x01\x10\x64\xee\xb4\xf5\xe0\x8d\xff\x0b\xcc\x45\xb5\x19\x59\x30\x53\x1b\x7b\xdb\xcc\xde\x6e\x54\xe1\x42\x55\x58\x88\x85\x96\x8c\x82\x12\xe5\x18\x52\xbe\x58\x3b\xde\x42\x31\xba\x0c\x41\x81\x4c\x2c\x81\xa9\x16\x8f\xd5\x6b\x95\x50\xfa\x94\x2d\x19\x9b\x2e\xac\x6d\x69\x57\x80\x32\x76\xba\x89\x10\x9b\x1b\xfc\xd5\x81\x1c\xe4\x73\xe2\x56\x71\xfd\x35\x62\x63\xca\x02\xe5\x2d\x43\x95\xb1\x01\x98\x00\x48\x74\xaf\x29\xa3\x84\x46\x1c\x04\x90\x10\xc4\xb6\xea\xa5\x4d\x87\x74\x23\x4a\x7d\x04\x89\xf1\xc6\x12\xc4\x81\xc4\x33\xff\x99\x7a\x06\x83\x2b\xdc\x09\x23\xb8\xb7\x2e\x8e\xfa\xa5\x61\x5f\x18\x8d\x34\xe0\xb9\x46\x32\xbf\x42\xaa\xd6\x47\xda\x4b\x4f\x5e\xe5\x4a\x69\x16\xfd\xe4\x80\xb4\xea\x02\xe2\x2a\xf3\xd1\xbb\x67\xf3\x51\xad\x0d\x5d\x17\x85\x02\xe5\x02\x8e\x6f\x43\x01\xf6\x3b\x1d\x35\x2f\x98\x92\xd5\xad\x45\x11\x44\x25\x9f\xfe\x90\x56\x42\xb5\xd4\x0a\x91\x67\x1a\xd1\xe0\xdc\xc6\xbe\x6e\xe2\xd9\x46\xf1\xec\x65\x96\x0f\x41\x9e\xd4\x19\x34\x62\x6e\x21\x4c\xdb\xf8\x24\x05\xc1\x22\x20\xaa\x72\x4b\xb4\xb5\x4f\x32\x4d\x79\x23\xdd\x24\x0a\xbb\x85\x9f\x21\xdd\xee\x32\x9c\x4d\xf4\xd5\x78\x80\x6f\x20\xfa\x5a\x36\xf2\xda\x04\x5d\x9b\xa0\xeb\x7e\xf2\x4f\x7c\xfb\x3f\xf5\xfb\x49\x0b\x56\x23\xf6\x5a\x77\xa1\x34\xcd\xac\x55\x51\x65\x16\xb6\x67\x6c\x0a\xd2\xde\xe4\x61\xea\x8e\x86\xfb\x25\x9b\xad\x92\x8d\x6b\xd9\x84\x49\x2b\x6e\x95\x6c\x76\x49\x6c\xbc\x6a\xe2\x86\x6f\x76\x4b\xec\xbc\xab\xb6\x56\xba\xa9\x8f\x04\xfa\x9a\x26\x44\x8f\x50\x69\x45\x9e\xaa\xd7\x8b\x0c\x49\x65\x2b\xbb\x2d\x79\x28\xfa\xc5\x42\xc3\x26\x0f\xf1\x97\x35\x16\x7a\x82\x6f\x61\x32\x72\x00\xea\x0c\x87\x72\x26\x13\x8d\x08\x3e\x61\x31\x05\x3c\x44\x2e\x1e\x63\xe4\x81\xd3\xa7\x25\x2b\xf2\x0d\x69\xc2\xdb\x31\xb1\x08\x60\x45\xad\x18\x4a\xc3\xfc\x35\x95\xa2\x1a\xa0\x52\x27\xbe\x96\x6f\x17\xa9\xc4\xaa\x46\x8b\x37\x09\x9e\x42\x01\x81\xa0\x1a\x89\x42\x05\x9a\x94\xa5\xad\x1a\x31\x31\x85\x24\x40\x6c\x82\x1c\x05\xe5\xc7\xa6\x5b\x08\x7a\xbf\x83\x8e\x3e\x22\x57\x54\x80\x95\xa0\x96\x84\x5a\x88\xf3\x7f\xbd\x78\x75\xa6\xf9\xf3\x04\x9c\x3f\x3b\x06\x7b\x87\x9d\x1e\x70\xd2\x22\x5a\x41\xa9\xcf\xdb\x18\x89\x71\x9b\xb2\xc9\xf6\x54\x04\xfe\x36\x1b\xbb\xb2\xd5\x6a\xd8\xae\x7f\xef\x24\xed\xfc\x57\xd8\xbb\xd8\x04\x50\x7f\x5f\x9b\x78\x47\x01\x54\x1a\x12\x6c\xe2\x27\x1b\xab\x36\xf1\xd3\xdd\x94\x58\x24\x27\x12\x96\x2d\x38\x77\xe3\x83\x0c\xcb\x94\x5c\xe4\x4f\x3f\xd4\x17\x55\x64\x68\x81\xc6\xfe\xca\x82\x0a\x0c\xe0\xe6\x60\x36\xa8\xc4\x28\xf4\xf8\xdb\x55\x64\xc4\xe4\xdf\x5f\x65\x46\x2c\x05\x2b\x16\x68\xe8\xce\xeb\xa9\xd3\xb0\xc0\xfa\x26\xcb\x35\x62\x42\x36\x55\x1b\x9b\xaa\x0d\x83\x73\x1b\xcf\xb0\x01\x93\x36\x55\x1b\x0f\xcb\xcd\x59\xa1\x6a\x23\x67\xd0\x1b\xd5\xd0\x17\x5c\x96\xdb\x56\x71\x14\xc1\x35\x29\xe6\x70\xf3\x7d\x1a\xd7\x73\x14\xfa\xdd\x75\x49\xc7\xc3\xdc\x33\x8d\x27\x60\xe9\x82\xf7\x02\x33\x37\xda\x7d\x53\x7e\x71\xc7\xc7\x7f\x12\x09\x34\x4f\xf9\xc6\xcf\x96\x3c\xe8\x9b\xf5\xb2\x07\x00\x55\x67\x7d\xf3\xd1\xd0\xdd\x1f\xf7\xbd\xbd\x2e\x36\x8b\x43\x0a\x11\x66\xd5\x81\xdf\x9a\xa0\xb1\xbe\xe9\x83\xd6\x7f\x0d\x33\x9f\x49\x00\xb8\xc9\x80\x6e\xfc\xdc\x26\x4c\x5a\xb1\x84\x24\x11\xb3\xcd\xa9\xdb\x4d\x3e\xf4\x4e\xcd\xaf\x6c\x1a\x46\x77\x62\x7a\xa2\xd0\xb3\xe4\x37\x7f\x9e\x9f\x7a\x45\x0b\x14\x79\x21\xcc\x57\x8e\xd4\x19\xa1\x85\xad\x9b\xef\xae\x6a\x14\xbd\x15\xf7\x56\xef\x24\xf1\xb7\x44\xa6\x2d\xaf\x6e\xf3\x19\xce\x58\xdf\x70\x01\x45\xa4\xae\x97\x8a\x49\xdf\xd8\xb4\x8d\x4d\x5b\xb3\x4d\xfb\x86\x0b\x5b\x1e\x7a\x89\xdf\x1a\xb4\x72\xa1\xd4\xaf\x22\x26\x28\xd7\xf2\xd5\x69\xe4\x85\xad\x37\x15\x80\x1b\xbd\xf8\x40\x98\x74\x87\x15\x80\xa9\xcf\xba\x29\xfe\x5b\x67\xf1\xdf\xfa\x32\x48\xdb\xd0\xf3\x28\x19\x66\x19\xa4\x6f\x3b\xa5\x94\xa1\xc9\x10\x47\x62\xe8\x32\xe4\x21\x22\x30\xf4\xb9\x1d\xc7\x73\xd9\x8c\xa7\x86\x9b\xc7\x37\x6b\x42\xd7\xa5\x11\x11\xc0\xe8\x0f\x3e\x4d\x11\x31\x47\xaa\x46\xbc\xb8\x2b\x5f\xae\x16\xc8\x50\x1f\x43\x9f\xdf\x77\x3a\xec\x48\xca\xc0\xeb\x74\xc6\x8b\x96\xb0\x22\xe5\xf5\x98\x03\x25\x3c\x86\xac\xd8\x2c\xe3\xd2\xbd\x1f\x54\x0e\x2d\xcf\x9a\xda\x1d\x04\x29\xee\x19\x31\x40\x4c\xa1\x00\x7c\x4a\x23\xdf\x03\x23\x04\x22\xae\x2f\x9a\x75\x29\x19\xe3\x49\xc4\x90\x5a\x14\xfa\x8a\x56\x33\xfa\xd2\x4c\xa1\x44\xaf\x19\xcd\xab\xf6\xc6\x14\xff\x55\x4d\xf1\x26\xed\xb6\x49\xbb\xdd\x2d\xef\xbe\xfd\x5d\xaf\x6d\x69\xe1\x79\x08\x5d\xf4\x17\xf0\x56\x96\xd8\x8b\x5f\x6a\x27\x7e\xd9\xab\x15\x96\xba\x58\xe1\xfe\x5c\x95\xb3\x74\xea\x9b\x7b\x29\xa4\xd8\xa7\xa1\x7f\x52\xea\xf7\xa0\x3c\x93\x94\x33\x29\x4b\x16\x7a\x27\x19\x41\x60\x86\x39\x1e\xf9\xea\x16\xfe\x88\x23\x06\xf0\xc6\xe1\xd8\x38\x1c\x77\xef\x70\x6c\x8c\xe6\xd2\xb5\xfb\x39\x0d\xb8\x54\xf9\x7e\xc9\x6c\x36\x52\xe3\x65\x8d\x7b\xcb\x72\xb8\x6a\x15\x5e\x57\xd8\x56\xaf\xc4\x97\xea\xb9\xb9\xe7\x28\xfb\x3d\x00\xcb\x64\xab\xbc\x2b\xcd\xd9\xc6\x12\x6d\x6a\xef\xee\x38\x0a\xc9\x64\xd0\x8c\x43\xd2\xa7\x4b\xd6\xdf\x99\xfd\x96\x0b\x40\xd2\x9e\x5f\x23\x04\xb9\x33\x13\x60\xfa\xf2\x67\x05\x8a\x2a\x7d\xf8\x22\xe9\xb5\x8e\x7b\xb1\xf1\x03\xd7\x89\x0d\xab\xf1\x52\xaa\x36\xf5\x78\x1b\x3f\xbd\x11\x93\x56\xf4\xd3\x33\x41\xdb\x78\xea\x77\x66\x58\xd0\x0c\xfa\x4b\x9d\xa6\x2d\xa9\x62\xcb\x79\xda\x93\x19\xf4\x23\xf5\xac\xa4\x68\x6f\x71\xa4\x96\x4f\x29\x13\xc0\xc7\x33\x49\x7b\x3a\x42\x53\x65\x9d\x03\xd5\xa8\xfb\x32\x67\x56\x33\xd9\xbd\xb7\x53\xab\x29\xab\x25\xf7\x57\x3b\xbb\x9a\x03\xb1\x96\x13\xac\xd5\x10\xbf\xc9\x73\xac\x8b\x6d\xe7\xe6\x24\xeb\xe6\x24\xeb\x52\x1c\xdb\x78\x14\x0d\x98\xb4\x39\xc9\xfa\x15\x5c\x89\x55\x4e\xb2\x66\x36\x72\x2b\x1b\x55\x22\x17\x53\x38\xd0\x37\x32\x3d\xd2\xff\x0f\x8e\x69\x10\x50\x12\x3f\x52\xff\x79\x81\x33\x27\x23\x55\xfc\x86\x33\x70\x8d\x89\x67\xfc\x19\xc2\x09\x32\xfe\xe4\xf8\x8b\xf9\xa7\xa0\x02\xfa\xc6\xdf\x58\xa0\x20\x71\x4b\x2c\x57\x52\x85\x4c\xfa\x2a\x02\x9b\xac\x96\xe3\x2d\x8c\x63\x25\x16\xe5\x46\x98\x08\x34\x31\xf7\xe5\xf0\x97\x06\xad\x14\xce\xd5\xcd\xd4\x0b\x25\x26\x49\x1b\xe8\xfb\xaf\xc6\x8b\xf2\x84\x89\x80\xbd\x52\xf4\x9e\xa3\x31\x62\x88\xb8\xb9\x04\x60\xc5\x1d\x5d\x36\xa6\x00\xb5\x26\xbc\x92\xd4\x59\x99\x03\xd4\x4c\x42\xcb\x0a\xa9\x6c\x9e\xba\x8c\x43\xec\xd5\x76\x52\xef\x0a\x34\x0d\x96\x9b\x60\xbc\x78\x7a\x1b\xc9\xc0\x54\x72\xbd\xaa\x91\x81\xe7\x4b\x24\xe0\x92\x28\xd2\x4f\x04\xb1\x85\x08\x68\xd7\xda\x1b\xc2\x9c\x9e\x1a\x53\x16\x40\x31\x90\x6e\x27\x72\x04\x0e\xd0\x22\x30\x01\xf5\x54\x05\xe4\xaa\x70\xd4\xf3\xf8\xb3\xbc\xb1\x5f\x84\x29\xb9\x40\x42\x6a\x0b\x5e\xb7\xb4\xb1\xb9\xb0\x23\xe6\xdf\x6e\xd2\x22\x66\x59\x45\x16\x1c\x8f\x74\x21\x5d\x1d\x62\xae\x8f\x11\x11\xc3\x1c\x7e\xf1\x33\x8e\x5c\x86\xea\xe6\x2e\xed\xbb\x78\xfe\x4c\x88\xf5\xa8\x17\x3e\xec\x7b\x47\x9a\x00\xd9\x4c\x8d\x5a\x1b\xa0\x75\xf4\xfa\x34\x46\x2a\x6f\xbd\xb0\x7c\x39\xeb\xe6\x1f\x4e\x35\x5a\x15\x5f\x7f\x2e\x68\x19\xdf\xd7\x12\x54\x32\x7f\x8e\x06\xae\x62\x57\x5e\xb4\x99\x0b\x06\x29\x7f\xa3\xab\xd4\x3f\x26\xac\xf2\x93\x08\xd5\x7a\xb1\x12\x63\xcd\x57\xc8\x18\x9c\x17\xde\x28\xc3\x54\xb6\xe1\x85\x09\x35\x69\x5f\x6a\x6a\x73\x36\x37\x96\x7b\x6e\x5a\xdd\xdf\x24\x3b\xaa\x57\x6b\xce\x2d\xf8\x85\xfa\x1e\x4f\xcc\xbe\xaa\x14\xd4\x6e\xba\x2e\x1d\x94\x10\xd4\x97\xf3\x35\x4c\x70\x4a\xb8\x80\xc4\x45\xed\x55\x64\xb4\x52\x8d\x64\x13\xf1\x28\xbe\xc3\x35\xae\xdc\x76\x8d\x79\xc9\xda\x54\x88\xf4\xa3\xfc\x2c\x6a\xad\xa0\x86\x3e\x47\x13\xcc\x05\x9b\xaf\x99\x25\x0a\x38\x48\x80\xdf\x01\x6f\x74\x63\xc0\x92\x11\xd7\xc5\xa5\x44\x96\x54\xf1\x69\x4e\x92\xf2\xe5\xa8\x56\x6e\xb5\x8e\x8a\x85\xb5\xad\xb5\x9b\xec\x19\xf4\x23\x8b\xb3\x65\x2a\xd1\x72\xe1\x6c\x15\xb6\xc9\xee\x5f\xb1\x1c\x38\x8f\xb6\xb9\xae\x0b\xeb\xb9\x79\xfd\x6e\xab\xe8\x1f\x97\x2f\xc4\x4b\x59\x5d\xac\x3c\xba\x10\x50\x14\xbc\x9f\x1c\x57\x10\x89\x02\x53\xba\x3c\xcc\x63\xe9\x44\xa6\x65\x63\x08\x7a\x73\xb3\x19\xf2\x91\x48\xb9\x56\x71\x16\xd2\xf4\x6a\x6c\x53\xa6\x36\x9b\x6a\xa7\xa3\x02\xb0\x7d\x4e\xf4\x2a\x95\x4e\x89\xb9\xd7\x90\x9d\x16\x05\x50\xe5\xd9\x40\xe8\x43\x82\x0a\x05\x53\xad\x55\x56\x5b\x0d\xd9\x2d\x3b\xfe\x26\x47\x56\x30\xcc\x1a\xf2\xd7\x42\xee\x42\x1d\x31\xad\x9b\x30\x9e\x6b\x51\xb1\x38\xab\x3a\x27\x00\x4a\x01\xc2\x32\x54\x28\x71\x2e\x24\x28\xcd\xb8\xa7\xb9\x28\xad\xdb\x3f\x5a\x86\x8a\xdb\xcc\xa3\x9e\xa5\x8a\x29\x34\x15\xd6\x52\x84\xe5\x1d\x99\xa5\xe3\x3e\xab\xab\xb2\xb4\x67\xb3\xdc\xad\x20\x76\x9d\x68\x3c\x3d\x9e\x42\x42\x90\x5f\xa3\xfc\x3c\x34\x86\x91\x2f\xe4\x53\x38\xf2\x51\x85\x4a\x8c\x5f\xe6\x19\xfe\x14\x71\x19\x11\x2c\xab\x5e\x23\x02\x39\xc7\x13\x52\xab\x5c\xb9\xa0\x61\x98\x6b\xe1\xc5\x67\x1b\xf3\x38\x2c\x3b\xb8\x1e\xda\xb4\x88\xc9\xb3\xdc\x60\x4a\x5b\xe6\x5b\x2d\xc6\x70\x0c\xb1\x5f\x46\x39\x0f\xc5\x2b\x9c\xd0\x74\xa4\x3c\xcd\xb0\x0c\x10\x8a\x0d\x73\x2f\x0a\xa2\x6e\x7a\x53\xb5\x59\x21\xe9\x03\x9a\x48\x6b\xe7\x68\x18\x9f\x92\x32\xde\x94\xbe\x67\x6b\xcb\xf9\x48\x68\xa6\xcc\xd6\x49\x6b\x85\xeb\x9c\xad\xb0\x02\x2e\x65\xb8\x8f\xeb\xfc\xbb\x38\x3c\x7d\x9c\x81\x53\xef\x87\x89\x4b\xd7\x14\xcd\x45\x7e\x6d\x86\x6f\xca\x21\x13\xf4\x23\x23\xc7\x57\xe7\x44\xca\x96\xca\xf2\xf2\x29\x0c\x51\xee\x71\xc8\xa8\x8b\x38\x37\x3f\x16\x27\x1f\xeb\xbc\xe2\x14\x12\xcf\xcf\xa7\x81\x72\x7a\x29\x2f\x17\x16\xa7\xc3\x26\x15\xd2\xda\xdb\xa6\x5e\x7d\xb3\x3e\x1f\xce\x5b\x8b\x5f\xa4\x74\xaa\xa5\x3f\x54\xc6\x6c\x55\xf7\xa6\xc4\xd8\x64\xfc\x85\x3d\x4c\xac\x16\x83\xcf\xeb\xc0\x45\x02\x11\xab\xcc\x6c\xde\x73\xb4\x36\x86\x62\x53\x92\x45\x8b\x55\x70\xe5\x56\x73\xbc\x72\x4e\xcd\x92\x7d\x73\x7a\xa4\x88\xdd\x7d\x38\x6a\x15\xc4\x2c\x69\x8a\x93\x6d\x8c\xe1\x4c\xa7\x5e\xec\x56\xb9\x98\x60\xd6\xbf\x24\x9f\x87\x89\xd8\xeb\x5b\x8c\xcd\x83\xf5\x0e\xd7\xe0\x16\xde\x8b\x3f\xb8\x0e\xc1\x5d\xb2\xb7\xdd\x7f\xfc\x1b\x38\x8e\x2d\xbb\xc3\x08\x2e\xd3\x6f\xc9\x16\x43\x68\xf9\xc6\x1a\x6a\xfe\xd3\x49\x51\x38\x47\x21\x43\x5c\x8e\x98\xab\xea\x53\xc5\x48\x3c\x0a\x43\xca\x04\xf2\xc0\x68\xae\x42\xd2\xa3\xd7\xa7\x71\x47\x4a\x50\x9e\xc7\x65\x53\x05\xca\xe6\x4a\x3f\x8a\x17\x76\xe1\xa9\xa6\x77\x9d\x10\x3f\x72\x4a\x86\x39\xb0\xf7\xb4\xab\x54\x34\xa4\xa5\xf9\x38\x83\x01\x2a\x57\xa0\xca\x51\xda\x75\x0a\xc0\x7c\x51\xa1\x2d\xf3\xe5\x07\xba\xcd\x2d\x47\x8a\x4d\x72\x49\x8c\xf3\x45\x42\x71\xa3\x65\xc6\x5a\xd7\x82\x29\xfa\x00\x45\xe4\xea\xf0\x3e\x32\xff\x2c\x21\xdf\x98\x47\xd8\xa5\x64\x58\xdc\x3c\x2b\x0d\xf6\xe6\xfc\x85\x4a\xa1\x12\xd5\x7e\xf5\xd1\x7c\x38\x5a\x34\x1f\x2f\x54\x93\xec\x38\x3c\x14\x68\x42\x19\xfe\x82\x2c\x9f\xda\xbe\xc5\xbc\x54\x0b\x0d\x0c\xe1\x08\xfb\xb8\xbc\x38\x6c\x65\xb8\x46\xe3\xb2\x12\x72\xe5\x7c\x7f\x55\x64\xed\x45\x0e\x55\x1a\x34\xf9\x1d\x29\x85\x93\x64\xa7\xd5\x3d\x04\x2e\x24\xe6\x25\x04\x33\x5d\xfe\x83\x00\x2c\xb9\x91\x25\x68\xd9\x82\x19\x63\xe4\x7b\x76\x59\x28\x69\x20\x60\x2a\xbd\x6f\x87\x80\xb2\xd9\xfa\x1b\xd8\x73\x49\x66\x65\x66\xbc\x50\x70\xfa\x28\xcf\xa1\xf4\xad\x25\x66\x6c\xb8\xdd\xd0\x28\xb8\x53\xdf\xba\x13\x88\x91\x01\x68\xfd\xbf\xef\xbe\x7b\x7f\xe4\xfc\x1b\x3a\x5f\x3a\xce\xe1\x87\xf7\x4e\xfa\xef\x61\xfb\xc3\x0f\xdf\xff\xcb\x78\xf7\xfd\xbf\xfe\xa7\xba\x08\x3a\x2d\x19\x95\x08\x80\x20\xe2\x42\x57\x45\x27\x23\x81\xab\xa5\x06\xba\x7a\x02\x28\x03\x58\x02\x99\x4b\x49\x45\x41\x28\xe6\x52\x54\x47\x08\xc0\x48\x50\x67\x82\x08\x62\x50\x20\x43\x00\x21\x21\x54\xc0\xd2\x66\x66\xd5\xe7\xdc\x3c\x0f\xcb\xb6\xd0\x7f\x5d\x21\x33\xba\x63\x4b\xf3\xae\xe8\x98\xa6\x04\xff\x1e\xd1\xa5\x27\x29\xdb\xca\x2d\x23\x5a\x8e\x80\x8c\xe8\x67\x27\xfb\x56\x5d\x80\x02\xca\xe6\xc3\x78\x37\x81\x37\x8d\x81\x5f\xaa\x6e\x0a\xe9\x56\x11\x96\x8f\x03\x7c\x4b\x48\x6e\x18\x2d\x8d\xd2\x71\x18\x59\xa0\x2c\x87\x4c\x06\xa3\x62\x9a\xee\x23\x70\xb6\x2d\xe7\x07\x11\x41\x9b\x6f\x6e\x4c\xf9\x6d\xc4\x6a\xfb\x12\xa8\xe4\xfc\x25\x22\x90\x88\xdf\x8c\xb2\xa4\x26\xf9\x68\x6e\x90\xe0\x00\xca\x26\x90\x60\xae\x16\x77\xfd\x38\x35\x2b\xb1\x41\x85\x5e\x9a\x4f\x6b\x52\x5d\xb7\x1c\x93\x32\x36\x64\x22\x90\x4f\x94\xe5\x14\xea\x09\x16\x53\xc4\xf4\x6d\x02\x94\xe5\x18\x00\xb0\x07\x3c\x14\x22\xe2\x61\x32\x49\xee\x36\x52\x7b\xc8\xd2\xd5\xcc\x51\x54\x9b\x59\x28\x8a\xa7\x35\xa4\x3c\xb2\x9e\x69\xd1\xe5\x5b\x85\x63\x53\x4d\xb2\x9a\xe5\x5b\x43\x72\x73\xb0\x5a\xb6\x6d\xcd\xeb\x2c\x43\xb2\x36\x4d\x63\xbe\x28\x8a\xc6\xed\xc4\xa3\x62\x9a\xd4\xb7\x4d\x97\x9f\x2b\xfd\xc1\xd7\xfc\x54\xdd\x01\x9f\x2b\x88\x30\xce\x73\xd8\x69\x20\x0b\xce\xb3\xd8\x65\x6f\x9d\x04\x55\x60\xfe\x37\x70\x5d\x8d\x13\x21\x15\x4c\xf8\xda\x65\x1b\x85\x47\xa5\xad\xc5\x1c\x22\x59\x52\xb7\xa1\xb6\x37\x77\x46\x72\x9b\x2c\x7c\xe8\xa1\xd0\xa7\x73\x54\xa7\xff\x57\xdb\x6b\xc8\xb3\x2e\x93\x03\x8b\x11\xaf\xdf\x88\xc9\x70\x5c\xdd\x69\x2c\x25\x9f\x9b\x98\x87\xe6\xba\x66\x75\xff\x6a\xf5\x54\x75\xce\xc3\x5b\x73\x32\xaf\x3a\xed\xb1\xbc\x89\x40\x9f\x43\x9c\xdf\x1b\x4e\x7e\x15\x81\x54\xd6\x01\x08\x1c\x20\x2e\x60\x10\x02\x4c\xd4\xb7\xad\x77\x76\x76\x0e\xe3\x29\x2e\x00\x7b\x54\x57\xfb\x5c\x8b\xa0\xc8\xf9\x4f\xc9\x6f\x15\x2b\x96\x4f\xd1\x95\xb7\x5f\x96\x87\x9b\x6c\x0e\x64\xfd\xab\xb2\xc8\xd8\x2b\x3c\xb0\xa4\x95\x8b\x8e\x74\xe1\xb5\xc5\x49\xd1\x2f\x34\x87\x0a\x0f\x35\x79\x7a\xed\x18\x21\x91\x75\xd1\xe8\xf7\xda\xe5\x56\xf5\x60\x3a\xc8\x91\x0e\x5e\x12\x34\x55\xab\x56\x33\x64\x7f\xff\xa3\xf3\xe1\x5f\xef\x3b\xce\x61\xfb\xc3\x8f\xdf\x7f\xf7\x1e\x9d\x60\x12\x05\xd7\xbf\xbd\x7c\x7e\xf9\xfa\xc3\x0f\xef\x9d\x1f\xf5\xcb\x0f\x3f\x7c\x1f\x47\xec\x49\x78\x64\xc5\xea\xf8\xf5\x9b\x3b\x46\x69\xab\x7c\xc9\x43\xb6\x92\xf4\x55\x0f\x29\xf3\x4b\x59\xc4\xd3\xa7\xd2\xcd\x65\xc8\xa5\x2c\xfd\xee\x43\x21\x2f\x66\x41\xb5\x70\x7b\x83\xe5\x98\xa6\x79\x2e\x46\xe3\x60\x9c\xd7\x29\xde\x39\x5b\xf8\x28\xff\x04\x01\x4c\x3c\xf4\xb9\x04\x3d\xbb\x8f\xb6\x11\x96\xe5\xd3\x53\xc5\xd3\x3a\xba\x56\x14\xb4\xe2\xd2\x73\xf3\x98\x8e\x46\xda\x38\x55\x54\x8b\xf4\x59\x14\x8c\x64\x6c\x31\xd6\x1e\x83\xd4\x2c\x08\xaa\x7c\xcd\x04\xad\x9f\x8c\xe2\x71\xa2\x94\x8c\x4e\x47\x13\x12\x5f\xd9\x63\x15\xd0\xff\x66\x39\xcd\x8b\xf8\xce\x6b\x5d\xc0\xac\x3a\x81\xd1\x1c\xb8\x0c\x0b\xc4\x30\x6c\x2b\x09\xe1\x73\x22\xe0\x67\x9d\x77\xc7\x3c\x13\x35\x80\xb9\x81\x50\x80\x7d\xc8\x80\xa0\x0a\x92\xd9\x05\x81\xab\x04\xf0\x15\x70\x7d\x18\x71\x15\x58\x41\x02\x2e\x7e\x7f\xa1\xbd\x80\x00\x11\x91\x25\x9e\x4e\x24\xdf\x14\xa3\x93\xc4\xaa\xea\xaf\x53\xdb\x90\xcc\x53\xb0\xb9\x1c\xe1\x95\x4e\xa0\xf2\x0c\xce\x33\xca\x12\xd6\x3d\x91\x88\x31\x75\x0d\x93\x34\xa7\x46\x06\x51\xb2\x9b\x9b\x03\x88\x29\xc2\xda\x06\x3f\x91\xe1\xa2\x1a\x69\x4c\x7d\x9f\x7e\x92\xe1\xa1\x26\x2c\xae\x84\x96\xbf\xab\xab\x2b\x7e\x93\x9d\x33\x53\xe9\x3a\xc8\x5d\xf3\x7d\xd6\xf8\x72\x79\x24\xc0\x10\x12\x6f\x98\xb8\x37\xb7\x41\xe9\x49\x02\xa4\x1a\xbf\x53\xcd\x58\x73\x86\xc9\x63\xa1\xcb\xb7\x3c\xe4\xe9\x24\xe2\xd8\x08\x90\x31\xd7\xa9\xc4\x27\xf2\x59\xa6\xf8\x75\x5d\x2e\x8f\x7c\xc1\x01\x64\xb9\xf9\x93\xd8\xb4\x53\xb9\x0e\x7d\xea\xa1\xdc\xd9\xee\xb2\xac\x17\x44\xd9\x14\xf7\x84\xb4\x56\xc5\x0a\xd5\x4b\x38\x06\x70\xdb\x55\xc8\xc5\xdc\x47\x03\xe5\x26\x68\x5d\xa1\xee\xb8\xb2\xaf\xb0\x6c\x81\xa9\x46\xd9\x82\x32\x64\xa1\x7e\x65\x2d\x58\x51\x9f\xa6\x88\xa1\xdc\x72\xca\x86\xcc\xad\x2a\x70\x24\xe5\x04\x79\xf1\xea\x48\xae\x52\xd4\xc8\xab\xc9\xb9\x92\x5c\xba\x7a\x02\xae\x0c\x12\xe4\x9f\xb1\xb4\xc8\x7f\xaa\x9d\xb3\xab\x27\x00\x12\x0f\x5c\xc5\x1b\x9b\x57\xd9\x42\x4b\x86\xd0\x47\xf7\x28\xd3\x93\x7e\xf5\x7f\xff\x29\xfb\xfe\xa4\x73\xcf\x57\x2f\x4e\x7f\x3b\xb1\xf4\x71\x29\xf9\x18\x11\x57\xe0\x19\x2a\xf6\x3f\x3a\x7b\x7a\xa5\x87\x7c\x75\x7e\xd5\x06\xbf\xd0\x4f\x68\x86\xd8\x13\x30\xa7\x91\x52\x0c\x92\x72\x08\x02\xf8\x19\x07\x51\x20\x79\xd0\xed\x64\xe0\x28\x51\xb4\xc2\x84\x52\x25\x16\x06\xfb\x4f\x52\x39\xb3\xad\xce\x42\xdd\x80\xfe\x40\x80\x88\x2f\xa9\x04\x57\xf0\x13\x77\xf8\x0d\x77\xb4\xdf\xa3\x91\x54\x7b\x6e\x9a\x35\xe0\x4a\xd7\x92\x5e\x35\x5d\xae\xf9\xb5\xfa\x13\xc8\xc3\x57\xe0\x13\xd0\x3f\xe5\x8b\x58\x55\xf7\xf7\xa1\xf3\xc1\x4e\x86\x3e\x87\x83\xe3\xb3\x26\x9a\x0c\xa8\x47\xd1\x37\x80\x0b\xc8\x04\xd7\xcf\x25\x55\x2b\x62\xec\xe3\x6b\x24\x91\xfe\x47\x6f\xf7\xab\x28\x16\xa5\x2e\xe5\xcb\xfc\xb4\x18\xfa\x06\x0a\xf5\x5e\xe5\xf7\xa6\x90\x83\x10\xb1\x00\x73\x1e\x1f\xc4\xe1\x08\x29\x91\xd2\x7c\x41\x9e\x21\x07\x67\x54\xa0\x76\x82\x9f\x36\x3a\xd9\x49\x7a\x29\xf1\x71\x91\x22\xe6\x46\xef\x6a\xf5\x15\x3b\x0d\x4a\xe6\x2a\x94\x92\x5d\x01\x59\x6c\x7c\x4e\xbf\x80\xa2\xda\x6b\x24\x25\xad\xd5\xd4\xdb\x56\x76\x19\x8b\xaa\x1d\x4d\xd0\x8a\x6f\x63\x31\x81\xa2\x01\x18\xa9\xa7\xf1\x43\xfd\xc7\xb3\x38\x6a\xfa\xf5\xed\x65\xce\xdd\x9d\x0a\x11\x4a\xe8\x79\x6a\x1b\x7d\x1f\xbf\x70\xaa\x47\x33\xba\xf5\x72\x9e\xfb\x7c\x64\xce\x23\xa8\x07\x80\xbd\x01\xf0\xe9\x64\xc8\x31\xb9\x1e\x76\xda\xdd\xf4\x85\x3e\xfc\x97\x83\x94\xbe\x5b\xea\x60\xa1\x2a\xf5\xe4\xdb\xe6\x20\xad\x02\xfe\x2f\xe8\x04\x5c\x60\x72\x9d\x3e\x4e\xd2\x18\xa0\x95\x6b\x6d\x2b\x26\x71\x8a\x9a\x20\x5f\xc9\x50\x84\x9c\xd5\x5a\xac\x88\x7f\x3b\x24\x93\x0c\xa3\x72\x31\x85\x03\xb8\x39\x5e\x55\x29\x83\xa3\x6a\x84\x87\xc5\x1a\x61\xc7\x56\x23\x5c\xde\xa0\xaf\x3e\x79\x19\x04\xe5\x54\x40\xb6\xd4\xb2\xfb\x83\x92\x9f\xc0\xc2\xd7\x33\x50\x7c\x51\x55\x33\x50\x3d\xba\xfc\x05\x91\x2f\xf0\xd0\xc7\xc4\x7a\x1f\x43\x7a\x04\xc1\x5c\xf3\xf9\x06\x66\x60\x2b\x61\x81\x17\x98\xd8\x5a\xc6\x88\xd7\xb7\xb1\x7e\xe9\x23\xfb\x7d\x76\x26\x8c\x46\xe1\x00\xb4\x10\xf1\x42\x8a\x8b\x49\x06\xf9\xe3\x53\xfa\x69\x08\x7d\xff\xf6\xe4\x5c\x4c\xe9\x27\x69\xf0\xab\x89\xa9\x6b\x71\x4b\x52\x04\x0d\xb1\xbb\xa0\x0a\x8b\x06\x81\x74\x14\xa4\x79\x12\xc8\x4b\xcf\xfc\x69\xeb\xa9\x00\xe8\xa4\x9c\x5d\x84\x2e\xab\x1b\x54\x25\x87\x4c\xb4\xd5\xaa\x2b\xe6\x78\x50\x78\xfb\x1c\x75\xa1\xf8\x30\xfb\x39\xb5\x82\x1c\xc3\x24\x1c\x31\x31\x54\x5e\x63\x55\x9b\xea\xb8\xb2\xfc\x3b\xf2\x3c\x55\x3a\x19\x71\x41\x03\xed\x8c\x26\xee\x88\x4b\x95\x7f\x22\x62\xd3\x1f\x3b\xbc\x01\xe2\x5c\x27\x02\x80\x60\x90\x70\x2c\x8a\xb5\x31\xd9\x6f\x31\x39\xf2\xb7\x80\x96\x12\x3d\xc9\xa5\xe4\x89\xd3\xad\x91\x8e\x8b\x26\x3c\x0f\x79\xb5\xa0\x62\xe1\x78\x26\x3b\xd5\x37\xac\x16\x12\xf3\x57\x3a\xd5\xda\x00\xfb\x74\x17\x33\x45\xbf\x09\xca\x7f\xc8\x5e\xb7\x47\xd9\x9e\x52\xcc\xff\x9c\x85\x58\x39\x9a\x88\xca\x16\x31\xce\xa7\x4a\x5c\x35\xb7\xc1\x91\xf2\xff\xab\xbb\x54\x2b\xf8\x66\x98\x3b\xb9\xd5\x61\x6d\xb4\x60\x8c\x26\x2b\x10\x7d\x16\x0c\xba\xcb\x2d\xc1\x13\xdd\x07\xc0\x58\x58\xc7\x8c\x06\x6a\xf2\x47\xd4\x2b\x6a\x8d\xec\xf7\xd7\x5f\x3e\xeb\x90\xc5\x18\xa3\x84\xc5\x77\x25\x6a\x39\x31\xf8\x5a\xb2\x36\x85\x7c\x38\x45\xd0\x43\x6c\x38\xc6\xbe\x40\xa5\x13\x15\xd9\x2f\x37\xc7\xcf\x54\x63\x30\x82\x5c\x86\xff\x3a\xb5\xa0\x0b\xe5\x5d\x35\xef\x94\x20\xa0\xe1\xde\x52\xf8\x6c\xdb\x49\x35\x78\x49\xd9\xd3\xe3\xc6\xc1\x2e\x4d\xb6\xc1\xeb\x15\x5b\x72\xcd\x49\xdc\xf9\xac\xb8\xd9\x51\xfc\xc5\x32\xf1\x8b\x1e\x6a\x71\xf3\xf5\xc9\x6a\x69\x1f\xc6\x86\x16\xe4\x09\x6a\xf1\x44\x7d\x7d\x71\x2d\x49\x52\x33\x91\xcd\x42\xc0\xc6\xb1\xdf\xcb\xf9\x0b\x3a\x31\x77\x69\x73\x27\xe6\x40\xeb\x70\xc4\x67\x1d\xbe\x2f\x08\xda\x9f\x74\x7a\x93\xe9\xee\xa4\x6f\xc4\x2f\xa5\x73\x9e\x46\x9f\xbd\x11\x1b\xb3\x4e\xa7\x17\x8e\xc9\xf5\xb4\x63\xba\x66\xd9\x8d\x3e\xa0\xc5\xd9\xcc\x75\xa0\xeb\x0a\xa7\xbb\xd7\x43\xe3\x9e\x77\xe0\x74\x7a\x9d\x43\xa7\xdf\xed\xee\x3b\x07\xfd\xbd\x9e\xe3\x8d\xf7\x76\xdc\x5e\xa7\xb7\xeb\xf6\xf6\x2c\x50\xe2\xdb\x7e\x40\x6b\xd4\xed\xf7\xbd\xc3\xc3\xae\xd3\x39\x40\x23\xa7\xdf\xdf\xef\x39\x07\xc8\xed\x3a\x68\xd4\xd9\xe9\xbb\x7b\x87\xbd\x9d\xee\xc8\xec\x1f\x31\x7f\x00\x5a\x63\x4a\x1d\x1b\xbe\xed\x6b\xc8\xdb\xd0\x0d\x50\xdb\xa5\xc1\xa0\xdf\xdf\x69\x15\xe2\x29\xeb\xf9\x51\x83\xfc\xce\xf5\x81\x4f\x26\x9d\x9d\x2e\x47\x87\x37\x0d\xc8\x47\x9d\xde\x6e\x6f\x6f\x17\x39\xf0\xe0\x00\x3a\xfd\xfe\x78\xe4\x1c\xf4\x77\x3b\x0e\xf2\x3a\xdd\x0e\x1a\xed\x8d\xdc\x5d\xb7\x8e\x7c\xcf\xdd\x85\x07\xbd\xc3\x03\x67\x84\xbc\x7d\xa7\xdf\xeb\x21\xe7\xe0\xb0\xbf\xef\x8c\xf7\xc6\x1e\xdc\x3b\xec\x1d\xf6\xc6\xe3\x32\xf9\x23\xc8\x62\xf2\x7b\xc1\xd8\x85\x9d\x4e\x4f\x1c\xde\xec\xf3\x49\x9b\xb3\x2a\xf2\x93\x53\x92\xc5\xc0\xb9\x7c\x38\x13\xb4\xec\x51\xbb\xf5\x7c\xac\x2d\xf6\x4c\x83\xa7\xfc\x37\x19\x41\x2e\x50\xe4\xa5\xb7\x71\xb0\xa2\x26\xf7\xc9\x08\xe6\xbf\x82\x9b\x86\xcd\xf9\xa1\xae\xd1\xbc\xb8\x1c\x93\x4d\xeb\xd6\xc5\xe5\xf9\xe9\xd9\xf3\x7c\x70\x61\x75\x24\xd3\x1e\xbf\x5e\xbc\x3a\x2b\x5c\x75\x14\x47\xe5\xa5\xad\xe1\xda\x08\x21\xce\xcf\xa8\xb7\x67\xc6\xcd\x1b\x45\x3c\xe2\x26\xca\xe7\xac\x3a\x8f\x5a\xa8\x6f\x51\x09\xb9\x61\x72\x6a\x38\x5f\xf2\x07\xbd\xa1\x8f\x84\x40\x6c\x78\x13\xa1\x22\x99\x8a\xbb\x52\xe0\xfc\x9b\x42\xba\x28\xdd\x19\x5f\x2a\xf5\x64\xb9\xf1\xd5\xa8\x64\x58\xa4\x81\x2a\xea\xab\x5b\xf9\xbc\x4c\x7b\x34\xee\xb5\x29\x9b\x6c\x87\x8c\x8e\xb1\x8f\x5a\x12\x7f\x1d\x7d\x3b\xc9\xa3\x0a\x5a\x2a\x2f\xea\xad\xa2\x47\x76\xb0\xd0\xb4\x3a\xa2\x59\xe9\x59\x1e\xd7\xea\xeb\x64\x2d\x49\xba\x56\xb7\x63\xac\xe1\xf8\x6a\xae\xc2\x65\x99\xf5\x79\x2d\x7d\x89\xec\x76\x0e\x8e\xba\xc2\x10\xb4\x8e\x5f\x9d\x9d\x9d\x1c\x5f\xbe\x3a\x77\x5e\x3e\x7f\x79\xe9\xe4\x9a\xc4\x17\x17\x82\xd6\xc5\x9c\xb8\x53\x46\x09\x8d\x38\x80\xae\xae\x19\xe5\x80\x50\x91\x9d\xba\xd1\x79\x73\xc8\xe7\xc4\xfd\x49\xae\xe9\xf2\xfd\x46\x85\x9b\x0d\x41\xab\x8b\xdf\x9e\xe2\xe0\xe6\xb9\xcb\x9e\x46\x2f\xf6\xba\xf0\xcd\xe7\xd3\x7f\xdf\xfc\x7c\x79\x73\x76\x0e\x53\x2e\x9d\xea\x3c\xf4\xef\x11\x62\xf3\x06\x9c\xea\xad\x89\x53\xbd\x85\x8c\xea\x59\xf8\xf4\x5f\x43\x38\x9e\xa9\x7b\x22\xa4\xdf\x15\x42\xc6\x51\x6e\x17\x66\x00\xde\x10\x18\x7f\xde\x4b\xa5\x5a\x74\x9e\x25\xa9\x8f\x50\x65\x13\x30\xc4\x43\x9d\x8e\x8c\xaf\x50\x18\x80\x12\x06\x83\x25\xc6\xcb\x8e\x47\xb9\xd4\x8f\x02\xa2\xdd\x42\x39\x52\x9c\x66\x07\x8f\xb1\xf7\xb8\x0d\x2e\x6c\xed\xd4\x7e\x94\x39\x9a\x34\xa0\x94\x3c\x89\x77\x89\x5d\x9f\x46\xde\x30\xde\xcb\x60\xc9\x53\x5d\xc8\xd2\x06\xbf\xeb\x3d\x05\x3d\x91\x03\x80\x3d\xf0\x13\xe8\xf6\x76\x2a\xa5\xc2\x7f\xfb\xf4\x79\x34\x1f\x9d\xb2\x13\xf2\x99\x1d\xa1\x60\xbf\xd7\x9f\xdc\x5c\x5f\xe3\xa7\xb3\x44\x2a\x8a\x77\xe5\xda\x24\xa1\xdf\xe9\xaf\x45\x12\xf6\x17\x09\xc2\xbe\x65\xbd\x34\xb9\x70\x37\x26\xa6\x5b\xbc\xc4\xd6\x4a\x4c\xb7\xb3\x1e\xb1\xde\x5d\x28\xd6\xbb\xcd\xc9\x99\x42\x0e\x46\x08\x91\xa4\xb4\x32\x9d\x1e\xeb\x07\xb8\x6d\x74\xed\xdf\xdf\x14\x65\x1b\x69\x2a\x09\x87\xbd\x9f\x1e\x77\xf1\x6f\x3b\x5e\xf4\xc7\xbb\xd3\xd9\x6c\xf7\xdd\xec\x85\x3f\xff\xd2\x0d\x9e\x9f\xef\xfc\x3a\xbf\x39\x7b\xac\x94\xdd\x98\x46\x66\xb5\x7d\x49\x9d\xbd\x7b\xb5\x3f\xe9\x4d\xf6\x7e\xb9\xf4\xde\xfc\xf6\x06\xf6\xae\xf9\x2f\x07\xbd\xeb\xdf\x9f\xee\xcc\x13\xce\x14\x6f\xc2\xb6\x2a\xfb\xee\x7a\x74\x7d\x77\xa1\xaa\xef\x5a\xd8\x92\x29\xa6\x19\x62\x78\x3c\x07\xbf\xbe\xbd\xd4\xf7\x6b\x0f\xc0\x79\x1c\xfb\x00\x18\x89\x29\x65\xf8\x4b\x72\xcf\xdf\x35\x22\xcd\xf8\xb3\xf3\x66\x7a\x32\xfd\x14\xfc\xf9\x73\xf8\xf6\xf5\xf8\xb4\xe7\x9f\xa1\xeb\xd0\xeb\xff\xfb\x69\xc2\x9f\x43\x69\x7c\x8f\x29\x19\xfb\xd8\x15\x0d\x78\xb5\xb3\xb7\x16\x5e\x99\x60\xec\xbc\x32\x5b\x98\x22\xa4\x0f\x98\x6a\x5d\x8a\x39\x80\xbe\x72\xd4\xd4\x39\xc8\x4a\x3e\xec\x5d\xbf\xeb\xbc\xc1\x27\xd7\x5f\xae\xff\x3c\xfe\xf2\xf6\x35\x3a\xed\xd1\x77\x68\xea\xed\x9c\xc4\x6c\x28\xdf\x6b\x6d\x23\xfd\x70\x2d\x94\x1f\x2e\x22\xfc\xd0\x2a\x23\xf1\x25\x38\xc9\xc5\xd8\x35\x53\x8e\x4e\x5e\xcc\x9e\x1d\x7e\x7c\xf9\xfb\xbb\xbd\x77\x93\xe9\xf8\xe5\xe1\xe4\xf9\x39\xff\x65\x76\xf2\x36\xa5\xb5\xb1\xb2\xb8\x3f\x8a\x4d\xbb\xae\xc6\x4c\xeb\xcb\x81\xf4\x77\xb8\x0c\xe2\x5e\x1d\xbf\x74\x4e\xfe\x74\x0e\x07\xf1\x0d\x51\x72\x09\x69\xbd\x98\xb5\x41\x9f\x85\x13\x5b\x73\x18\x62\xa7\x8b\x3f\x77\x76\x7c\xe2\xf9\xc1\x4d\xe7\x66\xec\xee\x73\x2c\xe0\x2e\xf7\x3f\xce\x0e\xcc\x88\x68\x6c\x7c\xa4\x51\xf2\xa1\x3b\xd9\xf5\x0e\x0e\x6e\x3a\x3e\x73\xbd\x59\x7f\xb2\x0f\xfd\xd1\x3e\xf7\xc7\x13\xf2\x71\xc7\x9b\x8e\xf8\xc7\x7f\xfc\x9f\xef\x4e\xfe\xbc\x3c\x3f\x02\x3f\x68\x8a\xdb\x0a\xe3\x9f\xb0\xfa\x50\xfe\x18\xe7\xf2\x11\x98\x83\xc7\xfd\x4e\xff\xf1\x13\xc5\x0b\xf5\xe7\xf1\x8b\x37\x17\x97\x27\xe7\x17\x9a\x19\xf2\xa5\xda\x57\x4f\x27\x16\x64\x80\x54\xfb\xee\x64\x97\xb2\xdd\xce\x0c\x47\x9d\x7d\x8a\xe4\xb4\x4d\xd9\xb5\xdb\xdb\xf3\x26\x63\xf1\xb1\x0b\xdd\xc7\xa6\xdb\x10\x6f\x55\xab\x5e\xb5\x44\x18\xfa\xf6\xfb\x1a\x7d\x72\xc9\xdf\xb2\xf9\x1e\xe1\x37\xa3\x1e\x3f\x0b\x9e\x7d\xdc\x1d\xfd\x19\x3e\xdd\x3f\x86\xad\xad\xff\x0d\x00\x00\xff\xff\x7e\xd1\x9a\x49\xe8\xd4\x00\x00")
+var _connector_mgmtYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x3d\x6b\x77\xdb\x36\xb2\xdf\xfd\x2b\x70\x95\xbd\x27\x4d\xd7\x92\x25\xf9\xed\x73\xbb\x7b\x5c\xdb\x49\xdd\xc6\x4e\x6a\x3b\x4d\xbb\x39\xb9\x32\x45\x42\x12\x63\x8a\x94\xf9\xb0\xad\xee\xde\xff\x7e\x31\x00\x48\x02\x20\xf8\x92\xe5\x47\x1a\x66\xcf\xee\x26\x22\x1e\x83\xc1\x60\x5e\x98\x19\x78\x33\xec\x1a\x33\x7b\x0f\xad\x77\xba\x9d\x2e\x7a\x81\x5c\x8c\x2d\x14\x4e\xec\x00\x19\x01\x1a\xd9\x7e\x10\x22\xc7\x76\x31\x0a\x3d\x64\x38\x8e\x77\x8b\x02\x6f\x8a\xd1\xf1\xe1\x51\x00\x3f\x5d\xb9\xe4\x17\xda\x1a\x3a\xb8\xc8\x63\xc3\x21\xcb\x33\xa3\x29\x76\xc3\xce\xca\x0b\xb4\xef\x38\x08\xbb\xd6\xcc\xb3\xdd\x30\x40\x16\x1e\x91\xe1\x2c\x34\xc1\x3e\x46\xb7\x36\xf9\x36\xc4\xc8\xb2\x03\xd3\xbb\xc1\xbe\x31\x74\x30\x1a\xce\x61\x26\x14\x05\xd8\x0f\x3a\xe8\x78\x44\xc6\x87\xb6\x30\x01\x87\x8e\xcc\x8b\xf1\x8c\x41\x92\x8c\x4c\x66\x6a\xcd\x7c\xfb\xc6\x08\x71\x6b\x15\x19\x16\xac\x02\x4f\xa1\x31\xf9\x7f\xd4\x32\x3d\xd7\xc5\x66\xe8\xf9\x83\xe9\x78\x1a\xb6\x79\xcb\xce\xdc\x98\x3a\x2d\xb2\x4e\x07\xaf\xd8\xee\xc8\xdb\x5b\x41\x28\xb4\x43\x07\xef\xa1\x83\xb8\x03\x3a\xc7\xfe\x8d\x6d\x62\xf4\xda\xc1\x38\x44\x27\x86\x6b\x8c\xb1\x4f\x1a\x12\x80\x03\xdb\x73\xf7\x50\xb7\xd3\xeb\x74\xc9\x0f\x16\x0e\x4c\xdf\x9e\x85\xf4\xc7\x92\xfe\x6c\x3d\x67\x98\xe0\x77\xff\xfd\x31\x80\x39\xa5\x1f\x50\x02\x68\xd0\x59\x21\x28\x80\x49\x00\xaa\x36\x8a\x7c\x67\x0f\x4d\xc2\x70\x16\xec\xad\xad\x11\x24\x77\x00\xd9\xc1\xc4\x1e\x85\x1d\xd3\x9b\x92\x26\x0a\x00\x27\x86\xed\xa2\xef\x66\xbe\x67\x45\x26\xfc\xf2\x0a\xb1\xe1\xf4\x83\x05\x21\x99\xbc\x6c\xc8\x73\xd2\xc8\x76\xc7\xda\x81\xc8\x38\x8e\x67\x1a\xce\xc4\x0b\xc2\xbd\x9d\x6e\xb7\x9b\xed\x9e\x7c\x4f\x7b\xae\x65\x5b\x99\x91\xef\x13\xd2\x21\x34\x34\x25\x2b\x58\x21\x53\x72\x04\xb8\xc6\x54\xda\x97\x8b\xf9\x0c\x07\xd9\xfe\xad\x96\xae\x75\xe5\x86\xe8\xc0\x89\x82\x10\xd7\xe8\xc0\xf7\x57\xdb\x7e\x65\x66\x84\x13\x0a\xff\x0b\xf8\x2f\xd2\x76\x7b\xb1\x42\xfe\xa7\x05\xdb\xb0\x26\x93\xe9\xda\x4d\xaf\xb5\x47\xc7\x1d\xe3\x90\xfd\x85\xd0\x27\x47\x08\xfb\xd3\xce\x01\x04\xc1\x59\xf4\x0d\x00\xe4\xd8\xda\x83\xfe\xbf\x31\x72\x3d\xc1\xa1\x61\x19\xa1\xc1\x5b\x05\xd1\x74\x6a\xf8\xf3\x3d\x42\x8a\x61\xe4\xbb\x01\x3d\x2d\x9c\xb2\xd1\x54\x6e\x2b\x2d\xae\x42\x7b\x1f\x07\x33\xcf\x0d\xb0\x00\x6e\xab\xdf\xed\xb6\xd2\x7f\x22\x20\xf7\x90\xec\xb6\xf8\x13\x42\xc6\x6c\xe6\xd8\x26\x05\x7e\xed\x4b\x40\x66\x93\xbe\x12\xa0\x4d\x72\xb4\x0d\xf5\x57\x84\xfe\xe6\xe3\xd1\x1e\x7a\xf9\x82\xa0\x71\x4a\x66\x26\xe3\x06\x6b\xac\x6d\xb0\xa6\x2c\xff\xa5\xd0\x59\x5a\xd7\x6f\xea\x5a\x92\xbd\xcb\x52\x5e\xd1\xc6\xad\x5d\x19\xa3\x2b\x63\x90\xfe\x1e\x42\xa7\xb5\x7f\xcb\x3f\x0c\x6c\xeb\xff\x38\x3e\x66\x86\x4f\x08\x2b\xe4\xe7\x9d\xed\x2d\x23\xb5\x4c\x97\x15\x2d\xe4\x17\x64\x27\x6c\x0b\x79\x94\x63\xa6\x9d\x10\x74\x5a\xc9\x47\x1d\x7c\xde\x43\x41\xe8\x93\x93\x9d\xfc\x6c\x93\xf1\x80\x74\x93\x1f\x7c\x7c\x1d\xd9\x3e\x26\xa4\x14\xfa\x11\xae\x4e\x93\xe9\x21\x25\x73\x63\x72\xb6\xed\x70\x2e\xb6\xfc\x11\x1b\x3e\xf6\xf7\xd0\x27\xf4\x39\x87\x6e\x93\xb1\x60\xa8\x1f\xe7\xc7\x87\x2a\xe5\xbe\x21\x5c\xd5\x50\xd6\x0b\x52\x24\xc1\x93\x84\xa5\xd2\xd6\x4f\x44\xb5\x2d\x2d\xd5\x4a\x8b\x6f\x29\x5d\xf1\x9d\x31\x9d\x39\x22\xa0\xf1\x1f\xa9\xdb\x11\x6b\x96\x6d\xa5\x9f\x3a\x1e\x75\x4d\x37\x48\x2b\xef\xd8\x5c\x64\x48\x8e\x08\xb4\xd0\x9c\x80\xb8\x00\x72\x04\xfa\xc1\x94\xf3\x73\x94\x6e\x74\x7b\x4f\x83\xd2\x23\xdf\xf7\xfc\xea\xa8\x24\x70\x2e\x8a\xc0\xb4\x6b\x2e\xda\xf6\xa3\x70\x42\x84\xff\x15\x76\x41\x21\xb0\xdd\x1b\xc3\x11\x8e\x37\x41\xd2\xc6\x57\x82\xa4\x8d\xc5\x91\xb4\x51\x86\xa4\x53\x2f\xa5\x25\x85\xc6\xf0\x9d\x1d\x84\x81\x80\xb0\x5e\xf7\x2f\x8f\xb0\x5e\xb7\x0c\x61\x07\x32\x92\x2c\x0f\x07\xee\xcb\x90\x21\x8b\xa8\xe9\xf3\xa9\xe7\xa7\x12\xa1\xb5\xd9\xfd\x3a\x70\x46\xe0\x5c\x14\x67\x69\xd7\x5c\x9c\x7d\x70\xf1\xdd\x8c\x20\x8d\x18\x18\x18\xe0\x42\x9e\x49\x35\x51\xab\xb6\x8c\xaf\xa3\xb2\x2d\x59\x3c\x06\x79\x5a\x9d\x41\xac\x38\xb2\xf7\x44\x37\x90\x0f\x50\x50\xa4\xda\x95\x75\xca\x6a\x2c\x00\xb2\x6e\x23\xd2\x96\xe4\xaf\x63\x61\x13\x4a\x9b\x07\xf6\x9f\x75\x9a\x7b\xbe\x85\xfd\x1f\xe7\x75\x26\x20\x18\x36\x27\xad\x67\x2f\xfc\xdf\x92\xad\xc8\x17\x23\x25\x3b\xd5\xc8\xdb\x6a\xf2\xb6\x61\x85\xa5\xac\x50\xb1\x85\x6a\x5a\x41\x31\x73\x9c\x81\x97\xa0\x8c\x3b\xde\x83\x31\x9a\x3e\x36\x42\x2c\x42\x29\xb1\xc5\x03\xfa\x99\x3a\x94\x6e\xd3\x23\xa3\xe3\x85\x85\x2d\xf5\x0c\x10\x6c\x27\xa2\xec\xfa\x73\x01\xbf\xcc\x90\x33\x82\xb9\x6b\xe6\x61\xfd\x3d\xf6\x47\x9e\x3f\xa5\xda\xb2\x41\x3d\x36\x64\x24\x70\xaa\xd1\x5e\x13\xdf\x73\xbd\x28\x00\x2f\x91\x8b\xfd\x95\x62\x6a\x63\x26\xdd\xd0\xf3\x1c\x6c\xb8\xc2\x17\x8d\x11\x87\x62\xcd\xfc\x47\xcf\x12\x10\x9c\xa3\x4e\x08\xc6\xbd\xf6\x70\x14\x1f\x0d\xfd\xc1\xa8\xc4\x01\xcf\x18\x90\xf2\x09\xc9\x3b\x1f\x49\x2f\xb6\x79\xb9\x27\xa5\x9a\xf5\x23\x0d\xd2\x5a\x29\xc1\xa5\x4e\x7c\xf4\x9f\x58\x7c\xe4\x73\x43\xd3\xc4\x33\x72\xcc\x45\x29\xf1\xb5\xe8\xcf\x5d\xba\x2f\x04\x84\xc5\xa5\x85\x3a\x44\x2e\x9e\x7e\x03\x29\x41\x5b\x32\x86\x18\xa4\x1c\xb1\x91\xaf\x8d\x3d\x5b\xd7\x9e\xbd\x48\xfd\x21\x44\xc4\x12\x9e\xe1\x45\xbe\xa9\x98\x69\x8d\x4e\xa2\x10\x96\x8b\xa2\x3c\xb5\x84\x49\xfb\xd8\xd3\x24\x0b\xe9\x2a\x56\xd8\x3d\xf4\x0c\x50\xbb\xb3\xe3\x34\xd6\xd7\x5f\xc9\xfa\xaa\x6b\x79\x35\x46\x57\x63\x74\x3d\x8d\xff\x29\x58\xbb\x61\x9a\x0a\x7e\x24\x33\x2b\x9e\x2e\xd7\xd0\xe2\x9a\x13\x96\xae\x5c\xe4\xbb\x00\x09\x0b\xff\x68\x27\x93\x17\x75\x45\xb7\x76\x38\xf1\xa2\x30\x87\xf3\xaf\x26\x83\x40\x24\xc1\x8d\xed\x39\x14\x62\xc2\x53\x7d\x90\xbc\xc0\x5f\x09\x9a\x61\x0c\xda\xef\xe7\xf3\x77\xa7\x88\x46\x11\x60\x9f\xde\x7a\xc5\x77\x68\x9c\x54\xd1\xc8\xc6\x8e\xf5\x55\x9b\x4b\x35\xac\x95\xa7\x66\xb7\xa9\xb2\x7d\x86\x83\xc8\x09\x0b\xd5\xa8\x9b\x54\x33\xf7\x69\xeb\xec\xf5\xa7\xe6\xe6\xa9\xb1\x71\x90\x26\x5c\xc4\x01\xf7\x03\x68\x53\x45\xa8\x6b\xe4\x59\x23\xcf\x1e\x51\x9e\xfd\xbb\x38\x3e\xa2\x44\xbb\xb4\xad\xd6\x63\x18\x01\xe2\x2d\x4c\x49\x70\x42\x85\x88\x84\x67\xcd\x9c\x2b\xde\xff\x37\x57\xff\x8d\xab\xe4\x01\xaf\xfe\x9b\x5b\xff\x45\xdd\x4a\xcd\xed\x7f\x5d\x69\xc5\x9a\x3a\x44\xa0\x3c\xa4\x08\x61\x33\xe4\x4a\x91\x43\xfa\xb9\x4c\x90\xe4\xb6\x7a\x56\xd7\x12\xef\xe2\x75\x8b\x9b\x31\xc1\x86\x25\x79\xb2\xe0\xcf\x5b\x8f\x4d\xad\x8e\x9f\xa1\x7a\xd1\x66\x4b\xd0\x4a\x2c\x1d\xc3\xbc\x8a\x85\x10\x45\x30\xf9\xb5\xe2\x02\xb4\x41\x91\x3a\x74\xc3\x44\x06\xbf\x46\x59\x65\x53\x12\x1a\x1a\xce\xb9\xe4\xe3\xa6\x66\x02\x54\x23\x04\xff\xaa\x42\x90\x1d\xbe\x7b\x88\x42\x69\x80\x22\x81\x48\x95\x64\xc9\x13\x82\x02\xc2\xbb\xec\x91\x4d\x28\xed\xf8\x30\x23\x1d\xbf\x22\x0e\x7f\x3f\x24\xaa\x03\x2c\xc8\xed\x67\xa0\x70\x3c\x24\xb3\xa7\x13\xe4\xf2\xfa\xf7\xf0\xb5\x8c\xd5\xe7\x35\x2a\xf7\x4e\x1d\x1a\xa1\x01\xe9\x26\x14\x08\xc5\x55\x02\xb4\x54\xd5\x5f\x35\xc5\xfe\x18\xb7\xe9\x28\x7f\xaf\xea\xbb\x62\x5c\xd5\x1b\x7e\x21\xd3\x15\xb8\xc1\x6a\x8e\xaa\xf8\xe3\xa9\x37\x8f\xe2\x67\x15\x9d\xbd\x3e\x40\x5b\xbb\xdd\x3e\xd9\x93\x38\xd9\x25\xf4\x3c\x27\xe8\xd8\x38\x1c\x75\x3c\x7f\xbc\x36\x09\xa7\xce\x9a\x3f\x32\xa1\xd5\x62\xd0\x2e\xdf\x69\xf7\x97\x8a\x31\x68\x0c\xc3\xc6\x30\x7c\x60\xc3\x30\x31\x75\x1a\xbb\xb0\xb1\x0b\x9f\xab\x17\x73\xcd\xc7\x37\x36\x64\x96\x05\xa5\xf9\x5e\x35\x13\xbc\x1e\x22\xb7\xab\x66\x28\x41\x8d\x40\x82\x47\xf4\xc5\x9e\xc5\x18\xd7\x39\x65\x39\x0a\x47\xf6\x38\xe2\xf6\xe2\x84\x9c\x03\xcf\x9f\x03\x7e\x2b\x78\x6c\xb3\xfd\x93\x0d\x56\x46\x58\x45\x8e\x11\x82\xaa\x1e\x37\x60\x79\xd5\xcf\xda\xcf\x1b\xa3\xae\x30\xf6\xe1\xa2\x18\x05\x7a\x22\x6d\x84\x7c\x23\xe4\x97\x2a\xe4\x1b\x41\xb5\x6c\x41\xe5\x39\xce\xd0\x30\xaf\xbe\x06\x39\xf5\xd0\x01\x2e\x31\x2e\x72\xad\xf5\x33\xde\x40\x8a\x52\x91\x98\xa2\x3e\xc6\xaf\x0d\x14\x39\x57\xec\x6f\x99\x99\x82\x14\x71\x11\x81\xcf\xb1\xb1\x9f\xb0\xd6\x55\x58\x86\x8f\x43\x16\xcc\xe2\x7a\x21\x85\x11\x7c\x8e\x04\x8a\x05\xf3\x12\xe2\xb1\x8b\x35\x48\x2e\xbc\x42\x2f\xc1\x0a\xf9\x7b\xa5\x4c\x04\x88\xac\x19\x4b\x59\x0b\x08\x41\xb0\x81\x11\xd2\x6f\x5b\x1b\xe5\x39\x0a\x5f\xb1\xcd\x9b\xc4\x19\x65\xb6\x37\x14\xf0\xda\xc4\xa7\x34\x31\xf8\x8d\x6a\xd1\xa8\x16\x5f\x77\x3a\x60\x5c\x3d\xa7\x6e\x71\x14\x93\x17\xdd\xa9\x13\xb7\x2a\x57\xea\x29\x4e\x00\x4c\xc1\xaa\xae\x08\x94\x64\x0b\x22\x53\x1a\xb3\x42\xd6\xa0\xd2\xe3\x9b\xcb\x1e\xe4\xcb\x7f\xba\xb0\x58\x4e\x05\x0b\x26\x13\xb2\xce\xcb\xc9\x29\xd4\x8c\xf5\x55\xa6\x16\xf2\x85\x34\x19\x86\x8d\x76\xd3\x68\x37\x4d\x86\xe1\x37\x96\x61\x28\x09\xf4\x4a\xf5\x5e\x14\x95\xe5\xbe\x19\x87\xea\x70\x55\x12\x0f\x4d\xb9\x4f\xe5\xdc\x43\xa5\xdf\x63\xa7\x1f\x3e\x4f\x3f\x39\xdf\x80\xda\xc5\x59\x14\x64\x36\xdc\xbd\x49\xad\x78\xe4\x52\x55\x31\x05\x8a\x15\x29\xf9\x6f\x35\x8b\x52\xa6\xbd\xea\xd5\xa5\x94\xad\xa1\xc7\x2f\x4d\x79\x7f\x5e\x2c\x5e\x36\x2a\x16\x66\x5e\x71\xca\x02\xa3\xb1\xb8\xe9\xb3\xe6\x7f\x15\x3d\xa1\xb1\x01\xd8\x44\x01\x35\x7a\xee\x03\xa6\x87\xc4\x64\xd6\x54\x88\x6c\x62\x82\x1e\x3d\x57\x64\x16\x3d\x8a\xe8\x89\x66\x96\xc6\xbf\xf9\xe3\xfc\xd8\x52\x25\x50\x64\xcd\xd4\x2c\xfa\x02\x21\x54\xda\xba\x7a\x84\x31\x03\xd1\x5a\x30\xbe\xf8\x51\x1c\x7f\x35\x3c\x6d\x32\xbb\x95\x3d\x9c\x9c\xdf\x04\xa1\x11\x46\xf4\x29\x04\xbe\xf4\x46\xa6\x35\x32\x6d\xc9\x32\xad\xb9\xa2\x7a\xb0\xf4\xbd\x25\x70\x65\x25\x8d\x2f\xc7\x26\xc8\xe6\xe9\x15\x71\xe4\xd2\xd6\x55\xf8\xd5\xd3\x91\x85\x66\x2d\x0d\x5f\x6c\xb2\xe0\xee\x9d\x05\x97\xe8\xac\x4d\x02\xdc\x32\x13\xe0\x96\xe7\x41\x5a\x33\x2c\xcb\x73\x07\xa9\x07\xe9\xeb\x76\x29\xa5\x60\x12\xca\xc3\xe1\xc0\x24\x9f\x09\xf6\x6d\xc3\x09\xf4\x30\x9e\x41\xb3\x20\x11\xdc\x01\x7f\x05\xca\x30\x4d\x2f\x72\x69\xe5\xaa\xb8\x3f\xba\x9d\x60\x57\x9c\x29\x1f\x70\xf5\x56\x3e\x1b\x2d\x90\x82\x3e\x22\x43\x3f\xb5\x3b\x6c\x1f\x68\xe0\x7d\xb2\xe3\x15\xbd\x63\x2f\x03\x44\x89\x47\xa0\x95\x1a\x0e\xb3\xfc\xde\xcf\xca\x87\x26\xa3\xa6\x34\xd0\x3e\x5d\x0c\xa1\x79\x23\x44\xc1\xc4\x8b\x1c\x0b\x5e\x4f\x8b\x02\xf6\x28\x5a\x1c\x5e\x88\xe9\xa1\x60\xcf\x89\x89\xd6\x17\x43\x0a\x44\x70\xc2\x99\x61\xb8\xea\x34\xa2\xb8\x31\x51\x1a\xb7\x5b\xe3\x76\x6b\x6e\xbd\xa8\xce\x02\x12\x3e\x98\x19\x26\xfe\x0b\x68\x2b\x0f\x93\xbf\x57\xbf\x0c\x70\xad\x22\xc0\x4f\xa7\xaa\x9c\x26\x5b\x5f\x5d\x4b\x71\xd5\x3e\x15\xf5\x93\x4c\xbf\xe7\x79\xbb\x97\xa0\xa4\x54\x3b\x49\x17\x84\x20\x9d\x01\xde\x71\x05\x0f\x70\x00\x0f\x9d\x36\x0a\x47\xa3\x70\x3c\xbe\xc2\xd1\x08\xcd\xda\xb1\xfb\x12\x07\xac\x15\xbe\x9f\x11\x9b\x95\xd8\x78\x96\xe3\xde\x33\x1c\x2e\x9f\x85\x17\x05\xb6\x15\x33\xf1\x5a\x3d\x9b\x9a\xfc\xcf\x4a\x32\xed\x57\xd9\xb3\x46\x12\x35\xb1\x77\x8f\x6c\x85\xa4\x34\x28\xda\x21\xc9\xaf\x35\xe3\xef\xc4\x7e\xf5\x0c\x90\xa4\xe7\x93\xc5\xe0\x2d\x43\x04\x88\xba\xfc\xa9\xb2\xa2\x5c\x1d\x5e\x5d\x7a\xa1\xe2\xae\x36\x7e\xe6\x3c\xb1\x62\x34\x5e\xb2\xaa\x26\x1e\xaf\xd1\xd3\x1f\x52\x4f\x4f\x09\xad\xd1\xd4\x1f\x4d\xb0\x60\x42\xa0\xb5\xb2\x69\x33\xac\x58\x93\x4f\x7b\x44\x06\x8d\xe8\x6f\x19\x46\x7b\x8f\x94\xda\x60\xe2\xf9\x21\x51\xd5\x6e\x60\xed\xc9\x0c\x55\x99\xb5\x34\x54\xa5\xee\x75\x72\x56\x53\xda\x7d\xb2\xac\xd5\x04\xd5\x80\xfd\xc5\x72\x57\xa5\x21\x96\x92\xc1\x9a\x3f\xe2\x57\x99\xc7\x5a\x2e\x3b\x9b\x4c\xd6\x26\x93\xb5\xd1\x28\x9a\x4c\xd6\xbf\x68\x26\x6b\x2a\x23\x13\xc7\x60\x52\xa8\xbf\xd4\x1d\x98\x68\x0a\xb5\xdc\x7f\xe7\x2c\x22\x26\xc7\xa4\x93\x66\x5f\x40\xc3\xc8\xfa\xed\x68\x69\x0c\xb1\x1a\x46\x0a\x76\x1c\x15\x11\xd3\x57\x52\xbf\xd0\xf3\xc7\x86\x6b\x07\xb4\xd5\x2a\x9a\x7a\xb4\x8a\xa3\x49\x76\x44\xaa\xe1\x58\xe2\x30\x7c\xb8\x89\xbf\xe9\x24\xdc\x84\x42\x2a\xfa\x00\x3d\x95\xa2\x1a\x49\xd4\x78\xfe\x1e\xd8\x40\x4b\x69\xee\x11\x9f\x32\xab\xca\x59\xef\xc9\x58\xdd\x1c\xa6\x06\xaf\x9f\x1c\x1f\x16\xb2\xc6\xe2\xae\xab\x64\x32\x78\xdd\x20\xa0\x9c\x30\x9a\x8d\x7d\xc3\xc2\xfa\xb2\xbc\xcf\x93\x21\x15\x6a\x33\xe9\x62\x1b\xb7\x5b\xa3\x24\x2f\xdd\xed\x96\x92\x57\xe3\x67\xab\xc9\xc6\xd3\x11\x61\x62\x0e\xfd\x1e\x7b\x8e\xe3\x05\xfb\x5f\xc2\x64\xa7\x53\xce\x39\x5f\xb0\x2f\xa0\x7d\xec\xad\x28\x1e\x0f\x81\x95\x5e\xd9\xae\x25\xfc\x13\xb4\x2f\xe1\x9f\xa0\x5d\x09\xff\x0c\xbd\xd0\x70\xc4\x6a\x70\x21\x9e\xc6\x5b\xa8\x79\x8f\x64\xe6\xc3\x76\x87\xb6\x88\x46\x98\xaf\xf4\x02\x07\xa0\xc8\x36\x52\xeb\xb4\x02\x70\xe5\xad\x28\xcc\xf9\xcd\xe8\x07\x4a\x02\x71\x1b\xc3\x71\xde\x8d\xca\x14\xd6\x84\xa9\xd2\xf5\x9e\xe1\x11\xf6\xb1\x6b\x4a\x8a\x6b\xce\x03\x2d\x3a\xa4\x30\x7a\xb7\xb0\xfe\x45\x9a\xcc\x3b\x5f\xb0\x93\x86\x86\xfa\x73\x9b\x27\xa7\x6e\x60\x5b\x85\x9d\xe8\x37\x65\x4d\x7b\xf5\x36\xd8\x2e\xdf\xde\x4a\x34\x30\x01\xac\xaf\x94\xc3\x79\x82\x43\xa3\x26\x88\xde\xad\x8b\xfd\x52\x00\x98\x4f\xd9\x1a\x18\x12\x0f\x8a\x0b\x03\x43\x06\x6d\x3b\xb4\xa7\xb8\x6c\x98\xa9\x67\xd1\xd4\x9f\x45\xc7\x61\x4b\x8d\x37\xf0\x91\x88\x34\xcb\x29\x58\xf3\x94\x90\x24\xbe\xc1\xc7\x32\x7c\xa2\xbf\x29\x3f\x42\xd6\x31\xae\x40\xfe\xf2\xc8\x85\x8f\x28\xb5\x2e\x54\x8b\x95\x97\x94\x54\xde\xb7\x4b\x86\xdc\x63\x8f\x92\x0d\xe2\x57\xf6\x56\xe3\xd8\xac\x41\x60\x1a\x0e\x1e\x44\xb3\x55\xe1\xc2\x3a\xd1\xe8\x7c\xe1\xc7\xb8\x6b\xab\xea\x91\x63\xc8\xa8\xe3\x51\xcf\xdd\x25\xca\xeb\x00\x8d\xc5\x58\x99\x61\xd7\x02\x11\x1b\xb9\xa1\xed\x10\x65\xf4\xd6\xf3\xaf\xe0\xc5\x78\xdb\xbc\x0a\x94\xb7\x07\x61\xc1\x21\x64\xf5\xf8\x91\xeb\xa6\x7d\xec\x10\x54\x5b\x13\x63\x0b\x1e\x05\xf4\xd1\xc8\xb0\x1d\x6c\x55\x5e\x32\x91\x9a\x6e\x34\xcd\x4a\x45\x22\x55\x18\x68\x9a\x2f\x1c\x00\xcd\x97\x04\x12\xcd\x37\x06\x98\xf4\x81\xd0\xd5\x98\xa8\xdb\x41\x21\x8e\xde\x63\x1f\x9c\x14\x44\xba\x64\xdf\x63\xb4\xc8\x76\x68\x57\xaa\x2b\x0f\x2e\x15\x08\x5f\x97\x9f\xe6\xc2\xa2\x30\xc9\xc9\xbd\x02\xee\x1d\x83\x00\x8b\x81\xb4\x18\x6a\x41\xb0\x95\xa5\x60\xad\xc2\x3e\x38\x46\x10\x72\x75\x80\x50\x06\xe4\x67\x61\x9f\x91\xb9\x91\xec\x60\xba\x90\xdb\x89\x4d\xcc\x13\xa2\x8e\xfa\x98\xec\x51\x06\x7f\xb9\xdb\xa7\xe7\x77\x65\xbc\xaa\x70\x48\x5e\x68\x60\x99\x43\x92\x83\xe0\xeb\x87\x94\x4d\x19\x32\x64\x7a\x06\x78\x27\x64\x1a\xbe\x3f\xa7\xd8\x8a\x42\x79\xfb\x97\x05\x1e\x42\x6e\xe4\x38\xc6\x90\x68\x87\x72\xca\x1c\x13\xf1\xa0\xfb\x55\x85\x5e\x26\x4f\xcd\xc1\x7c\x30\x98\x65\x91\x23\x6a\x90\xb5\xc4\x8e\xec\xf8\xaa\xad\x10\x51\xe5\x52\xaf\xad\x90\x6d\x34\xe6\xca\x17\x6d\xf3\xca\x16\x31\xed\xc7\x1d\x13\xdc\x53\x41\x7e\x3f\xc7\x21\x38\xa3\x83\x22\x05\xda\x16\xd5\xe7\xc8\x77\xee\xa7\x1a\x91\x01\x8a\x95\x1e\x0e\xe3\x3e\xcb\xd3\x2c\x02\xcc\x74\x6c\xb2\xda\x81\x04\x1f\xff\x8d\xbd\xd1\x50\x00\x69\xd2\xb7\x5c\x4b\x12\x47\x2c\x06\xfd\x37\xec\xc3\x53\x02\xa0\xb0\xc1\x6d\xf5\x23\xa9\x32\x58\x67\xac\x51\x1d\x03\xb5\xf6\xdf\x1f\x73\xa0\x64\x21\x67\xc3\xc7\x9b\x9e\xfc\xe3\x84\x81\xa5\x77\xa5\xb5\x94\x83\xee\x38\x8c\x82\x32\x14\xd9\x66\x83\x53\x85\x24\x68\x65\xc8\xb5\x70\x92\xcc\x8b\x28\xd9\xfe\x7c\x61\x89\xb3\x4d\x75\x3e\xe7\x5b\x1f\xb9\x10\xd7\x3e\x72\xca\x86\x8a\x6b\xaf\xb5\xb5\x92\x65\xcb\xe9\x3e\x10\x6d\xdb\x5f\x00\x1d\xf9\xa7\x55\x62\xa9\x3f\x79\x8e\x15\x68\xde\xb9\x60\x99\xa9\x30\x02\xfc\xd5\x60\x63\xa2\x63\x97\x88\x0c\x02\x44\x67\x11\x1a\xcd\x65\x23\xe9\x46\xbc\xe0\xcf\xe4\xf2\xc2\x00\xa6\xb0\x2f\x69\x9b\x1c\x92\x7e\x21\xef\x22\xe3\x0a\x74\xea\x33\x3c\x26\xdb\xed\xcf\x97\x8c\x12\x3a\x38\x8a\x07\x7f\x04\xdc\xb0\xc6\x84\xa9\xf1\x19\x97\x85\xa5\x98\x96\x68\x6e\xb3\x44\x49\x72\xb6\xb3\x16\x5b\xad\x7d\x35\x6f\xbb\xb5\x74\xc3\x18\xc2\x83\x70\x31\x13\xcd\xe6\x65\xe7\x41\x1b\x5f\x2c\xa9\xd9\xe6\x32\xd8\xe2\xb9\x56\xce\x73\xf5\xf4\xf0\x96\xea\x85\xca\xbe\xb7\x90\xa0\x5a\x4d\x6c\x3b\x17\x2d\x1b\x0d\x56\x64\x9b\xa2\x8d\x2c\x3b\xe0\xd4\x89\x45\xc9\x46\x94\x57\x6b\x2e\x36\xa3\x06\x5b\x8c\xb5\x9c\x52\x5b\xa2\xef\x40\xb7\x65\x34\x96\xb9\x70\x3b\x72\x06\xd6\xef\x09\x3b\xa5\xa0\xa8\x89\x3e\xf5\xb4\x18\x19\x39\x6b\x20\x18\xd1\xcc\x31\x5c\xac\xe4\xe3\xb5\x16\x39\x6d\x05\xcb\x6e\xe9\xe1\x17\x31\xb2\x80\x60\x66\x23\x3f\x14\x70\xe7\xb4\x82\x59\xd1\x86\x05\x52\x8b\x9c\xc3\x59\x24\x07\xb5\x76\x76\x9d\x55\x50\x72\x6e\x95\x1b\x84\xe5\xa4\xb4\x6c\xfd\xa8\xce\x2a\xee\xb3\x8f\x6c\x97\x72\xb6\xf0\xaf\x6b\x4c\x64\x8b\xce\xea\x79\xa2\xf0\xeb\xc1\x04\x1e\x3c\x71\x0a\x98\x9f\x85\x47\x46\xe4\x84\xf0\x2b\xd8\x67\x39\x2c\x91\x7f\x94\x11\x7e\x88\x03\xb0\x08\xea\xb2\xd7\xc8\x35\x82\xc0\x1e\xbb\x85\xcc\x35\x08\xbd\xd9\x4c\x6a\x61\xf1\xd2\x59\x32\x0c\x75\x27\x67\x53\x8b\x12\x31\xfe\x4d\x9a\x8c\x72\x4b\xb9\x55\x39\x84\x8a\xcd\x2c\x48\x88\xec\x2a\x04\xcf\x95\xef\xb1\x07\xd0\xd4\x86\xd2\x07\x85\xd4\x45\x6d\xaa\xf0\xee\x05\x74\x40\x11\x68\xa6\x1c\x0d\x78\x11\x1e\xd1\x6e\x53\x2e\x96\xb5\x37\x2b\x30\x9a\x48\xb3\x45\xd4\x9a\xa3\x3a\xa7\x27\x4c\x81\x25\x3b\xee\xcb\x22\xfd\x8e\x9b\xa7\x2f\x95\xcc\x9d\x41\xac\xd2\x55\x05\xb3\x4c\xaf\x6d\x89\x21\xcf\x0c\x43\xe2\xd0\x2f\x84\x10\xb2\x22\x25\x12\x5a\x52\xc9\x1b\x4c\x8c\x19\x96\x7e\x26\xad\x89\xd5\x11\x78\xbe\xdc\x9a\x79\xe3\xc8\xf9\xb5\x1c\xd9\x9d\x22\xf1\x25\x99\x2e\x34\x4a\x87\x8e\x2a\x40\xda\xeb\xb6\x7e\x00\x43\xcb\xe6\xbc\x36\xb7\x0a\xa8\x93\x1e\xfd\x81\xe8\x7b\xaf\xad\xde\x64\x10\x1b\xcf\x5f\xda\x43\x84\xaa\x7c\x78\x99\x07\x96\x72\x59\xd6\xbc\x25\x06\xc6\xa7\x6b\xad\x3c\x8a\x8e\x49\x8a\x91\xe1\xd4\x61\x38\x98\x79\x8e\x6d\xce\x6b\x0f\x7a\xc6\xba\xbf\xa7\xbd\x5b\xd2\x11\xb0\x22\xa7\x3e\x90\xe7\xbc\x63\x2b\x43\x4f\xc2\x3c\x5a\x8d\xf3\x1f\xed\x64\xae\x43\x3c\xb2\x5d\x1c\x20\x1b\x9e\xed\xb4\xd0\xc4\xbb\x95\x72\xc8\xa8\xbb\x38\x76\x93\xd2\x82\x5f\x76\x48\x59\x66\xd0\x29\x20\x20\xba\xa3\x79\x57\xd9\xc2\xe4\xf0\x87\x43\x8b\x18\x52\x69\xd7\x55\x74\xe9\xe2\x1b\xec\x5f\xa2\xef\x40\x1f\xe6\xc2\xee\x15\x72\xb0\x71\x43\x40\xe5\xbe\x70\x53\x7d\x28\x95\x6b\x0f\xd4\x74\x9d\xc3\x3b\xa3\x64\x1c\xcf\x6d\x73\x3f\xfa\x65\xbc\x10\xcd\x00\x44\x72\x80\xb6\x7d\x39\x35\xee\x06\xcc\x3b\x1e\x5c\x22\x70\x95\x06\x9d\x32\x3a\xd5\x5d\x6e\x90\x03\x08\xe0\xab\x77\x64\x09\x28\xe9\x1d\x60\x3a\x5f\x2e\xba\x4e\x8c\x3b\x7b\x1a\x4d\x11\x99\x65\x88\x7d\x9e\x15\x0c\xc1\x4f\xa1\x7d\x83\xd3\x35\x89\xb7\x04\xc9\xc2\x3a\xa5\xb7\xe0\x79\x17\x16\xf0\x4e\xaa\x37\x1a\x81\xff\xce\x73\xad\xa0\xea\x66\x1e\x62\xc7\x98\x43\xed\x0c\xde\x0f\x0d\x31\x19\x9f\xf9\xac\x69\x04\x68\x0c\xb0\x1e\xde\x55\x69\x30\xcb\x8b\x86\xf0\x19\xec\x22\xc0\xe7\x9c\xfc\xcd\x71\xbc\x5b\xfe\xf8\x21\x0c\x53\x7f\x81\x8a\x22\xa2\x9c\xbd\xbc\xb5\x1d\xf8\x34\x32\x65\x06\x37\x49\x34\x00\x96\x2c\xf1\xc3\xc5\x41\x52\xc4\x6c\x66\x90\xbf\xa1\xef\x40\xbb\x78\x45\xcf\x11\x69\x18\x4d\xa5\xf2\xa7\xe9\xda\x70\x67\xdc\x41\x97\x5d\xd4\xef\xa2\xef\xc9\x7f\x7a\xed\xcd\xcb\x74\x0c\xf1\xf0\xb1\x45\xdf\x62\x7c\x65\x11\xac\x1a\x21\xe9\xb1\xd7\xed\x16\x1d\x3c\x3a\x46\x29\x6f\x65\xc0\x55\x34\x60\x15\xcb\x75\x31\x3b\x53\xb2\xe1\xea\x9a\x0f\xa2\xda\xa4\xf2\xba\xa7\xb0\x4b\x73\x16\x53\xd3\xf2\x88\x63\xa7\x07\x37\xcc\xd3\xbc\xb7\xd8\x25\xe3\xd6\x86\x46\xb7\x7e\xb6\xc6\xf0\x42\x56\xb0\x8c\x35\xa2\xef\x0d\x32\xaa\x67\x36\xb6\x2c\xe1\x96\x22\x87\x8c\xaf\x55\xe5\x02\x83\x44\x27\xf5\xe9\x0d\x39\x39\x84\x76\x98\x08\x3c\x2e\x91\x3a\x4b\xb9\xfc\x75\xf1\x5d\x38\x60\xc0\x57\xb9\xe3\xe3\xb0\x42\x2f\x91\x63\xea\xe0\xef\x54\xbf\xce\x2b\xbe\x09\xa4\x20\xc6\xca\x88\x35\x60\xb1\x13\x85\xa0\x72\x2d\x89\xd1\x83\x92\x99\x41\x14\x87\xa9\x77\xc3\xb8\x23\xe1\x5c\x97\xea\xe8\xe1\x65\x16\xf1\xf1\xe7\xce\x22\xa4\xa5\x57\xd9\x74\x0b\xab\x89\xff\xa4\x27\xe7\xd0\x9e\x1f\x73\x77\xb5\x32\xc1\xbd\xb6\x42\x3e\xc5\x1f\x0d\xdf\x15\x1d\xf4\xba\xa3\x78\xab\xb4\x29\x13\xce\xf1\x98\xc8\x18\xc6\xf7\xdc\xe9\x7e\xd1\xea\x9c\xdc\xec\x60\x15\xb0\x18\x01\xc8\x22\x99\x8a\x2e\xa5\x23\x24\x47\x93\xdd\x06\xd3\x17\x9b\x70\xad\x9f\x15\xca\xaa\x2b\x45\xe3\x46\x29\x90\x40\x4f\xe2\xf0\x5a\x86\xa8\xaa\xd9\x3b\x76\x90\xd5\xec\x16\x6f\xab\x2a\x1a\xbf\x01\x97\x9a\xba\xe4\x34\x8b\x93\x58\x15\xe0\x1f\x5b\xc9\xcd\xcd\x83\xcf\x4c\x09\xb5\xfc\x79\xdb\x8f\x5c\x74\x93\xa6\x80\xca\x41\xfb\x4a\x90\xbb\xce\x36\x97\xe3\xbb\xc9\xbf\x6d\xcf\x91\x92\x76\x74\xc8\xa2\x9d\x72\x0f\xef\x85\x1f\x61\xb0\xca\xe4\xd3\x16\xdf\x0d\x4c\x88\x99\xe3\x7a\xd9\x79\x52\x5c\xab\x35\x97\xd3\xa6\x7b\x8b\x9c\xcf\x4a\xfb\x91\xe2\xff\xb7\x78\xb6\xfc\x2d\x4a\x9a\x68\x77\x69\x3f\x05\x38\x67\x3b\x88\x41\x81\x1d\xab\x60\x53\xa4\x52\x2f\x6d\x1e\x1b\x5b\xa8\x3c\x87\x93\xdc\xed\xf8\xf9\xfc\xdd\x29\xd1\x08\x40\xee\xfb\x20\xaf\x60\x5b\x78\x5c\x7f\x09\x68\x05\x1e\x14\x2b\xdf\x4a\xa6\xa1\xc7\xb4\x49\x2c\x66\x12\x7c\x94\xab\xf7\x6a\x10\xb0\x7c\xbd\x1b\x4d\x0d\x97\x3a\x46\xc1\x3b\x2c\x7e\xab\x3c\x93\x6a\x21\x30\x8f\x67\x99\x11\xb5\xef\x82\xc8\xa7\xe9\xe8\xca\xcd\xb2\xbc\xc1\xab\xa2\x77\x8e\xc6\x8f\x04\x60\xcd\x13\x7a\x0f\x09\xca\x4d\x27\x4a\x83\xff\x1e\x32\xd0\xd8\x57\x96\x55\xd1\xc6\x24\x0a\x79\xd6\x45\x56\xa4\xec\x17\xd4\xba\x49\x12\x87\x79\x37\xcd\xa5\xfc\x2d\x61\x04\x34\xfb\x01\xfc\x33\xe2\x43\x45\x8b\x03\x5b\xd5\x9f\xf7\x1c\x5d\x74\x5a\x77\xaf\x56\x80\x19\x51\x38\x91\x9b\x65\x36\x82\xaa\x41\x54\x31\x8a\x31\x9c\xc1\xff\x03\x07\x8b\xeb\x0f\xda\x37\x20\xd9\xe3\xa5\xb6\x72\x02\x08\x2e\xc8\xac\x81\x36\x7c\xe0\x42\xf0\x3d\xe6\x71\xa2\x33\xd0\x55\x03\x98\x59\x12\x2c\x54\x95\x0d\xa2\xd9\xcc\xa3\xee\x4e\x1e\x2e\xbe\xff\xfe\x38\x4e\x49\x74\xb1\x8c\xeb\xbc\x70\x78\xc9\x55\xcf\x35\x02\x76\x82\xd5\x28\x78\xba\xee\x65\x8e\x08\xd9\x4f\x03\x69\xd8\x27\xca\x5b\x51\x2f\x11\xb2\x26\xba\xa1\x31\xa1\xe8\x2c\x95\xed\xa8\x1c\xd7\x89\x5c\xd9\x83\xb3\xce\xfb\xcd\xc4\x79\x5d\x71\x18\x39\xe7\x70\x41\x9d\xb9\x96\x75\x70\x54\xe6\xaa\x02\x57\x04\xf7\xbe\x4e\x09\xa8\x6f\xd5\xda\xa4\xcb\x40\x4d\xcf\xc9\x66\xb2\x9d\xbd\xa5\x3e\x01\x97\xb6\x5f\x7c\x36\xc7\x18\x96\xed\xc7\x5b\xda\x24\x7d\x69\x82\x70\xdb\xb1\xe7\xdb\x7f\x2a\xbe\x8a\xfb\xee\x4b\x3e\xd1\x18\x33\x63\x68\x3b\x76\xf6\x70\xe8\xa4\xbe\xd0\x38\xcb\x84\x4c\xd8\xef\x07\x05\x56\x9f\x22\x59\xe4\x4f\xa0\x3a\x1d\x65\x38\x71\x64\x1e\x95\x95\x26\xd9\x5a\xe1\x7d\x0f\x6e\x56\x81\x67\x5b\xf5\xa0\x66\x46\x4b\x0f\x0c\x55\xa0\xf5\xb4\x90\xe1\x40\x48\x64\x7a\x5f\xe7\x02\x52\xe7\x49\x5d\xf8\x3f\x4e\x30\xa1\x0f\xbf\xdc\x29\x93\x02\xcf\x2f\x8b\xd2\x6f\x5a\x22\x11\x9e\x6a\x99\xda\x63\x9f\xbe\x89\xc9\xed\x1e\x75\x1e\xb0\x26\x66\x8e\x61\x12\x3d\x67\x98\xe3\x2f\x55\xad\x51\xa6\x60\x27\x9d\x4a\x4f\x87\xae\x0e\x28\x9d\x9c\x0d\x02\x4e\x44\x71\x45\x8b\xb2\x79\xec\x5a\x03\x6f\x34\x70\xec\x11\xae\xbb\x11\x00\x25\xe9\x0e\x60\x42\x77\x15\xc7\x2a\x44\xe8\x14\xdf\x8a\x37\x90\x74\x75\x76\xa0\xdf\x0a\xa0\x48\xb0\x7e\xc8\x5e\x70\x85\x12\x19\x23\x6a\x88\x4e\x60\x93\xc9\x0f\x9d\xfb\x24\x7d\x64\x15\xa8\x6f\x40\xc3\x84\x65\xe6\xc6\xa7\x2a\x55\x05\x5f\xc8\x18\x4a\xbe\x6a\x22\x37\x2a\x06\xfd\x56\x0a\xb1\xa0\x2e\x09\xb2\xcd\x10\x23\xfa\xbf\xdf\x7d\xf7\x69\xbf\xfd\x2f\xa3\xfd\x67\xb7\xbd\xfb\xf9\x53\x3b\xf9\xfb\xa0\xf3\xf9\xfb\x57\xff\x14\xbe\xbd\xfa\xe7\xdf\xf2\x53\xee\x93\xba\x80\x00\x00\x9a\x46\x41\xc8\x72\xf0\xe3\x99\xd0\x65\xad\x89\x2e\x69\x1e\x9a\x0d\x83\xcc\x81\x3a\xf1\x74\x16\xce\x81\x4d\x90\xbf\x13\x03\xcb\x6b\x8f\xb1\x8b\x7d\xd9\x29\x4c\x14\x15\x2f\xcc\xf3\x44\x65\x28\xc5\xb0\x2c\x1b\xda\x1a\xce\xfb\x1c\x9a\x61\x1d\x5b\x0c\x77\xaa\xbb\x29\x59\xf0\xaf\x91\x57\x7b\x93\xd2\xe3\xb9\xb7\xf0\x45\xfa\x14\x4f\x3d\x7f\x3e\xe0\x8e\xa1\xa0\xaa\x99\x7b\x42\xbb\x51\xa0\x5b\xea\x58\x8e\x3d\xb5\xef\x39\x92\x39\x8b\x6a\x83\x74\x30\x8b\x34\xa3\xd4\x03\x26\x1d\x23\x67\x9b\x9e\xe2\x3e\x57\x77\x9c\x9f\xc5\xc5\xae\xf8\xe5\x5a\xa4\xdf\x5a\x8c\x4e\x3e\x02\xb9\x98\xbf\xc0\xae\xe1\x86\xbf\x08\x9e\xb1\x2a\x51\xa1\x81\xb0\x84\xb6\x54\xfe\xab\x78\x9e\x82\x93\x58\xa1\x1a\x85\x6d\xd5\xa8\x24\x51\x0f\x49\x29\x1a\x5a\x39\x1e\x45\xd9\x4d\x6b\x53\xed\x8b\x7a\x8a\xa0\x2a\x87\x80\x00\xd0\x59\x88\xfc\xe7\xd9\xd7\xdc\x79\x47\x33\x39\x40\xd8\x2b\x79\xf2\x05\xf2\x58\x25\xcf\x1c\x77\xb9\xae\x70\x31\x53\x16\x2a\xdc\x5f\xa8\xb1\x85\xd9\xa7\xa1\xa4\x3d\x58\x2c\x08\x64\xc9\xe7\x2c\x05\xb2\x72\xf4\x80\xae\x84\xc0\xe2\xe4\x91\xb3\x4d\xef\x41\x9e\xd6\xdf\xab\x19\x15\xc3\xf2\x56\x3d\x02\x9e\x73\x16\x21\x14\xed\xd5\xaf\xc1\x2d\x29\x5a\xac\xa7\xbd\x65\x2e\x28\x07\xf2\x6f\x40\x75\x15\xca\xfe\xe6\x20\xe1\xa1\x93\xa7\xca\x02\xfc\x25\x40\xd2\x58\xa3\x8a\xdc\x5e\xae\x0d\x22\x84\x3a\x07\x03\xc2\x50\x1d\x6f\x5e\x78\xff\xb3\xd8\x75\x82\x8c\xba\x94\x0e\x34\x42\xbc\x38\x1c\x3a\x85\x71\x71\xa5\x31\xa9\xab\xb9\xf8\x32\xce\xe2\x21\xd2\xa5\x64\x22\xad\xaa\x09\x1d\x05\x14\x39\x38\x95\x2a\x97\x34\xe2\xf1\xe0\xfd\x07\xe6\x32\x19\xce\x45\xbb\xd6\x76\xa1\xc6\x79\x3c\xda\x2a\xad\x75\x10\x81\x01\x3b\xf2\xbd\x69\xd6\x98\xe7\xf7\x5d\x23\xcf\x0b\x67\x04\xac\xb0\x66\x15\xa9\x46\xdb\xae\xa5\x6d\x57\x17\x4f\x4f\x11\x82\x23\x19\x05\x4b\xbe\x89\xc8\xf7\xd9\xd6\xd7\x2a\xf0\xdd\xcc\x96\x93\x7a\x4a\x6c\xef\xb4\x03\x0b\x32\x0f\x8d\xe9\x0c\x0e\xca\xd9\xeb\x03\xb4\xbe\xbe\xbe\xcb\xb9\x82\x32\xd8\x8b\x85\x0b\x79\x84\x92\xca\x7d\x1f\xc5\x27\x5b\x7c\x28\x0a\xee\x37\xae\x1a\xf4\x94\x5f\x63\xca\xb6\xca\xef\xc4\x54\xdb\x4b\xf9\xac\xd1\x6b\x39\x45\xd1\xd5\x69\x4a\x54\x45\x01\x3b\x3b\xc2\xb9\x2e\x62\x84\xd4\x4a\xa3\x01\xeb\xec\xa4\xa2\x34\x26\x24\xc8\x97\xc6\xa2\x97\xe7\xd3\xdf\xdb\x9f\xff\xf9\xa9\xdb\xde\xed\x7c\xfe\xfb\xab\xef\x3e\xe1\x23\x9b\x88\xea\xab\x5f\x4e\xde\x5c\xbc\xff\xfc\xfd\xa7\xf6\xdf\xd9\xc7\xcf\xdf\xbf\xe2\x4e\x9e\xf8\x8c\x6b\xa1\x02\x9e\xfc\xb8\x20\xad\x64\xcb\xc4\xa6\x27\x89\x3d\x01\x95\x20\x3f\xe3\xe4\x3d\x3e\x64\x01\xbb\x10\x16\xba\xa2\xbf\x95\xd0\x80\xaa\xbc\xea\xa4\x79\xbe\x41\x2c\x1b\xc8\x60\x10\xca\x19\xaa\x6f\xd1\xcb\x85\xa1\xa0\x24\x14\x51\xf6\xf1\x5d\x66\xf4\xf4\x9d\xfa\x4a\x50\x66\x0b\x47\xaa\xc5\x0c\x59\x92\x3f\x6a\xf1\x9a\x21\x62\x15\x43\x06\xb4\x50\x74\xb1\x10\xe8\x34\xf6\x99\x2a\x99\xc0\x59\xb0\x41\x5d\x7c\x63\xbc\xfc\x65\xa8\xd5\x16\x93\x65\x74\xbb\x6c\x21\xfc\x29\x3f\x2d\x81\xfe\x27\xf5\xa3\x9f\xb3\x3c\x3c\xcc\x6b\x92\x41\x27\xaa\x4a\xf8\x64\x11\xbe\x6d\x74\x28\x85\x04\x73\x37\x34\xee\x62\x67\x79\x4a\x6a\x48\xf0\x9c\x07\x84\xcc\x1d\x23\x09\xd2\x12\xbb\x60\x74\x19\x0f\x7c\x49\x98\x01\x0b\xe7\x85\xc4\x27\x74\xfe\xeb\x5b\xa6\x38\x4e\x09\xbf\x4a\x7d\x95\x47\x80\x37\x8a\xe8\xf8\x56\x88\xf6\xe7\x45\xb6\xdc\x79\x32\xac\xe4\x56\xbe\x64\xb7\x3f\x42\xf2\xd0\x6b\xc8\xe6\x60\xa8\x5b\x05\xc0\x7c\x5a\x52\x18\xc4\xa9\xe0\x74\x06\x74\x07\xe2\x04\x64\x64\x9b\xc9\xe0\x55\x50\xad\x58\xf8\x77\x92\x02\xc3\x16\xc6\x4b\x58\xc0\x9f\xcb\xcb\xcb\xe0\xda\x91\x5c\xcc\xc8\x08\x4c\xf1\x7b\xda\xf8\xa2\x3e\x10\x68\x40\xf4\xbc\x41\xac\x11\xdf\x07\xa4\xd5\x24\xa0\x29\x17\xbe\x63\x86\x58\x71\x87\xe1\xe5\x04\x9a\x5e\x6b\x61\x8b\xf9\x9d\x47\x82\x4f\x85\x90\x03\xf5\x3e\xb3\x0a\x76\x29\x0d\x27\xd1\x9e\x2c\x88\x4c\x58\x19\x40\xd3\x49\xe8\x9a\xe8\xec\x96\x5c\x9c\x2b\x4b\xeb\x0a\x29\x8b\xe4\x1e\x2f\xad\x95\x73\x42\xd9\x11\xe6\x03\xdc\xf7\x14\x06\xe1\x1c\x8a\x72\x81\x42\xc0\x78\x05\x7d\xfb\x52\x7f\xc2\xd2\x03\x46\x1b\xa5\x07\x4a\xa0\x85\xe2\x93\x55\x72\xa2\x6e\x27\x18\xb2\xeb\x84\xe3\x94\x4e\x29\x9d\x2a\xb4\x0f\x74\x02\xaa\x3f\x3d\x1d\xf1\x13\xcb\x0c\x78\xba\x39\x97\x80\xa5\xcb\x55\x74\x29\x2c\x01\xfe\xc9\xa9\x05\xfe\x4a\xaf\xfd\xc9\x5f\xc0\xe4\xb8\xe4\x51\x19\x97\xe9\x41\x8b\xa7\x60\x05\xd2\xc0\x00\xa1\xe3\xfe\xcf\x3f\xa0\xef\x0f\xec\xba\xe2\xf2\xed\xf1\x2f\x47\x9a\x3e\x44\xed\xfd\x12\xb9\x26\xcd\xab\x53\xfa\xef\x9f\x1e\x5e\xb2\x29\xdf\x9d\x5d\x76\xd0\x4f\xa4\x3d\x81\x69\x15\xcd\xbd\x88\x32\x06\x96\xb9\x35\xe5\xa9\x7a\x04\x07\xbd\x6e\x3a\x1c\xcf\x17\x33\xe2\x95\x52\xb2\x10\xd0\x7f\x94\xd0\x99\xee\x74\x2a\x41\x4f\x34\xc8\x8f\x25\x27\x00\xc5\x5d\x1a\xb7\x41\x3b\xb8\x26\xff\xa5\x7a\x0f\x03\x92\x5e\x93\x32\xd4\xa0\x4b\x56\x04\xe0\xb2\xea\x71\x95\xcf\xea\x0f\x48\x1e\x9f\x0e\x1f\x0f\xfd\x83\x5c\x7d\x80\x76\xff\x34\x6b\x7f\xd6\x2f\x83\x15\x50\xb2\x79\x91\x20\xb6\x0c\x66\x19\xb2\x5b\x77\x9e\xa5\x43\x7f\x87\x55\x2d\x08\xb1\x63\x5f\x61\x00\xfa\xbf\xfb\x9b\x0f\xc2\x58\x28\xbb\x84\x8f\xf2\xb6\x08\xfc\xc6\x60\xa9\x15\xd4\x25\x0c\x61\xda\x84\x92\xa6\x76\x10\xf0\x0a\x4a\x01\xc6\x94\xa4\x18\x5e\xe0\x5e\x2c\xe9\x7a\xea\x85\xb8\x13\xc3\xc7\x84\x4e\x5a\x3c\x1c\x28\x9e\x67\x97\xb3\x12\x8e\xbc\x77\x3e\xfb\xe2\x4a\x03\xa5\xb9\x1c\xa6\xa4\x67\x40\x1a\x19\x2f\xf1\x97\x0c\xdb\xab\x44\x25\xad\xc5\xd8\xdb\x4a\x5a\xe9\x9f\x26\xfd\xc7\x60\xf1\x52\xff\xe2\xa0\x10\x66\x40\x7f\xe5\x3f\xb2\x7f\xbc\xe6\x56\xd3\xcf\x1f\x2f\x24\x75\x77\x12\x86\x33\x18\x5d\x5e\xad\x5a\xad\x43\xfb\xea\x98\x52\x8e\x89\x21\xba\x75\x32\x4f\x2a\x7c\x64\xee\xd3\x8b\x07\x80\xca\x6f\x8e\x37\x1e\x04\xb6\x7b\x35\xe8\x76\x7a\xf2\x55\x86\x3c\x92\x5c\x07\xb9\xf2\x13\x7b\x54\xac\xaf\x89\x93\xb4\x14\xf8\xdf\x7a\x63\x74\x4e\xbe\x65\x3c\x5f\xa8\x25\xb5\xd6\x45\xc2\xb5\x55\x4e\x20\x87\x61\xa9\x23\xa7\x81\x62\x0b\xc2\xdf\x99\xc1\x4d\x6f\x7e\x24\x18\x14\x28\x17\xe6\xcb\x8b\xc3\x6a\xd3\xe2\x0e\x03\xb5\xb8\x43\x5b\x57\xdc\x21\x1b\x5d\x94\x5f\x32\x0f\x6a\xad\xab\x56\x71\x7a\xd4\xd2\xc7\x29\x92\x23\x60\x87\x0e\xdb\x81\xaa\xf1\x42\xf9\xb3\x53\xcf\x15\xd1\x76\xec\x81\x63\xbb\xda\x52\xf4\x49\xed\x18\xf1\xcc\xe7\x7a\x2d\x4e\x60\x2c\xf4\xd6\x76\x75\x2d\x39\xe0\xc5\x6d\x72\xe3\x7f\xd8\x9f\xbb\xf6\xd8\xf7\xa2\x19\x21\x05\xec\x5a\x34\xc1\x22\x5b\xbd\x30\x98\x78\xb7\x03\xc2\x78\xef\xbf\x9c\x73\x28\x70\x40\x04\x7e\xfe\x62\x8a\x5a\xdc\x73\x29\xa1\x37\xb3\xcd\x92\x10\x52\x42\x3c\xa0\x28\x80\x78\x02\x2f\x69\x5c\xac\x8d\x49\x4f\x3a\x00\x73\xca\xe9\x49\xe8\x22\xbf\x41\x7e\x2e\x62\x0a\x36\x3d\x75\xaa\x8f\x07\xcf\xee\x7f\xad\xa1\x44\x4e\x2b\x67\x2d\x97\x90\x63\xa9\x45\x44\x69\x38\xa0\x5a\x63\x5e\x9b\x7c\xbb\x32\xfb\x67\xdf\xb2\x68\xdc\x37\x61\xd6\xde\x94\x29\xa3\xb1\x3a\x02\xa5\x15\x88\x7e\x12\x72\xd1\xcf\x15\x5e\x82\xcd\x80\x39\x02\xa0\x94\xb8\x1b\xd8\x61\x27\x77\xf8\xf2\xe5\x50\xb7\x7f\xf1\x5a\xb4\xde\x11\x57\x88\x97\x66\x40\xf3\x38\x1b\x4b\x57\x0c\x5b\x43\x1c\xaf\x95\xa4\xa4\x3c\x02\xd7\x12\x89\x14\x76\xad\x96\x23\xac\x00\x7d\x72\xf1\x9d\x80\x5f\x05\xe4\xdf\xa0\xd7\xfd\x41\xd6\xbb\x14\x55\x4a\x2c\x83\xaa\xcd\x16\xb1\x52\x02\xf3\x31\x25\x57\x86\x6d\xb4\x6f\x6a\xaa\x48\x57\x62\xf0\xd5\x20\x6f\x4b\xa7\x63\x65\x81\x39\xaa\x9c\x40\x7c\x07\x25\xf4\xeb\x1d\xc1\x23\xd6\x07\xaa\x7c\x50\x4c\xd0\xfb\x1e\xd8\xfc\xa1\x67\xcd\xbf\xe1\xe3\xb3\x0c\x5a\xe4\x10\xc5\x28\x7e\x2c\x52\x93\xc8\xe0\xa1\x68\x8d\x98\x4c\x83\x09\x36\x2c\xec\x93\x79\x9c\x10\xfb\x15\xe9\xed\x35\x6d\x8c\x86\x06\xdc\x41\xf2\x68\x1b\x96\xe5\x63\xd2\x7d\x27\x22\x08\xb1\x71\xef\x49\x7c\xba\xeb\xa4\x12\xda\x63\xf3\x72\x63\xd7\x8b\x23\x27\x8a\x19\x5b\x5c\x9f\x9a\x77\x3e\x35\xa6\xb8\x0a\x95\xfe\xc4\xa6\x2a\x6f\xbe\x3c\x5a\x75\x8b\xe6\x8a\xc1\x22\x86\x30\x07\x8d\x6f\xd4\xc3\x93\x6b\x86\x92\xaa\x91\x6c\x6a\x02\x56\xb6\xfd\x4e\xe6\x44\x77\x17\x2f\xf6\xa5\x52\x67\xa8\xb5\x3b\x0c\x6e\xba\xc1\x76\xe8\xe2\xed\x71\xb7\x3f\x9e\x6c\x8e\x37\x04\xfb\x25\x53\xa0\x4f\xe8\xb3\x35\xf4\x47\x7e\xb7\xdb\x9f\x8d\xdc\xab\x49\x57\x54\xcd\xd2\x52\xec\xa8\x15\xf8\x37\x66\xdb\x30\xcd\xb0\xdd\xdb\xea\xe3\x51\xdf\xda\x69\x77\xfb\xdd\xdd\xf6\x46\xaf\xb7\xdd\xde\xd9\xd8\xea\xb7\xad\xd1\xd6\xba\xd9\xef\xf6\x37\xcd\xfe\x96\x66\x14\x5e\xa6\x1d\xb5\x86\xbd\x8d\x0d\x6b\x77\xb7\xd7\xee\xee\xe0\x61\x7b\x63\x63\xbb\xdf\xde\xc1\x66\xaf\x8d\x87\xdd\xf5\x0d\x73\x6b\xb7\xbf\xde\x1b\x8a\xfd\xa1\x2e\x3d\x6a\x8d\x3c\xaf\xad\x83\xb7\x73\x65\x04\x1d\xc3\x9c\xe2\x0e\x31\x8a\xf6\x36\x36\xd6\x5b\x55\x0a\xff\x09\xcb\xef\x5e\xed\x38\xee\xb8\xbb\xde\x0b\xf0\xee\x75\x85\xe5\x63\xb2\xc2\xfe\xd6\x26\x6e\x1b\x3b\x3b\x06\x01\x7f\x34\x24\xcb\xdf\xec\xb6\xb1\xd5\xed\x75\xf1\x70\x6b\x68\x6e\x9a\x45\xcb\xb7\xcc\x4d\x63\xa7\xbf\xbb\xd3\x1e\x62\x6b\xbb\xbd\xd1\xef\xe3\xf6\xce\xee\xc6\x76\x7b\xb4\x35\xb2\x0c\xb2\xfa\xdd\xfe\x68\x94\x5d\xfe\xd0\xf0\xf9\xf2\xfb\xd3\x91\x69\x90\xe5\x87\xbb\xd7\xdb\xc1\xb8\x13\xf8\x79\xcb\x8f\x73\x67\x55\xc3\x39\x9b\x85\x8b\x5a\x7a\xab\x5d\x9b\xe9\xaa\xb3\x3d\x13\xe3\x29\xfb\xf0\x44\x6a\x28\x06\x99\xaf\xdc\x58\xa1\x9b\xbb\x4a\x56\x28\xc5\x94\x27\x66\xb3\x52\x3e\x1f\xcf\xf3\x1e\xf4\x68\x9d\x5f\x9c\x1d\x9f\xbe\x91\x8d\x0b\xad\x22\x99\xf4\x80\x94\x7b\xa5\x46\x3d\xb7\xca\x33\x57\xc3\x85\x16\x02\xf7\xcf\xd0\xaf\xa7\x42\xc9\xe4\xac\x37\x8b\x36\xa1\x3a\x67\x5e\x96\xb2\x12\x12\x45\x1d\x72\x83\xb8\xdc\xa3\x1c\x25\x6a\x58\x03\x07\xc3\x2d\xea\xe0\x3a\xc2\xea\x32\x29\x76\x81\xe0\x9c\xeb\x56\x4e\x70\x46\x2d\xd7\x93\xe6\x25\x78\x21\x92\xa1\x8c\x03\xe5\x84\xe4\xb7\x64\xbf\x4c\x67\x38\xea\x77\x3c\x7f\xbc\x46\x36\x82\x30\x54\xdc\x02\xf8\x99\xf5\xdd\x8e\x7f\x2a\x08\x27\xac\xb5\x1e\xe8\xa0\x59\xd3\xe2\x80\xa6\xd1\x8a\x32\xac\xf9\xcf\xcc\x6b\x9c\x74\xad\x5e\x57\x38\xc3\xfc\x4d\x05\xe5\x9d\xc0\x62\xbf\x16\x7b\x5c\x7e\x4d\x1a\x87\xd6\x81\x40\xad\x83\x77\xa7\xa7\x47\x07\x17\xef\xce\xda\x27\x6f\x4e\x2e\xda\x52\x13\x5e\xd2\x81\x9c\xa2\xec\xeb\x56\x76\x40\x73\x83\x92\x94\x41\xe6\x37\xa7\xef\x60\xfd\x00\x67\x3a\x5b\x98\x5e\x79\xf8\x8d\x2c\xcb\xfe\x78\x6c\x4f\xaf\xdf\x98\xfe\x61\xf4\x76\xab\x67\x7c\xb8\x3b\xfe\xd7\xf5\x8f\x17\xd7\xa7\x67\x46\x82\xa5\x63\xe6\x87\xfe\x15\xdc\xc7\x15\x30\xd5\x5f\x12\xa6\xfa\xa5\x88\xea\x6b\xf0\xf4\x1f\x81\x38\x5e\xb3\x82\x59\xb4\xc2\x9e\x1f\x60\xe9\x16\x06\x1e\x56\xa4\x55\x30\xc8\x57\xea\x6a\x61\x7e\x96\x38\x3e\x82\x86\x4d\x10\xf0\x06\xcc\x1d\xc9\x6b\xdf\xee\xa1\x0c\x04\x7b\x35\xe6\x4b\x73\x3b\x4d\xcf\x89\xa6\x2e\x53\x0b\x47\xb4\xd8\x05\x75\xb3\xa3\x97\xb6\xf5\xb2\x83\xce\x75\xed\xe8\x7d\xd4\x9e\x14\x2d\x33\xa6\x97\xb1\xec\x96\xd8\x74\xbc\xc8\x1a\xf0\xbb\x0c\x3f\xfe\x95\x05\xb2\x74\xd0\xaf\xec\x4e\x81\x6d\x24\x04\x62\xa0\x1f\x50\xaf\xbf\x9e\x4b\x15\xce\xc7\xc3\x37\xd1\x7c\x78\xec\x1f\xb9\x77\xfe\x3e\x9e\x6e\xf7\x37\xc6\xd7\x57\x57\xf6\xe1\x4d\x4c\x15\x1b\x15\x28\x01\x1e\x3b\x5d\x06\x25\x6c\x97\x11\xc2\xb6\xe6\xbc\x5c\xa4\xf5\xf4\xb1\x95\xc6\x17\x5a\x1e\xa6\xb7\x3e\xf4\xb1\x51\xbe\x98\x5e\xb7\xca\x62\x7a\xdd\xe5\x90\xf5\x66\x29\x59\x6f\x56\x5f\x0e\x5c\x33\x0d\x31\x76\xe3\x68\xdc\x64\x7b\x0e\xe9\xbf\x2b\xac\x6b\xfb\xe9\xb6\x28\xbd\x48\xa3\x4e\x38\xdb\xfa\xe1\x65\xcf\xfe\x65\xdd\x8a\x7e\xfb\xe3\xf8\xe6\x66\xf3\x8f\x9b\xb7\xce\xfc\xcf\xde\xf4\xcd\xd9\xfa\xcf\xf3\xeb\xd3\x97\x94\xd9\x8d\xbc\x48\x4c\xd0\xc8\xb0\xb3\x3f\xde\x6d\x8f\xfb\xe3\xad\x9f\x2e\xac\x0f\xbf\x7c\x30\xfa\x57\xc1\x4f\x3b\xfd\xab\x5f\x0f\xd7\xe7\x31\x66\x7a\x55\x98\x7d\x6f\x39\xbc\xbe\x57\xca\xea\x7b\x1a\xb4\xa4\x8c\xe9\x06\xfb\xf6\x68\x0e\x17\x58\xec\x49\x61\x78\x28\x8e\xd9\x3e\xbc\x88\x89\xfd\x67\xfc\x40\x0b\x3c\x38\x5c\x09\x3f\xeb\x1f\x26\x47\x93\xdb\xe9\xef\x3f\xce\x3e\xbe\x1f\x1d\xf7\x9d\x53\x7c\x35\xb3\x36\xfe\x75\x18\xe3\x67\x17\x84\x2f\x94\x33\x73\x6c\x33\xac\x80\xab\xf5\xad\xa5\xe0\x4a\x1c\x46\x8f\x2b\xb1\x85\x48\x42\x2c\x3b\x9e\xf1\x52\x22\x11\x0d\x87\x2a\x6a\x34\x22\x39\x17\x0f\x5b\x57\x7f\x74\x3f\xd8\x47\x57\x7f\x5e\xfd\x7e\xf0\xe7\xc7\xf7\xf8\xb8\xef\xfd\x81\x27\xd6\xfa\x11\x47\x43\xf6\x49\x5f\xdd\xd2\x77\x97\xb2\xf2\xdd\xb2\x85\xef\x6a\x69\x84\x57\x2f\x8f\x9f\x05\x2e\xd8\x72\x7c\xf4\xf6\xe6\xf5\xee\x97\x93\x5f\xff\xd8\xfa\x63\x3c\x19\x9d\xec\x8e\xdf\x9c\x05\x3f\xdd\x1c\x7d\x4c\xd6\x5a\x99\x59\x3c\xdd\x8a\x45\xb9\xce\x2a\x64\xc5\x29\x09\x10\x1a\x60\x06\x60\xc4\xbd\x3b\x38\x69\x1f\xfd\xde\xde\xdd\x8b\x4b\x65\x92\x23\xc4\xf8\x62\xda\x06\xdf\x85\x6d\x2e\xcd\x09\x8c\xed\x9e\x7d\xd7\x5d\x77\x88\x12\x3f\xbd\xee\x5e\x8f\xcc\xed\xc0\x0e\x8d\xcd\xc0\xf9\x72\xb3\x83\xe5\x58\xfd\x58\xa9\xa6\x78\xe8\x8d\x37\xad\x9d\x9d\xeb\xae\xe3\x9b\xd6\xcd\xc6\x78\xdb\x70\x86\xdb\x81\x33\x1a\xbb\x5f\xd6\xad\xc9\x30\xf8\xf2\xdf\xff\xf5\xdd\xd1\xef\x17\x67\xfb\xe8\x7b\xb6\xe2\x0e\x85\xf8\x07\x22\x99\xdd\x10\xf6\x4c\xf4\x47\x10\x92\x7d\x49\xf8\xf5\xcb\x55\x56\xf2\x0b\xfe\x79\xf0\xf6\xc3\xf9\xc5\xd1\xd9\x39\x43\x06\x7c\xa4\xf7\xea\xe9\xd3\x7f\xe9\x40\xb4\x3d\x01\xc7\xf3\x37\xbb\x37\x76\xd4\xdd\xf6\x30\x6c\xdb\xc4\xbf\x22\xe6\xbe\x35\x1e\x85\x5f\x7a\x86\xf9\x52\x54\x1b\xf8\x55\x35\xed\x55\xb8\x08\x81\xdf\xbe\x2a\xe0\x27\x17\xc1\x47\x7f\xbe\xe5\x06\xd7\xc3\x7e\x70\x3a\x7d\xfd\x65\x73\xf8\xfb\xec\x70\xfb\x80\xa8\x8f\xff\x0f\x84\x9e\x4a\xcf\xac\x13\x01\x00")
 
 func connector_mgmtYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func connector_mgmtYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 54504, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "connector_mgmt.yaml", size: 70572, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
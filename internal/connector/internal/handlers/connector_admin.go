@@ -133,10 +133,15 @@ func (h *ConnectorAdminHandler) UpgradeConnectorsByType(writer http.ResponseWrit
 			for i2, upgrade := range resource {
 				upgrades[i2] = *presenters.ConvertConnectorAvailableTypeUpgrade(&upgrade)
 			}
-			return nil, h.Service.UpgradeConnectorsByType(request.Context(), id, upgrades)
+			upgradeOperations, serviceError := h.Service.UpgradeConnectorsByType(request.Context(), id, upgrades)
+			if serviceError != nil {
+				return nil, serviceError
+			}
+			return presentOperationList(upgradeOperations), nil
 		},
+		Location: operationLocation,
 	}
-	handlers.Handle(writer, request, &cfg, http.StatusNoContent)
+	handlers.Handle(writer, request, &cfg, http.StatusAccepted)
 }
 
 func (h *ConnectorAdminHandler) GetConnectorUpgradesByOperator(writer http.ResponseWriter, request *http.Request) {
@@ -184,11 +189,16 @@ func (h *ConnectorAdminHandler) UpgradeConnectorsByOperator(writer http.Response
 			for i2, upgrade := range resource {
 				upgrades[i2] = *presenters.ConvertConnectorAvailableOperatorUpgrade(&upgrade)
 			}
-			return nil, h.Service.UpgradeConnectorsByOperator(request.Context(), id, upgrades)
+			upgradeOperations, serviceError := h.Service.UpgradeConnectorsByOperator(request.Context(), id, upgrades)
+			if serviceError != nil {
+				return nil, serviceError
+			}
+			return presentOperationList(upgradeOperations), nil
 		},
+		Location: operationLocation,
 	}
 
-	handlers.Handle(writer, request, &cfg, http.StatusNoContent)
+	handlers.Handle(writer, request, &cfg, http.StatusAccepted)
 }
 
 func (h *ConnectorAdminHandler) GetClusterNamespaces(writer http.ResponseWriter, request *http.Request) {
@@ -623,6 +633,38 @@ func (h *ConnectorAdminHandler) ListConnectorTypes(writer http.ResponseWriter, r
 	handlers.HandleGet(writer, request, &cfg)
 }
 
+func (h *ConnectorAdminHandler) ListConnectorTypeDeprecations(writer http.ResponseWriter, request *http.Request) {
+	cfg := handlers.HandlerConfig{
+		Validate: []handlers.Validate{},
+		Action: func() (interface{}, *errors.ServiceError) {
+			deprecations, err := h.ConnectorTypesService.ListDeprecations()
+			if err != nil {
+				return nil, err
+			}
+
+			result := private.ConnectorTypeDeprecationList{
+				Kind:  "ConnectorTypeDeprecationList",
+				Page:  1,
+				Size:  int32(len(deprecations)),
+				Total: int32(len(deprecations)),
+			}
+
+			result.Items = make([]private.ConnectorTypeDeprecation, len(deprecations))
+			for i, deprecation := range deprecations {
+				item, err := presenters.PresentConnectorTypeDeprecation(deprecation)
+				if err != nil {
+					return nil, err
+				}
+				result.Items[i] = *item
+			}
+
+			return result, nil
+		},
+	}
+
+	handlers.HandleGet(writer, request, &cfg)
+}
+
 func (h *ConnectorAdminHandler) GetConnectorType(writer http.ResponseWriter, request *http.Request) {
 	id := mux.Vars(request)["connector_type_id"]
 
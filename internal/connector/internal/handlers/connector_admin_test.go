@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ConnectorAdminHandler_ListConnectorTypeDeprecations(t *testing.T) {
+	replacedBy := "type-b"
+	endOfLife := time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		deprecations   dbapi.ConnectorTypeDeprecationList
+		err            *errors.ServiceError
+		wantStatusCode int
+		want           private.ConnectorTypeDeprecationList
+	}{
+		{
+			name:           "should return an error if the deprecations can't be listed",
+			err:            errors.GeneralError("test"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "should list the deprecated connector types with their connectors",
+			deprecations: dbapi.ConnectorTypeDeprecationList{
+				{
+					ConnectorType: &dbapi.ConnectorType{
+						Model:      db.Model{ID: "type-a"},
+						Name:       "Type A",
+						Version:    "1.0",
+						ReplacedBy: &replacedBy,
+						EndOfLife:  &endOfLife,
+					},
+					Connectors: dbapi.ConnectorList{
+						{Model: db.Model{ID: "connector-1"}, ConnectorTypeId: "type-a"},
+					},
+				},
+				{
+					ConnectorType: &dbapi.ConnectorType{Model: db.Model{ID: "type-c"}},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			want: private.ConnectorTypeDeprecationList{
+				Kind:  "ConnectorTypeDeprecationList",
+				Page:  1,
+				Size:  2,
+				Total: 2,
+			},
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewConnectorAdminHandler(ConnectorAdminHandler{
+				ConnectorTypesService: &services.ConnectorTypesServiceMock{
+					ListDeprecationsFunc: func() (dbapi.ConnectorTypeDeprecationList, *errors.ServiceError) {
+						return tt.deprecations, tt.err
+					},
+				},
+			})
+			req, err := http.NewRequest(http.MethodGet, "/connector_types/deprecations", nil)
+			Expect(err).ToNot(HaveOccurred())
+			rw := httptest.NewRecorder()
+			h.ListConnectorTypeDeprecations(rw, req)
+
+			resp := rw.Result()
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
+			if tt.wantStatusCode != http.StatusOK {
+				return
+			}
+
+			var got private.ConnectorTypeDeprecationList
+			Expect(json.NewDecoder(resp.Body).Decode(&got)).To(Succeed())
+			Expect(got.Kind).To(Equal(tt.want.Kind))
+			Expect(got.Size).To(Equal(tt.want.Size))
+			Expect(got.Total).To(Equal(tt.want.Total))
+			Expect(got.Items).To(HaveLen(2))
+			Expect(got.Items[0].ConnectorTypeId).To(Equal("type-a"))
+			Expect(got.Items[0].ReplacedBy).To(Equal(replacedBy))
+			Expect(got.Items[0].Connectors).To(HaveLen(1))
+			Expect(got.Items[0].Connectors[0].Id).To(Equal("connector-1"))
+			Expect(got.Items[1].ConnectorTypeId).To(Equal("type-c"))
+			Expect(got.Items[1].Connectors).To(BeEmpty())
+		})
+	}
+}
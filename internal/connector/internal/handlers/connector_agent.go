@@ -14,11 +14,11 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/getsentry/sentry-go"
-	"github.com/golang/glog"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
@@ -137,7 +137,7 @@ func (h *ConnectorClusterHandler) ListDeployments(w http.ResponseWriter, r *http
 					converted, serviceError := h.presentDeployment(r, resource)
 					if serviceError != nil {
 						sentry.CaptureException(serviceError)
-						glog.Errorf("failed to present connector deployment %s: %v", resource.ID, serviceError)
+						logger.NewUHCLogger(r.Context()).WithFields(logger.ConnectorIDField, resource.ConnectorID).Errorf("failed to present connector deployment %s: %v", resource.ID, serviceError)
 						// also reduce size and total count
 						list.Size--
 						list.Total--
@@ -234,7 +234,7 @@ func (h *ConnectorClusterHandler) presentDeployment(r *http.Request, resource db
 	if err != nil {
 		if invalidSecrets {
 			// log error in getting secrets and signal that connector spec doesn't have secrets
-			glog.Errorf("Error getting connector %s with base64 secrets: %s", apiSpec.ID, err)
+			logger.NewUHCLogger(ctx).WithFields(logger.ConnectorIDField, apiSpec.ID).Errorf("Error getting connector %s with base64 secrets: %s", apiSpec.ID, err)
 			apiSpec.ConnectorSpec = []byte("{}")
 		} else {
 			return private.ConnectorDeployment{}, err
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
@@ -145,6 +146,7 @@ func (h *ConnectorNamespaceHandler) Get(w http.ResponseWriter, r *http.Request)
 			}
 			return presenters.PresentConnectorNamespace(resource, h.QuotaConfig), nil
 		},
+		ETag: connectorNamespaceETag,
 	}
 	handlers.HandleGet(w, r, cfg)
 }
@@ -167,6 +169,11 @@ func (h *ConnectorNamespaceHandler) Update(w http.ResponseWriter, r *http.Reques
 			if err != nil {
 				return nil, err
 			}
+			// the update is conditioned on the version the If-Match header is checked against
+			ifMatch := r.Header.Get(handlers.HeaderIfMatch)
+			if err := handlers.CheckIfMatch(ifMatch, handlers.ETag(existing.Version)); err != nil {
+				return nil, err
+			}
 
 			// Copy over the fields that support being updated...
 			if len(resource.Name) != 0 {
@@ -176,7 +183,7 @@ func (h *ConnectorNamespaceHandler) Update(w http.ResponseWriter, r *http.Reques
 				return nil, nil
 			}
 
-			return nil, h.Service.Update(r.Context(), existing)
+			return nil, handlers.ConditionalWriteError(ifMatch, h.Service.Update(r.Context(), existing))
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusNoContent)
@@ -192,6 +199,7 @@ func (h *ConnectorNamespaceHandler) Delete(w http.ResponseWriter, r *http.Reques
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_namespace_id", &connectorNamespaceId,
 				handlers.MinLen(1), handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceAdmin()),
+			handlers.ValidateIfMatch(r, h.currentConnectorNamespaceETag(ctx, connectorNamespaceId)),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			err := h.Service.Delete(r.Context(), connectorNamespaceId)
@@ -201,6 +209,25 @@ func (h *ConnectorNamespaceHandler) Delete(w http.ResponseWriter, r *http.Reques
 	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
+// connectorNamespaceETag returns the entity tag of a presented namespace, derived from its resource version
+func connectorNamespaceETag(result interface{}) string {
+	if namespace, ok := result.(public.ConnectorNamespace); ok {
+		return handlers.ETag(namespace.ResourceVersion)
+	}
+	return ""
+}
+
+// currentConnectorNamespaceETag returns the entity tag of the stored version of a namespace, for the If-Match header of a request to be checked against it
+func (h *ConnectorNamespaceHandler) currentConnectorNamespaceETag(ctx context.Context, connectorNamespaceId string) func() (string, *errors.ServiceError) {
+	return func() (string, *errors.ServiceError) {
+		namespace, err := h.Service.Get(ctx, connectorNamespaceId)
+		if err != nil {
+			return "", err
+		}
+		return handlers.ETag(namespace.Version), nil
+	}
+}
+
 func (h *ConnectorNamespaceHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
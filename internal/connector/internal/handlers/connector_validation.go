@@ -3,10 +3,14 @@ package handlers
 import (
 	"context"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/cron"
 	"strings"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/xeipuuv/gojsonschema"
@@ -55,3 +59,120 @@ func connectorValidationFunction(connectorTypesService services.ConnectorTypesSe
 		return handlers.ValidateJsonSchema("connector type schema", schemaLoader, "connector spec", documentLoader)
 	}
 }
+
+func validateRestartPolicy(policy *public.ConnectorRestartPolicy) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := handlers.Validation("restart_policy.type", &policy.Type,
+			handlers.WithDefault(string(dbapi.ConnectorRestartPolicyNever)), handlers.IsOneOf(dbapi.ValidRestartPolicyTypes...))(); err != nil {
+			return err
+		}
+		if policy.MaxRetries < 0 || policy.BackoffSeconds < 0 {
+			return errors.BadRequest("restart_policy.max_retries and restart_policy.backoff_seconds must not be negative")
+		}
+		if dbapi.ConnectorRestartPolicyType(policy.Type) == dbapi.ConnectorRestartPolicyOnFailure && policy.MaxRetries == 0 {
+			return errors.BadRequest("restart_policy.max_retries is required for restart policy type %s", policy.Type)
+		}
+		return nil
+	}
+}
+
+func validateCronExpression() handlers.ValidateOption {
+	return func(field string, value *string) *errors.ServiceError {
+		if *value == "" {
+			return nil
+		}
+		if _, err := cron.Parse(*value); err != nil {
+			return errors.BadRequest("%s is not a valid cron expression: %v", field, err)
+		}
+		return nil
+	}
+}
+
+// connectorFieldValidation validates a connector request field identified by its JSON pointer path
+type connectorFieldValidation struct {
+	path     string
+	validate handlers.Validate
+}
+
+// connectorRequestFieldValidations returns the connector request field validations shared by creating and dry-run validating a connector,
+// the connector type, channel and spec are validated separately
+func connectorRequestFieldValidations(user *authz.ValidationUser, resource *public.ConnectorRequest) []connectorFieldValidation {
+	return []connectorFieldValidation{
+		{"/channel", handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40))},
+		{"/name", handlers.Validation("name", &resource.Name, handlers.WithDefault("New Connector"), handlers.MinLen(1), handlers.MaxLen(100))},
+		{"/kafka/id", handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength))},
+		{"/kafka/url", handlers.Validation("kafka.url", &resource.Kafka.Url, handlers.MinLen(1))},
+		{"/service_account/client_id", handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1))},
+		{"/service_account/client_secret", handlers.Validation("service_account.client_secret", &resource.ServiceAccount.ClientSecret, handlers.MinLen(1))},
+		{"/connector_type_id", handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength))},
+		{"/desired_state", handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.WithDefault("ready"), handlers.IsOneOf(dbapi.ValidDesiredStates...))},
+		{"/restart_policy", validateRestartPolicy(&resource.RestartPolicy)},
+		{"/schedule/pause", handlers.Validation("schedule.pause", &resource.Schedule.Pause, validateCronExpression())},
+		{"/schedule/resume", handlers.Validation("schedule.resume", &resource.Schedule.Resume, validateCronExpression())},
+		{"/namespace_id", handlers.Validation("namespace_id", &resource.NamespaceId,
+			handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest), user.ValidateNamespaceConnectorQuota(),
+			user.ValidateNamespaceConnectorResourceQuota(&resource.ConnectorTypeId, (*string)(&resource.Channel)))},
+	}
+}
+
+func newConnectorViolation(path string, err *errors.ServiceError) public.ConnectorValidationViolation {
+	return public.ConnectorValidationViolation{
+		Path:   path,
+		Code:   strings.Replace(errors.CodeStr(err.Code), errors.ERROR_CODE_PREFIX, errors.CONNECTOR_MGMT_ERROR_CODE_PREFIX, 1),
+		Reason: err.Reason,
+	}
+}
+
+// connectorSpecViolations validates the connector type, channel and spec of a connector request,
+// returning all connector type schema violations instead of only the first one
+func connectorSpecViolations(connectorTypesService services.ConnectorTypesService, resource *public.ConnectorRequest, tid string) (*dbapi.ConnectorType, []public.ConnectorValidationViolation) {
+	if tid != "" && tid != resource.ConnectorTypeId {
+		return nil, []public.ConnectorValidationViolation{newConnectorViolation("/connector_type_id",
+			errors.BadRequest("resource type id should be: %s", tid))}
+	}
+	ct, err := connectorTypesService.Get(resource.ConnectorTypeId)
+	if err != nil {
+		return nil, []public.ConnectorValidationViolation{newConnectorViolation("/connector_type_id",
+			errors.BadRequest("invalid connector type id %s: %s", resource.ConnectorTypeId, err))}
+	}
+
+	var violations []public.ConnectorValidationViolation
+	if !arrays.Contains(ct.ChannelNames(), string(resource.Channel)) {
+		violations = append(violations, newConnectorViolation("/channel",
+			errors.BadRequest("channel is not valid. Must be one of: %s", strings.Join(ct.ChannelNames(), ", "))))
+	}
+
+	schemaDom, err := ct.JsonSchemaAsMap()
+	if err != nil {
+		return ct, append(violations, newConnectorViolation("/connector_type_id", err))
+	}
+	schema, serr := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schemaDom))
+	if serr != nil {
+		return ct, append(violations, newConnectorViolation("/connector_type_id", errors.BadRequest("invalid connector type schema: %v", serr)))
+	}
+	result, serr := schema.Validate(gojsonschema.NewGoLoader(resource.Connector))
+	if serr != nil {
+		return ct, append(violations, newConnectorViolation("/connector", errors.BadRequest("invalid connector spec: %v", serr)))
+	}
+	for _, e := range result.Errors() {
+		violations = append(violations, newConnectorViolation(jsonSchemaErrorPath("/connector", e), errors.BadRequest("%s", e.Description())))
+	}
+	return ct, violations
+}
+
+// jsonSchemaErrorPath converts the field of a json schema validation error to a JSON pointer below the given base path
+func jsonSchemaErrorPath(base string, e gojsonschema.ResultError) string {
+	var segments []string
+	if field := e.Field(); field != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		segments = strings.Split(field, ".")
+	}
+	// required property errors are reported on the parent object
+	if property, ok := e.Details()["property"].(string); ok && e.Type() == "required" {
+		segments = append(segments, property)
+	}
+	path := base
+	for _, s := range segments {
+		path += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+	}
+	return path
+}
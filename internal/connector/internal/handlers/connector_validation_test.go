@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+func Test_jsonSchemaErrorPath(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"required": ["topic"],
+		"properties": {
+			"topic": {"type": "string"},
+			"data/shape": {
+				"type": "object",
+				"properties": {
+					"format": {"type": "string", "enum": ["json", "avro"]}
+				}
+			},
+			"processors": {"type": "array", "items": {"type": "integer"}}
+		}
+	}`
+
+	tests := []struct {
+		name     string
+		document string
+		want     []string
+	}{
+		{
+			name:     "valid document",
+			document: `{"topic": "test"}`,
+			want:     nil,
+		},
+		{
+			name:     "missing required property",
+			document: `{}`,
+			want:     []string{"/connector/topic"},
+		},
+		{
+			name:     "nested and escaped properties",
+			document: `{"topic": "test", "data/shape": {"format": "xml"}, "processors": [1, "two"]}`,
+			want:     []string{"/connector/data~1shape/format", "/connector/processors/1"},
+		},
+		{
+			name:     "invalid root",
+			document: `"test"`,
+			want:     []string{"/connector"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(schema), gojsonschema.NewStringLoader(tt.document))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var paths []string
+			for _, e := range result.Errors() {
+				paths = append(paths, jsonSchemaErrorPath("/connector", e))
+			}
+			g.Expect(paths).To(ConsistOf(tt.want))
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
@@ -25,8 +27,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	jsonpatch "github.com/evanphx/json-patch"
-	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 )
 
@@ -42,6 +44,7 @@ type ConnectorsHandler struct {
 	vaultService          vault.VaultService
 	authZService          authz.AuthZService
 	connectorsConfig      *config.ConnectorsConfig
+	operationService      operations.OperationService
 }
 
 // this is an initial guess at what operation is being performed in update
@@ -53,7 +56,7 @@ var stateToOperationsMap = map[public.ConnectorDesiredState]phase.ConnectorOpera
 
 func NewConnectorsHandler(connectorsService services.ConnectorsService, connectorTypesService services.ConnectorTypesService,
 	namespaceService services.ConnectorNamespaceService, vaultService vault.VaultService, authZService authz.AuthZService,
-	connectorsConfig *config.ConnectorsConfig) *ConnectorsHandler {
+	connectorsConfig *config.ConnectorsConfig, operationService operations.OperationService) *ConnectorsHandler {
 	return &ConnectorsHandler{
 		connectorsService:     connectorsService,
 		connectorTypesService: connectorTypesService,
@@ -61,6 +64,7 @@ func NewConnectorsHandler(connectorsService services.ConnectorsService, connecto
 		vaultService:          vaultService,
 		authZService:          authZService,
 		connectorsConfig:      connectorsConfig,
+		operationService:      operationService,
 	}
 }
 
@@ -70,23 +74,17 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	var resource public.ConnectorRequest
 	tid := mux.Vars(r)["tid"]
+	validates := []handlers.Validate{handlers.ValidateAsyncEnabled(r, "creating connector")}
+	for _, v := range connectorRequestFieldValidations(user, &resource) {
+		validates = append(validates, v.validate)
+	}
+	// the connector spec is validated after field defaults have been applied
+	validates = append(validates, validateConnectorRequest(h.connectorTypesService, &resource, tid))
+
 	cfg := &handlers.HandlerConfig{
 
 		MarshalInto: &resource,
-		Validate: []handlers.Validate{
-			handlers.ValidateAsyncEnabled(r, "creating connector"),
-			handlers.Validation("channel", (*string)(&resource.Channel), handlers.WithDefault("stable"), handlers.MaxLen(40)),
-			handlers.Validation("name", &resource.Name, handlers.WithDefault("New Connector"), handlers.MinLen(1), handlers.MaxLen(100)),
-			handlers.Validation("kafka.id", &resource.Kafka.Id, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
-			handlers.Validation("kafka.url", &resource.Kafka.Url, handlers.MinLen(1)),
-			handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
-			handlers.Validation("service_account.client_secret", &resource.ServiceAccount.ClientSecret, handlers.MinLen(1)),
-			handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxConnectorTypeIdLength)),
-			handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.WithDefault("ready"), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
-			validateConnectorRequest(h.connectorTypesService, &resource, tid),
-			handlers.Validation("namespace_id", &resource.NamespaceId,
-				handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest), user.ValidateNamespaceConnectorQuota()),
-		},
+		Validate:    validates,
 
 		Action: func() (interface{}, *errors.ServiceError) {
 
@@ -110,6 +108,9 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
 			}
+			if ct.IsEndOfLife(time.Now()) {
+				return nil, errors.BadRequest("connector type %s has reached its end of life and can not be used for new connectors", ct.ID)
+			}
 
 			err = moveSecretsToVault(convResource, ct, h.vaultService, true)
 			if err != nil {
@@ -123,6 +124,9 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 			if err := stripSecretReferences(convResource, ct); err != nil {
 				return nil, err
 			}
+			if err := h.createRevision(r.Context(), convResource, user.UserId()); err != nil {
+				return nil, err
+			}
 
 			return presenters.PresentConnector(convResource)
 		},
@@ -132,6 +136,60 @@ func (h ConnectorsHandler) Create(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
 
+// ValidateConnector performs a dry-run validation of a connector request and reports all violations without persisting anything
+func (h ConnectorsHandler) ValidateConnector(w http.ResponseWriter, r *http.Request) {
+
+	user := h.authZService.GetValidationUser(r.Context())
+
+	var resource public.ConnectorRequest
+	tid := mux.Vars(r)["tid"]
+	cfg := &handlers.HandlerConfig{
+
+		MarshalInto: &resource,
+
+		Action: func() (interface{}, *errors.ServiceError) {
+			violations := make([]public.ConnectorValidationViolation, 0)
+			namespaceValid := true
+			for _, v := range connectorRequestFieldValidations(user, &resource) {
+				if err := v.validate(); err != nil {
+					violations = append(violations, newConnectorViolation(v.path, err))
+					namespaceValid = namespaceValid && v.path != "/namespace_id"
+				}
+			}
+
+			ct, specViolations := connectorSpecViolations(h.connectorTypesService, &resource, tid)
+			violations = append(violations, specViolations...)
+			if ct != nil && ct.IsEndOfLife(time.Now()) {
+				violations = append(violations, newConnectorViolation("/connector_type_id",
+					errors.BadRequest("connector type %s has reached its end of life and can not be used for new connectors", ct.ID)))
+			}
+
+			// namespace id is a required field if unassigned connectors are not supported
+			if resource.NamespaceId == "" {
+				if !h.connectorsConfig.ConnectorEnableUnassignedConnectors {
+					violations = append(violations, newConnectorViolation("/namespace_id",
+						errors.MinimumFieldLengthNotReached("namespace_id is not valid. Minimum length 1 is required.")))
+				}
+			} else if namespaceValid {
+				convResource, err := presenters.ConvertConnectorRequest(resource)
+				if err != nil {
+					return nil, err
+				}
+				if err := ValidateConnectorOperation(r.Context(), h.namespaceService, convResource, phase.CreateConnector); err != nil {
+					violations = append(violations, newConnectorViolation("/namespace_id", err))
+				}
+			}
+
+			return public.ConnectorValidationResult{
+				Valid:      len(violations) == 0,
+				Violations: violations,
+			}, nil
+		},
+	}
+
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
 func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 
 	connectorId := mux.Vars(r)["connector_id"]
@@ -145,150 +203,294 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			handlers.Validation("Content-Type header", &contentType, handlers.IsOneOf("application/json", "application/json-patch+json", "application/merge-patch+json")),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
-			dbresource, serr := h.connectorsService.Get(r.Context(), connectorId, connectorTypeId)
-			if serr != nil {
-				return nil, serr
-			}
-
-			resource, serr := presenters.PresentConnector(&dbresource.Connector)
-			if serr != nil {
-				return nil, serr
-			}
-
-			ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
-			if serr != nil {
-				return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
-			}
-
-			originalSecrets, err := getSecretRefs(&dbresource.Connector, ct)
-			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
-			}
-
 			// Apply the patch..
 			patchBytes, err := ioutil.ReadAll(r.Body)
 			if err != nil {
 				return nil, errors.BadRequest("failed to get patch bytes")
 			}
+			return h.patchConnector(r.Context(), connectorId, connectorTypeId, r.Header.Get(handlers.HeaderIfMatch), contentType, patchBytes)
+		},
+		ETag: connectorETag,
+	}
 
-			// Don't allow updating connector secrets with values like {"ref": "something"}
-			serr = validateConnectorPatch(patchBytes, ct)
-			if serr != nil {
-				return nil, serr
-			}
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
 
-			patch := public.ConnectorRequest{}
-			serr = PatchResource(resource, contentType, patchBytes, &patch)
-			if serr != nil {
-				return nil, serr
-			}
+// patchConnector applies the patch to the connector through the connector validation and FSM update path. The update is
+// conditioned on the version of the connector the If-Match header, if any, is checked against.
+func (h ConnectorsHandler) patchConnector(ctx context.Context, connectorId string, connectorTypeId string, ifMatch string,
+	contentType string, patchBytes []byte) (interface{}, *errors.ServiceError) {
+	dbresource, serr := h.connectorsService.Get(ctx, connectorId, connectorTypeId)
+	if serr != nil {
+		return nil, serr
+	}
+	if serr := handlers.CheckIfMatch(ifMatch, handlers.ETag(dbresource.Version)); serr != nil {
+		return nil, serr
+	}
 
-			// get and validate patch operation type
-			var operation phase.ConnectorOperation
-			if operation, serr = h.getOperation(resource, patch); err != nil {
-				return nil, serr
-			}
-			if operation == phase.UnassignConnector && !h.connectorsConfig.ConnectorEnableUnassignedConnectors {
-				return nil, errors.FieldValidationError("Unsupported connector state %s", patch.DesiredState)
-			}
-			if serr = ValidateConnectorOperation(r.Context(), h.namespaceService, &dbresource.Connector, operation,
-				func(connector *dbapi.Connector) *errors.ServiceError {
-					resource.DesiredState = public.ConnectorDesiredState(dbresource.DesiredState)
-					return nil
-				}); serr != nil {
-				return nil, serr
-			}
+	resource, serr := presenters.PresentConnector(&dbresource.Connector)
+	if serr != nil {
+		return nil, serr
+	}
 
-			// But we don't want to allow the user to update ALL fields.. so copy
-			// over the fields that they are allowed to modify..
-			resource.Name = patch.Name
-			resource.Connector = patch.Connector
-			resource.Kafka = patch.Kafka
-			resource.ServiceAccount = patch.ServiceAccount
-			resource.SchemaRegistry = patch.SchemaRegistry
+	ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
+	if serr != nil {
+		return nil, errors.BadRequest("invalid connector type id: %s", resource.ConnectorTypeId)
+	}
 
-			if h.connectorsConfig.ConnectorEnableUnassignedConnectors {
-				// check namespace id change, from unassigned to assigned and vice versa
-				if operation == phase.AssignConnector && patch.NamespaceId != "" && resource.NamespaceId == "" {
-					resource.NamespaceId = patch.NamespaceId
-				}
-				if operation == phase.UnassignConnector && patch.NamespaceId == "" && resource.NamespaceId != "" {
-					resource.NamespaceId = patch.NamespaceId
+	originalSecrets, err := getSecretRefs(&dbresource.Connector, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get existing secrets: %v", err)
+	}
+
+	// Don't allow updating connector secrets with values like {"ref": "something"}
+	serr = validateConnectorPatch(patchBytes, ct)
+	if serr != nil {
+		return nil, serr
+	}
+
+	patch := public.ConnectorRequest{}
+	serr = PatchResource(resource, contentType, patchBytes, &patch)
+	if serr != nil {
+		return nil, serr
+	}
+
+	// get and validate patch operation type
+	var operation phase.ConnectorOperation
+	if operation, serr = h.getOperation(resource, patch); err != nil {
+		return nil, serr
+	}
+	if operation == phase.UnassignConnector && !h.connectorsConfig.ConnectorEnableUnassignedConnectors {
+		return nil, errors.FieldValidationError("Unsupported connector state %s", patch.DesiredState)
+	}
+	if serr = ValidateConnectorOperation(ctx, h.namespaceService, &dbresource.Connector, operation,
+		func(connector *dbapi.Connector) *errors.ServiceError {
+			resource.DesiredState = public.ConnectorDesiredState(dbresource.DesiredState)
+			return nil
+		}); serr != nil {
+		return nil, serr
+	}
+
+	// But we don't want to allow the user to update ALL fields.. so copy
+	// over the fields that they are allowed to modify..
+	resource.Name = patch.Name
+	resource.Connector = patch.Connector
+	resource.Kafka = patch.Kafka
+	resource.ServiceAccount = patch.ServiceAccount
+	resource.SchemaRegistry = patch.SchemaRegistry
+	resource.RestartPolicy = patch.RestartPolicy
+	resource.Schedule = patch.Schedule
+
+	if h.connectorsConfig.ConnectorEnableUnassignedConnectors {
+		// check namespace id change, from unassigned to assigned and vice versa
+		if operation == phase.AssignConnector && patch.NamespaceId != "" && resource.NamespaceId == "" {
+			resource.NamespaceId = patch.NamespaceId
+		}
+		if operation == phase.UnassignConnector && patch.NamespaceId == "" && resource.NamespaceId != "" {
+			resource.NamespaceId = patch.NamespaceId
+		}
+	}
+
+	// If we didn't change anything, then just skip the update...
+	originalResource, _ := presenters.PresentConnector(&dbresource.Connector)
+	if reflect.DeepEqual(originalResource, resource) {
+		return originalResource, nil
+	}
+
+	// revalidate
+	user := h.authZService.GetValidationUser(ctx)
+	validates := []handlers.Validate{
+		handlers.Validation("name", &resource.Name, handlers.MinLen(1), handlers.MaxLen(100)),
+		handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
+		// handlers.Validation("kafka_id", &resource.Metadata.KafkaId, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
+		handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
+		handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
+		validateConnector(h.connectorTypesService, &resource, connectorTypeId),
+		validateRestartPolicy(&resource.RestartPolicy),
+		handlers.Validation("schedule.pause", &resource.Schedule.Pause, validateCronExpression()),
+		handlers.Validation("schedule.resume", &resource.Schedule.Resume, validateCronExpression()),
+		handlers.Validation("namespace_id", &resource.NamespaceId, handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest)),
+	}
+	if operation == phase.AssignConnector {
+		// connectors assigned to a namespace count against its quota
+		validates = append(validates, handlers.Validation("namespace_id", &resource.NamespaceId, user.ValidateNamespaceConnectorQuota(),
+			user.ValidateNamespaceConnectorResourceQuota(&resource.ConnectorTypeId, (*string)(&resource.Channel))))
+	}
+
+	for _, v := range validates {
+		err := v()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p, svcErr := presenters.ConvertConnector(resource)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	svcErr = moveSecretsToVault(p, ct, h.vaultService, false)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	// update connector phase before desired state
+	statusChanged := false
+	if originalResource.Status.State != public.ConnectorState(dbapi.ConnectorStatusPhaseAssigning) {
+		dbresource.Status.Phase = phase.ConnectorStartingPhase[operation]
+		p.Status.Phase = dbresource.Status.Phase
+		statusChanged = true
+	}
+	// a changed schedule is re-evaluated by the connector manager
+	if originalResource.Schedule != resource.Schedule {
+		dbresource.Status.NextScheduledAction = ""
+		dbresource.Status.NextScheduledAt = nil
+		statusChanged = true
+	}
+	if statusChanged {
+		serr = h.connectorsService.SaveStatus(ctx, dbresource.Status)
+		if serr != nil {
+			return nil, serr
+		}
+	}
+	// update modified connector including desired state
+	serr = h.connectorsService.Update(ctx, p)
+	if serr != nil {
+		return nil, handlers.ConditionalWriteError(ifMatch, serr)
+	}
+
+	newSecrets, err := getSecretRefs(p, ct)
+	if err != nil {
+		return nil, errors.GeneralError("could not get existing secrets: %v", err)
+	}
+
+	staleSecrets := StringListSubtract(originalSecrets, newSecrets...)
+	if len(staleSecrets) > 0 {
+		_ = db.AddPostCommitAction(ctx, func() {
+			for _, s := range staleSecrets {
+				err = h.vaultService.DeleteSecretString(s)
+				if err != nil {
+					logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
 				}
 			}
+		})
+	}
+
+	if err := stripSecretReferences(p, ct); err != nil {
+		return nil, err
+	}
+	if err := h.createRevision(ctx, p, user.UserId()); err != nil {
+		return nil, err
+	}
+
+	return presenters.PresentConnector(p)
+}
+
+// createRevision records the connector configuration, which must have its secrets stripped, as a new revision
+func (h ConnectorsHandler) createRevision(ctx context.Context, connector *dbapi.Connector, author string) *errors.ServiceError {
+	return h.connectorsService.CreateRevision(ctx, &dbapi.ConnectorRevision{
+		ConnectorID:      connector.ID,
+		ConnectorVersion: connector.Version,
+		ConnectorTypeId:  connector.ConnectorTypeId,
+		ConnectorSpec:    connector.ConnectorSpec,
+		DesiredState:     connector.DesiredState,
+		Channel:          connector.Channel,
+		Author:           author,
+	})
+}
 
-			// If we didn't change anything, then just skip the update...
-			originalResource, _ := presenters.PresentConnector(&dbresource.Connector)
-			if reflect.DeepEqual(originalResource, resource) {
-				return originalResource, nil
+func (h ConnectorsHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+
+			// check the connector exists and is visible to the user
+			if _, err := h.connectorsService.Get(ctx, connectorId, ""); err != nil {
+				return nil, err
 			}
 
-			// revalidate
-			user := h.authZService.GetValidationUser(r.Context())
-			validates := []handlers.Validate{
-				handlers.Validation("name", &resource.Name, handlers.MinLen(1), handlers.MaxLen(100)),
-				handlers.Validation("connector_type_id", &resource.ConnectorTypeId, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
-				// handlers.Validation("kafka_id", &resource.Metadata.KafkaId, handlers.MinLen(1), handlers.MaxLen(maxKafkaNameLength)),
-				handlers.Validation("service_account.client_id", &resource.ServiceAccount.ClientId, handlers.MinLen(1)),
-				handlers.Validation("desired_state", (*string)(&resource.DesiredState), handlers.IsOneOf(dbapi.ValidDesiredStates...)),
-				validateConnector(h.connectorTypesService, &resource, connectorTypeId),
-				handlers.Validation("namespace_id", &resource.NamespaceId, handlers.MaxLen(maxConnectorNamespaceIdLength), user.AuthorizedNamespaceUser(errors.ErrorBadRequest)),
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			revisions, paging, err := h.connectorsService.ListRevisions(ctx, connectorId, listArgs)
+			if err != nil {
+				return nil, err
 			}
 
-			for _, v := range validates {
-				err := v()
+			resourceList := public.ConnectorRevisionList{
+				Kind:  "ConnectorRevisionList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: make([]public.ConnectorRevision, 0, len(revisions)),
+			}
+			for _, revision := range revisions {
+				converted, err := presenters.PresentConnectorRevision(revision)
 				if err != nil {
 					return nil, err
 				}
+				resourceList.Items = append(resourceList.Items, converted)
 			}
 
-			p, svcErr := presenters.ConvertConnector(resource)
-			if svcErr != nil {
-				return nil, svcErr
-			}
+			return resourceList, nil
+		},
+	}
 
-			svcErr = moveSecretsToVault(p, ct, h.vaultService, false)
-			if svcErr != nil {
-				return nil, svcErr
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h ConnectorsHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	connectorId := mux.Vars(r)["connector_id"]
+	revision := r.URL.Query().Get("revision")
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.Validation("revision", &revision, handlers.MinLen(1)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+
+			number, err := strconv.ParseInt(revision, 10, 64)
+			if err != nil || number < 1 {
+				return nil, errors.BadRequest("revision is not valid. Must be a positive integer")
 			}
 
-			// update connector phase before desired state
-			if originalResource.Status.State != public.ConnectorState(dbapi.ConnectorStatusPhaseAssigning) {
-				dbresource.Status.Phase = phase.ConnectorStartingPhase[operation]
-				p.Status.Phase = dbresource.Status.Phase
-				serr = h.connectorsService.SaveStatus(r.Context(), dbresource.Status)
-				if serr != nil {
-					return nil, serr
-				}
+			dbresource, serr := h.connectorsService.Get(ctx, connectorId, "")
+			if serr != nil {
+				return nil, serr
 			}
-			// update modified connector including desired state
-			serr = h.connectorsService.Update(r.Context(), p)
+			target, serr := h.connectorsService.GetRevision(ctx, connectorId, number)
 			if serr != nil {
 				return nil, serr
 			}
-
-			newSecrets, err := getSecretRefs(p, ct)
-			if err != nil {
-				return nil, errors.GeneralError("could not get existing secrets: %v", err)
+			if target.ConnectorTypeId != dbresource.ConnectorTypeId {
+				return nil, errors.BadRequest("revision %d has connector type %s, connector type can not be changed to rollback connector %s",
+					number, target.ConnectorTypeId, connectorId)
 			}
-
-			staleSecrets := StringListSubtract(originalSecrets, newSecrets...)
-			if len(staleSecrets) > 0 {
-				_ = db.AddPostCommitAction(r.Context(), func() {
-					for _, s := range staleSecrets {
-						err = h.vaultService.DeleteSecretString(s)
-						if err != nil {
-							logger.Logger.Errorf("failed to delete vault secret key '%s': %v", s, err)
-						}
-					}
-				})
+			ct, serr := h.connectorTypesService.Get(dbresource.ConnectorTypeId)
+			if serr != nil {
+				return nil, errors.BadRequest("invalid connector type id: %s", dbresource.ConnectorTypeId)
 			}
 
-			if err := stripSecretReferences(p, ct); err != nil {
-				return nil, err
+			// revisions don't include secrets, so diff against the current spec with its secrets stripped,
+			// the resulting patch leaves the current connector secrets in place
+			current := dbresource.Connector
+			if serr := stripSecretReferences(&current, ct); serr != nil {
+				return nil, serr
+			}
+			specPatch, err := jsonpatch.CreateMergePatch(current.ConnectorSpec, target.ConnectorSpec)
+			if err != nil {
+				return nil, errors.GeneralError("failed to create patch for revision %d of connector %s: %v", number, connectorId, err)
+			}
+			patchBytes, err := json.Marshal(map[string]json.RawMessage{"connector": specPatch})
+			if err != nil {
+				return nil, errors.GeneralError("failed to create patch for revision %d of connector %s: %v", number, connectorId, err)
 			}
 
-			return presenters.PresentConnector(p)
+			return h.patchConnector(ctx, connectorId, "", "", "application/merge-patch+json", patchBytes)
 		},
 	}
 
@@ -296,6 +498,14 @@ func (h ConnectorsHandler) Patch(w http.ResponseWriter, r *http.Request) {
 	handlers.Handle(w, r, cfg, http.StatusAccepted)
 }
 
+// connectorWarnings returns warnings for the user about a connector of the given type
+func connectorWarnings(ct *dbapi.ConnectorType) []string {
+	if warning := ct.DeprecationWarning(time.Now()); warning != "" {
+		return []string{warning}
+	}
+	return nil
+}
+
 func (h ConnectorsHandler) getOperation(resource public.Connector, patch public.ConnectorRequest) (phase.ConnectorOperation, *errors.ServiceError) {
 	operation, ok := stateToOperationsMap[patch.DesiredState]
 	if !ok {
@@ -436,8 +646,16 @@ func (h ConnectorsHandler) Get(w http.ResponseWriter, r *http.Request) {
 				}
 			}
 
-			return presenters.PresentConnectorWithError(resource)
+			connector, err := presenters.PresentConnectorWithError(resource)
+			if err != nil {
+				return nil, err
+			}
+			if ct != nil {
+				connector.Warnings = connectorWarnings(ct)
+			}
+			return connector, nil
 		},
+		ETag: connectorETag,
 	}
 	handlers.HandleGet(w, r, cfg)
 }
@@ -448,14 +666,42 @@ func (h ConnectorsHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.Validation("connector_id", &connectorId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+			handlers.ValidateIfMatch(r, currentConnectorETag(r.Context(), h.connectorsService, connectorId, "")),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
 
 			ctx := r.Context()
-			return nil, HandleConnectorDelete(ctx, h.connectorsService, h.namespaceService, connectorId)
+			if err := HandleConnectorDelete(ctx, h.connectorsService, h.namespaceService, connectorId); err != nil {
+				return nil, err
+			}
+			operation, err := registerConnectorDeletion(ctx, h.connectorsService, h.operationService, connectorId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(operation), nil
 		},
+		Location: operationLocation,
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
+}
+
+// connectorETag returns the entity tag of a presented connector, derived from its resource version
+func connectorETag(result interface{}) string {
+	if connector, ok := result.(public.Connector); ok {
+		return handlers.ETag(connector.ResourceVersion)
+	}
+	return ""
+}
+
+// currentConnectorETag returns the entity tag of the stored version of a connector, for the If-Match header of a request to be checked against it
+func currentConnectorETag(ctx context.Context, connectorsService services.ConnectorsService, connectorId string, connectorTypeId string) func() (string, *errors.ServiceError) {
+	return func() (string, *errors.ServiceError) {
+		connector, err := connectorsService.Get(ctx, connectorId, connectorTypeId)
+		if err != nil {
+			return "", err
+		}
+		return handlers.ETag(connector.Version), nil
 	}
-	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
 }
 
 func HandleConnectorDelete(ctx context.Context, connectorsService services.ConnectorsService,
@@ -522,9 +768,12 @@ func (h ConnectorsHandler) List(w http.ResponseWriter, r *http.Request) {
 
 				converted, err := presenters.PresentConnectorWithError(resource)
 				if err != nil {
-					glog.Errorf("connector id='%s' presentation failed: %v", resource.ID, err)
+					logger.NewUHCLogger(ctx).WithFields(logger.ConnectorIDField, resource.ID).Errorf("connector id='%s' presentation failed: %v", resource.ID, err)
 					return nil, errors.GeneralError("internal error")
 				}
+				if ct != nil {
+					converted.Warnings = connectorWarnings(ct)
+				}
 				resourceList.Items = append(resourceList.Items, converted)
 
 			}
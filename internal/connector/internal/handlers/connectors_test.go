@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/authz"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+func Test_ConnectorsHandler_Rollback(t *testing.T) {
+	connectorType := &dbapi.ConnectorType{
+		Model:      db.Model{ID: "type-a"},
+		JsonSchema: api.JSON(`{"type":"object","properties":{"level":{"type":"string"}}}`),
+		Channels:   []dbapi.ConnectorChannel{{Channel: "stable"}},
+	}
+	newConnector := func() *dbapi.ConnectorWithConditions {
+		return &dbapi.ConnectorWithConditions{
+			Connector: dbapi.Connector{
+				Model:           db.Model{ID: "connector-id"},
+				Name:            "connector",
+				Owner:           "user",
+				OrganisationId:  "org",
+				Version:         3,
+				ConnectorTypeId: "type-a",
+				ConnectorSpec:   api.JSON(`{"level":"debug"}`),
+				DesiredState:    dbapi.ConnectorReady,
+				Channel:         "stable",
+				ServiceAccount:  dbapi.ServiceAccount{ClientId: "client-id"},
+				RestartPolicy:   dbapi.ConnectorRestartPolicy{Type: dbapi.ConnectorRestartPolicyNever},
+				Status:          dbapi.ConnectorStatus{Phase: dbapi.ConnectorStatusPhaseReady},
+			},
+		}
+	}
+	revision := &dbapi.ConnectorRevision{
+		ConnectorID:     "connector-id",
+		Revision:        1,
+		ConnectorTypeId: "type-a",
+		ConnectorSpec:   api.JSON(`{"level":"info"}`),
+	}
+
+	tests := []struct {
+		name           string
+		revision       string
+		getErr         *errors.ServiceError
+		targetRevision *dbapi.ConnectorRevision
+		getRevisionErr *errors.ServiceError
+		updateErr      *errors.ServiceError
+		wantStatusCode int
+		wantUpdated    bool
+	}{
+		{
+			name:           "should rollback the connector spec to the revision",
+			revision:       "1",
+			targetRevision: revision,
+			wantStatusCode: http.StatusAccepted,
+			wantUpdated:    true,
+		},
+		{
+			name:           "should return bad request for an invalid revision",
+			revision:       "0",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return not found for a missing revision",
+			revision:       "7",
+			getRevisionErr: errors.NotFound("Connector revision with revision='7' not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "should return not found for a revision of a connector in another organisation",
+			revision:       "1",
+			getErr:         errors.NotFound("Connector with id='connector-id' not found"),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:     "should return bad request for a revision with another connector type",
+			revision: "1",
+			targetRevision: &dbapi.ConnectorRevision{
+				ConnectorID:     "connector-id",
+				Revision:        1,
+				ConnectorTypeId: "type-b",
+				ConnectorSpec:   api.JSON(`{"level":"info"}`),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "should return a conflict if the connector version is stale",
+			revision:       "1",
+			targetRevision: revision,
+			updateErr:      errors.Conflict("failed to update connector: resource version changed"),
+			wantStatusCode: http.StatusConflict,
+			wantUpdated:    true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *dbapi.Connector
+			connectorsService := &services.ConnectorsServiceMock{
+				GetFunc: func(ctx context.Context, id string, tid string) (*dbapi.ConnectorWithConditions, *errors.ServiceError) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return newConnector(), nil
+				},
+				GetRevisionFunc: func(ctx context.Context, connectorId string, number int64) (*dbapi.ConnectorRevision, *errors.ServiceError) {
+					if tt.getRevisionErr != nil {
+						return nil, tt.getRevisionErr
+					}
+					return tt.targetRevision, nil
+				},
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
+					updated = resource
+					return tt.updateErr
+				},
+				CreateRevisionFunc: func(ctx context.Context, revision *dbapi.ConnectorRevision) *errors.ServiceError {
+					return nil
+				},
+			}
+			connectorTypesService := &services.ConnectorTypesServiceMock{
+				GetFunc: func(id string) (*dbapi.ConnectorType, *errors.ServiceError) {
+					return connectorType, nil
+				},
+			}
+			h := NewConnectorsHandler(connectorsService, connectorTypesService, &services.ConnectorNamespaceServiceMock{},
+				nil, authz.NewAuthZService(nil, nil, connectorsService), &config.ConnectorsConfig{}, &operations.OperationServiceMock{})
+
+			req, err := http.NewRequest(http.MethodPost, "/connectors/connector-id/rollback?revision="+tt.revision, nil)
+			Expect(err).ToNot(HaveOccurred())
+			req = mux.SetURLVars(req, map[string]string{"connector_id": "connector-id"})
+			req = req.WithContext(auth.SetTokenInContext(req.Context(), &jwt.Token{
+				Claims: jwt.MapClaims{"username": "user", "org_id": "org"},
+			}))
+			rw := httptest.NewRecorder()
+			h.Rollback(rw, req)
+
+			resp := rw.Result()
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
+			Expect(updated != nil).To(Equal(tt.wantUpdated))
+			if tt.getErr != nil {
+				Expect(connectorsService.GetRevisionCalls()).To(BeEmpty())
+			}
+			if !tt.wantUpdated {
+				return
+			}
+
+			var spec map[string]interface{}
+			Expect(json.Unmarshal(updated.ConnectorSpec, &spec)).To(Succeed())
+			Expect(spec).To(Equal(map[string]interface{}{"level": "info"}))
+			Expect(updated.Version).To(Equal(int64(3)))
+			if tt.updateErr != nil {
+				Expect(connectorsService.CreateRevisionCalls()).To(BeEmpty())
+			} else {
+				Expect(connectorsService.CreateRevisionCalls()).To(HaveLen(1))
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
+	"github.com/gorilla/mux"
+)
+
+type OperationHandler struct {
+	service operations.OperationService
+}
+
+func NewOperationHandler(service operations.OperationService) *OperationHandler {
+	return &OperationHandler{
+		service: service,
+	}
+}
+
+func (h OperationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	operationId := mux.Vars(r)["id"]
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.Validation("id", &operationId, handlers.MinLen(1), handlers.MaxLen(maxConnectorIdLength)),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			resource, err := h.service.Get(r.Context(), operationId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(resource), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h OperationHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			resources, paging, err := h.service.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			resourceList := public.OperationList{
+				Kind:  "OperationList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.Operation{},
+			}
+			for _, resource := range resources {
+				resourceList.Items = append(resourceList.Items, presenters.PresentOperation(resource))
+			}
+
+			return resourceList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// presentOperationList presents the operations tracking the asynchronous actions accepted by a request, in a single page
+func presentOperationList(operations api.OperationList) public.OperationList {
+	resourceList := public.OperationList{
+		Kind:  "OperationList",
+		Page:  1,
+		Size:  int32(len(operations)),
+		Total: int32(len(operations)),
+		Items: []public.Operation{},
+	}
+	for _, operation := range operations {
+		resourceList.Items = append(resourceList.Items, presenters.PresentOperation(operation))
+	}
+	return resourceList
+}
+
+// operationLocation returns the path of the operation returned by the action of an asynchronous request. A request
+// accepting several actions has no single operation to point to.
+func operationLocation(result interface{}) string {
+	switch operation := result.(type) {
+	case public.Operation:
+		return operation.Href
+	case public.OperationList:
+		if len(operation.Items) == 1 {
+			return operation.Items[0].Href
+		}
+	}
+	return ""
+}
+
+// registerConnectorDeletion returns the operation tracking the deletion of a connector until its record is deleted
+func registerConnectorDeletion(ctx context.Context, connectorsService services.ConnectorsService, operationService operations.OperationService, connectorId string) (*api.Operation, *errors.ServiceError) {
+	connector, err := connectorsService.Get(ctx, connectorId, "")
+	if err != nil {
+		return nil, err
+	}
+	return operationService.Create(ctx, &api.Operation{
+		Kind:           api.OperationKindConnectorDeletion.String(),
+		TargetKind:     api.OperationTargetConnector,
+		TargetId:       connector.ID,
+		OrganisationId: connector.OrganisationId,
+		Owner:          connector.Owner,
+	})
+}
@@ -0,0 +1,44 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorRestartPolicyAndSchedule(migrationId string) *gormigrate.Migration {
+
+	type ConnectorRestartPolicy struct {
+		Type           string `gorm:"not null;default:never"`
+		MaxRetries     int32  `gorm:"not null;default:0"`
+		BackoffSeconds int32  `gorm:"not null;default:0"`
+	}
+
+	type ConnectorSchedule struct {
+		Pause  string `gorm:"not null;default:''"`
+		Resume string `gorm:"not null;default:''"`
+	}
+
+	type Connector struct {
+		RestartPolicy ConnectorRestartPolicy `gorm:"embedded;embeddedPrefix:restart_policy_"`
+		Schedule      ConnectorSchedule      `gorm:"embedded;embeddedPrefix:schedule_"`
+	}
+
+	type ConnectorStatus struct {
+		RetryCount          int32 `gorm:"not null;default:0"`
+		NextRetryAt         *time.Time
+		NextScheduledAction string
+		NextScheduledAt     *time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.AddTableColumnsAction(&Connector{}),
+		db.AddTableColumnsAction(&ConnectorStatus{}),
+	)
+}
@@ -0,0 +1,30 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addConnectorRevisionsTable(migrationId string) *gormigrate.Migration {
+
+	type ConnectorRevision struct {
+		db.Model
+		ConnectorID      string `gorm:"not null;uniqueIndex:idx_connector_revisions_connector_id_revision"`
+		Revision         int64  `gorm:"not null;uniqueIndex:idx_connector_revisions_connector_id_revision"`
+		ConnectorVersion int64
+		ConnectorTypeId  string
+		ConnectorSpec    string `gorm:"type:jsonb"`
+		DesiredState     string
+		Channel          string
+		Author           string
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&ConnectorRevision{}),
+	)
+}
@@ -2,6 +2,7 @@ package migrations
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/go-gormigrate/gormigrate/v2"
 )
 
@@ -42,13 +43,23 @@ var migrations = []*gormigrate.Migration{
 	addConnectorTypeChecksum("202204050000"),
 	removeConnectorsDeployedColumn("202204270000"),
 	fixConnectorNamespaceVersionTrigger("202206060000"),
+	addConnectorTypeDeprecation("202207010000"),
+	addConnectorRestartPolicyAndSchedule("202207050000"),
+	addConnectorRevisionsTable("202207080000"),
+}
+
+var gormOptions = &gormigrate.Options{
+	TableName:      "connector_migrations",
+	IDColumnName:   "id",
+	IDColumnSize:   255,
+	UseTransaction: false,
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
-	return db.NewMigration(dbConfig, &gormigrate.Options{
-		TableName:      "connector_migrations",
-		IDColumnName:   "id",
-		IDColumnSize:   255,
-		UseTransaction: false,
-	}, migrations)
+	return db.NewMigration(dbConfig, gormOptions, migrations)
+}
+
+// NewHealthCheck returns the health check of the migrations of the service being applied
+func NewHealthCheck(connectionFactory *db.ConnectionFactory) *environments.FuncHealthCheck {
+	return db.NewMigrationHealthCheck("connector_migrations", connectionFactory, gormOptions, migrations)
 }
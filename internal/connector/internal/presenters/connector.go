@@ -47,12 +47,29 @@ func ConvertConnector(from public.Connector) (*dbapi.Connector, *errors.ServiceE
 			ClientId:     from.ServiceAccount.ClientId,
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
+		RestartPolicy: ConvertConnectorRestartPolicy(from.RestartPolicy),
+		Schedule: dbapi.ConnectorSchedule{
+			Pause:  from.Schedule.Pause,
+			Resume: from.Schedule.Resume,
+		},
 		Status: dbapi.ConnectorStatus{
 			Phase: dbapi.ConnectorStatusPhase(from.Status.State),
 		},
 	}, nil
 }
 
+func ConvertConnectorRestartPolicy(from public.ConnectorRestartPolicy) dbapi.ConnectorRestartPolicy {
+	policyType := dbapi.ConnectorRestartPolicyType(from.Type)
+	if policyType == "" {
+		policyType = dbapi.ConnectorRestartPolicyNever
+	}
+	return dbapi.ConnectorRestartPolicy{
+		Type:           policyType,
+		MaxRetries:     from.MaxRetries,
+		BackoffSeconds: from.BackoffSeconds,
+	}
+}
+
 func PresentConnectorWithError(from *dbapi.ConnectorWithConditions) (public.Connector, *errors.ServiceError) {
 	connector, err := PresentConnector(&from.Connector)
 	if err != nil {
@@ -92,6 +109,23 @@ func PresentConnectorAdminView(from *dbapi.ConnectorWithConditions) (admin.Conne
 		},
 		DesiredState: admin.ConnectorDesiredState(from.DesiredState),
 		Channel:      admin.Channel(from.Channel),
+		RestartPolicy: admin.ConnectorRestartPolicy{
+			Type:           string(from.RestartPolicy.Type),
+			MaxRetries:     from.RestartPolicy.MaxRetries,
+			BackoffSeconds: from.RestartPolicy.BackoffSeconds,
+		},
+		Schedule: admin.ConnectorSchedule{
+			Pause:  from.Schedule.Pause,
+			Resume: from.Schedule.Resume,
+		},
+	}
+	connector.Status.RetryCount = from.Status.RetryCount
+	connector.Status.NextScheduledAction = admin.ConnectorDesiredState(from.Status.NextScheduledAction)
+	if from.Status.NextRetryAt != nil {
+		connector.Status.NextRetryAt = *from.Status.NextRetryAt
+	}
+	if from.Status.NextScheduledAt != nil {
+		connector.Status.NextScheduledAt = *from.Status.NextScheduledAt
 	}
 	reference := PresentReference(connector.Id, connector)
 	connector.Kind = reference.Kind
@@ -140,6 +174,18 @@ func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceE
 		namespaceId = *from.NamespaceId
 	}
 
+	status := public.ConnectorStatusStatus{
+		State:               public.ConnectorState(from.Status.Phase),
+		RetryCount:          from.Status.RetryCount,
+		NextScheduledAction: public.ConnectorDesiredState(from.Status.NextScheduledAction),
+	}
+	if from.Status.NextRetryAt != nil {
+		status.NextRetryAt = *from.Status.NextRetryAt
+	}
+	if from.Status.NextScheduledAt != nil {
+		status.NextScheduledAt = *from.Status.NextScheduledAt
+	}
+
 	reference := PresentReference(from.ID, from)
 	return public.Connector{
 		Id:   reference.Id,
@@ -154,11 +200,9 @@ func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceE
 		NamespaceId:     namespaceId,
 		ConnectorTypeId: from.ConnectorTypeId,
 		Connector:       spec,
-		Status: public.ConnectorStatusStatus{
-			State: public.ConnectorState(from.Status.Phase),
-		},
-		DesiredState: public.ConnectorDesiredState(from.DesiredState),
-		Channel:      public.Channel(from.Channel),
+		Status:          status,
+		DesiredState:    public.ConnectorDesiredState(from.DesiredState),
+		Channel:         public.Channel(from.Channel),
 		Kafka: public.KafkaConnectionSettings{
 			Id:  from.Kafka.KafkaID,
 			Url: from.Kafka.BootstrapServer,
@@ -171,5 +215,14 @@ func PresentConnector(from *dbapi.Connector) (public.Connector, *errors.ServiceE
 			ClientId:     from.ServiceAccount.ClientId,
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
+		RestartPolicy: public.ConnectorRestartPolicy{
+			Type:           string(from.RestartPolicy.Type),
+			MaxRetries:     from.RestartPolicy.MaxRetries,
+			BackoffSeconds: from.RestartPolicy.BackoffSeconds,
+		},
+		Schedule: public.ConnectorSchedule{
+			Pause:  from.Schedule.Pause,
+			Resume: from.Schedule.Resume,
+		},
 	}, nil
 }
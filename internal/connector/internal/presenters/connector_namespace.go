@@ -177,7 +177,13 @@ func PresentConnectorNamespace(namespace *dbapi.ConnectorNamespace, quotaConfig
 			State:              public.ConnectorNamespaceState(namespace.Status.Phase),
 			Version:            namespace.Status.Version,
 			ConnectorsDeployed: namespace.Status.ConnectorsDeployed,
-			Error:              getError(namespace.Status.Conditions),
+			Resources: public.ConnectorNamespaceResources{
+				MemoryRequests: namespace.Status.Resources.MemoryRequests,
+				MemoryLimits:   namespace.Status.Resources.MemoryLimits,
+				CpuRequests:    namespace.Status.Resources.CPURequests,
+				CpuLimits:      namespace.Status.Resources.CPULimits,
+			},
+			Error: getError(namespace.Status.Conditions),
 		},
 	}
 	if namespace.TenantUser != nil {
@@ -242,7 +248,13 @@ func PresentConnectorNamespaceDeployment(namespace *dbapi.ConnectorNamespace, qu
 			State:              private.ConnectorNamespaceState(namespace.Status.Phase),
 			Version:            namespace.Status.Version,
 			ConnectorsDeployed: namespace.Status.ConnectorsDeployed,
-			Error:              getError(namespace.Status.Conditions),
+			Resources: private.ConnectorNamespaceResources{
+				MemoryRequests: namespace.Status.Resources.MemoryRequests,
+				MemoryLimits:   namespace.Status.Resources.MemoryLimits,
+				CpuRequests:    namespace.Status.Resources.CPURequests,
+				CpuLimits:      namespace.Status.Resources.CPULimits,
+			},
+			Error: getError(namespace.Status.Conditions),
 		},
 	}
 	if namespace.TenantUser != nil {
@@ -298,7 +310,13 @@ func PresentPrivateConnectorNamespace(namespace *dbapi.ConnectorNamespace, quota
 			State:              admin.ConnectorNamespaceState(namespace.Status.Phase),
 			Version:            namespace.Status.Version,
 			ConnectorsDeployed: namespace.Status.ConnectorsDeployed,
-			Error:              getError(namespace.Status.Conditions),
+			Resources: admin.ConnectorNamespaceResources{
+				MemoryRequests: namespace.Status.Resources.MemoryRequests,
+				MemoryLimits:   namespace.Status.Resources.MemoryLimits,
+				CpuRequests:    namespace.Status.Resources.CPURequests,
+				CpuLimits:      namespace.Status.Resources.CPULimits,
+			},
+			Error: getError(namespace.Status.Conditions),
 		},
 	}
 	if namespace.TenantUser != nil {
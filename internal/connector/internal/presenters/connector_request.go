@@ -38,5 +38,10 @@ func ConvertConnectorRequest(from public.ConnectorRequest) (*dbapi.Connector, *e
 			ClientId:     from.ServiceAccount.ClientId,
 			ClientSecret: from.ServiceAccount.ClientSecret,
 		},
+		RestartPolicy: ConvertConnectorRestartPolicy(from.RestartPolicy),
+		Schedule: dbapi.ConnectorSchedule{
+			Pause:  from.Schedule.Pause,
+			Resume: from.Schedule.Resume,
+		},
 	}, nil
 }
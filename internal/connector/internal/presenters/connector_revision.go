@@ -0,0 +1,28 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+func PresentConnectorRevision(from *dbapi.ConnectorRevision) (public.ConnectorRevision, *errors.ServiceError) {
+	spec := map[string]interface{}{}
+	if err := from.ConnectorSpec.Unmarshal(&spec); err != nil {
+		return public.ConnectorRevision{}, errors.GeneralError("invalid connector spec in revision %d of connector %s: %v",
+			from.Revision, from.ConnectorID, err)
+	}
+
+	return public.ConnectorRevision{
+		Id:                       from.ID,
+		Kind:                     KindConnectorRevision,
+		Revision:                 from.Revision,
+		ConnectorResourceVersion: from.ConnectorVersion,
+		ConnectorTypeId:          from.ConnectorTypeId,
+		Channel:                  public.Channel(from.Channel),
+		DesiredState:             public.ConnectorDesiredState(from.DesiredState),
+		Connector:                spec,
+		Author:                   from.Author,
+		CreatedAt:                from.CreatedAt,
+	}, nil
+}
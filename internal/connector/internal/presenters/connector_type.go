@@ -63,7 +63,7 @@ func PresentConnectorType(from *dbapi.ConnectorType) (*public.ConnectorType, err
 		return nil, err
 	}
 	reference := PresentReference(from.ID, from)
-	return &public.ConnectorType{
+	result := &public.ConnectorType{
 		Id:           reference.Id,
 		Kind:         reference.Kind,
 		Href:         reference.Href,
@@ -75,7 +75,15 @@ func PresentConnectorType(from *dbapi.ConnectorType) (*public.ConnectorType, err
 		Labels:       from.LabelNames(),
 		Channels:     toChannelSlice(from.ChannelNames()),
 		Capabilities: from.CapabilitiesNames(),
-	}, nil
+		Deprecated:   from.Deprecated,
+	}
+	if from.ReplacedBy != nil {
+		result.ReplacedBy = *from.ReplacedBy
+	}
+	if from.EndOfLife != nil {
+		result.EndOfLife = *from.EndOfLife
+	}
+	return result, nil
 }
 
 func PresentConnectorTypeAdminView(from dbapi.ConnectorCatalogEntry) (*admin.ConnectorTypeAdminView, *errors.ServiceError) {
@@ -87,6 +95,13 @@ func PresentConnectorTypeAdminView(from dbapi.ConnectorCatalogEntry) (*admin.Con
 		IconHref:    from.ConnectorType.IconHref,
 		Labels:      make([]string, len(from.ConnectorType.Labels)),
 		Channels:    make(map[string]admin.ConnectorTypeChannel),
+		Deprecated:  from.ConnectorType.Deprecated,
+	}
+	if from.ConnectorType.ReplacedBy != nil {
+		view.ReplacedBy = *from.ConnectorType.ReplacedBy
+	}
+	if from.ConnectorType.EndOfLife != nil {
+		view.EndOfLife = *from.ConnectorType.EndOfLife
 	}
 
 	for i, l := range from.ConnectorType.Labels {
@@ -117,3 +132,28 @@ func PresentConnectorTypeAdminView(from dbapi.ConnectorCatalogEntry) (*admin.Con
 
 	return &view, nil
 }
+
+func PresentConnectorTypeDeprecation(from dbapi.ConnectorTypeDeprecation) (*admin.ConnectorTypeDeprecation, *errors.ServiceError) {
+	deprecation := admin.ConnectorTypeDeprecation{
+		ConnectorTypeId: from.ConnectorType.ID,
+		Name:            from.ConnectorType.Name,
+		Version:         from.ConnectorType.Version,
+		Connectors:      make([]admin.ConnectorAdminView, len(from.Connectors)),
+	}
+	if from.ConnectorType.ReplacedBy != nil {
+		deprecation.ReplacedBy = *from.ConnectorType.ReplacedBy
+	}
+	if from.ConnectorType.EndOfLife != nil {
+		deprecation.EndOfLife = *from.ConnectorType.EndOfLife
+	}
+
+	for i, connector := range from.Connectors {
+		view, err := PresentConnectorAdminView(&dbapi.ConnectorWithConditions{Connector: *connector})
+		if err != nil {
+			return nil, err
+		}
+		deprecation.Connectors[i] = view
+	}
+
+	return &deprecation, nil
+}
@@ -5,6 +5,7 @@ import (
 	admin "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/admin/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/compat"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 )
@@ -22,12 +23,16 @@ const (
 	KindConnectorDeploymentAdminView = "ConnectorDeploymentAdminView"
 	// KindConnectorNamespace is a string identifier for the type dbapi.ConnectorNamespace
 	KindConnectorNamespace = "ConnectorNamespace"
+	// KindConnectorRevision is a string identifier for the type dbapi.ConnectorRevision
+	KindConnectorRevision = "ConnectorRevision"
 	// KindConnectorType is a string identifier for the type dbapi.ConnectorType
 	KindConnectorType = "ConnectorType"
 	// ConnectorTypeAdminView is a string identifier for the type admin.ConnectorTypeAdminView
 	ConnectorTypeAdminView = "ConnectorTypeAdminView"
 	// KindError is a string identifier for the type api.ServiceError
 	KindError = "Error"
+	// KindOperation is a string identifier for the type api.Operation
+	KindOperation = "Operation"
 )
 
 func PresentReference(id, obj interface{}) compat.ObjectReference {
@@ -54,6 +59,8 @@ func objectKind(i interface{}) string {
 		return ConnectorTypeAdminView
 	case errors.ServiceError, *errors.ServiceError:
 		return KindError
+	case api.Operation, *api.Operation:
+		return KindOperation
 	default:
 		return ""
 	}
@@ -81,6 +88,8 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("/api/connector_mgmt/v1/admin/kafka_connector_clusters/%s/deployments/%s", obj.Spec.ClusterId, id)
 	case dbapi.ConnectorNamespace, *dbapi.ConnectorNamespace:
 		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_namespaces/%s", id)
+	case api.Operation, *api.Operation:
+		return fmt.Sprintf("/api/connector_mgmt/v1/operations/%s", id)
 	default:
 		return ""
 	}
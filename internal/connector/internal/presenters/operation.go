@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+func PresentOperation(operation *api.Operation) public.Operation {
+	reference := PresentReference(operation.ID, operation)
+
+	return public.Operation{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		Href:          reference.Href,
+		OperationKind: operation.Kind,
+		Target: public.ObjectReference{
+			Id:   operation.TargetId,
+			Kind: operation.TargetKind,
+			Href: operationTargetPath(operation),
+		},
+		State:       operation.State,
+		Progress:    operation.Progress,
+		Error:       operation.Error,
+		CreatedAt:   operation.CreatedAt,
+		UpdatedAt:   operation.UpdatedAt,
+		StartedAt:   operation.StartedAt,
+		CompletedAt: operation.CompletedAt,
+	}
+}
+
+// operationTargetPath returns the path of the target of the operation, the data plane clusters have none in this API
+func operationTargetPath(operation *api.Operation) string {
+	switch operation.TargetKind {
+	case api.OperationTargetConnector:
+		return fmt.Sprintf("/api/connector_mgmt/v1/kafka_connectors/%s", operation.TargetId)
+	case api.OperationTargetKafka:
+		return fmt.Sprintf("/api/kafkas_mgmt/v1/kafkas/%s", operation.TargetId)
+	default:
+		return ""
+	}
+}
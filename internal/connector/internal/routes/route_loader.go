@@ -35,7 +35,9 @@ type options struct {
 	ConnectorsHandler         *handlers.ConnectorsHandler
 	ConnectorClusterHandler   *handlers.ConnectorClusterHandler
 	ConnectorNamespaceHandler *handlers.ConnectorNamespaceHandler
+	OperationHandler          *handlers.OperationHandler
 	DB                        *db.ConnectionFactory
+	IdempotencyMiddleware     *coreHandlers.IdempotencyMiddleware
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -86,14 +88,20 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	})
 
 	apiV1ConnectorsRouter := apiV1Router.PathPrefix("/kafka_connectors").Subrouter()
-	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.Create).Methods(http.MethodPost)
 	apiV1ConnectorsRouter.HandleFunc("", s.ConnectorsHandler.List).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/validate", s.ConnectorsHandler.ValidateConnector).Methods(http.MethodPost)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Get).Methods(http.MethodGet)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConnectorsRouter.HandleFunc("/{connector_id}", s.ConnectorsHandler.Delete).Methods(http.MethodDelete)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/revisions", s.ConnectorsHandler.ListRevisions).Methods(http.MethodGet)
+	apiV1ConnectorsRouter.HandleFunc("/{connector_id}/rollback", s.ConnectorsHandler.Rollback).Methods(http.MethodPost)
 	apiV1ConnectorsRouter.Use(authorizeMiddleware)
 	apiV1ConnectorsRouter.Use(requireOrgID)
 
+	apiV1ConnectorsCreateRouter := apiV1ConnectorsRouter.NewRoute().Subrouter()
+	apiV1ConnectorsCreateRouter.HandleFunc("", s.ConnectorsHandler.Create).Methods(http.MethodPost)
+	apiV1ConnectorsCreateRouter.Use(s.IdempotencyMiddleware.IdempotencyKey)
+
 	//  /api/connector_mgmt/v1/kafka_connector_clusters
 	v1Collections = append(v1Collections, api.CollectionMetadata{
 		ID:   "kafka_connector_clusters",
@@ -133,6 +141,18 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	apiV1ConnectorNamespacesRouter.Use(authorizeMiddleware)
 	apiV1ConnectorNamespacesRouter.Use(requireOrgID)
 
+	//  /api/connector_mgmt/v1/operations
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "operations",
+		Kind: "OperationList",
+	})
+
+	apiV1OperationsRouter := apiV1Router.PathPrefix("/operations").Subrouter()
+	apiV1OperationsRouter.HandleFunc("", s.OperationHandler.List).Methods(http.MethodGet)
+	apiV1OperationsRouter.HandleFunc("/{id}", s.OperationHandler.Get).Methods(http.MethodGet)
+	apiV1OperationsRouter.Use(authorizeMiddleware)
+	apiV1OperationsRouter.Use(requireOrgID)
+
 	// This section adds the API's accessed by the connector agent...
 	{
 		//  /api/connector_mgmt/v1/kafka_connector_clusters/{id}
@@ -177,6 +197,7 @@ func (s *options) AddRoutes(mainRouter *mux.Router) error {
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.GetConnector).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connectors/{connector_id}", s.ConnectorAdminHandler.DeleteConnector).Methods(http.MethodDelete)
 	adminRouter.HandleFunc("/kafka_connector_types", s.ConnectorAdminHandler.ListConnectorTypes).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_connector_types/deprecations", s.ConnectorAdminHandler.ListConnectorTypeDeprecations).Methods(http.MethodGet)
 	adminRouter.HandleFunc("/kafka_connector_types/{connector_type_id}", s.ConnectorAdminHandler.GetConnectorType).Methods(http.MethodGet)
 
 	v1Metadata := api.VersionMetadata{
@@ -187,6 +187,19 @@ func (u *ValidationUser) ValidateNamespaceConnectorQuota() handlers.ValidateOpti
 	}
 }
 
+func (u *ValidationUser) ValidateNamespaceConnectorResourceQuota(connectorTypeId *string, channel *string) handlers.ValidateOption {
+	return func(field string, value *string) (err *errors.ServiceError) {
+		if u.err != nil {
+			err = u.err
+		} else {
+			if value != nil && len(*value) > 0 {
+				err = u.service.namespaceService.CheckConnectorResourceQuota(*value, *connectorTypeId, *channel)
+			}
+		}
+		return err
+	}
+}
+
 func (u *User) IsOrgAdmin() bool {
 	return u.claims.IsOrgAdmin()
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
 	"github.com/spyzhov/ajson"
@@ -43,9 +44,9 @@ type ConnectorClusterService interface {
 	GetDeploymentByConnectorId(ctx context.Context, connectorID string) (dbapi.ConnectorDeployment, *errors.ServiceError)
 	GetDeployment(ctx context.Context, id string) (dbapi.ConnectorDeployment, *errors.ServiceError)
 	GetAvailableDeploymentTypeUpgrades(listArgs *services.ListArguments) (dbapi.ConnectorDeploymentTypeUpgradeList, *api.PagingMeta, *errors.ServiceError)
-	UpgradeConnectorsByType(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentTypeUpgradeList) *errors.ServiceError
+	UpgradeConnectorsByType(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentTypeUpgradeList) (api.OperationList, *errors.ServiceError)
 	GetAvailableDeploymentOperatorUpgrades(listArgs *services.ListArguments) (dbapi.ConnectorDeploymentOperatorUpgradeList, *api.PagingMeta, *errors.ServiceError)
-	UpgradeConnectorsByOperator(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentOperatorUpgradeList) *errors.ServiceError
+	UpgradeConnectorsByOperator(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentOperatorUpgradeList) (api.OperationList, *errors.ServiceError)
 	CleanupDeployments() *errors.ServiceError
 	ReconcileEmptyDeletingClusters(ctx context.Context, clusterIds []string) (int, []*errors.ServiceError)
 	ReconcileNonEmptyDeletingClusters(ctx context.Context, clusterIds []string) (int, []*errors.ServiceError)
@@ -65,11 +66,13 @@ type connectorClusterService struct {
 	keycloakService           sso.KafkaKeycloakService
 	connectorsService         ConnectorsService
 	connectorNamespaceService ConnectorNamespaceService
+	operationService          operations.OperationService
 }
 
 func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus signalbus.SignalBus, vaultService vault.VaultService,
 	connectorTypesService ConnectorTypesService, connectorsService ConnectorsService,
-	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService) *connectorClusterService {
+	keycloakService sso.KafkaKeycloakService, connectorNamespaceService ConnectorNamespaceService,
+	operationService operations.OperationService) *connectorClusterService {
 	return &connectorClusterService{
 		connectionFactory:         connectionFactory,
 		bus:                       bus,
@@ -78,6 +81,7 @@ func NewConnectorClusterService(connectionFactory *db.ConnectionFactory, bus sig
 		connectorsService:         connectorsService,
 		keycloakService:           keycloakService,
 		connectorNamespaceService: connectorNamespaceService,
+		operationService:          operationService,
 	}
 }
 
@@ -221,7 +225,7 @@ func (k *connectorClusterService) Delete(ctx context.Context, id string) *errors
 // List returns all connector clusters visible to the user within the requested paging window.
 func (k *connectorClusterService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.ConnectorClusterList, *api.PagingMeta, *errors.ServiceError) {
 	var resourceList dbapi.ConnectorClusterList
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(ctx)
 	pagingMeta := &api.PagingMeta{
 		Page: listArgs.Page,
 		Size: listArgs.Size,
@@ -451,6 +455,7 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 		return services.HandleGetError("Connector", "id", deployment.ConnectorID, err)
 	}
 
+	previousPhase := connectorStatus.Phase
 	connectorStatus.Phase = deploymentStatus.Phase
 	if deploymentStatus.Phase == dbapi.ConnectorStatusPhaseDeleted {
 		// we don't need the deployment anymore...
@@ -460,10 +465,23 @@ func (k *connectorClusterService) UpdateConnectorDeploymentStatus(ctx context.Co
 	}
 
 	// update the connector status
-	if err := dbConn.Where("id = ?", deployment.ConnectorID).Updates(&connectorStatus).Error; err != nil {
+	if err := dbConn.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", deployment.ConnectorID).Updates(&connectorStatus).Error; err != nil {
+			return err
+		}
+		return publishConnectorStatusChange(tx, deployment.ConnectorID, previousPhase, connectorStatus.Phase)
+	}); err != nil {
 		return services.HandleUpdateError("Connector status", err)
 	}
 
+	// a ready connector starts over with its restart policy retries
+	if deploymentStatus.Phase == dbapi.ConnectorStatusPhaseReady {
+		if err := dbConn.Model(&dbapi.ConnectorStatus{}).Where("id = ?", deployment.ConnectorID).
+			UpdateColumns(map[string]interface{}{"retry_count": 0, "next_retry_at": nil}).Error; err != nil {
+			return services.HandleUpdateError("Connector status", err)
+		}
+	}
+
 	return nil
 }
 
@@ -645,12 +663,12 @@ func (k *connectorClusterService) GetAvailableDeploymentTypeUpgrades(listArgs *s
 	return
 }
 
-func (k *connectorClusterService) UpgradeConnectorsByType(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentTypeUpgradeList) *errors.ServiceError {
+func (k *connectorClusterService) UpgradeConnectorsByType(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentTypeUpgradeList) (api.OperationList, *errors.ServiceError) {
 
 	// get deployment ids from available upgrades
 	available, _, serr := k.GetAvailableDeploymentTypeUpgrades(&services.ListArguments{})
 	if serr != nil {
-		return serr
+		return nil, serr
 	}
 
 	availableConnectors := toTypeMap(available)
@@ -670,10 +688,11 @@ func (k *connectorClusterService) UpgradeConnectorsByType(ctx context.Context, c
 		}
 	}
 	if len(errorList) != 0 {
-		return errors.Conflict(errorList.Error())
+		return nil, errors.Conflict(errorList.Error())
 	}
 
 	// upgrade connector type channels
+	var upgradeOperations api.OperationList
 	notificationAdded := false
 	dbConn := k.connectionFactory.New()
 	for cid, upgrade := range availableConnectors {
@@ -684,7 +703,10 @@ func (k *connectorClusterService) UpgradeConnectorsByType(ctx context.Context, c
 			Update("ConnectorTypeChannelId", upgrade.ShardMetadata.AvailableId).Error; err != nil {
 			errorList = append(errorList,
 				services.HandleUpdateError(`Connector deployment id=`+cid, err))
+		} else if operation, serr := k.createUpgradeOperation(ctx, cid); serr != nil {
+			errorList = append(errorList, serr)
 		} else {
+			upgradeOperations = append(upgradeOperations, operation)
 			if !notificationAdded {
 				_ = db.AddPostCommitAction(ctx, func() {
 					k.bus.Notify(fmt.Sprintf("/kafka_connector_clusters/%s/deployments", clusterId))
@@ -695,9 +717,9 @@ func (k *connectorClusterService) UpgradeConnectorsByType(ctx context.Context, c
 	}
 
 	if len(errorList) != 0 {
-		return services.HandleUpdateError(`Connector deployment`, errorList)
+		return nil, services.HandleUpdateError(`Connector deployment`, errorList)
 	}
-	return nil
+	return upgradeOperations, nil
 }
 
 func toTypeMap(arr []dbapi.ConnectorDeploymentTypeUpgrade) map[string]dbapi.ConnectorDeploymentTypeUpgrade {
@@ -778,11 +800,11 @@ func (k *connectorClusterService) GetAvailableDeploymentOperatorUpgrades(listArg
 	return
 }
 
-func (k *connectorClusterService) UpgradeConnectorsByOperator(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentOperatorUpgradeList) *errors.ServiceError {
+func (k *connectorClusterService) UpgradeConnectorsByOperator(ctx context.Context, clusterId string, upgrades dbapi.ConnectorDeploymentOperatorUpgradeList) (api.OperationList, *errors.ServiceError) {
 	// get deployment ids from available upgrades
 	available, _, serr := k.GetAvailableDeploymentOperatorUpgrades(&services.ListArguments{})
 	if serr != nil {
-		return serr
+		return nil, serr
 	}
 
 	availableConnectors := toOperatorMap(available)
@@ -808,10 +830,11 @@ func (k *connectorClusterService) UpgradeConnectorsByOperator(ctx context.Contex
 		}
 	}
 	if len(errorList) != 0 {
-		return errors.Conflict(errorList.Error())
+		return nil, errors.Conflict(errorList.Error())
 	}
 
 	// update deployments by setting operator_id to available_id
+	var upgradeOperations api.OperationList
 	notificationAdded := false
 	dbConn := k.connectionFactory.New()
 	for cid, upgrade := range availableConnectors {
@@ -822,7 +845,10 @@ func (k *connectorClusterService) UpgradeConnectorsByOperator(ctx context.Contex
 			Update("OperatorID", upgrade.Operator.Available.Id).Error; err != nil {
 			errorList = append(errorList,
 				services.HandleUpdateError("Connector deployment id="+cid, serr))
+		} else if operation, serr := k.createUpgradeOperation(ctx, cid); serr != nil {
+			errorList = append(errorList, serr)
 		} else {
+			upgradeOperations = append(upgradeOperations, operation)
 			if !notificationAdded {
 				_ = db.AddPostCommitAction(ctx, func() {
 					k.bus.Notify(fmt.Sprintf("/kafka_connector_clusters/%s/deployments", clusterId))
@@ -833,10 +859,28 @@ func (k *connectorClusterService) UpgradeConnectorsByOperator(ctx context.Contex
 	}
 
 	if len(errorList) != 0 {
-		return services.HandleUpdateError(`Connector deployment`, errorList)
+		return nil, services.HandleUpdateError(`Connector deployment`, errorList)
 	}
 
-	return nil
+	return upgradeOperations, nil
+}
+
+// createUpgradeOperation records the upgrade of a connector, the connector manager completes it once the deployment
+// of the connector reports the upgraded version
+func (k *connectorClusterService) createUpgradeOperation(ctx context.Context, connectorId string) (*api.Operation, *errors.ServiceError) {
+	var connector dbapi.Connector
+	if err := k.connectionFactory.New().Select("id, owner, organisation_id").
+		Where("id = ?", connectorId).First(&connector).Error; err != nil {
+		return nil, services.HandleGetError("Connector", "id", connectorId, err)
+	}
+
+	return k.operationService.Create(ctx, &api.Operation{
+		Kind:           api.OperationKindConnectorUpgrade.String(),
+		TargetKind:     api.OperationTargetConnector,
+		TargetId:       connector.ID,
+		OrganisationId: connector.OrganisationId,
+		Owner:          connector.Owner,
+	})
 }
 
 func toOperatorMap(arr []dbapi.ConnectorDeploymentOperatorUpgrade) map[string]dbapi.ConnectorDeploymentOperatorUpgrade {
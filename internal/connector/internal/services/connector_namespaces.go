@@ -21,8 +21,10 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/queryparser"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+//go:generate moq -out connector_namespaces_moq.go . ConnectorNamespaceService
 type ConnectorNamespaceService interface {
 	Create(ctx context.Context, request *dbapi.ConnectorNamespace) *errors.ServiceError
 	Update(ctx context.Context, request *dbapi.ConnectorNamespace) *errors.ServiceError
@@ -39,6 +41,7 @@ type ConnectorNamespaceService interface {
 	ReconcileDeletedNamespaces(ctx context.Context) (int64, *errors.ServiceError)
 	GetNamespaceTenant(namespaceId string) (*dbapi.ConnectorNamespace, *errors.ServiceError)
 	CheckConnectorQuota(namespaceId string) *errors.ServiceError
+	CheckConnectorResourceQuota(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError
 	CanCreateEvalNamespace(userId string) *errors.ServiceError
 	GetEmptyDeletingNamespaces(clusterId string) (dbapi.ConnectorNamespaceList, *errors.ServiceError)
 }
@@ -150,11 +153,15 @@ func (k *connectorNamespaceService) validateAnnotations(request *dbapi.Connector
 
 func (k *connectorNamespaceService) Update(ctx context.Context, request *dbapi.ConnectorNamespace) *errors.ServiceError {
 
+	return k.update(k.connectionFactory.New(), request)
+}
+
+// update saves the namespace with the given dbConn, failing if the namespace version changed
+func (k *connectorNamespaceService) update(dbConn *gorm.DB, request *dbapi.ConnectorNamespace) *errors.ServiceError {
 	if request.Version == 0 {
 		return errors.BadRequest("resource version is required")
 	}
 
-	dbConn := k.connectionFactory.New()
 	updates := dbConn.Where(`id = ? AND version = ?`, request.ID, request.Version).
 		Updates(request)
 	if err := updates.Error; err != nil {
@@ -189,6 +196,9 @@ func (k *connectorNamespaceService) Get(ctx context.Context, namespaceID string)
 	if err := k.setConnectorsDeployed(dbapi.ConnectorNamespaceList{result}); err != nil {
 		return result, err
 	}
+	if err := k.setResourceUsage(dbapi.ConnectorNamespaceList{result}); err != nil {
+		return result, err
+	}
 
 	return result, nil
 }
@@ -233,6 +243,66 @@ func (k *connectorNamespaceService) setConnectorsDeployed(namespaces dbapi.Conne
 	return nil
 }
 
+func (k *connectorNamespaceService) setResourceUsage(namespaces dbapi.ConnectorNamespaceList) *errors.ServiceError {
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(namespaces))
+	for i, ns := range namespaces {
+		ids[i] = ns.ID
+	}
+	usage, err := k.getResourceUsage(ids...)
+	if err != nil {
+		return err
+	}
+
+	for _, ns := range namespaces {
+		u := usage[ns.ID]
+		ns.Status.Resources = dbapi.ConnectorNamespaceResources{
+			MemoryRequests: quantityString(u.MemoryRequests),
+			MemoryLimits:   quantityString(u.MemoryLimits),
+			CPURequests:    quantityString(u.CPURequests),
+			CPULimits:      quantityString(u.CPULimits),
+		}
+	}
+
+	return nil
+}
+
+// quantityString returns an empty string for zero quantities so unused resources are omitted
+func quantityString(q resource.Quantity) string {
+	if q.IsZero() {
+		return ""
+	}
+	return q.String()
+}
+
+// getResourceUsage sums the catalog resource footprints of connectors in namespaces
+func (k *connectorNamespaceService) getResourceUsage(ids ...string) (map[string]config.NamespaceResourceUsage, *errors.ServiceError) {
+	result := make([]struct {
+		Id              string
+		ConnectorTypeId string
+		Channel         string
+		Count           int64
+	}, 0)
+	if err := k.connectionFactory.New().Model(&dbapi.Connector{}).
+		Select("namespace_id as id, connector_type_id, channel, count(*) as count").
+		Group("namespace_id, connector_type_id, channel").
+		Where("namespace_id in ?", ids).
+		Find(&result).Error; err != nil {
+		return nil, services.HandleGetError(`Connector namespace`, `id`, ids, err)
+	}
+
+	usage := make(map[string]config.NamespaceResourceUsage, len(ids))
+	for _, row := range result {
+		u := usage[row.Id]
+		u.Add(k.connectorsConfig.GetConnectorResources(row.ConnectorTypeId, row.Channel), row.Count)
+		usage[row.Id] = u
+	}
+	return usage, nil
+}
+
 func GetValidNamespaceColumns() []string {
 	return []string{`name`, `cluster_id`, `owner`, `expiration`, `tenant_user_id`, `tenant_organisation_id`, `status_phase`}
 }
@@ -248,7 +318,7 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 		Size:  listArguments.Size,
 		Total: 0,
 	}
-	dbConn := k.connectionFactory.New().Model(&resourceList)
+	dbConn := k.connectionFactory.NewReadOnly(ctx).Model(&resourceList)
 	if len(clusterIDs) != 0 {
 		dbConn = dbConn.Where("cluster_id IN ?", clusterIDs)
 	}
@@ -296,6 +366,9 @@ func (k *connectorNamespaceService) List(ctx context.Context, clusterIDs []strin
 	if err := k.setConnectorsDeployed(resourceList); err != nil {
 		return resourceList, &pagingMeta, err
 	}
+	if err := k.setResourceUsage(resourceList); err != nil {
+		return resourceList, &pagingMeta, err
+	}
 	return resourceList, &pagingMeta, nil
 }
 
@@ -368,7 +441,7 @@ func (k *connectorNamespaceService) UpdateConnectorNamespaceStatus(ctx context.C
 	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
 		var namespace dbapi.ConnectorNamespace
 		if err := dbConn.Unscoped().Where(`id = ?`, namespaceID).
-			Select("id", "deleted_at", "cluster_id", "version", "status_phase", "status_version", "status_conditions").
+			Select("id", "deleted_at", "name", "cluster_id", "version", "tenant_organisation_id", "status_phase", "status_version", "status_conditions").
 			First(&namespace).Error; err != nil {
 			return services.HandleGetError("Connector namespace", "id", namespaceID, err)
 		}
@@ -382,6 +455,7 @@ func (k *connectorNamespaceService) UpdateConnectorNamespaceStatus(ctx context.C
 			return services.HandleGetError("Connector cluster", "id", namespace.ClusterId, err)
 		}
 
+		previousPhase := namespace.Status.Phase
 		updated, serr := phase.PerformNamespaceOperation(&cluster, &namespace, phase.ConnectNamespace)
 		if serr != nil {
 			return serr
@@ -418,9 +492,12 @@ func (k *connectorNamespaceService) UpdateConnectorNamespaceStatus(ctx context.C
 			if updated {
 				updatedNamespace.Status.Phase = status.Phase
 			}
-			if err := k.Update(ctx, &updatedNamespace); err != nil {
+			if err := k.update(dbConn, &updatedNamespace); err != nil {
 				return err
 			}
+			if updated {
+				return publishNamespacePhaseChange(dbConn, &namespace, previousPhase, status.Phase)
+			}
 		}
 
 		return nil
@@ -434,7 +511,7 @@ func (k *connectorNamespaceService) UpdateConnectorNamespaceStatus(ctx context.C
 func (k *connectorNamespaceService) DeleteNamespaces(ctx context.Context, dbConn *gorm.DB, query interface{}, values ...interface{}) (int64, *errors.ServiceError) {
 	var namespaces []dbapi.ConnectorNamespace
 
-	if err := dbConn.Model(&dbapi.ConnectorNamespace{}).Select("id", "cluster_id").
+	if err := dbConn.Model(&dbapi.ConnectorNamespace{}).Select("id", "name", "cluster_id", "tenant_organisation_id", "status_phase").
 		Where(query, values...).Find(&namespaces).Error; err != nil {
 		return 0, services.HandleGetError("Connector namespace", fmt.Sprintf("%s", query), values, err)
 	}
@@ -460,6 +537,15 @@ func (k *connectorNamespaceService) DeleteNamespaces(ctx context.Context, dbConn
 		Update("status_phase", dbapi.ConnectorNamespacePhaseDeleting).Error; err != nil {
 		return 0, services.HandleUpdateError("Connector namespace", err)
 	}
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if ns.Status.Phase == dbapi.ConnectorNamespacePhaseDeleted {
+			continue
+		}
+		if err := publishNamespacePhaseChange(dbConn, ns, ns.Status.Phase, dbapi.ConnectorNamespacePhaseDeleting); err != nil {
+			return 0, services.HandleUpdateError("Connector namespace", err)
+		}
+	}
 
 	// notify namespace status update
 	_ = db.AddPostCommitAction(ctx, func() {
@@ -553,21 +639,27 @@ func (k *connectorNamespaceService) ReconcileUnusedDeletingNamespaces(_ context.
 	var count int64
 	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
 
-		// get ids of all namespaces in deleting phase that never had connectors and were never created in data plane
-		var namespaceIds []string
-		if err := dbConn.Table("connector_namespaces").Select("connector_namespaces.id").
+		// get all namespaces in deleting phase that never had connectors and were never created in data plane
+		var namespaces []dbapi.ConnectorNamespace
+		if err := dbConn.Table("connector_namespaces").
+			Select("connector_namespaces.id, connector_namespaces.name, connector_namespaces.tenant_organisation_id").
 			Joins("LEFT JOIN connectors ON connectors.namespace_id = connector_namespaces.id").
 			Where("connector_namespaces.status_phase = ? AND connector_namespaces.status_version='' AND "+
 				"connector_namespaces.deleted_at IS NULL AND namespace_id IS NULL", dbapi.ConnectorNamespacePhaseDeleting).
-			Find(&namespaceIds).Error; err != nil {
+			Find(&namespaces).Error; err != nil {
 			return services.HandleGetError("Connector namespace",
 				"status_phase", dbapi.ConnectorNamespacePhaseDeleting, err)
 		}
-		count = int64(len(namespaceIds))
+		count = int64(len(namespaces))
 		if count == 0 {
 			return nil
 		}
 
+		namespaceIds := make([]string, len(namespaces))
+		for i := range namespaces {
+			namespaceIds[i] = namespaces[i].ID
+		}
+
 		// mark empty unused namespaces as deleted
 		if err := dbConn.Where("id IN ?", namespaceIds).
 			Updates(&dbapi.ConnectorNamespace{
@@ -578,6 +670,13 @@ func (k *connectorNamespaceService) ReconcileUnusedDeletingNamespaces(_ context.
 			count = 0
 			return services.HandleUpdateError("Connector namespace", err)
 		}
+		for i := range namespaces {
+			if err := publishNamespacePhaseChange(dbConn, &namespaces[i],
+				dbapi.ConnectorNamespacePhaseDeleting, dbapi.ConnectorNamespacePhaseDeleted); err != nil {
+				count = 0
+				return services.HandleUpdateError("Connector namespace", err)
+			}
+		}
 
 		return nil
 
@@ -678,20 +777,27 @@ func (k *connectorNamespaceService) GetNamespaceTenant(namespaceId string) (*dba
 	return &namespace, nil
 }
 
-func (k *connectorNamespaceService) CheckConnectorQuota(namespaceId string) *errors.ServiceError {
-	dbConn := k.connectionFactory.New()
+func (k *connectorNamespaceService) getNamespaceQuota(namespaceId string) (config.NamespaceQuota, *errors.ServiceError) {
 	var profileName string
 	var quota config.NamespaceQuota
-	if err := dbConn.Model(&dbapi.ConnectorNamespaceAnnotation{}).
+	if err := k.connectionFactory.New().Model(&dbapi.ConnectorNamespaceAnnotation{}).
 		Where("namespace_id = ? AND key = ?", namespaceId, profiles.AnnotationProfileKey).
 		Select("value").First(&profileName).Error; err != nil {
-		return errors.FailedToCheckQuota("Error reading Connector namespace annotation with namespace id %s: %s", namespaceId, err)
+		return quota, errors.FailedToCheckQuota("Error reading Connector namespace annotation with namespace id %s: %s", namespaceId, err)
 	}
 	quota, _ = k.quotaConfig.GetNamespaceQuota(profileName)
+	return quota, nil
+}
+
+func (k *connectorNamespaceService) CheckConnectorQuota(namespaceId string) *errors.ServiceError {
+	quota, err := k.getNamespaceQuota(namespaceId)
+	if err != nil {
+		return err
+	}
 	if quota.Connectors > 0 {
 		// get number of connectors using this namespace
 		var count int64
-		if err := dbConn.Model(&dbapi.Connector{}).Where("namespace_id = ?", namespaceId).
+		if err := k.connectionFactory.New().Model(&dbapi.Connector{}).Where("namespace_id = ?", namespaceId).
 			Count(&count).Error; err != nil {
 			return services.HandleGetError("Connector", "namespace_id", namespaceId, err)
 		}
@@ -702,6 +808,33 @@ func (k *connectorNamespaceService) CheckConnectorQuota(namespaceId string) *err
 	return nil
 }
 
+// CheckConnectorResourceQuota checks that adding a connector of the connector type and channel
+// doesn't exceed the memory and CPU quotas of the namespace
+func (k *connectorNamespaceService) CheckConnectorResourceQuota(namespaceId string, connectorTypeId string, channel string) *errors.ServiceError {
+	resources := k.connectorsConfig.GetConnectorResources(connectorTypeId, channel)
+	if resources == nil {
+		return nil
+	}
+	quota, err := k.getNamespaceQuota(namespaceId)
+	if err != nil {
+		return err
+	}
+	if !quota.HasResourceQuota() {
+		return nil
+	}
+
+	usage, err := k.getResourceUsage(namespaceId)
+	if err != nil {
+		return err
+	}
+	u := usage[namespaceId]
+	u.Add(resources, 1)
+	if err := quota.Exceeded(u); err != nil {
+		return errors.InsufficientQuotaError("Connector namespace %s resource quota exceeded: %s", namespaceId, err)
+	}
+	return nil
+}
+
 func (k *connectorNamespaceService) CanCreateEvalNamespace(userId string) *errors.ServiceError {
 	dbConn := k.connectionFactory.New()
 	var count int64
@@ -22,6 +22,7 @@ import (
 	"github.com/golang/glog"
 )
 
+//go:generate moq -out connector_types_moq.go . ConnectorTypesService
 type ConnectorTypesService interface {
 	Get(id string) (*dbapi.ConnectorType, *errors.ServiceError)
 	List(listArgs *services.ListArguments) (dbapi.ConnectorTypeList, *api.PagingMeta, *errors.ServiceError)
@@ -33,6 +34,7 @@ type ConnectorTypesService interface {
 	GetLatestConnectorShardMetadata(tid, channel string) (*dbapi.ConnectorShardMetadata, *errors.ServiceError)
 	CatalogEntriesReconciled() (bool, *errors.ServiceError)
 	DeleteUnusedAndNotInCatalog() *errors.ServiceError
+	ListDeprecations() (dbapi.ConnectorTypeDeprecationList, *errors.ServiceError)
 	ListCatalogEntries(*coreService.ListArguments) ([]dbapi.ConnectorCatalogEntry, *api.PagingMeta, *errors.ServiceError)
 	GetCatalogEntry(tyd string) (*dbapi.ConnectorCatalogEntry, *errors.ServiceError)
 }
@@ -202,6 +204,21 @@ func (cts *connectorTypesService) ForEachConnectorCatalogEntry(f func(id string,
 			return err
 		}
 
+		// update deprecation columns explicitly, since zero values are skipped when updating the type
+		var replacedBy *string
+		if entry.ReplacedBy != "" {
+			replacedBy = &entry.ReplacedBy
+		}
+		dbConn := cts.connectionFactory.New()
+		if err := dbConn.Model(connectorType).Where("id = ?", connectorType.ID).
+			UpdateColumns(map[string]interface{}{
+				"deprecated":  entry.Deprecated,
+				"replaced_by": replacedBy,
+				"end_of_life": entry.EndOfLife,
+			}).Error; err != nil {
+			return errors.GeneralError("failed to update connector type %s deprecation: %v", entry.ConnectorType.Id, err.Error())
+		}
+
 		// reconcile channels
 		for channel, ccc := range entry.Channels {
 			err := f(entry.ConnectorType.Id, channel, &ccc)
@@ -211,7 +228,6 @@ func (cts *connectorTypesService) ForEachConnectorCatalogEntry(f func(id string,
 		}
 
 		// update type checksum for latest catalog shard metadata
-		dbConn := cts.connectionFactory.New()
 		if err = dbConn.Model(connectorType).Where("id = ?", connectorType.ID).
 			UpdateColumn("checksum", cts.connectorsConfig.CatalogChecksums[connectorType.ID]).Error; err != nil {
 			return errors.GeneralError("failed to update connector type %s checksum: %v", entry.ConnectorType.Id, err.Error())
@@ -352,11 +368,11 @@ func (cts *connectorTypesService) CatalogEntriesReconciled() (bool, *errors.Serv
 }
 
 func (cts *connectorTypesService) DeleteUnusedAndNotInCatalog() *errors.ServiceError {
-	notToBeDeletedIDs := make([]string, len(cts.connectorsConfig.CatalogEntries))
+	catalogIDs := make([]string, 0, len(cts.connectorsConfig.CatalogEntries))
 	for _, entry := range cts.connectorsConfig.CatalogEntries {
-		notToBeDeletedIDs = append(notToBeDeletedIDs, entry.ConnectorType.Id)
+		catalogIDs = append(catalogIDs, entry.ConnectorType.Id)
 	}
-	glog.V(5).Infof("Connector Type IDs in catalog not to be deleted: %v", notToBeDeletedIDs)
+	glog.V(5).Infof("Connector Type IDs in catalog not to be deleted: %v", catalogIDs)
 
 	var usedConnectorTypeIDs []string
 	dbConn := cts.connectionFactory.New()
@@ -365,7 +381,19 @@ func (cts *connectorTypesService) DeleteUnusedAndNotInCatalog() *errors.ServiceE
 	}
 	glog.V(5).Infof("Connector Type IDs used by at least an active connector not to be deleted: %v", usedConnectorTypeIDs)
 
-	notToBeDeletedIDs = append(notToBeDeletedIDs, usedConnectorTypeIDs...)
+	// connector types removed from the catalog that are still in use are deprecated,
+	// so that their connectors show up in the deprecation report
+	if len(usedConnectorTypeIDs) > 0 {
+		query := dbConn.Model(&dbapi.ConnectorType{}).Where("id IN ?", usedConnectorTypeIDs)
+		if len(catalogIDs) > 0 {
+			query = query.Where("id NOT IN ?", catalogIDs)
+		}
+		if err := query.UpdateColumn("deprecated", true).Error; err != nil {
+			return errors.GeneralError("failed to deprecate connector types not in catalog: %v", err.Error())
+		}
+	}
+
+	notToBeDeletedIDs := append(catalogIDs, usedConnectorTypeIDs...)
 
 	if err := dbConn.Delete(&dbapi.ConnectorType{}, "id NOT IN ?", notToBeDeletedIDs).Error; err != nil {
 		return errors.GeneralError("failed to delete connector type with ids %v : %v", notToBeDeletedIDs, err.Error())
@@ -374,6 +402,44 @@ func (cts *connectorTypesService) DeleteUnusedAndNotInCatalog() *errors.ServiceE
 	return nil
 }
 
+func (cts *connectorTypesService) ListDeprecations() (dbapi.ConnectorTypeDeprecationList, *errors.ServiceError) {
+	var connectorTypes dbapi.ConnectorTypeList
+	dbConn := cts.connectionFactory.New()
+	if err := dbConn.Where("deprecated = ?", true).Order("id ASC").Find(&connectorTypes).Error; err != nil {
+		return nil, errors.GeneralError("failed to find deprecated connector types: %v", err.Error())
+	}
+
+	if len(connectorTypes) == 0 {
+		return dbapi.ConnectorTypeDeprecationList{}, nil
+	}
+
+	connectorTypeIDs := make([]string, len(connectorTypes))
+	for i, ct := range connectorTypes {
+		connectorTypeIDs[i] = ct.ID
+	}
+
+	// load the connectors of all the deprecated connector types at once rather than one query per connector type
+	var connectors dbapi.ConnectorList
+	if err := dbConn.Joins("Status").Where("connectors.connector_type_id IN ?", connectorTypeIDs).
+		Order("connectors.id ASC").Find(&connectors).Error; err != nil {
+		return nil, errors.GeneralError("failed to find connectors of deprecated connector types: %v", err.Error())
+	}
+	connectorsByType := make(map[string]dbapi.ConnectorList, len(connectorTypes))
+	for _, connector := range connectors {
+		connectorsByType[connector.ConnectorTypeId] = append(connectorsByType[connector.ConnectorTypeId], connector)
+	}
+
+	result := make(dbapi.ConnectorTypeDeprecationList, len(connectorTypes))
+	for i, ct := range connectorTypes {
+		result[i] = dbapi.ConnectorTypeDeprecation{
+			ConnectorType: ct,
+			Connectors:    connectorsByType[ct.ID],
+		}
+	}
+
+	return result, nil
+}
+
 func (cts *connectorTypesService) ListCatalogEntries(listArgs *coreService.ListArguments) ([]dbapi.ConnectorCatalogEntry, *api.PagingMeta, *errors.ServiceError) {
 	types, pagin, err := cts.List(listArgs)
 	if err != nil {
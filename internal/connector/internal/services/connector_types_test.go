@@ -0,0 +1,77 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorTypesService_ListDeprecations(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupFn        func()
+		wantErr        bool
+		wantConnectors map[string][]string
+	}{
+		{
+			name: "should return an error if the deprecated connector types can't be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "connector_types"`).WithQueryException()
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not list connectors if no connector type is deprecated",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "connector_types"`).WithReply(nil)
+				mocket.Catcher.NewMock().WithQuery(`FROM "connectors"`).WithQueryException()
+			},
+			wantConnectors: map[string][]string{},
+		},
+		{
+			name: "should load the connectors of all the deprecated connector types in a single query",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "connector_types" WHERE deprecated = $1`).
+					WithReply([]map[string]interface{}{{"id": "type-a"}, {"id": "type-b"}, {"id": "type-c"}})
+				// a query per connector type would hit this mock and fail
+				mocket.Catcher.NewMock().WithQuery(`connectors.connector_type_id = $1`).WithQueryException()
+				mocket.Catcher.NewMock().WithQuery(`connectors.connector_type_id IN ($1,$2,$3)`).
+					WithReply([]map[string]interface{}{
+						{"id": "connector-1", "connector_type_id": "type-a"},
+						{"id": "connector-2", "connector_type_id": "type-b"},
+						{"id": "connector-3", "connector_type_id": "type-a"},
+					})
+			},
+			wantConnectors: map[string][]string{
+				"type-a": {"connector-1", "connector-3"},
+				"type-b": {"connector-2"},
+				"type-c": nil,
+			},
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			cts := NewConnectorTypesService(nil, db.NewMockConnectionFactory(nil))
+			got, err := cts.ListDeprecations()
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			connectors := map[string][]string{}
+			for _, deprecation := range got {
+				var ids []string
+				for _, connector := range deprecation.Connectors {
+					ids = append(ids, connector.ID)
+				}
+				connectors[deprecation.ConnectorType.ID] = ids
+			}
+			Expect(connectors).To(Equal(tt.wantConnectors))
+		})
+	}
+}
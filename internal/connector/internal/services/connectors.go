@@ -15,6 +15,7 @@ import (
 	"github.com/spyzhov/ajson"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
@@ -22,6 +23,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 )
 
+//go:generate moq -out connectors_moq.go . ConnectorsService
 type ConnectorsService interface {
 	Create(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError
 	Get(ctx context.Context, id string, tid string) (*dbapi.ConnectorWithConditions, *errors.ServiceError)
@@ -31,6 +33,9 @@ type ConnectorsService interface {
 	Delete(ctx context.Context, id string) *errors.ServiceError
 	ForEach(f func(*dbapi.Connector) *errors.ServiceError, query string, args ...interface{}) []error
 	ForceDelete(ctx context.Context, id string) *errors.ServiceError
+	CreateRevision(ctx context.Context, revision *dbapi.ConnectorRevision) *errors.ServiceError
+	GetRevision(ctx context.Context, connectorId string, revision int64) (*dbapi.ConnectorRevision, *errors.ServiceError)
+	ListRevisions(ctx context.Context, connectorId string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError)
 }
 
 var _ ConnectorsService = &connectorsService{}
@@ -169,6 +174,9 @@ func (k *connectorsService) Delete(ctx context.Context, id string) *errors.Servi
 	if err := dbConn.Where("id = ?", id).Delete(&dbapi.ConnectorStatus{}).Error; err != nil {
 		return services.HandleGetError("ConnectorStatus", "id", id, err)
 	}
+	if err := dbConn.Where("connector_id = ?", id).Delete(&dbapi.ConnectorRevision{}).Error; err != nil {
+		return services.HandleDeleteError("ConnectorRevision", "connector_id", id, err)
+	}
 
 	_ = db.AddPostCommitAction(ctx, func() {
 		// delete related distributed resources...
@@ -217,7 +225,7 @@ func (k *connectorsService) List(ctx context.Context, listArgs *services.ListArg
 		return nil, nil, errors.NewWithCause(errors.ErrorMalformedRequest, err, "Unable to list connector type requests: %s", err.Error())
 	}
 
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(ctx)
 	pagingMeta := &api.PagingMeta{
 		Page: listArgs.Page,
 		Size: listArgs.Size,
@@ -288,14 +296,33 @@ func selectConnectorWithConditions(dbConn *gorm.DB) *gorm.DB {
 			"and connector_deployment_statuses.deleted_at IS NULL")
 }
 
+// connectorUpdateColumns are the columns of the connectors written by Update, along with the reference to the client
+// secret of the service account when it is replaced
+var connectorUpdateColumns = []string{
+	"name", "namespace_id", "connector_spec", "desired_state",
+	"kafka_id", "kafka_bootstrap_server",
+	"schema_registry_id", "schema_registry_url",
+	"service_account_client_id",
+	"restart_policy_type", "restart_policy_max_retries", "restart_policy_backoff_seconds",
+	"schedule_pause", "schedule_resume",
+}
+
 func (k connectorsService) Update(ctx context.Context, resource *dbapi.Connector) *errors.ServiceError {
 
 	if resource.Version == 0 {
 		return errors.BadRequest("resource version is required")
 	}
 
+	// only the columns which can be changed are written, zero values included, so that the restart policy and the
+	// schedule can be cleared in the same versioned update, the callers may pass a connector converted from its
+	// presentation, which has no organisation or placement
+	columns := connectorUpdateColumns
+	if resource.ServiceAccount.ClientSecretRef != "" {
+		columns = append(columns[:len(columns):len(columns)], "service_account_client_secret")
+	}
 	dbConn := k.connectionFactory.New()
-	update := dbConn.Model(resource).Where("id = ? AND version = ?", resource.ID, resource.Version).Updates(resource)
+	update := dbConn.Model(resource).Where("id = ? AND version = ?", resource.ID, resource.Version).
+		Select(columns).Updates(resource)
 	if err := update.Error; err != nil {
 		return services.HandleUpdateError(`Connector`, err)
 	}
@@ -318,8 +345,21 @@ func (k connectorsService) Update(ctx context.Context, resource *dbapi.Connector
 }
 
 func (k connectorsService) SaveStatus(ctx context.Context, resource dbapi.ConnectorStatus) *errors.ServiceError {
-	dbConn := k.connectionFactory.New()
-	if err := dbConn.Model(resource).Save(resource).Error; err != nil {
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var previousPhases []dbapi.ConnectorStatusPhase
+		if err := tx.Model(&dbapi.ConnectorStatus{}).Where("id = ?", resource.ID).Pluck("phase", &previousPhases).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(resource).Save(resource).Error; err != nil {
+			return err
+		}
+		// a new status has no previous phase
+		var previousPhase dbapi.ConnectorStatusPhase
+		if len(previousPhases) > 0 {
+			previousPhase = previousPhases[0]
+		}
+		return publishConnectorStatusChange(tx, resource.ID, previousPhase, resource.Phase)
+	}); err != nil {
 		return errors.GeneralError("failed to update: %s", err.Error())
 	}
 	return nil
@@ -391,3 +431,70 @@ func (k *connectorsService) ForceDelete(ctx context.Context, id string) *errors.
 	}
 	return nil
 }
+
+// CreateRevision records an accepted connector configuration as the next revision of the connector
+func (k *connectorsService) CreateRevision(ctx context.Context, revision *dbapi.ConnectorRevision) *errors.ServiceError {
+	if err := k.connectionFactory.New().Transaction(func(dbConn *gorm.DB) error {
+		// lock the connector so that its concurrent revisions are numbered one after the other
+		var connector dbapi.Connector
+		if err := dbConn.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").
+			Where("id = ?", revision.ConnectorID).First(&connector).Error; err != nil {
+			return services.HandleGetError("Connector", "id", revision.ConnectorID, err)
+		}
+
+		var latest int64
+		if err := dbConn.Model(&dbapi.ConnectorRevision{}).Where("connector_id = ?", revision.ConnectorID).
+			Select("COALESCE(MAX(revision), 0)").Scan(&latest).Error; err != nil {
+			return errors.GeneralError("failed to get latest revision of connector %s: %v", revision.ConnectorID, err)
+		}
+
+		revision.ID = api.NewID()
+		revision.Revision = latest + 1
+		if err := dbConn.Create(revision).Error; err != nil {
+			return services.HandleCreateError("ConnectorRevision", err)
+		}
+		return nil
+	}); err != nil {
+		if svcErr, ok := err.(*errors.ServiceError); ok {
+			return svcErr
+		}
+		return errors.GeneralError("failed to create revision of connector %s: %v", revision.ConnectorID, err)
+	}
+	return nil
+}
+
+// GetRevision gets a connector revision by its revision number
+func (k *connectorsService) GetRevision(ctx context.Context, connectorId string, revision int64) (*dbapi.ConnectorRevision, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New()
+
+	var resource dbapi.ConnectorRevision
+	if err := dbConn.Where("connector_id = ? AND revision = ?", connectorId, revision).First(&resource).Error; err != nil {
+		return nil, services.HandleGetError("Connector revision", "revision", revision, err)
+	}
+	return &resource, nil
+}
+
+// ListRevisions returns the revisions of a connector, latest revision first
+func (k *connectorsService) ListRevisions(ctx context.Context, connectorId string, listArgs *services.ListArguments) (dbapi.ConnectorRevisionList, *api.PagingMeta, *errors.ServiceError) {
+	dbConn := k.connectionFactory.New().Where("connector_id = ?", connectorId)
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	// set total, limit and paging (based on https://gitlab.cee.redhat.com/service/api-guidelines#user-content-paging)
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&dbapi.ConnectorRevisionList{}).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+	dbConn = dbConn.Offset((pagingMeta.Page - 1) * pagingMeta.Size).Limit(pagingMeta.Size)
+
+	var resources dbapi.ConnectorRevisionList
+	if err := dbConn.Order("revision desc").Find(&resources).Error; err != nil {
+		return resources, pagingMeta, errors.GeneralError("Unable to list connector revisions: %s", err)
+	}
+
+	return resources, pagingMeta, nil
+}
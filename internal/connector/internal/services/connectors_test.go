@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_connectorsService_Update(t *testing.T) {
+	tests := []struct {
+		name        string
+		rowsUpdated int64
+		wantErr     bool
+		wantUpdates int
+	}{
+		{
+			name:        "should only write the changeable columns, clearing the restart policy and the schedule, in the versioned update",
+			rowsUpdated: 1,
+			wantUpdates: 1,
+		},
+		{
+			name:        "should return a conflict if the version changed",
+			rowsUpdated: 0,
+			wantErr:     true,
+			wantUpdates: 1,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updates []string
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "connectors"`).WithRowsNum(tt.rowsUpdated).
+				WithCallback(func(query string, args []driver.NamedValue) {
+					updates = append(updates, query)
+				})
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connectors"`).
+				WithReply([]map[string]interface{}{{"id": "connector-id", "version": 2}})
+
+			k := NewConnectorsService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+			connector := &dbapi.Connector{
+				Model:   db.Model{ID: "connector-id"},
+				Version: 1,
+			}
+			err := k.Update(context.Background(), connector)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				Expect(err.HttpCode).To(Equal(http.StatusConflict))
+			}
+			Expect(updates).To(HaveLen(tt.wantUpdates))
+			Expect(updates[0]).To(ContainSubstring(`"restart_policy_type"=`))
+			Expect(updates[0]).To(ContainSubstring(`"schedule_pause"=`))
+			Expect(updates[0]).To(ContainSubstring(`version = `))
+			// the connectors converted from their presentation have no organisation or placement, which must be kept
+			Expect(updates[0]).NotTo(ContainSubstring(`"organisation_id"=`))
+			Expect(updates[0]).NotTo(ContainSubstring(`"cloud_provider"=`))
+			Expect(updates[0]).NotTo(ContainSubstring(`"region"=`))
+			Expect(updates[0]).NotTo(ContainSubstring(`"owner"=`))
+		})
+	}
+}
+
+func Test_connectorsService_CreateRevision(t *testing.T) {
+	tests := []struct {
+		name         string
+		missing      bool
+		latest       interface{}
+		insertFails  bool
+		wantErr      bool
+		wantRevision int64
+	}{
+		{
+			name:         "should create the first revision of a connector",
+			latest:       0,
+			wantRevision: 1,
+		},
+		{
+			name:         "should increment the latest revision of a connector",
+			latest:       3,
+			wantRevision: 4,
+		},
+		{
+			name:        "should return an error if the revision can't be inserted",
+			latest:      3,
+			insertFails: true,
+			wantErr:     true,
+		},
+		{
+			name:    "should return an error if the connector doesn't exist",
+			missing: true,
+			wantErr: true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			lock := mocket.Catcher.NewMock().WithQuery(`SELECT "id" FROM "connectors" WHERE id = $1 AND "connectors"."deleted_at" IS NULL ORDER BY "connectors"."id" LIMIT 1 FOR UPDATE`).
+				WithArgs("connector-id")
+			if !tt.missing {
+				lock.WithReply([]map[string]interface{}{{"id": "connector-id"}})
+			}
+			mocket.Catcher.NewMock().WithQuery(`SELECT COALESCE(MAX(revision), 0) FROM "connector_revisions" WHERE (connector_id = $1)`).
+				WithArgs("connector-id").
+				WithReply([]map[string]interface{}{{"coalesce": tt.latest}})
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "connector_revisions"`)
+			if tt.insertFails {
+				insert.WithExecException()
+			}
+
+			k := NewConnectorsService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+			revision := &dbapi.ConnectorRevision{ConnectorID: "connector-id", ConnectorVersion: 5}
+			err := k.CreateRevision(context.Background(), revision)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			// the connector is locked before its latest revision is read
+			Expect(lock.Triggered).To(BeTrue())
+			Expect(insert.Triggered).To(Equal(!tt.missing))
+			if !tt.wantErr {
+				Expect(revision.ID).ToNot(BeEmpty())
+				Expect(revision.Revision).To(Equal(tt.wantRevision))
+			}
+		})
+	}
+}
+
+func Test_connectorsService_GetRevision(t *testing.T) {
+	tests := []struct {
+		name         string
+		reply        []map[string]interface{}
+		wantHttpCode int
+	}{
+		{
+			name:  "should get the revision of a connector",
+			reply: []map[string]interface{}{{"id": "revision-id", "connector_id": "connector-id", "revision": 2}},
+		},
+		{
+			name:         "should return not found for a missing revision",
+			wantHttpCode: http.StatusNotFound,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE (connector_id = $1 AND revision = $2)`).
+				WithArgs("connector-id", int64(2)).
+				WithReply(tt.reply)
+
+			k := NewConnectorsService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+			revision, err := k.GetRevision(context.Background(), "connector-id", 2)
+			if tt.wantHttpCode != 0 {
+				Expect(err).ToNot(BeNil())
+				Expect(err.HttpCode).To(Equal(tt.wantHttpCode))
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(revision.ID).To(Equal("revision-id"))
+			Expect(revision.Revision).To(Equal(int64(2)))
+		})
+	}
+}
+
+func Test_connectorsService_ListRevisions(t *testing.T) {
+	RegisterTestingT(t)
+
+	var query string
+	mocket.Catcher.Reset()
+	mocket.Catcher.NewMock().WithQuery(`SELECT count(1) FROM "connector_revisions" WHERE (connector_id = $1)`).
+		WithReply([]map[string]interface{}{{"count": 3}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "connector_revisions" WHERE (connector_id = $1)`).
+		WithCallback(func(q string, args []driver.NamedValue) {
+			query = q
+		}).
+		WithReply([]map[string]interface{}{
+			{"id": "revision-3", "revision": 3},
+			{"id": "revision-2", "revision": 2},
+		})
+
+	k := NewConnectorsService(db.NewMockConnectionFactory(nil), nil, nil, nil)
+	revisions, paging, err := k.ListRevisions(context.Background(), "connector-id", &services.ListArguments{Page: 1, Size: 2})
+	Expect(err).To(BeNil())
+	Expect(paging.Total).To(Equal(3))
+	Expect(paging.Size).To(Equal(2))
+	Expect(query).To(ContainSubstring(`ORDER BY revision desc`))
+	Expect(query).To(ContainSubstring(`LIMIT 2`))
+	Expect(revisions).To(HaveLen(2))
+	Expect(revisions[0].Revision).To(Equal(int64(3)))
+	Expect(revisions[1].Revision).To(Equal(int64(2)))
+}
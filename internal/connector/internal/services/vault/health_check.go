@@ -0,0 +1,13 @@
+package vault
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+)
+
+// NewHealthCheck returns the health check of the connection to the vault storing the connector secrets
+func NewHealthCheck(vaultService VaultService) *environments.FuncHealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        "vault",
+		Criticality: environments.HealthCheckNonCritical,
+	}, vaultService.CheckConnection)
+}
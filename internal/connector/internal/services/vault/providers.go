@@ -15,5 +15,6 @@ func ConfigProviders() di.Option {
 func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(NewVaultService),
+		di.Provide(NewHealthCheck, di.As(new(environments.HealthCheck))),
 	)
 }
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 )
@@ -18,6 +19,8 @@ type VaultService interface {
 	DeleteSecretString(name string) error
 	ForEachSecret(f func(name string, owningResource string) bool) error
 	Kind() string
+	// CheckConnection returns an error when the vault can't be reached
+	CheckConnection(ctx context.Context) error
 }
 
 func NewVaultService(vaultConfig *Config) (VaultService, error) {
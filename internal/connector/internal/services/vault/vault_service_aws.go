@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -50,6 +52,13 @@ func (k *awsVaultService) Kind() string {
 	return KindAws
 }
 
+func (k *awsVaultService) CheckConnection(ctx context.Context) error {
+	_, err := k.secretClient.ListSecretsWithContext(ctx, &secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int64(1),
+	})
+	return err
+}
+
 func (k *awsVaultService) GetSecretString(name string) (string, error) {
 	metrics.IncreaseVaultServiceTotalCount("get")
 	result, err := k.secretCache.GetSecretString(name)
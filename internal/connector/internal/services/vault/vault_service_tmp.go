@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/metrics"
 	"sync"
@@ -43,6 +44,10 @@ func (k *TmpVaultService) Kind() string {
 	return KindTmp
 }
 
+func (k *TmpVaultService) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
 func (k *TmpVaultService) ResetCounters() {
 	k.mu.Lock()
 	defer k.mu.Unlock()
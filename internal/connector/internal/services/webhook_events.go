@@ -0,0 +1,68 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
+	"gorm.io/gorm"
+)
+
+// connectorStatusChangedEventData is the data of the connector status change events delivered to the webhooks
+type connectorStatusChangedEventData struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	PreviousState string `json:"previous_state,omitempty"`
+	State         string `json:"state"`
+}
+
+// namespacePhaseChangedEventData is the data of the namespace phase change events delivered to the webhooks
+type namespacePhaseChangedEventData struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	PreviousPhase string `json:"previous_phase,omitempty"`
+	Phase         string `json:"phase"`
+}
+
+// publishConnectorStatusChange writes a phase change of the connector to the webhooks outbox with the given tx, which must
+// be the transaction of the change, unless its phase did not change
+func publishConnectorStatusChange(tx *gorm.DB, connectorId string, previousPhase dbapi.ConnectorStatusPhase, phase dbapi.ConnectorStatusPhase) error {
+	if phase == "" || phase == previousPhase {
+		return nil
+	}
+
+	var connectors []dbapi.Connector
+	if err := tx.Unscoped().Select("id", "name", "organisation_id").
+		Where("id = ?", connectorId).Limit(1).Find(&connectors).Error; err != nil {
+		return err
+	}
+	if len(connectors) == 0 {
+		return nil
+	}
+
+	return webhooks.RecordEvent(tx, connectors[0].OrganisationId, webhooks.EventTypeConnectorStatusChanged,
+		fmt.Sprintf("/api/connector_mgmt/v1/kafka_connectors/%s", connectorId), connectorId,
+		connectorStatusChangedEventData{
+			Id:            connectorId,
+			Name:          connectors[0].Name,
+			PreviousState: string(previousPhase),
+			State:         string(phase),
+		})
+}
+
+// publishNamespacePhaseChange writes a phase change of the namespace to the webhooks outbox with the given tx, which must
+// be the transaction of the change, unless its phase did not change. Only the namespaces of an organisation are published.
+func publishNamespacePhaseChange(tx *gorm.DB, namespace *dbapi.ConnectorNamespace, previousPhase dbapi.ConnectorNamespacePhaseEnum, phase dbapi.ConnectorNamespacePhaseEnum) error {
+	if phase == previousPhase || namespace.TenantOrganisationId == nil {
+		return nil
+	}
+
+	return webhooks.RecordEvent(tx, *namespace.TenantOrganisationId, webhooks.EventTypeConnectorNamespacePhaseChanged,
+		fmt.Sprintf("/api/connector_mgmt/v1/kafka_connector_namespaces/%s", namespace.ID), namespace.ID,
+		namespacePhaseChangedEventData{
+			Id:            namespace.ID,
+			Name:          namespace.Name,
+			PreviousPhase: string(previousPhase),
+			Phase:         string(phase),
+		})
+}
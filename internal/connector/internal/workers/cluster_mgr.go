@@ -40,11 +40,11 @@ func NewClusterManager(clusterService services.ConnectorClusterService, db *db.C
 	}
 }
 
-func (m *ClusterManager) Reconcile() []error {
+func (m *ClusterManager) Reconcile(ctx context.Context) []error {
 
 	var errs []error
 	if m.ctx == nil {
-		ctx, err := m.db.NewContext(context.Background())
+		ctx, err := m.db.NewContext(ctx)
 		if err != nil {
 			return []error{err}
 		}
@@ -9,12 +9,15 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/phase"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services/vault"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
 	"github.com/golang/glog"
@@ -30,7 +33,9 @@ type ConnectorManager struct {
 	connectorService        services.ConnectorsService
 	connectorClusterService services.ConnectorClusterService
 	connectorTypesService   services.ConnectorTypesService
+	namespaceService        services.ConnectorNamespaceService
 	vaultService            vault.VaultService
+	operationService        operations.OperationService
 	lastVersion             int64
 	startupReconcileDone    bool
 	startupReconcileWG      sync.WaitGroup
@@ -49,7 +54,9 @@ func NewConnectorManager(
 	connectorTypesService services.ConnectorTypesService,
 	connectorService services.ConnectorsService,
 	connectorClusterService services.ConnectorClusterService,
+	namespaceService services.ConnectorNamespaceService,
 	vaultService vault.VaultService,
+	operationService operations.OperationService,
 	db *db.ConnectionFactory,
 	reconciler workers.Reconciler,
 ) *ConnectorManager {
@@ -62,7 +69,9 @@ func NewConnectorManager(
 		connectorService:        connectorService,
 		connectorClusterService: connectorClusterService,
 		connectorTypesService:   connectorTypesService,
+		namespaceService:        namespaceService,
 		vaultService:            vaultService,
+		operationService:        operationService,
 		startupReconcileDone:    false,
 		db:                      db,
 	}
@@ -90,7 +99,7 @@ func (k *ConnectorManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *ConnectorManager) Reconcile() []error {
+func (k *ConnectorManager) Reconcile(ctx context.Context) []error {
 	glog.V(5).Infoln("Reconciling connectors...")
 	var errs []error
 
@@ -121,7 +130,7 @@ func (k *ConnectorManager) Reconcile() []error {
 	}
 
 	if k.ctx == nil {
-		ctx, err := k.db.NewContext(context.Background())
+		ctx, err := k.db.NewContext(ctx)
 		if err != nil {
 			return []error{err}
 		}
@@ -145,14 +154,86 @@ func (k *ConnectorManager) Reconcile() []error {
 		"desired_state = ? AND phase IN ?", dbapi.ConnectorDeleted,
 		[]string{string(dbapi.ConnectorStatusPhaseAssigning), string(dbapi.ConnectorStatusPhaseDeleted)})
 
+	// reconcile failed connectors in "ready" desired state with an "on-failure" restart policy and retries left
+	k.doReconcile(&errs, "failed", k.reconcileFailed,
+		"desired_state = ? AND phase = ? AND restart_policy_type = ? AND retry_count < restart_policy_max_retries",
+		dbapi.ConnectorReady, dbapi.ConnectorStatusPhaseFailed, dbapi.ConnectorRestartPolicyOnFailure)
+
+	// reconcile assigned connectors with a pause or resume schedule
+	k.doReconcile(&errs, "scheduled", k.reconcileSchedule,
+		"desired_state IN ? AND connectors.namespace_id IS NOT NULL AND (schedule_pause <> '' OR schedule_resume <> '')",
+		[]string{string(dbapi.ConnectorReady), string(dbapi.ConnectorStopped)})
+
 	// reconcile connector updates for assigned connectors that aren't being deleted...
 	k.doReconcile(&errs, "updated", k.reconcileConnectorUpdate,
 		"version > ? AND phase NOT IN ?", k.lastVersion,
 		[]string{string(dbapi.ConnectorStatusPhaseAssigning), string(dbapi.ConnectorStatusPhaseDeleting), string(dbapi.ConnectorStatusPhaseDeleted)})
 
+	// reconcile the upgrade operations of connectors whose deployment has been upgraded
+	k.reconcileUpgradeOperations(&errs)
+
 	return errs
 }
 
+// connectorUpgradeProgress is the progress of the upgrade of a connector whose deployment has not been applied yet
+const connectorUpgradeProgress = 50
+
+// connectorDeletionProgress is the progress of the deletion of a connector whose deployment has been removed
+const connectorDeletionProgress = 50
+
+func (k *ConnectorManager) reconcileUpgradeOperations(errs *[]error) {
+	upgradeOperations, serr := k.operationService.ListActive(api.OperationKindConnectorUpgrade)
+	if serr != nil {
+		*errs = append(*errs, errors.Wrap(serr, "failed to list active connector upgrade operations"))
+		return
+	}
+
+	count := 0
+	for _, operation := range upgradeOperations {
+		if err := k.reconcileUpgradeOperation(operation); err != nil {
+			*errs = append(*errs, errors.Wrapf(err, "failed to reconcile upgrade operation %s of connector %s", operation.ID, operation.TargetId))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		glog.V(5).Infof("Reconciled %d upgrade operations", count)
+	}
+}
+
+func (k *ConnectorManager) reconcileUpgradeOperation(operation *api.Operation) error {
+	deployment, serr := k.connectorClusterService.GetDeploymentByConnectorId(k.ctx, operation.TargetId)
+	if serr != nil && !serr.Is404() {
+		return serr
+	}
+
+	if serr != nil {
+		serr = k.operationService.Complete(api.OperationKindConnectorUpgrade, operation.TargetId,
+			errors.Errorf("connector %s is no longer deployed", operation.TargetId))
+	} else {
+		var status dbapi.ConnectorDeploymentStatus
+		if err := k.db.New().Where("id = ?", deployment.ID).First(&status).Error; err != nil {
+			return errors.Wrapf(err, "failed to get status of connector deployment %s", deployment.ID)
+		}
+
+		// the agent reports the version of the deployment it applied
+		switch {
+		case status.Phase == dbapi.ConnectorStatusPhaseFailed:
+			serr = k.operationService.Complete(api.OperationKindConnectorUpgrade, operation.TargetId,
+				errors.Errorf("connector deployment %s failed", deployment.ID))
+		case status.Version >= deployment.Version && status.Phase == dbapi.ConnectorStatusPhaseReady:
+			serr = k.operationService.Complete(api.OperationKindConnectorUpgrade, operation.TargetId, nil)
+		default:
+			serr = k.operationService.UpdateProgress(api.OperationKindConnectorUpgrade, operation.TargetId, connectorUpgradeProgress, nil)
+		}
+	}
+
+	if serr != nil {
+		return serr
+	}
+	return nil
+}
+
 func (k *ConnectorManager) ReconcileConnectorCatalogEntry(id string, channel string, ccc *config.ConnectorChannelConfig) *serviceError.ServiceError {
 
 	ctc := dbapi.ConnectorShardMetadata{
@@ -249,6 +330,9 @@ func (k *ConnectorManager) reconcileDeleting(ctx context.Context, connector *dba
 			if err = k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
 				return err
 			}
+			if err = k.operationService.UpdateProgress(api.OperationKindConnectorDeletion, connector.ID, connectorDeletionProgress, nil); err != nil {
+				return err
+			}
 		} else {
 			return err
 		}
@@ -260,6 +344,101 @@ func (k *ConnectorManager) reconcileDeleted(ctx context.Context, connector *dbap
 	if err := k.connectorService.Delete(ctx, connector.ID); err != nil {
 		return err
 	}
+	if err := k.operationService.Complete(api.OperationKindConnectorDeletion, connector.ID, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k *ConnectorManager) reconcileFailed(ctx context.Context, connector *dbapi.Connector) error {
+	status := &connector.Status
+	now := time.Now()
+
+	// schedule the next restart using the restart policy backoff
+	if status.NextRetryAt == nil {
+		nextRetryAt := now.Add(connector.RestartPolicy.Backoff(status.RetryCount))
+		status.NextRetryAt = &nextRetryAt
+		if err := k.connectorService.SaveStatus(ctx, *status); err != nil {
+			return errors.Wrapf(err, "failed to schedule restart for connector %s", connector.ID)
+		}
+		return nil
+	}
+	if now.Before(*status.NextRetryAt) {
+		return nil
+	}
+
+	status.RetryCount++
+	status.NextRetryAt = nil
+	status.Phase = phase.ConnectorStartingPhase[phase.RestartConnector]
+	if err := k.connectorService.SaveStatus(ctx, *status); err != nil {
+		return errors.Wrapf(err, "failed to update restart status for connector %s", connector.ID)
+	}
+	// update the connector version, so its deployment is applied again by the agent
+	if err := k.connectorService.Update(ctx, connector); err != nil {
+		return errors.Wrapf(err, "failed to restart connector %s", connector.ID)
+	}
+	workers.NewWorkerLogger(k).WithFields(logger.ConnectorIDField, connector.ID).V(5).Infof("Restarted failed connector %s, retry %d of %d", connector.ID,
+		status.RetryCount, connector.RestartPolicy.MaxRetries)
+
+	return nil
+}
+
+func (k *ConnectorManager) reconcileSchedule(ctx context.Context, connector *dbapi.Connector) error {
+	status := &connector.Status
+	now := time.Now()
+
+	due := status.NextScheduledAt != nil && !now.Before(*status.NextScheduledAt)
+	if status.NextScheduledAt != nil && !due {
+		return nil
+	}
+
+	var namespace *dbapi.ConnectorNamespace
+	if due {
+		var serr *serviceError.ServiceError
+		namespace, serr = k.namespaceService.Get(ctx, *connector.NamespaceId)
+		if serr != nil {
+			return errors.Wrapf(serr, "failed to get namespace for connector %s", connector.ID)
+		}
+		// the due action is performed once the namespace is connected again
+		if namespace.Status.Phase != dbapi.ConnectorNamespacePhaseReady {
+			workers.NewWorkerLogger(k).WithFields(logger.ConnectorIDField, connector.ID).V(10).Infof("Skipped scheduled %s of connector %s in %s namespace %s",
+				status.NextScheduledAction, connector.ID, namespace.Status.Phase, namespace.ID)
+			return nil
+		}
+	}
+
+	dueAction := status.NextScheduledAction
+	nextAction, nextAt, err := connector.Schedule.Next(now)
+	if err != nil {
+		return errors.Wrapf(err, "invalid schedule for connector %s", connector.ID)
+	}
+	status.NextScheduledAction = nextAction
+	status.NextScheduledAt = nextAt
+
+	if due {
+		operation := phase.StopConnector
+		if dueAction == dbapi.ConnectorReady {
+			operation = phase.RestartConnector
+		}
+		updated, serr := phase.PerformConnectorOperation(namespace, connector, operation,
+			func(connector *dbapi.Connector) *serviceError.ServiceError {
+				if err := k.connectorService.SaveStatus(ctx, connector.Status); err != nil {
+					return err
+				}
+				return k.connectorService.Update(ctx, connector)
+			})
+		if serr != nil {
+			return errors.Wrapf(serr, "failed to perform scheduled %s of connector %s", operation, connector.ID)
+		}
+		if updated {
+			workers.NewWorkerLogger(k).WithFields(logger.ConnectorIDField, connector.ID).V(5).Infof("Performed scheduled %s of connector %s", operation, connector.ID)
+			return nil
+		}
+	}
+
+	if err := k.connectorService.SaveStatus(ctx, *status); err != nil {
+		return errors.Wrapf(err, "failed to update next scheduled action for connector %s", connector.ID)
+	}
 	return nil
 }
 
@@ -282,7 +461,7 @@ func (k *ConnectorManager) reconcileConnectorUpdate(ctx context.Context, connect
 	if cerr := db.AddPostCommitAction(ctx, func() {
 		k.lastVersion = connector.Version
 	}); cerr != nil {
-		glog.Errorf("failed to AddPostCommitAction to save lastVersion %d: %v", connector.Version, cerr.Error())
+		workers.NewWorkerLogger(k).WithFields(logger.ConnectorIDField, connector.ID).Errorf("failed to AddPostCommitAction to save lastVersion %d: %v", connector.Version, cerr.Error())
 		if err == nil {
 			err = cerr
 		} else {
@@ -300,7 +479,7 @@ func (k *ConnectorManager) doReconcile(errs *[]error, reconcilePhase string, rec
 	if serviceErrs = k.connectorService.ForEach(func(connector *dbapi.Connector) *serviceError.ServiceError {
 		return InDBTransaction(k.ctx, func(ctx context.Context) error {
 			if err := reconcileFunc(ctx, connector); err != nil {
-				glog.Errorf("failed to reconcile %s connector %s in phase %s: %v", reconcilePhase,
+				workers.NewWorkerLogger(k).WithFields(logger.ConnectorIDField, connector.ID).Errorf("failed to reconcile %s connector %s in phase %s: %v", reconcilePhase,
 					connector.ID, connector.Status.Phase, err)
 				return err
 			}
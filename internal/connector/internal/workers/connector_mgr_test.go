@@ -0,0 +1,236 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/connector/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	. "github.com/onsi/gomega"
+)
+
+func buildConnector(modifyFn func(connector *dbapi.Connector)) *dbapi.Connector {
+	namespaceId := "namespace-id"
+	connector := &dbapi.Connector{
+		Model:        db.Model{ID: "connector-id"},
+		NamespaceId:  &namespaceId,
+		Version:      1,
+		DesiredState: dbapi.ConnectorReady,
+		RestartPolicy: dbapi.ConnectorRestartPolicy{
+			Type:           dbapi.ConnectorRestartPolicyOnFailure,
+			MaxRetries:     3,
+			BackoffSeconds: 10,
+		},
+		Status: dbapi.ConnectorStatus{
+			Model: db.Model{ID: "connector-id"},
+			Phase: dbapi.ConnectorStatusPhaseFailed,
+		},
+	}
+	if modifyFn != nil {
+		modifyFn(connector)
+	}
+	return connector
+}
+
+func Test_ConnectorManager_reconcileFailed(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name            string
+		connector       *dbapi.Connector
+		saveStatusErr   *serviceError.ServiceError
+		wantErr         bool
+		wantSaveStatus  int
+		wantUpdate      int
+		wantRetryCount  int32
+		wantPhase       dbapi.ConnectorStatusPhase
+		wantNextRetryAt bool
+	}{
+		{
+			name:            "should schedule the first restart using the restart policy backoff",
+			connector:       buildConnector(nil),
+			wantSaveStatus:  1,
+			wantPhase:       dbapi.ConnectorStatusPhaseFailed,
+			wantNextRetryAt: true,
+		},
+		{
+			name: "should not restart the connector before its restart is due",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Status.NextRetryAt = &future
+			}),
+			wantPhase:       dbapi.ConnectorStatusPhaseFailed,
+			wantNextRetryAt: true,
+		},
+		{
+			name: "should restart the connector once its restart is due",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Status.NextRetryAt = &past
+				connector.Status.RetryCount = 1
+			}),
+			wantSaveStatus: 1,
+			wantUpdate:     1,
+			wantRetryCount: 2,
+			wantPhase:      dbapi.ConnectorStatusPhaseAssigned,
+		},
+		{
+			name:           "should return an error if the restart can't be scheduled",
+			connector:      buildConnector(nil),
+			saveStatusErr:  serviceError.GeneralError("test"),
+			wantErr:        true,
+			wantSaveStatus: 1,
+			wantPhase:      dbapi.ConnectorStatusPhaseFailed,
+			// the next retry is set in memory but was not saved
+			wantNextRetryAt: true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connectorService := &services.ConnectorsServiceMock{
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *serviceError.ServiceError {
+					return tt.saveStatusErr
+				},
+				UpdateFunc: func(ctx context.Context, resource *dbapi.Connector) *serviceError.ServiceError {
+					return nil
+				},
+			}
+			k := &ConnectorManager{
+				BaseWorker:       workers.BaseWorker{WorkerType: "connector"},
+				connectorService: connectorService,
+			}
+
+			err := k.reconcileFailed(context.Background(), tt.connector)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(connectorService.SaveStatusCalls()).To(HaveLen(tt.wantSaveStatus))
+			Expect(connectorService.UpdateCalls()).To(HaveLen(tt.wantUpdate))
+			Expect(tt.connector.Status.RetryCount).To(Equal(tt.wantRetryCount))
+			Expect(tt.connector.Status.Phase).To(Equal(tt.wantPhase))
+			Expect(tt.connector.Status.NextRetryAt != nil).To(Equal(tt.wantNextRetryAt))
+		})
+	}
+}
+
+func Test_ConnectorManager_reconcileSchedule(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name             string
+		connector        *dbapi.Connector
+		namespacePhase   dbapi.ConnectorNamespacePhaseEnum
+		wantErr          bool
+		wantSaveStatus   int
+		wantUpdate       int
+		wantDesiredState dbapi.ConnectorDesiredState
+		wantNextAction   dbapi.ConnectorDesiredState
+	}{
+		{
+			name: "should schedule the next action of a connector",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "0 0 * * *"}
+			}),
+			wantSaveStatus:   1,
+			wantDesiredState: dbapi.ConnectorReady,
+			wantNextAction:   dbapi.ConnectorStopped,
+		},
+		{
+			name: "should not do anything before the next action is due",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "0 0 * * *"}
+				connector.Status.NextScheduledAction = dbapi.ConnectorStopped
+				connector.Status.NextScheduledAt = &future
+			}),
+			wantDesiredState: dbapi.ConnectorReady,
+			wantNextAction:   dbapi.ConnectorStopped,
+		},
+		{
+			name: "should stop the connector when a pause is due",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "0 0 * * *", Resume: "0 8 * * *"}
+				connector.Status.NextScheduledAction = dbapi.ConnectorStopped
+				connector.Status.NextScheduledAt = &past
+			}),
+			wantSaveStatus:   1,
+			wantUpdate:       1,
+			wantDesiredState: dbapi.ConnectorStopped,
+		},
+		{
+			name: "should resume the connector when a resume is due",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.DesiredState = dbapi.ConnectorStopped
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "0 0 * * *", Resume: "0 8 * * *"}
+				connector.Status.NextScheduledAction = dbapi.ConnectorReady
+				connector.Status.NextScheduledAt = &past
+			}),
+			wantSaveStatus:   1,
+			wantUpdate:       1,
+			wantDesiredState: dbapi.ConnectorReady,
+		},
+		{
+			name: "should skip a due action while the namespace is disconnected",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "0 0 * * *", Resume: "0 8 * * *"}
+				connector.Status.NextScheduledAction = dbapi.ConnectorStopped
+				connector.Status.NextScheduledAt = &past
+			}),
+			namespacePhase:   dbapi.ConnectorNamespacePhaseDisconnected,
+			wantDesiredState: dbapi.ConnectorReady,
+			wantNextAction:   dbapi.ConnectorStopped,
+		},
+		{
+			name: "should return an error if the schedule is invalid",
+			connector: buildConnector(func(connector *dbapi.Connector) {
+				connector.Schedule = dbapi.ConnectorSchedule{Pause: "not a cron expression"}
+			}),
+			wantErr:          true,
+			wantDesiredState: dbapi.ConnectorReady,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespacePhase := tt.namespacePhase
+			if namespacePhase == "" {
+				namespacePhase = dbapi.ConnectorNamespacePhaseReady
+			}
+			connectorService := &services.ConnectorsServiceMock{
+				SaveStatusFunc: func(ctx context.Context, resource dbapi.ConnectorStatus) *serviceError.ServiceError {
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, resource *dbapi.Connector) *serviceError.ServiceError {
+					return nil
+				},
+			}
+			k := &ConnectorManager{
+				BaseWorker:       workers.BaseWorker{WorkerType: "connector"},
+				connectorService: connectorService,
+				namespaceService: &services.ConnectorNamespaceServiceMock{
+					GetFunc: func(ctx context.Context, namespaceID string) (*dbapi.ConnectorNamespace, *serviceError.ServiceError) {
+						return &dbapi.ConnectorNamespace{
+							Status: dbapi.ConnectorNamespaceStatus{Phase: namespacePhase},
+						}, nil
+					},
+				},
+			}
+
+			err := k.reconcileSchedule(context.Background(), tt.connector)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(connectorService.SaveStatusCalls()).To(HaveLen(tt.wantSaveStatus))
+			Expect(connectorService.UpdateCalls()).To(HaveLen(tt.wantUpdate))
+			Expect(tt.connector.DesiredState).To(Equal(tt.wantDesiredState))
+			if tt.wantNextAction != "" {
+				Expect(tt.connector.Status.NextScheduledAction).To(Equal(tt.wantNextAction))
+				Expect(tt.connector.Status.NextScheduledAt).ToNot(BeNil())
+			}
+		})
+	}
+}
@@ -40,11 +40,11 @@ func NewNamespaceManager(namespaceService services.ConnectorNamespaceService, db
 	}
 }
 
-func (m *NamespaceManager) Reconcile() []error {
+func (m *NamespaceManager) Reconcile(ctx context.Context) []error {
 
 	var errs []error
 	if m.ctx == nil {
-		ctx, err := m.db.NewContext(context.Background())
+		ctx, err := m.db.NewContext(ctx)
 		if err != nil {
 			return []error{err}
 		}
@@ -58,7 +58,9 @@ func serviceProviders() di.Option {
 		di.Provide(handlers.NewConnectorAdminHandler),
 		di.Provide(handlers.NewConnectorTypesHandler),
 		di.Provide(handlers.NewConnectorsHandler),
+		di.Provide(migrations.NewHealthCheck, di.As(new(environments2.HealthCheck))),
 		di.Provide(handlers.NewConnectorClusterHandler),
+		di.Provide(handlers.NewOperationHandler),
 		di.Provide(routes.NewRouteLoader),
 		di.Provide(workers.NewClusterManager, di.As(new(coreWorkers.Worker))),
 		di.Provide(workers.NewConnectorManager, di.As(new(coreWorkers.Worker))),
@@ -161,6 +161,7 @@ Feature: connector agent API
       "quota": {},
       "resource_version": ${response.resource_version},
       "status": {
+        "resources": {},
         "connectors_deployed": 0,
         "state": "disconnected"
       },
@@ -193,6 +194,7 @@ Feature: connector agent API
       "quota": {},
       "resource_version": ${response.resource_version},
       "status": {
+        "resources": {},
         "connectors_deployed": 0,
         "state": "disconnected"
       },
@@ -1039,6 +1041,7 @@ Feature: connector agent API
       "quota": {},
       "resource_version": ${response.resource_version},
       "status": {
+        "resources": {},
         "connectors_deployed": 1,
         "error": "Testing: This is a test failure message; Testing2: This is another test failure message",
         "state": "ready",
@@ -1180,8 +1183,9 @@ Feature: connector agent API
       """
       ${upgrade_items}
       """
-    And the response code should be 204
-    And the response should match ""
+    And the response code should be 202
+    And the ".kind" selection from the response should match "OperationList"
+    And the ".items[0].operation_kind" selection from the response should match "connector_upgrade"
 
     # agent should get updated connector type version
     Given I am logged in as "Shard"
@@ -1276,8 +1280,9 @@ Feature: connector agent API
       """
       ${upgrade_items}
       """
-    And the response code should be 204
-    And the response should match ""
+    And the response code should be 202
+    And the ".kind" selection from the response should match "OperationList"
+    And the ".items[0].operation_kind" selection from the response should match "connector_upgrade"
 
     # agent should get updated operator id
     Given I am logged in as "Shard"
@@ -1663,7 +1668,8 @@ Feature: connector agent API
     #-----------------------------------------------------------------------------------------------------------------
     Given I am logged in as "Bobby"
     When I DELETE path "/v1/kafka_connectors/${connector_id}"
-    Then the response code should be 204
+    Then the response code should be 202
+    And the ".operation_kind" selection from the response should match "connector_deletion"
 
     Given I am logged in as "Shard"
     And I set the "Authorization" header to "Bearer ${shard_token}"
@@ -1483,8 +1483,10 @@ Feature: create a connector
       Given I reset the vault counters
       Given I am logged in as "Gary"
       When I DELETE path "/v1/kafka_connectors/${connector_id}"
-      Then the response code should be 204
-      And the response should match ""
+      Then the response code should be 202
+      And the ".operation_kind" selection from the response should match "connector_deletion"
+      And the ".target.id" selection from the response should match "${connector_id}"
+      And the response header "Location" should match "/api/connector_mgmt/v1/operations/${response.id}"
 
       # The delete occurs async in a worker, so we have to wait a little for the counters to update.
       Given I sleep for 10 seconds
@@ -2034,8 +2036,10 @@ Feature: create a connector
       Given I reset the vault counters
 
       When I DELETE path "/v1/kafka_connectors/${connector_id}"
-      Then the response code should be 204
-      And the response should match ""
+      Then the response code should be 202
+      And the ".operation_kind" selection from the response should match "connector_deletion"
+      And the ".target.id" selection from the response should match "${connector_id}"
+      And the response header "Location" should match "/api/connector_mgmt/v1/operations/${response.id}"
 
       # The delete occurs async in a worker, so we have to wait a little for the counters to update.
       Given I sleep for 10 seconds
@@ -244,6 +244,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       },
       "tenant": {
@@ -309,6 +310,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -438,6 +440,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -507,6 +510,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       }
     }
@@ -547,6 +551,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       }
     }
@@ -581,6 +586,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          },
@@ -604,6 +610,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -729,6 +736,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -779,6 +787,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       }
     }
@@ -816,6 +825,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -892,6 +902,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
@@ -919,6 +930,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       }
     }
@@ -946,6 +958,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       }
     }
@@ -968,6 +981,7 @@ Feature: connector namespaces API
       },
       "status": {
         "state": "disconnected",
+        "resources": {},
         "connectors_deployed": 0
       },
       "expiration": "1000-01-01T10:10:10.00Z"
@@ -1006,6 +1020,7 @@ Feature: connector namespaces API
            },
            "status": {
              "state": "disconnected",
+             "resources": {},
              "connectors_deployed": 0
            }
          }
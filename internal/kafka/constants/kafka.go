@@ -21,6 +21,12 @@ const (
 	KafkaRequestStatusReady KafkaStatus = "ready"
 	// KafkaRequestStatusFailed - kafka request failed
 	KafkaRequestStatusFailed KafkaStatus = "failed"
+	// KafkaRequestStatusSuspending - kafka request waiting for the data plane to scale the kafka down
+	KafkaRequestStatusSuspending KafkaStatus = "suspending"
+	// KafkaRequestStatusSuspended - kafka scaled down on the data plane, either on user request or until its deletion grace period is over
+	KafkaRequestStatusSuspended KafkaStatus = "suspended"
+	// KafkaRequestStatusResuming - kafka request waiting for the data plane to scale a suspended kafka back up
+	KafkaRequestStatusResuming KafkaStatus = "resuming"
 	// KafkaRequestStatusDeprovision - kafka request status when to be deleted by kafka
 	KafkaRequestStatusDeprovision KafkaStatus = "deprovision"
 	// KafkaRequestStatusDeleting - external resources are being deleted for the kafka request
@@ -53,6 +59,9 @@ var ordinals = map[string]int{
 	KafkaRequestStatusPreparing.String():    10,
 	KafkaRequestStatusProvisioning.String(): 20,
 	KafkaRequestStatusReady.String():        30,
+	KafkaRequestStatusSuspending.String():   33,
+	KafkaRequestStatusSuspended.String():    35,
+	KafkaRequestStatusResuming.String():     37,
 	KafkaRequestStatusDeprovision.String():  40,
 	KafkaRequestStatusDeleting.String():     50,
 	KafkaRequestStatusFailed.String():       500,
@@ -0,0 +1,28 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// ConfigStatus struct for ConfigStatus
+type ConfigStatus struct {
+	// Name of the configuration
+	Name string `json:"name"`
+	// Configuration files of the configuration
+	Files []string `json:"files,omitempty"`
+	// SHA-256 checksum of the configuration files loaded
+	Checksum string `json:"checksum"`
+	// Time at which the configuration in use was loaded
+	LoadedAt time.Time `json:"loaded_at"`
+	// Error of the last reload if it failed, in which case the previously loaded configuration is still in use
+	LastReloadError string `json:"last_reload_error,omitempty"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ConfigStatusList struct for ConfigStatusList
+type ConfigStatusList struct {
+	Kind  string         `json:"kind"`
+	Items []ConfigStatus `json:"items"`
+}
@@ -52,4 +52,6 @@ type Kafka struct {
 	ClusterId              string             `json:"cluster_id,omitempty"`
 	Namespace              string             `json:"namespace,omitempty"`
 	SizeId                 string             `json:"size_id,omitempty"`
+	// Expiration time set when the lifespan of the Kafka instance has been extended, overriding the lifespan of its size
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 }
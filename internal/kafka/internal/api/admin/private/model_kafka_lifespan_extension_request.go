@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaLifespanExtensionRequest struct for KafkaLifespanExtensionRequest
+type KafkaLifespanExtensionRequest struct {
+	// New expiration time of the Kafka instance, it must be after its current expiration time
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,33 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+import (
+	"time"
+)
+
+// KafkaOwnershipTransfer struct for KafkaOwnershipTransfer
+type KafkaOwnershipTransfer struct {
+	Id      string `json:"id,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Href    string `json:"href,omitempty"`
+	KafkaId string `json:"kafka_id,omitempty"`
+	// Owner of the Kafka instance when the transfer was requested
+	FromOwner          string `json:"from_owner,omitempty"`
+	FromOrganisationId string `json:"from_organisation_id,omitempty"`
+	// User the Kafka instance is transferred to
+	ToOwner          string `json:"to_owner,omitempty"`
+	ToOrganisationId string `json:"to_organisation_id,omitempty"`
+	RequestedBy      string `json:"requested_by,omitempty"`
+	// Values: [pending_approval, pending, accepted, declined, cancelled]. Transfers to another organisation are 'pending_approval' until an admin approves them
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// LogLevel struct for LogLevel
+type LogLevel struct {
+	// Component logging the records
+	Component string `json:"component"`
+	// Level of the info records logged by the component
+	Level int32 `json:"level"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// LogLevelList struct for LogLevelList
+type LogLevelList struct {
+	Kind string `json:"kind"`
+	// Level of the info records logged by the components which are not listed, set by the -v flag
+	DefaultLevel int32      `json:"default_level"`
+	Items        []LogLevel `json:"items"`
+}
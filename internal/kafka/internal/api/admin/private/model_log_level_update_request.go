@@ -0,0 +1,16 @@
+/*
+ * Kafka Service Fleet Manager Admin APIs
+ *
+ * The admin APIs for the fleet manager of Kafka service
+ *
+ * API version: 0.0.4
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// LogLevelUpdateRequest struct for LogLevelUpdateRequest
+type LogLevelUpdateRequest struct {
+	// Level of the info records logged by the component, a record is logged if its level is lower or equal
+	Level int32 `json:"level"`
+}
@@ -13,6 +13,8 @@ type DataPlaneKafkaStatus struct {
 	StrimziVersion  string
 	KafkaIBPVersion string
 	AdminServerURI  string
+	// PrivateEndpointServiceName is only reported for kafkas exposed through an internal ingress
+	PrivateEndpointServiceName string
 }
 
 type DataPlaneKafkaStatusCondition struct {
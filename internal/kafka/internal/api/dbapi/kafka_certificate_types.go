@@ -0,0 +1,34 @@
+package dbapi
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+// KafkaCertificate is the TLS certificate issued for the bootstrap and routes hosts of a Kafka instance
+type KafkaCertificate struct {
+	api.Meta
+	KafkaID string `json:"kafka_id" gorm:"index"`
+	// Hosts is the comma separated list of hosts the certificate is valid for
+	Hosts string `json:"hosts"`
+	// Certificate is the PEM encoded certificate chain
+	Certificate string `json:"certificate"`
+	// EncryptedKey is the PEM encoded private key of the certificate, encrypted at rest
+	EncryptedKey string    `json:"encrypted_key"`
+	NotAfter     time.Time `json:"not_after"`
+	// Key is the decrypted EncryptedKey, it is never stored
+	Key string `json:"-" gorm:"-"`
+}
+
+func (c *KafkaCertificate) GetHosts() []string {
+	if c.Hosts == "" {
+		return nil
+	}
+	return strings.Split(c.Hosts, ",")
+}
+
+func (c *KafkaCertificate) SetHosts(hosts []string) {
+	c.Hosts = strings.Join(hosts, ",")
+}
@@ -0,0 +1,46 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+type KafkaEventType string
+
+const (
+	// KafkaEventTypeStatusChange is recorded every time the status of a kafka changes
+	KafkaEventTypeStatusChange KafkaEventType = "status_change"
+	// KafkaEventTypePlacement is recorded when a kafka is assigned to a data plane cluster
+	KafkaEventTypePlacement KafkaEventType = "placement"
+	// KafkaEventTypeRoutesCreated is recorded once the DNS records of a kafka have been created
+	KafkaEventTypeRoutesCreated KafkaEventType = "routes_created"
+	// KafkaEventTypeUpgrade is recorded when a kafka has been upgraded to another version
+	KafkaEventTypeUpgrade KafkaEventType = "upgrade"
+	// KafkaEventTypeDataPlaneCondition is recorded when the data plane reports a new condition for a kafka
+	KafkaEventTypeDataPlaneCondition KafkaEventType = "data_plane_condition"
+	// KafkaEventTypeAdminUpdate is recorded when a kafka is updated through the admin API
+	KafkaEventTypeAdminUpdate KafkaEventType = "admin_update"
+	// KafkaEventTypeFailover is recorded on both kafkas of a replicated pair when their roles are swapped
+	KafkaEventTypeFailover KafkaEventType = "failover"
+)
+
+func (t KafkaEventType) String() string {
+	return string(t)
+}
+
+// KafkaEventActorSystem is the actor of the events recorded by the fleet manager workers and the data plane
+const KafkaEventActorSystem = "system"
+
+// KafkaEvent is an entry in the history of a Kafka instance
+type KafkaEvent struct {
+	api.Meta
+	KafkaID string `json:"kafka_id" gorm:"index"`
+	Type    string `json:"type"`
+	// Actor is the user who caused the event, or KafkaEventActorSystem
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+	// PreviousStatus is only set by status changes, Status is the status of the kafka after the event
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+type KafkaEventList []*KafkaEvent
@@ -0,0 +1,47 @@
+package dbapi
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+type KafkaOwnershipTransferStatus string
+
+const (
+	// KafkaOwnershipTransferStatusPendingApproval is the status of a transfer to another organisation waiting for the approval of an admin
+	KafkaOwnershipTransferStatusPendingApproval KafkaOwnershipTransferStatus = "pending_approval"
+	// KafkaOwnershipTransferStatusPending is the status of a transfer waiting for the acceptance of the new owner
+	KafkaOwnershipTransferStatusPending   KafkaOwnershipTransferStatus = "pending"
+	KafkaOwnershipTransferStatusAccepted  KafkaOwnershipTransferStatus = "accepted"
+	KafkaOwnershipTransferStatusDeclined  KafkaOwnershipTransferStatus = "declined"
+	KafkaOwnershipTransferStatusCancelled KafkaOwnershipTransferStatus = "cancelled"
+)
+
+func (s KafkaOwnershipTransferStatus) String() string {
+	return string(s)
+}
+
+// KafkaOwnershipTransfer is a request to transfer the ownership of a Kafka instance to another user.
+// The ownership only changes once the new owner has accepted the transfer.
+type KafkaOwnershipTransfer struct {
+	api.Meta
+	KafkaID            string `json:"kafka_id" gorm:"index"`
+	FromOwner          string `json:"from_owner"`
+	FromOrganisationId string `json:"from_organisation_id"`
+	ToOwner            string `json:"to_owner" gorm:"index"`
+	ToOrganisationId   string `json:"to_organisation_id"`
+	// RequestedBy is the user who requested the transfer, either the owner of the kafka or an admin of its organisation
+	RequestedBy string `json:"requested_by"`
+	Status      string `json:"status" gorm:"index"`
+}
+
+type KafkaOwnershipTransferList []*KafkaOwnershipTransfer
+
+// IsOpen returns whether the transfer can still be accepted, declined or cancelled
+func (t *KafkaOwnershipTransfer) IsOpen() bool {
+	return t.Status == KafkaOwnershipTransferStatusPending.String() || t.Status == KafkaOwnershipTransferStatusPendingApproval.String()
+}
+
+// IsCrossOrganisation returns whether the kafka is transferred to a user of another organisation
+func (t *KafkaOwnershipTransfer) IsCrossOrganisation() bool {
+	return t.FromOrganisationId != t.ToOrganisationId
+}
@@ -0,0 +1,45 @@
+package dbapi
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+type KafkaReplicationRole string
+
+const (
+	KafkaReplicationRolePrimary   KafkaReplicationRole = "primary"
+	KafkaReplicationRoleSecondary KafkaReplicationRole = "secondary"
+)
+
+func (r KafkaReplicationRole) String() string {
+	return string(r)
+}
+
+// KafkaReplicatedPair relates two Kafka instances of different regions: the primary serves the clients while the
+// secondary mirrors its topics, ready to take over. The alias host always resolves to the bootstrap server of the primary.
+type KafkaReplicatedPair struct {
+	api.Meta
+	Owner            string `json:"owner" gorm:"index"`
+	OrganisationId   string `json:"organisation_id" gorm:"index"`
+	PrimaryKafkaID   string `json:"primary_kafka_id" gorm:"index"`
+	SecondaryKafkaID string `json:"secondary_kafka_id" gorm:"index"`
+	// MirroredTopics is the regular expression of the topics mirrored from the primary to the secondary
+	MirroredTopics string `json:"mirrored_topics"`
+	// SyncConsumerGroupOffsets if the offsets of the consumer groups of the primary are mirrored as well
+	SyncConsumerGroupOffsets bool `json:"sync_consumer_group_offsets"`
+	// AliasHost is the stable DNS name pointing to the bootstrap server of the primary
+	AliasHost string `json:"alias_host"`
+	// AliasKafkaID is the id of the kafka the alias DNS record currently points to. It differs from the primary
+	// until the record has been updated in the DNS provider after a failover.
+	AliasKafkaID string     `json:"alias_kafka_id"`
+	FailedOverAt *time.Time `json:"failed_over_at"`
+}
+
+type KafkaReplicatedPairList []*KafkaReplicatedPair
+
+// IsAliasInSync returns whether the alias DNS record points to the current primary
+func (p *KafkaReplicatedPair) IsAliasInSync() bool {
+	return p.AliasKafkaID == p.PrimaryKafkaID
+}
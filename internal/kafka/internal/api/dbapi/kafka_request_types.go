@@ -54,6 +54,36 @@ type KafkaRequest struct {
 	SizeId                  string `json:"size_id"`
 	BillingCloudAccountId   string `json:"billing_cloud_account_id"`
 	Marketplace             string `json:"marketplace"`
+	// ExpiresAt overrides the expiration time derived from the lifespan of the instance size when the lifespan has been extended
+	ExpiresAt *time.Time `json:"expires_at"`
+	// ExpiryNotificationThresholdSeconds is the threshold, in seconds before the expiration time, of the last expiry notification sent to the owner
+	ExpiryNotificationThresholdSeconds *int64 `json:"expiry_notification_threshold_seconds"`
+	// DeletionScheduledAt is the time at which a suspended Kafka is deprovisioned, unless it is restored before
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	// KafkaConfig the kafka settings tuned by the user within the kafka config ranges of the instance size
+	KafkaConfig api.JSON `json:"kafka_config"`
+	// Private if the kafka is only reachable over cloud private links or VPC peering. Private kafkas are placed on
+	// clusters supporting them, are exposed through an internal ingress and get no public DNS records
+	Private bool `json:"private"`
+	// PrivateEndpointServiceName is the name of the cloud provider endpoint service reported by the data plane for a private kafka,
+	// which private links are created against
+	PrivateEndpointServiceName string `json:"private_endpoint_service_name"`
+	// ReplicatedPairId is the id of the replicated pair the kafka is a member of, if any
+	ReplicatedPairId string `json:"replicated_pair_id" gorm:"index"`
+	// ReplicationRole is the role of the kafka within its replicated pair: the primary serves the clients while the
+	// secondary mirrors the topics of the primary
+	ReplicationRole string `json:"replication_role"`
+	// Version is bumped by the database on every change of the state edited by the users and the admins, which the
+	// entity tag of the kafka is derived from. The status updates of the data plane don't change it.
+	Version int64 `json:"version"`
+}
+
+// KafkaConfig contains the kafka settings tuned by the user. Settings left empty use the limits of the instance size
+type KafkaConfig struct {
+	MaxDataRetentionPeriod      string `json:"max_data_retention_period,omitempty"`
+	DefaultRetentionPeriod      string `json:"default_retention_period,omitempty"`
+	MessageMaxBytes             int64  `json:"message_max_bytes,omitempty"`
+	MaxConnectionAttemptsPerSec int    `json:"max_connection_attempts_per_sec,omitempty"`
 }
 
 type KafkaList []*KafkaRequest
@@ -97,10 +127,41 @@ func (k *KafkaRequest) SetRoutes(routes []DataPlaneKafkaRoute) error {
 	}
 }
 
+// GetKafkaConfig returns the kafka settings tuned by the user, or nil if none have been tuned
+func (k *KafkaRequest) GetKafkaConfig() (*KafkaConfig, error) {
+	if k.KafkaConfig == nil {
+		return nil, nil
+	}
+	var kafkaConfig KafkaConfig
+	if err := json.Unmarshal(k.KafkaConfig, &kafkaConfig); err != nil {
+		return nil, err
+	}
+	return &kafkaConfig, nil
+}
+
+// SetKafkaConfig stores the kafka settings tuned by the user, replacing any previously tuned settings
+func (k *KafkaRequest) SetKafkaConfig(kafkaConfig *KafkaConfig) error {
+	if kafkaConfig == nil {
+		k.KafkaConfig = nil
+		return nil
+	}
+	if c, err := json.Marshal(kafkaConfig); err != nil {
+		return err
+	} else {
+		k.KafkaConfig = c
+		return nil
+	}
+}
+
 // GetExpirationTime returns when the Kafka request will expire based on the
 // provided lifespanSeconds value. lifespanSeconds is assumed to be greater
-// than 0
+// than 0. The lifespan is ignored when the expiration time of the Kafka
+// request has been extended
 func (k *KafkaRequest) GetExpirationTime(lifespanSeconds int) *time.Time {
+	if k.ExpiresAt != nil {
+		expireTime := *k.ExpiresAt
+		return &expireTime
+	}
 	expireTime := k.CreatedAt.Add(time.Duration(lifespanSeconds) * time.Second)
 	return &expireTime
 }
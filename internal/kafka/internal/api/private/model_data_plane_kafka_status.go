@@ -18,4 +18,6 @@ type DataPlaneKafkaStatus struct {
 	// Routes created for a Kafka cluster
 	Routes         *[]DataPlaneKafkaStatusRoutes `json:"routes,omitempty"`
 	AdminServerURI string                        `json:"adminServerURI,omitempty"`
+	// Name of the cloud provider endpoint service of a Kafka cluster exposed through an internal ingress, which private links are created against
+	PrivateEndpointServiceName string `json:"privateEndpointServiceName,omitempty"`
 }
@@ -13,4 +13,6 @@ package private
 type ManagedKafkaAllOfMetadataLabels struct {
 	Bf2OrgKafkaInstanceProfileType          string `json:"bf2.org/kafkaInstanceProfileType,omitempty"`
 	Bf2OrgKafkaInstanceProfileQuotaConsumed string `json:"bf2.org/kafkaInstanceProfileQuotaConsumed"`
+	// Set to \"true\" when the Kafka instance is suspended, either on user request or during its deletion grace period. Suspended Kafka instances are scaled down while keeping their storage
+	Bf2OrgSuspended string `json:"bf2.org/suspended,omitempty"`
 }
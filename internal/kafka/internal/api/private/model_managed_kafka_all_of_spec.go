@@ -13,9 +13,11 @@ package private
 type ManagedKafkaAllOfSpec struct {
 	ServiceAccounts []ManagedKafkaAllOfSpecServiceAccounts `json:"serviceAccounts,omitempty"`
 	Capacity        ManagedKafkaCapacity                   `json:"capacity,omitempty"`
+	KafkaConfig     *ManagedKafkaKafkaConfig               `json:"kafkaConfig,omitempty"`
 	Oauth           ManagedKafkaAllOfSpecOauth             `json:"oauth,omitempty"`
 	Owners          []string                               `json:"owners,omitempty"`
 	Endpoint        ManagedKafkaAllOfSpecEndpoint          `json:"endpoint,omitempty"`
 	Versions        ManagedKafkaVersions                   `json:"versions,omitempty"`
 	Deleted         bool                                   `json:"deleted"`
+	Mirroring       *ManagedKafkaMirroring                 `json:"mirroring,omitempty"`
 }
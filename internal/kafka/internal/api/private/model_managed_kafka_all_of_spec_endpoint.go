@@ -13,4 +13,6 @@ package private
 type ManagedKafkaAllOfSpecEndpoint struct {
 	BootstrapServerHost string                            `json:"bootstrapServerHost,omitempty"`
 	Tls                 *ManagedKafkaAllOfSpecEndpointTls `json:"tls,omitempty"`
+	// Whether the Kafka has to be exposed through an internal ingress, only reachable over cloud private links or VPC peering
+	Internal bool `json:"internal,omitempty"`
 }
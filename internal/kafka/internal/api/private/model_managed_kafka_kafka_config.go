@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager APIs that are used by internal services e.g kas-fleetshard operators.
+ *
+ * API version: 1.5.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ManagedKafkaKafkaConfig Kafka settings tuned by the user which are not part of the capacity
+type ManagedKafkaKafkaConfig struct {
+	// Default duration (ISO8601) for retaining data in topics
+	DefaultRetentionPeriod string `json:"defaultRetentionPeriod,omitempty"`
+	// Maximum message size in bytes
+	MessageMaxBytes int64 `json:"messageMaxBytes,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager APIs that are used by internal services e.g kas-fleetshard operators.
+ *
+ * API version: 1.5.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package private
+
+// ManagedKafkaMirroring Mirroring of the topics of another Kafka into this one, set for the secondary of a replicated pair
+type ManagedKafkaMirroring struct {
+	// Bootstrap server host of the Kafka whose topics are mirrored
+	SourceBootstrapServerHost string `json:"sourceBootstrapServerHost,omitempty"`
+	// Regular expression of the mirrored topics
+	Topics string `json:"topics,omitempty"`
+	// Whether the offsets of the consumer groups are mirrored as well
+	SyncConsumerGroupOffsets bool `json:"syncConsumerGroupOffsets,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaConfig Kafka settings tuned by the user. Each setting must be within the range allowed for the size of the Kafka instance. Settings which are not specified use the limits of the size
+type KafkaConfig struct {
+	// Maximum duration (ISO8601) for retaining data in topics
+	MaxDataRetentionPeriod string `json:"max_data_retention_period,omitempty"`
+	// Default duration (ISO8601) for retaining data in topics. It can not exceed the maximum retention period
+	DefaultRetentionPeriod string `json:"default_retention_period,omitempty"`
+	// Maximum message size in bytes
+	MessageMaxBytes int64 `json:"message_max_bytes,omitempty"`
+	// Maximum number of connection attempts per second
+	MaxConnectionAttemptsPerSec int32 `json:"max_connection_attempts_per_sec,omitempty"`
+}
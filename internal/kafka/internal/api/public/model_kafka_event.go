@@ -0,0 +1,32 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaEvent struct for KafkaEvent
+type KafkaEvent struct {
+	Id      string `json:"id,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+	Href    string `json:"href,omitempty"`
+	KafkaId string `json:"kafka_id,omitempty"`
+	// Values: [status_change, placement, routes_created, upgrade, data_plane_condition, admin_update, failover]
+	Type string `json:"type,omitempty"`
+	// User who caused the event, or 'system' for the events caused by the service itself
+	Actor  string `json:"actor,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// Status of the Kafka instance before a status change. Only set by 'status_change' events
+	PreviousStatus string `json:"previous_status,omitempty"`
+	// Status of the Kafka instance after the event
+	Status    string    `json:"status,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
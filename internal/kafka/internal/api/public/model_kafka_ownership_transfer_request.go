@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaOwnershipTransferRequest Schema for the request to transfer a Kafka instance to another user
+type KafkaOwnershipTransferRequest struct {
+	// User the Kafka instance is transferred to
+	ToOwner string `json:"to_owner"`
+	// Organisation of the new owner. Defaults to the organisation of the Kafka instance. Transfers to another organisation have to be approved by an admin
+	ToOrganisationId *string `json:"to_organisation_id,omitempty"`
+}
@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// KafkaReplicatedPair struct for KafkaReplicatedPair
+type KafkaReplicatedPair struct {
+	Id    string `json:"id,omitempty"`
+	Kind  string `json:"kind,omitempty"`
+	Href  string `json:"href,omitempty"`
+	Owner string `json:"owner,omitempty"`
+	// Kafka instance serving the clients
+	PrimaryKafkaId string `json:"primary_kafka_id,omitempty"`
+	// Kafka instance mirroring the topics of the primary one, ready to take over
+	SecondaryKafkaId string `json:"secondary_kafka_id,omitempty"`
+	// Regular expression of the topics mirrored from the primary to the secondary Kafka instance
+	MirroredTopics string `json:"mirrored_topics,omitempty"`
+	// Whether the offsets of the consumer groups are mirrored as well
+	SyncConsumerGroupOffsets bool `json:"sync_consumer_group_offsets,omitempty"`
+	// Bootstrap server host always resolving to the primary Kafka instance. It is not set for private Kafka instances
+	AliasBootstrapServerHost string `json:"alias_bootstrap_server_host,omitempty"`
+	// Time of the last failover of the pair
+	FailedOverAt *time.Time `json:"failed_over_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at,omitempty"`
+	UpdatedAt    time.Time  `json:"updated_at,omitempty"`
+}
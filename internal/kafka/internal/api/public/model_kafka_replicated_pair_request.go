@@ -0,0 +1,23 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// KafkaReplicatedPairRequest Schema for the request to create a replicated pair of Kafka instances
+type KafkaReplicatedPairRequest struct {
+	Primary KafkaRequestPayload `json:"primary"`
+	// The name of the secondary Kafka instance
+	SecondaryName string `json:"secondary_name"`
+	// The region of the secondary Kafka instance, which must differ from the region of the primary one
+	SecondaryRegion string `json:"secondary_region"`
+	// Regular expression of the topics mirrored from the primary to the secondary Kafka instance. All the topics are mirrored by default
+	MirroredTopics string `json:"mirrored_topics,omitempty"`
+	// Whether the offsets of the consumer groups are mirrored as well. The default value is false
+	SyncConsumerGroupOffsets *bool `json:"sync_consumer_group_offsets,omitempty"`
+}
@@ -18,7 +18,7 @@ type KafkaRequest struct {
 	Id   string `json:"id,omitempty"`
 	Kind string `json:"kind,omitempty"`
 	Href string `json:"href,omitempty"`
-	// Values: [accepted, preparing, provisioning, ready, failed, deprovision, deleting]
+	// Values: [accepted, preparing, provisioning, ready, suspending, suspended, resuming, failed, deprovision, deleting]
 	Status string `json:"status,omitempty"`
 	// Name of Cloud used to deploy. For example AWS
 	CloudProvider string `json:"cloud_provider,omitempty"`
@@ -29,9 +29,11 @@ type KafkaRequest struct {
 	Name                string `json:"name,omitempty"`
 	BootstrapServerHost string `json:"bootstrap_server_host,omitempty"`
 	// The kafka admin server url to perform kafka admin operations e.g acl management etc. The value will be available when the Kafka has been fully provisioned i.e it reaches a 'ready' state
-	AdminApiServerUrl           string     `json:"admin_api_server_url,omitempty"`
-	CreatedAt                   time.Time  `json:"created_at,omitempty"`
-	ExpiresAt                   *time.Time `json:"expires_at,omitempty"`
+	AdminApiServerUrl string     `json:"admin_api_server_url,omitempty"`
+	CreatedAt         time.Time  `json:"created_at,omitempty"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+	// The time at which a suspended Kafka instance is deprovisioned, unless it is restored before. Only set for Kafka instances deleted during the deletion grace period of their instance type
+	DeletionScheduledAt         *time.Time `json:"deletion_scheduled_at,omitempty"`
 	UpdatedAt                   time.Time  `json:"updated_at,omitempty"`
 	FailedReason                string     `json:"failed_reason,omitempty"`
 	Version                     string     `json:"version,omitempty"`
@@ -49,4 +51,13 @@ type KafkaRequest struct {
 	MaxConnectionAttemptsPerSec int32      `json:"max_connection_attempts_per_sec,omitempty"`
 	BillingCloudAccountId       string     `json:"billing_cloud_account_id,omitempty"`
 	Marketplace                 string     `json:"marketplace,omitempty"`
+	// Whether the Kafka instance is only reachable over cloud private links or VPC peering
+	Private bool `json:"private,omitempty"`
+	// The name of the cloud provider endpoint service that private links to a private Kafka instance are created against. The value will be available when the private Kafka instance reaches a 'ready' state
+	PrivateEndpointServiceName string       `json:"private_endpoint_service_name,omitempty"`
+	KafkaConfig                *KafkaConfig `json:"kafka_config,omitempty"`
+	// The ID of the replicated pair the Kafka instance is a member of, if any
+	ReplicatedPairId string `json:"replicated_pair_id,omitempty"`
+	// Values: [primary, secondary]. The role of the Kafka instance within its replicated pair
+	ReplicationRole string `json:"replication_role,omitempty"`
 }
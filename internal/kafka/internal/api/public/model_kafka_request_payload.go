@@ -28,4 +28,7 @@ type KafkaRequestPayload struct {
 	BillingCloudAccountId *string `json:"billing_cloud_account_id,omitempty"`
 	// marketplace where the instance is purchased on
 	Marketplace *string `json:"marketplace,omitempty"`
+	// Whether the Kafka instance is only reachable over cloud private links or VPC peering. A private Kafka instance gets no public DNS records and is only placed on data plane clusters supporting private Kafka instances. The default value is false
+	Private     *bool        `json:"private,omitempty"`
+	KafkaConfig *KafkaConfig `json:"kafka_config,omitempty"`
 }
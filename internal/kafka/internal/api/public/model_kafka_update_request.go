@@ -11,7 +11,9 @@ package public
 
 // KafkaUpdateRequest struct for KafkaUpdateRequest
 type KafkaUpdateRequest struct {
+	// User to transfer the Kafka instance to. The Kafka instance keeps its owner until the new owner accepts the transfer, see the kafka_ownership_transfers endpoints
 	Owner *string `json:"owner,omitempty"`
 	// Whether connection reauthentication is enabled or not. If set to true, connection reauthentication on the Kafka instance will be required every 5 minutes.
-	ReauthenticationEnabled *bool `json:"reauthentication_enabled,omitempty"`
+	ReauthenticationEnabled *bool        `json:"reauthentication_enabled,omitempty"`
+	KafkaConfig             *KafkaConfig `json:"kafka_config,omitempty"`
 }
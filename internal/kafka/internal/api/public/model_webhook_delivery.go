@@ -0,0 +1,36 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// WebhookDelivery struct for WebhookDelivery
+type WebhookDelivery struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	// ID of the CloudEvent delivered
+	EventId   string `json:"event_id,omitempty"`
+	EventType string `json:"event_type,omitempty"`
+	// Values: [pending, delivered, dead_letter]
+	Status   string `json:"status,omitempty"`
+	Attempts int32  `json:"attempts,omitempty"`
+	// Time of the next attempt of a pending delivery
+	NextAttemptAt time.Time  `json:"next_attempt_at,omitempty"`
+	LastAttemptAt *time.Time `json:"last_attempt_at,omitempty"`
+	// Status code of the response to the last attempt
+	ResponseCode int32 `json:"response_code,omitempty"`
+	// Error of the last attempt
+	Error       string     `json:"error,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+}
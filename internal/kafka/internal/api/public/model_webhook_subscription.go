@@ -0,0 +1,29 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+import (
+	"time"
+)
+
+// WebhookSubscription struct for WebhookSubscription
+type WebhookSubscription struct {
+	Id   string `json:"id,omitempty"`
+	Kind string `json:"kind,omitempty"`
+	Href string `json:"href,omitempty"`
+	// User who subscribed the webhook
+	Owner string `json:"owner,omitempty"`
+	Url   string `json:"url,omitempty"`
+	// Types of the events delivered to the webhook. All the events are delivered when empty
+	EventTypes []string `json:"event_types,omitempty"`
+	// Key of the HMAC-SHA256 signature of the deliveries, sent in the 'Webhook-Signature' header. Only returned when the subscription is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Kafka Service Fleet Manager
+ *
+ * Kafka Service Fleet Manager is a Rest API to manage Kafka instances.
+ *
+ * API version: 1.8.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package public
+
+// WebhookSubscriptionRequest Schema for the request to subscribe a webhook
+type WebhookSubscriptionRequest struct {
+	// HTTPS url the events are posted to
+	Url string `json:"url"`
+	// Types of the events delivered to the webhook. All the events are delivered when empty. Values: [kafka.status_changed, connector.status_changed, connector_namespace.phase_changed]
+	EventTypes []string `json:"event_types,omitempty"`
+}
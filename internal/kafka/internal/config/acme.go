@@ -0,0 +1,115 @@
+package config
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/acme"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+)
+
+const certificateEncryptionKeyLength = 32
+
+// ACMEConfig configures the issuance of a TLS certificate per Kafka instance from an ACME server,
+// replacing the shared certificate of the KafkaConfig. Domain ownership is proven with DNS-01 challenges
+// created through the DNS provider of the DNSConfig.
+type ACMEConfig struct {
+	EnableKafkaACMECertificates bool          `json:"enable_kafka_acme_certificates"`
+	DirectoryURL                string        `json:"directory_url"`
+	DirectoryCAFile             string        `json:"directory_ca_file"`
+	Email                       string        `json:"email"`
+	AccountKeyFile              string        `json:"account_key_file"`
+	EncryptionKeyFile           string        `json:"encryption_key_file"`
+	RenewBefore                 time.Duration `json:"renew_before"`
+
+	AccountKey    crypto.Signer  `json:"-"`
+	EncryptionKey []byte         `json:"-"`
+	DirectoryCAs  *x509.CertPool `json:"-"`
+}
+
+func NewACMEConfig() *ACMEConfig {
+	return &ACMEConfig{
+		EnableKafkaACMECertificates: false,
+		DirectoryURL:                "https://acme-v02.api.letsencrypt.org/directory",
+		AccountKeyFile:              "secrets/acme-account.key",
+		EncryptionKeyFile:           "secrets/acme-certificate-encryption.key",
+		RenewBefore:                 30 * 24 * time.Hour,
+	}
+}
+
+func (c *ACMEConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableKafkaACMECertificates, "enable-kafka-acme-certificates", c.EnableKafkaACMECertificates, "Enable issuing a TLS certificate per Kafka instance from an ACME server instead of using the shared Kafka certificate")
+	fs.StringVar(&c.DirectoryURL, "acme-directory-url", c.DirectoryURL, "ACME server directory URL")
+	fs.StringVar(&c.DirectoryCAFile, "acme-directory-ca-file", c.DirectoryCAFile, "File containing the CA certificates trusted to connect to the ACME server, the system CAs are used when empty")
+	fs.StringVar(&c.Email, "acme-account-email", c.Email, "Contact email of the ACME account")
+	fs.StringVar(&c.AccountKeyFile, "acme-account-key-file", c.AccountKeyFile, "File containing the PEM encoded private key of the ACME account")
+	fs.StringVar(&c.EncryptionKeyFile, "acme-certificate-encryption-key-file", c.EncryptionKeyFile, "File containing the base64 encoded 32 bytes AES key used to encrypt the stored Kafka certificate keys")
+	fs.DurationVar(&c.RenewBefore, "acme-renew-before", c.RenewBefore, "Renew Kafka certificates when they expire within this duration")
+}
+
+func (c *ACMEConfig) ReadFiles() error {
+	if !c.EnableKafkaACMECertificates {
+		return nil
+	}
+
+	var accountKey string
+	if err := shared.ReadFileValueString(c.AccountKeyFile, &accountKey); err != nil {
+		return err
+	}
+	key, err := acme.ParseAccountKey([]byte(accountKey))
+	if err != nil {
+		return fmt.Errorf("invalid acme account key in %s: %v", c.AccountKeyFile, err)
+	}
+	c.AccountKey = key
+
+	var encryptionKey string
+	if err := shared.ReadFileValueString(c.EncryptionKeyFile, &encryptionKey); err != nil {
+		return err
+	}
+	if c.EncryptionKey, err = base64.StdEncoding.DecodeString(encryptionKey); err != nil {
+		return fmt.Errorf("certificate encryption key in %s must be base64 encoded: %v", c.EncryptionKeyFile, err)
+	}
+
+	if c.DirectoryCAFile != "" {
+		var directoryCA string
+		if err := shared.ReadFileValueString(c.DirectoryCAFile, &directoryCA); err != nil {
+			return err
+		}
+		c.DirectoryCAs = x509.NewCertPool()
+		if !c.DirectoryCAs.AppendCertsFromPEM([]byte(directoryCA)) {
+			return fmt.Errorf("no PEM encoded certificate found in %s", c.DirectoryCAFile)
+		}
+	}
+	return nil
+}
+
+func (c *ACMEConfig) Validate(env *environments.Env) error {
+	if !c.EnableKafkaACMECertificates {
+		return nil
+	}
+
+	var kafkaConfig *KafkaConfig
+	env.MustResolve(&kafkaConfig)
+	return c.validate(kafkaConfig)
+}
+
+func (c *ACMEConfig) validate(kafkaConfig *KafkaConfig) error {
+	if !kafkaConfig.EnableKafkaExternalCertificate {
+		return fmt.Errorf("enable-kafka-acme-certificates requires enable-kafka-external-certificate, certificates are issued for hosts of the Kafka domain name")
+	}
+	if c.DirectoryURL == "" {
+		return fmt.Errorf("acme-directory-url is required when ACME certificates are enabled")
+	}
+	if len(c.EncryptionKey) != certificateEncryptionKeyLength {
+		return fmt.Errorf("certificate encryption key must be %d bytes long, got %d", certificateEncryptionKeyLength, len(c.EncryptionKey))
+	}
+	if c.RenewBefore <= 0 {
+		return fmt.Errorf("acme-renew-before must be positive, got %s", c.RenewBefore)
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_NewACMEConfig(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(NewACMEConfig()).To(Equal(&ACMEConfig{
+		DirectoryURL:      "https://acme-v02.api.letsencrypt.org/directory",
+		AccountKeyFile:    "secrets/acme-account.key",
+		EncryptionKeyFile: "secrets/acme-certificate-encryption.key",
+		RenewBefore:       30 * 24 * time.Hour,
+	}))
+}
+
+func Test_ReadFilesACMEConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keyBytes, _ := x509.MarshalECPrivateKey(key)
+	accountKeyFile := writeFile("account.key", pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}))
+	encryptionKeyFile := writeFile("encryption.key", []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	invalidFile := writeFile("invalid", []byte("invalid !"))
+
+	tests := []struct {
+		name     string
+		modifyFn func(config *ACMEConfig)
+		wantErr  bool
+	}{
+		{
+			name: "should not read files when ACME certificates are disabled",
+			modifyFn: func(config *ACMEConfig) {
+				config.AccountKeyFile = "missing"
+			},
+		},
+		{
+			name: "should read the account and encryption keys",
+			modifyFn: func(config *ACMEConfig) {
+				config.EnableKafkaACMECertificates = true
+				config.AccountKeyFile = accountKeyFile
+				config.EncryptionKeyFile = encryptionKeyFile
+			},
+		},
+		{
+			name: "should return an error when the account key is invalid",
+			modifyFn: func(config *ACMEConfig) {
+				config.EnableKafkaACMECertificates = true
+				config.AccountKeyFile = invalidFile
+				config.EncryptionKeyFile = encryptionKeyFile
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the encryption key is not base64 encoded",
+			modifyFn: func(config *ACMEConfig) {
+				config.EnableKafkaACMECertificates = true
+				config.AccountKeyFile = accountKeyFile
+				config.EncryptionKeyFile = invalidFile
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the directory CA file contains no certificate",
+			modifyFn: func(config *ACMEConfig) {
+				config.EnableKafkaACMECertificates = true
+				config.AccountKeyFile = accountKeyFile
+				config.EncryptionKeyFile = encryptionKeyFile
+				config.DirectoryCAFile = invalidFile
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewACMEConfig()
+			tt.modifyFn(config)
+			err := config.ReadFiles()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr && config.EnableKafkaACMECertificates {
+				g.Expect(config.AccountKey).To(Equal(key))
+				g.Expect(config.EncryptionKey).To(HaveLen(32))
+			}
+		})
+	}
+}
+
+func Test_ValidateACMEConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		modifyFn    func(config *ACMEConfig)
+		kafkaConfig *KafkaConfig
+		wantErr     bool
+	}{
+		{
+			name:        "should accept a valid config",
+			modifyFn:    func(config *ACMEConfig) {},
+			kafkaConfig: &KafkaConfig{EnableKafkaExternalCertificate: true},
+		},
+		{
+			name:        "should require the external certificate to be enabled",
+			modifyFn:    func(config *ACMEConfig) {},
+			kafkaConfig: &KafkaConfig{},
+			wantErr:     true,
+		},
+		{
+			name: "should require a 32 bytes encryption key",
+			modifyFn: func(config *ACMEConfig) {
+				config.EncryptionKey = make([]byte, 16)
+			},
+			kafkaConfig: &KafkaConfig{EnableKafkaExternalCertificate: true},
+			wantErr:     true,
+		},
+		{
+			name: "should require a directory url",
+			modifyFn: func(config *ACMEConfig) {
+				config.DirectoryURL = ""
+			},
+			kafkaConfig: &KafkaConfig{EnableKafkaExternalCertificate: true},
+			wantErr:     true,
+		},
+		{
+			name: "should require a positive renewal period",
+			modifyFn: func(config *ACMEConfig) {
+				config.RenewBefore = 0
+			},
+			kafkaConfig: &KafkaConfig{EnableKafkaExternalCertificate: true},
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewACMEConfig()
+			config.EnableKafkaACMECertificates = true
+			config.EncryptionKey = make([]byte, 32)
+			tt.modifyFn(config)
+			g.Expect(config.validate(tt.kafkaConfig) != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/pkg/errors"
@@ -45,6 +48,12 @@ type DataplaneClusterConfig struct {
 	RawKubernetesConfig                         *clientcmdapi.Config
 	StrimziOperatorOLMConfig                    OperatorInstallationConfig `json:"strimzi_operator_olm_config"`
 	KasFleetshardOperatorOLMConfig              OperatorInstallationConfig `json:"kas_fleetshard_operator_olm_config"`
+	// SuspendedKafkaCapacityWeight is the share, between 0 and 1, of their capacity that suspending and suspended kafkas, which are
+	// scaled down on the data plane, are counted with towards the kafka instance limits of clusters and regions
+	SuspendedKafkaCapacityWeight float64 `json:"suspended_kafka_capacity_weight"`
+
+	// reloadedClusterConfig holds the *ClusterConfig of the manual clusters read by Reload
+	reloadedClusterConfig atomic.Value
 }
 
 type OperatorInstallationConfig struct {
@@ -104,6 +113,7 @@ func NewDataplaneClusterConfig() *DataplaneClusterConfig {
 			SubscriptionConfigFile: "config/kas-fleetshard-operator-subscription-spec-config.yaml",
 			SubscriptionConfig:     operatorsv1alpha1.SubscriptionConfig{},
 		},
+		SuspendedKafkaCapacityWeight: 1,
 	}
 }
 
@@ -120,6 +130,7 @@ type ManualCluster struct {
 	ProviderType          api.ClusterProviderType `yaml:"provider_type"`
 	ClusterDNS            string                  `yaml:"cluster_dns"`
 	SupportedInstanceType string                  `yaml:"supported_instance_type"`
+	SupportsPrivateKafka  bool                    `yaml:"supports_private_kafka"`
 }
 
 func (c *ManualCluster) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -225,6 +236,11 @@ func (conf *ClusterConfig) GetClusterSupportedInstanceType(clusterId string) (st
 	return manualCluster.SupportedInstanceType, exist
 }
 
+func (conf *ClusterConfig) GetClusterSupportsPrivateKafka(clusterId string) (bool, bool) {
+	manualCluster, exist := conf.clusterConfigMap[clusterId]
+	return manualCluster.SupportsPrivateKafka, exist
+}
+
 func (conf *ClusterConfig) ExcessClusters(clusterList map[string]api.Cluster) []string {
 	var res []string
 
@@ -287,6 +303,20 @@ func (c *DataplaneClusterConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionStartingCSV, "kas-fleetshard-operator-starting-csv", c.KasFleetshardOperatorOLMConfig.SubscriptionStartingCSV, "kas-fleetshard operator subscription starting CSV")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard-operator-sub-channel", c.KasFleetshardOperatorOLMConfig.SubscriptionChannel, "kas-fleetshard operator subscription channel")
 	fs.StringVar(&c.KasFleetshardOperatorOLMConfig.SubscriptionConfigFile, "kas-fleetshard-operator-subscription-config-file", c.KasFleetshardOperatorOLMConfig.SubscriptionConfigFile, "kas-fleetshard operator subscription config. This is applied for standalone clusters only. The configuration must be of type https://pkg.go.dev/github.com/operator-framework/api@v0.3.25/pkg/operators/v1alpha1?utm_source=gopls#SubscriptionConfig")
+	fs.Float64Var(&c.SuspendedKafkaCapacityWeight, "suspended-kafka-capacity-weight", c.SuspendedKafkaCapacityWeight, "Share, between 0 and 1, of their capacity that suspended kafkas are counted with towards the kafka instance limit of a data plane cluster")
+}
+
+func (c *DataplaneClusterConfig) Validate(env *environments.Env) error {
+	if c.SuspendedKafkaCapacityWeight < 0 || c.SuspendedKafkaCapacityWeight > 1 {
+		return fmt.Errorf("suspended-kafka-capacity-weight must be between 0 and 1, got %v", c.SuspendedKafkaCapacityWeight)
+	}
+	return nil
+}
+
+// WeightedKafkaCapacityConsumed returns the capacity consumed on a cluster once the capacity consumed by its suspended
+// kafkas, which is part of the given total, is weighted with the suspended kafka capacity weight
+func (c *DataplaneClusterConfig) WeightedKafkaCapacityConsumed(capacityConsumed, suspendedCapacityConsumed int) int {
+	return capacityConsumed - suspendedCapacityConsumed + int(math.Ceil(float64(suspendedCapacityConsumed)*c.SuspendedKafkaCapacityWeight))
 }
 
 func (c *DataplaneClusterConfig) ReadFiles() error {
@@ -411,8 +441,50 @@ func readOperatorsSubscriptionConfigFile(file string, subscriptionConfig *operat
 	return k8sYaml.UnmarshalStrict([]byte(fileContents), &subscriptionConfig)
 }
 
+func (c *DataplaneClusterConfig) ConfigFiles() []string {
+	if c.IsDataPlaneManualScalingEnabled() {
+		return []string{c.DataPlaneClusterConfigFile}
+	}
+	return nil
+}
+
+// Reload reads the manual clusters configuration again. The kubeconfig is not read again, so that adding a standalone
+// cluster which is not in the kubeconfig context requires a restart.
+func (c *DataplaneClusterConfig) Reload() error {
+	if !c.IsDataPlaneManualScalingEnabled() {
+		return nil
+	}
+
+	list, err := readDataPlaneClusterConfig(c.DataPlaneClusterConfigFile)
+	if err != nil {
+		return err
+	}
+	for _, cluster := range list {
+		if cluster.ProviderType != api.ClusterProviderStandalone {
+			continue
+		}
+		if c.RawKubernetesConfig == nil {
+			return errors.Errorf("standalone cluster with ID: %s, and Name %s can't be added without a restart", cluster.ClusterId, cluster.Name)
+		}
+		if err := validateClusterIsInKubeconfigContext(*c.RawKubernetesConfig, cluster); err != nil {
+			return err
+		}
+	}
+
+	c.reloadedClusterConfig.Store(NewClusterConfig(list))
+	return nil
+}
+
+// GetClusterConfig returns the manual clusters configuration. Callers should not keep it, Reload replaces it.
+func (c *DataplaneClusterConfig) GetClusterConfig() *ClusterConfig {
+	if clusterConfig, ok := c.reloadedClusterConfig.Load().(*ClusterConfig); ok {
+		return clusterConfig
+	}
+	return c.ClusterConfig
+}
+
 func (c *DataplaneClusterConfig) FindClusterNameByClusterId(clusterId string) string {
-	for _, cluster := range c.ClusterConfig.clusterList {
+	for _, cluster := range c.GetClusterConfig().clusterList {
 		if cluster.ClusterId == clusterId {
 			return cluster.Name
 		}
@@ -834,6 +834,77 @@ func Test_IsReadyDataPlaneClustersReconcileEnabled(t *testing.T) {
 	}
 }
 
+func Test_DataplaneClusterConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		weight  float64
+		wantErr bool
+	}{
+		{
+			name:   "should accept a weight of 0",
+			weight: 0,
+		},
+		{
+			name:   "should accept a weight of 1",
+			weight: 1,
+		},
+		{
+			name:    "should reject a negative weight",
+			weight:  -0.5,
+			wantErr: true,
+		},
+		{
+			name:    "should reject a weight greater than 1",
+			weight:  1.5,
+			wantErr: true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := NewDataplaneClusterConfig()
+			conf.SuspendedKafkaCapacityWeight = tt.weight
+			Expect(conf.Validate(nil) != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_WeightedKafkaCapacityConsumed(t *testing.T) {
+	tests := []struct {
+		name   string
+		weight float64
+		want   int
+	}{
+		{
+			name:   "should fully count suspended kafkas with a weight of 1",
+			weight: 1,
+			want:   10,
+		},
+		{
+			name:   "should not count suspended kafkas with a weight of 0",
+			weight: 0,
+			want:   7,
+		},
+		{
+			name:   "should round the weighted capacity of suspended kafkas up",
+			weight: 0.5,
+			want:   9,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := NewDataplaneClusterConfig()
+			conf.SuspendedKafkaCapacityWeight = tt.weight
+			Expect(conf.WeightedKafkaCapacityConsumed(10, 3)).To(Equal(tt.want))
+		})
+	}
+}
+
 func Test_ReadFiles(t *testing.T) {
 	type fields struct {
 		config *DataplaneClusterConfig
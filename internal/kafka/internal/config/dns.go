@@ -0,0 +1,84 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+)
+
+const (
+	DNSProviderRoute53 = "route53"
+	DNSProviderRFC2136 = "rfc2136"
+)
+
+// DNSConfig selects the provider used to manage the CNAME records of the Kafka instances routes.
+// Route53 credentials are configured in the AWSConfig.
+type DNSConfig struct {
+	Provider string `json:"provider"`
+
+	// Used for dynamic updates (RFC 2136) against an authoritative name server
+	RFC2136Server         string        `json:"rfc2136_server"`
+	RFC2136Zone           string        `json:"rfc2136_zone"`
+	RFC2136TSIGKeyName    string        `json:"rfc2136_tsig_key_name"`
+	RFC2136TSIGSecret     string        `json:"rfc2136_tsig_secret"`
+	RFC2136TSIGSecretFile string        `json:"rfc2136_tsig_secret_file"`
+	RFC2136TSIGAlgorithm  string        `json:"rfc2136_tsig_algorithm"`
+	RFC2136Timeout        time.Duration `json:"rfc2136_timeout"`
+}
+
+func NewDNSConfig() *DNSConfig {
+	return &DNSConfig{
+		Provider:             DNSProviderRoute53,
+		RFC2136TSIGAlgorithm: dns.DefaultTSIGAlgorithm,
+		RFC2136Timeout:       10 * time.Second,
+	}
+}
+
+func (c *DNSConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Provider, "dns-provider", c.Provider, "DNS provider used to manage Kafka routes records, one of 'route53' or 'rfc2136'")
+	fs.StringVar(&c.RFC2136Server, "dns-rfc2136-server", c.RFC2136Server, "Address (host:port) of the name server accepting RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136Zone, "dns-rfc2136-zone", c.RFC2136Zone, "Zone updated on the RFC 2136 name server, defaults to the Kafka domain name")
+	fs.StringVar(&c.RFC2136TSIGKeyName, "dns-rfc2136-tsig-key-name", c.RFC2136TSIGKeyName, "Name of the TSIG key used to sign RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136TSIGSecretFile, "dns-rfc2136-tsig-secret-file", c.RFC2136TSIGSecretFile, "File containing the base64 encoded TSIG secret used to sign RFC 2136 dynamic updates")
+	fs.StringVar(&c.RFC2136TSIGAlgorithm, "dns-rfc2136-tsig-algorithm", c.RFC2136TSIGAlgorithm, "TSIG algorithm used to sign RFC 2136 dynamic updates")
+	fs.DurationVar(&c.RFC2136Timeout, "dns-rfc2136-timeout", c.RFC2136Timeout, "Timeout of RFC 2136 dynamic update requests")
+}
+
+func (c *DNSConfig) ReadFiles() error {
+	if c.Provider != DNSProviderRFC2136 {
+		return nil
+	}
+	return shared.ReadFileValueString(c.RFC2136TSIGSecretFile, &c.RFC2136TSIGSecret)
+}
+
+func (c *DNSConfig) Validate(env *environments.Env) error {
+	switch c.Provider {
+	case DNSProviderRoute53:
+		return nil
+	case DNSProviderRFC2136:
+		if c.RFC2136Server == "" {
+			return fmt.Errorf("dns-rfc2136-server is required when using the %s dns provider", DNSProviderRFC2136)
+		}
+		if c.RFC2136TSIGKeyName != "" && c.RFC2136TSIGSecret == "" {
+			return fmt.Errorf("a tsig secret is required for tsig key %s", c.RFC2136TSIGKeyName)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported dns provider %q, must be one of %s or %s", c.Provider, DNSProviderRoute53, DNSProviderRFC2136)
+	}
+}
+
+func (c *DNSConfig) RFC2136Config() dns.RFC2136Config {
+	return dns.RFC2136Config{
+		Server:        c.RFC2136Server,
+		Zone:          c.RFC2136Zone,
+		TSIGKeyName:   c.RFC2136TSIGKeyName,
+		TSIGSecret:    c.RFC2136TSIGSecret,
+		TSIGAlgorithm: c.RFC2136TSIGAlgorithm,
+		Timeout:       c.RFC2136Timeout,
+	}
+}
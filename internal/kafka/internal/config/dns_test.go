@@ -0,0 +1,133 @@
+package config
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	. "github.com/onsi/gomega"
+)
+
+func Test_NewDNSConfig(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(NewDNSConfig()).To(Equal(&DNSConfig{
+		Provider:             DNSProviderRoute53,
+		RFC2136TSIGAlgorithm: dns.DefaultTSIGAlgorithm,
+		RFC2136Timeout:       10 * time.Second,
+	}))
+}
+
+func Test_ReadFilesDNSConfig(t *testing.T) {
+	secretFile, err := os.CreateTemp("", "tsig-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(secretFile.Name())
+	if _, err := secretFile.WriteString("c2VjcmV0\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		modifyFn   func(config *DNSConfig)
+		wantSecret string
+		wantErr    bool
+	}{
+		{
+			name: "should not read the tsig secret when using route53",
+			modifyFn: func(config *DNSConfig) {
+				config.RFC2136TSIGSecretFile = "invalid"
+			},
+		},
+		{
+			name: "should read the tsig secret when using rfc2136",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = DNSProviderRFC2136
+				config.RFC2136TSIGSecretFile = secretFile.Name()
+			},
+			wantSecret: "c2VjcmV0",
+		},
+		{
+			name: "should return an error when the tsig secret file is missing",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = DNSProviderRFC2136
+				config.RFC2136TSIGSecretFile = "invalid"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewDNSConfig()
+			tt.modifyFn(config)
+			err := config.ReadFiles()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(config.RFC2136TSIGSecret).To(Equal(tt.wantSecret))
+		})
+	}
+}
+
+func Test_ValidateDNSConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(config *DNSConfig)
+		wantErr  string
+	}{
+		{
+			name:     "should accept the default route53 provider",
+			modifyFn: func(config *DNSConfig) {},
+		},
+		{
+			name: "should accept a rfc2136 provider with a signing key",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = DNSProviderRFC2136
+				config.RFC2136Server = "ns1.example.com:53"
+				config.RFC2136TSIGKeyName = "fleet-manager"
+				config.RFC2136TSIGSecret = "c2VjcmV0"
+			},
+		},
+		{
+			name: "should require the rfc2136 server",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = DNSProviderRFC2136
+			},
+			wantErr: "dns-rfc2136-server is required when using the rfc2136 dns provider",
+		},
+		{
+			name: "should require the tsig secret of the signing key",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = DNSProviderRFC2136
+				config.RFC2136Server = "ns1.example.com:53"
+				config.RFC2136TSIGKeyName = "fleet-manager"
+			},
+			wantErr: "a tsig secret is required for tsig key fleet-manager",
+		},
+		{
+			name: "should reject unknown providers",
+			modifyFn: func(config *DNSConfig) {
+				config.Provider = "cloudflare"
+			},
+			wantErr: `unsupported dns provider "cloudflare", must be one of route53 or rfc2136`,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewDNSConfig()
+			tt.modifyFn(config)
+			err := config.Validate(nil)
+			if tt.wantErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			}
+		})
+	}
+}
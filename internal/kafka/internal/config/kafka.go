@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"sync/atomic"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -33,6 +34,9 @@ type KafkaConfig struct {
 	EnableKafkaOwnerConfig bool                               `json:"enable_kafka_owner_config"`
 	KafkaOwnerList         []string                           `json:"kafka_owner_list"`
 	KafkaOwnerListFile     string                             `json:"kafka_owner_list_file"`
+
+	// reloadedInstanceTypes holds the *KafkaSupportedInstanceTypesConfig read by Reload, which replaces the one read on startup
+	reloadedInstanceTypes atomic.Value
 }
 
 func NewKafkaConfig() *KafkaConfig {
@@ -55,6 +59,8 @@ func (c *KafkaConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.KafkaTLSKeyFile, "kafka-tls-key-file", c.KafkaTLSKeyFile, "File containing kafka certificate private key")
 	fs.BoolVar(&c.EnableKafkaExternalCertificate, "enable-kafka-external-certificate", c.EnableKafkaExternalCertificate, "Enable custom certificate for Kafka TLS")
 	fs.BoolVar(&c.KafkaLifespan.EnableDeletionOfExpiredKafka, "enable-deletion-of-expired-kafka", c.KafkaLifespan.EnableDeletionOfExpiredKafka, "Enable the deletion of kafkas when its life span has expired")
+	fs.BoolVar(&c.KafkaLifespan.EnableExpiryNotifications, "enable-kafka-expiry-notifications", c.KafkaLifespan.EnableExpiryNotifications, "Enable notifying the owners of kafkas before their life span expires")
+	fs.DurationSliceVar(&c.KafkaLifespan.ExpiryNotificationThresholds, "kafka-expiry-notification-thresholds", c.KafkaLifespan.ExpiryNotificationThresholds, "Durations before the expiration time of a kafka at which its owner is notified")
 	fs.StringVar(&c.KafkaDomainName, "kafka-domain-name", c.KafkaDomainName, "The domain name to use for Kafka instances")
 	fs.StringVar(&c.Quota.Type, "quota-type", c.Quota.Type, "The type of the quota service to be used. The available options are: 'ams' for AMS backed implementation and 'quota-management-list' for quota list backed implementation (default).")
 	fs.BoolVar(&c.Quota.AllowDeveloperInstance, "allow-developer-instance", c.Quota.AllowDeveloperInstance, "Allow the creation of kafka developer instances")
@@ -89,11 +95,42 @@ func (c *KafkaConfig) ReadFiles() error {
 }
 
 func (c *KafkaConfig) Validate(env *environments.Env) error {
+	if err := c.KafkaLifespan.validate(); err != nil {
+		return err
+	}
 	return c.SupportedInstanceTypes.Configuration.validate()
 }
 
+func (c *KafkaConfig) ConfigFiles() []string {
+	return []string{c.SupportedInstanceTypes.ConfigurationFile}
+}
+
+// Reload reads the supported instance types again
+func (c *KafkaConfig) Reload() error {
+	supportedInstanceTypes := &KafkaSupportedInstanceTypesConfig{
+		ConfigurationFile: c.SupportedInstanceTypes.ConfigurationFile,
+	}
+	if err := shared.ReadYamlFile(supportedInstanceTypes.ConfigurationFile, &supportedInstanceTypes.Configuration); err != nil {
+		return err
+	}
+	if err := supportedInstanceTypes.Configuration.validate(); err != nil {
+		return err
+	}
+
+	c.reloadedInstanceTypes.Store(supportedInstanceTypes)
+	return nil
+}
+
+// GetSupportedInstanceTypes returns the supported instance types currently loaded
+func (c *KafkaConfig) GetSupportedInstanceTypes() *SupportedKafkaInstanceTypesConfig {
+	if supportedInstanceTypes, ok := c.reloadedInstanceTypes.Load().(*KafkaSupportedInstanceTypesConfig); ok {
+		return &supportedInstanceTypes.Configuration
+	}
+	return &c.SupportedInstanceTypes.Configuration
+}
+
 func (c *KafkaConfig) GetFirstAvailableSize(instanceType string) (*KafkaInstanceSize, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +141,7 @@ func (c *KafkaConfig) GetFirstAvailableSize(instanceType string) (*KafkaInstance
 }
 
 func (c *KafkaConfig) GetKafkaInstanceSize(instanceType, sizeId string) (*KafkaInstanceSize, error) {
-	kafkaInstanceType, err := c.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+	kafkaInstanceType, err := c.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,37 @@
 package config
 
+import (
+	"fmt"
+	"time"
+)
+
 type KafkaLifespanConfig struct {
 	EnableDeletionOfExpiredKafka bool
+	// EnableExpiryNotifications enables notifying the owners of Kafkas with a lifespan before they expire
+	EnableExpiryNotifications bool
+	// ExpiryNotificationThresholds are the durations before the expiration time at which the owner is notified
+	ExpiryNotificationThresholds []time.Duration
 }
 
 func NewKafkaLifespanConfig() *KafkaLifespanConfig {
 	return &KafkaLifespanConfig{
 		EnableDeletionOfExpiredKafka: true,
+		EnableExpiryNotifications:    false,
+		ExpiryNotificationThresholds: []time.Duration{24 * time.Hour, time.Hour},
+	}
+}
+
+func (c *KafkaLifespanConfig) validate() error {
+	if !c.EnableExpiryNotifications {
+		return nil
+	}
+	if len(c.ExpiryNotificationThresholds) == 0 {
+		return fmt.Errorf("at least one kafka expiry notification threshold is required when expiry notifications are enabled")
+	}
+	for _, threshold := range c.ExpiryNotificationThresholds {
+		if threshold <= 0 {
+			return fmt.Errorf("kafka expiry notification thresholds must be positive, got %s", threshold)
+		}
 	}
+	return nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 )
@@ -15,6 +16,7 @@ func Test_NewKafkaLifespanConfig(t *testing.T) {
 			name: "should return new KafkaLifespanConfig",
 			want: &KafkaLifespanConfig{
 				EnableDeletionOfExpiredKafka: true,
+				ExpiryNotificationThresholds: []time.Duration{24 * time.Hour, time.Hour},
 			},
 		},
 	}
@@ -27,3 +29,50 @@ func Test_NewKafkaLifespanConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateKafkaLifespanConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(config *KafkaLifespanConfig)
+		wantErr  bool
+	}{
+		{
+			name: "should not validate thresholds when expiry notifications are disabled",
+			modifyFn: func(config *KafkaLifespanConfig) {
+				config.ExpiryNotificationThresholds = nil
+			},
+		},
+		{
+			name: "should accept the default thresholds",
+			modifyFn: func(config *KafkaLifespanConfig) {
+				config.EnableExpiryNotifications = true
+			},
+		},
+		{
+			name: "should require a threshold when expiry notifications are enabled",
+			modifyFn: func(config *KafkaLifespanConfig) {
+				config.EnableExpiryNotifications = true
+				config.ExpiryNotificationThresholds = nil
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject a threshold that is not positive",
+			modifyFn: func(config *KafkaLifespanConfig) {
+				config.EnableExpiryNotifications = true
+				config.ExpiryNotificationThresholds = []time.Duration{time.Hour, 0}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewKafkaLifespanConfig()
+			tt.modifyFn(config)
+			g.Expect(config.validate() != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -3,9 +3,11 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/senseyeio/duration"
@@ -24,9 +26,10 @@ func getValidMaturityStates() []MaturityStatus {
 }
 
 type KafkaInstanceType struct {
-	Id          string              `yaml:"id"`
-	DisplayName string              `yaml:"display_name"`
-	Sizes       []KafkaInstanceSize `yaml:"sizes"`
+	Id                         string              `yaml:"id"`
+	DisplayName                string              `yaml:"display_name"`
+	Sizes                      []KafkaInstanceSize `yaml:"sizes"`
+	DeletionGracePeriodSeconds *int                `yaml:"deletion_grace_period_seconds"`
 }
 
 func (kp *KafkaInstanceType) GetKafkaInstanceSizeByID(sizeId string) (*KafkaInstanceSize, error) {
@@ -50,15 +53,26 @@ func (kp *KafkaInstanceType) HasAnInstanceSizeWithLifespan() bool {
 	return false
 }
 
+// HasDeletionGracePeriod returns true if deleted Kafkas of type kp are suspended
+// for a grace period before being deprovisioned
+func (kp *KafkaInstanceType) HasDeletionGracePeriod() bool {
+	return kp.DeletionGracePeriodSeconds != nil
+}
+
 // validates kafka instance type config to ensure the following:
 // - id must be defined and included in the valid instance type id list
 // - display_name must be defined and included in the valid instance type list
 // - sizes cannot be an empty list and each size id must be unique
+// - deletion_grace_period_seconds, when defined, must be larger than zero
 func (kp *KafkaInstanceType) validate() error {
 	if kp.Id == "" || kp.DisplayName == "" || len(kp.Sizes) == 0 {
 		return fmt.Errorf("Kafka instance type '%s' is missing required parameters.", kp.Id)
 	}
 
+	if kp.DeletionGracePeriodSeconds != nil && *kp.DeletionGracePeriodSeconds <= 0 {
+		return fmt.Errorf("Kafka instance type '%s' specifies a deletion_grace_period_seconds value less than or equals to Zero.", kp.Id)
+	}
+
 	if !arrays.Contains(types.ValidKafkaInstanceTypes, kp.Id) {
 		return fmt.Errorf("kafka instance type id '%s' is not valid. Valid kafka instance types are: '%v'", kp.Id, types.ValidKafkaInstanceTypes)
 	}
@@ -98,6 +112,16 @@ type KafkaInstanceSize struct {
 	ReplicationFactor           int            `yaml:"replicationFactor"` // also abbreviated as RF in Kafka terminology
 	LifespanSeconds             *int           `yaml:"lifespanSeconds"`
 	MaturityStatus              MaturityStatus `yaml:"maturityStatus"`
+	// KafkaConfigRanges, when defined, allows users to tune the kafka settings of instances of this size within these ranges
+	KafkaConfigRanges *KafkaConfigRanges `yaml:"kafkaConfigRanges"`
+}
+
+// KafkaConfigRanges declares the lowest values users can tune the kafka settings of an instance size to.
+// The highest values are the limits of the instance size.
+type KafkaConfigRanges struct {
+	MinDataRetentionPeriod      string   `yaml:"minDataRetentionPeriod"`
+	MinMessageSize              Quantity `yaml:"minMessageSize"`
+	MinConnectionAttemptsPerSec int      `yaml:"minConnectionAttemptsPerSec"`
 }
 
 // validates Kafka instance size configuration to ensure the following:
@@ -163,6 +187,12 @@ func (k *KafkaInstanceSize) validate(instanceTypeId string) error {
 		return fmt.Errorf("maturityStatus for Kafka instance type '%s', size '%s' is unknown: '%s'", k.Id, instanceTypeId, k.MaturityStatus)
 	}
 
+	if k.KafkaConfigRanges != nil {
+		if err := k.KafkaConfigRanges.validate(k, instanceTypeId); err != nil {
+			return err
+		}
+	}
+
 	if maxDataRetentionPeriod.IsZero() || egressThroughputQuantity.CmpInt64(1) < 0 ||
 		ingressThroughputQuantity.CmpInt64(1) < 0 || maxDataRetentionSize.CmpInt64(1) < 0 ||
 		k.TotalMaxConnections <= 0 || k.MaxPartitions <= 0 || k.MaxConnectionAttemptsPerSec <= 0 ||
@@ -174,6 +204,113 @@ func (k *KafkaInstanceSize) validate(instanceTypeId string) error {
 	return nil
 }
 
+// validates the kafka config ranges of an instance size to ensure that each lowest value is defined,
+// larger than zero and not larger than the corresponding limit of the instance size
+func (r *KafkaConfigRanges) validate(size *KafkaInstanceSize, instanceTypeId string) error {
+	if r.MinDataRetentionPeriod == "" || r.MinMessageSize.IsEmpty() || r.MinConnectionAttemptsPerSec <= 0 {
+		return fmt.Errorf("kafkaConfigRanges for Kafka instance type '%s', size '%s' is missing required parameters", instanceTypeId, size.Id)
+	}
+
+	minDataRetentionPeriod, err := parseISO8601Duration(r.MinDataRetentionPeriod)
+	if err != nil {
+		return fmt.Errorf("kafkaConfigRanges.minDataRetentionPeriod for Kafka instance type '%s', size '%s' is invalid: %s", instanceTypeId, size.Id, err.Error())
+	}
+	maxDataRetentionPeriod, err := parseISO8601Duration(size.MaxDataRetentionPeriod)
+	if err != nil {
+		return fmt.Errorf("maxDataRetentionPeriod for Kafka instance type '%s', size '%s' is invalid: %s", instanceTypeId, size.Id, err.Error())
+	}
+	if minDataRetentionPeriod <= 0 || minDataRetentionPeriod > maxDataRetentionPeriod {
+		return fmt.Errorf("kafkaConfigRanges.minDataRetentionPeriod for Kafka instance type '%s', size '%s' must be between zero and maxDataRetentionPeriod", instanceTypeId, size.Id)
+	}
+
+	minMessageSize, err := r.MinMessageSize.ToInt64()
+	if err != nil {
+		return fmt.Errorf("kafkaConfigRanges.minMessageSize for Kafka instance type '%s', size '%s' is invalid: %s", instanceTypeId, size.Id, err.Error())
+	}
+	maxMessageSize, err := size.MaxMessageSize.ToInt64()
+	if err != nil {
+		return fmt.Errorf("maxMessageSize for Kafka instance type '%s', size '%s' is invalid: %s", instanceTypeId, size.Id, err.Error())
+	}
+	if minMessageSize <= 0 || minMessageSize > maxMessageSize {
+		return fmt.Errorf("kafkaConfigRanges.minMessageSize for Kafka instance type '%s', size '%s' must be between zero and maxMessageSize", instanceTypeId, size.Id)
+	}
+
+	if r.MinConnectionAttemptsPerSec > size.MaxConnectionAttemptsPerSec {
+		return fmt.Errorf("kafkaConfigRanges.minConnectionAttemptsPerSec for Kafka instance type '%s', size '%s' must not be larger than maxConnectionAttemptsPerSec", instanceTypeId, size.Id)
+	}
+
+	return nil
+}
+
+// ValidateKafkaConfigSettings checks that the kafka settings tuned by a user are within the kafka config ranges of the instance size
+func (k *KafkaInstanceSize) ValidateKafkaConfigSettings(settings dbapi.KafkaConfig) error {
+	if k.KafkaConfigRanges == nil {
+		return fmt.Errorf("kafka settings cannot be tuned for instance size '%s'", k.Id)
+	}
+	ranges := k.KafkaConfigRanges
+
+	// both retention periods share the same range, the default retention period cannot exceed the maximum one
+	minDataRetentionPeriod, err := parseISO8601Duration(ranges.MinDataRetentionPeriod)
+	if err != nil {
+		return err
+	}
+	maxDataRetentionPeriod, err := parseISO8601Duration(k.MaxDataRetentionPeriod)
+	if err != nil {
+		return err
+	}
+	if settings.MaxDataRetentionPeriod != "" {
+		period, err := parseISO8601Duration(settings.MaxDataRetentionPeriod)
+		if err != nil {
+			return fmt.Errorf("max_data_retention_period '%s' is not a valid ISO8601 duration", settings.MaxDataRetentionPeriod)
+		}
+		if period < minDataRetentionPeriod || period > maxDataRetentionPeriod {
+			return fmt.Errorf("max_data_retention_period must be between %s and %s", ranges.MinDataRetentionPeriod, k.MaxDataRetentionPeriod)
+		}
+		maxDataRetentionPeriod = period
+	}
+	if settings.DefaultRetentionPeriod != "" {
+		period, err := parseISO8601Duration(settings.DefaultRetentionPeriod)
+		if err != nil {
+			return fmt.Errorf("default_retention_period '%s' is not a valid ISO8601 duration", settings.DefaultRetentionPeriod)
+		}
+		if period < minDataRetentionPeriod || period > maxDataRetentionPeriod {
+			return fmt.Errorf("default_retention_period must be between %s and the maximum data retention period", ranges.MinDataRetentionPeriod)
+		}
+	}
+
+	if settings.MessageMaxBytes != 0 {
+		minMessageSize, err := ranges.MinMessageSize.ToInt64()
+		if err != nil {
+			return err
+		}
+		maxMessageSize, err := k.MaxMessageSize.ToInt64()
+		if err != nil {
+			return err
+		}
+		if settings.MessageMaxBytes < minMessageSize || settings.MessageMaxBytes > maxMessageSize {
+			return fmt.Errorf("message_max_bytes must be between %d and %d", minMessageSize, maxMessageSize)
+		}
+	}
+
+	if settings.MaxConnectionAttemptsPerSec != 0 &&
+		(settings.MaxConnectionAttemptsPerSec < ranges.MinConnectionAttemptsPerSec || settings.MaxConnectionAttemptsPerSec > k.MaxConnectionAttemptsPerSec) {
+		return fmt.Errorf("max_connection_attempts_per_sec must be between %d and %d", ranges.MinConnectionAttemptsPerSec, k.MaxConnectionAttemptsPerSec)
+	}
+
+	return nil
+}
+
+// parseISO8601Duration returns the length of an ISO8601 duration. Years, months and days are
+// measured from the zero time, so that durations are always compared the same way
+func parseISO8601Duration(value string) (time.Duration, error) {
+	d, err := duration.ParseISO8601(value)
+	if err != nil {
+		return 0, err
+	}
+	var zero time.Time
+	return d.Shift(zero).Sub(zero), nil
+}
+
 type SupportedKafkaInstanceTypesConfig struct {
 	SupportedKafkaInstanceTypes []KafkaInstanceType `yaml:"supported_instance_types"`
 }
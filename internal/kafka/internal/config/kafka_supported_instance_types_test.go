@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	. "github.com/onsi/gomega"
 )
 
@@ -814,6 +815,148 @@ func TestKafkaSupportedSizesConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should not return an error if deletion grace period is set",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:                         "standard",
+							DisplayName:                "Standard",
+							DeletionGracePeriodSeconds: &[]int{86400}[0],
+							Sizes: []KafkaInstanceSize{
+								buildTestStandardKafkaInstanceSize(),
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should return an error if deletion grace period is not larger than zero",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:                         "standard",
+							DisplayName:                "Standard",
+							DeletionGracePeriodSeconds: &[]int{0}[0],
+							Sizes: []KafkaInstanceSize{
+								buildTestStandardKafkaInstanceSize(),
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should not return an error with valid kafka config ranges",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testKafkaInstanceSizex1.KafkaConfigRanges = buildTestKafkaConfigRanges()
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should return an error if a kafka config range is missing",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testKafkaInstanceSizex1.KafkaConfigRanges = buildTestKafkaConfigRanges()
+				testKafkaInstanceSizex1.KafkaConfigRanges.MinMessageSize = ""
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return an error if the minimum data retention period exceeds the maximum one",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testKafkaInstanceSizex1.KafkaConfigRanges = buildTestKafkaConfigRanges()
+				testKafkaInstanceSizex1.KafkaConfigRanges.MinDataRetentionPeriod = "P15D"
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return an error if the minimum message size exceeds the maximum one",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testKafkaInstanceSizex1.KafkaConfigRanges = buildTestKafkaConfigRanges()
+				testKafkaInstanceSizex1.KafkaConfigRanges.MinMessageSize = "2Mi"
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should return an error if the minimum connection attempts per second exceed the maximum",
+			configFactoryFunc: func() SupportedKafkaInstanceTypesConfig {
+				testKafkaInstanceSizex1 := buildTestStandardKafkaInstanceSize()
+				testKafkaInstanceSizex1.KafkaConfigRanges = buildTestKafkaConfigRanges()
+				testKafkaInstanceSizex1.KafkaConfigRanges.MinConnectionAttemptsPerSec = 101
+				res := SupportedKafkaInstanceTypesConfig{
+					SupportedKafkaInstanceTypes: []KafkaInstanceType{
+						{
+							Id:          "standard",
+							DisplayName: "Standard",
+							Sizes: []KafkaInstanceSize{
+								testKafkaInstanceSizex1,
+							},
+						},
+					},
+				}
+				return res
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -863,6 +1006,102 @@ func TestKafkaInstanceType_HasAnInstanceSizeWithLifespan(t *testing.T) {
 
 }
 
+func TestKafkaInstanceSize_ValidateKafkaConfigSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		withRanges bool
+		settings   dbapi.KafkaConfig
+		wantErr    bool
+	}{
+		{
+			name:       "should return an error when the size does not allow tuning kafka settings",
+			withRanges: false,
+			settings:   dbapi.KafkaConfig{MessageMaxBytes: 1024},
+			wantErr:    true,
+		},
+		{
+			name:       "should not return an error when no setting is tuned",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{},
+			wantErr:    false,
+		},
+		{
+			name:       "should not return an error when all settings are within the ranges",
+			withRanges: true,
+			settings: dbapi.KafkaConfig{
+				MaxDataRetentionPeriod:      "P7D",
+				DefaultRetentionPeriod:      "P1D",
+				MessageMaxBytes:             512 * 1024,
+				MaxConnectionAttemptsPerSec: 50,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "should return an error when the maximum data retention period is not a duration",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MaxDataRetentionPeriod: "7 days"},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the maximum data retention period exceeds the limit of the size",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MaxDataRetentionPeriod: "P15D"},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the default retention period exceeds the tuned maximum data retention period",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MaxDataRetentionPeriod: "P1D", DefaultRetentionPeriod: "P2D"},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the default retention period is below the range",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{DefaultRetentionPeriod: "PT1M"},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the message size is below the range",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MessageMaxBytes: 512},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the message size exceeds the limit of the size",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MessageMaxBytes: 2 * 1024 * 1024},
+			wantErr:    true,
+		},
+		{
+			name:       "should return an error when the connection attempts per second exceed the limit of the size",
+			withRanges: true,
+			settings:   dbapi.KafkaConfig{MaxConnectionAttemptsPerSec: 101},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			size := buildTestStandardKafkaInstanceSize()
+			if tt.withRanges {
+				size.KafkaConfigRanges = buildTestKafkaConfigRanges()
+			}
+			err := size.ValidateKafkaConfigSettings(tt.settings)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func buildTestKafkaConfigRanges() *KafkaConfigRanges {
+	return &KafkaConfigRanges{
+		MinDataRetentionPeriod:      "PT1H",
+		MinMessageSize:              "1Ki",
+		MinConnectionAttemptsPerSec: 1,
+	}
+}
+
 func buildTestStandardKafkaInstanceSize() KafkaInstanceSize {
 	return KafkaInstanceSize{
 		Id:                          "x1",
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+)
+
+const (
+	NotifierWebhook = "webhook"
+	NotifierSMTP    = "smtp"
+)
+
+// NotificationConfig selects the notifier used to deliver notifications to the Kafka owners, such as the
+// warnings sent before a Kafka with a lifespan expires.
+type NotificationConfig struct {
+	Notifier string `json:"notifier"`
+
+	WebhookURL       string `json:"webhook_url"`
+	WebhookToken     string `json:"webhook_token"`
+	WebhookTokenFile string `json:"webhook_token_file"`
+
+	SMTPAddress      string   `json:"smtp_address"`
+	SMTPFrom         string   `json:"smtp_from"`
+	SMTPTo           []string `json:"smtp_to"`
+	SMTPUsername     string   `json:"smtp_username"`
+	SMTPPassword     string   `json:"smtp_password"`
+	SMTPPasswordFile string   `json:"smtp_password_file"`
+}
+
+func NewNotificationConfig() *NotificationConfig {
+	return &NotificationConfig{
+		Notifier: NotifierWebhook,
+	}
+}
+
+func (c *NotificationConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Notifier, "notifier", c.Notifier, "Notifier used to deliver notifications to kafka owners, one of 'webhook' or 'smtp'")
+	fs.StringVar(&c.WebhookURL, "notification-webhook-url", c.WebhookURL, "URL notifications are posted to when using the webhook notifier")
+	fs.StringVar(&c.WebhookTokenFile, "notification-webhook-token-file", c.WebhookTokenFile, "File containing the bearer token sent to the notification webhook")
+	fs.StringVar(&c.SMTPAddress, "notification-smtp-address", c.SMTPAddress, "Address (host:port) of the SMTP server used by the smtp notifier")
+	fs.StringVar(&c.SMTPFrom, "notification-smtp-from", c.SMTPFrom, "Sender address of the notification emails")
+	fs.StringSliceVar(&c.SMTPTo, "notification-smtp-to", c.SMTPTo, "Addresses receiving every notification email in addition to the kafka owner")
+	fs.StringVar(&c.SMTPUsername, "notification-smtp-username", c.SMTPUsername, "Username used to authenticate with the SMTP server")
+	fs.StringVar(&c.SMTPPasswordFile, "notification-smtp-password-file", c.SMTPPasswordFile, "File containing the password used to authenticate with the SMTP server")
+}
+
+func (c *NotificationConfig) ReadFiles() error {
+	switch c.Notifier {
+	case NotifierWebhook:
+		if c.WebhookTokenFile != "" {
+			return shared.ReadFileValueString(c.WebhookTokenFile, &c.WebhookToken)
+		}
+	case NotifierSMTP:
+		if c.SMTPPasswordFile != "" {
+			return shared.ReadFileValueString(c.SMTPPasswordFile, &c.SMTPPassword)
+		}
+	}
+	return nil
+}
+
+func (c *NotificationConfig) Validate(env *environments.Env) error {
+	var kafkaConfig *KafkaConfig
+	env.MustResolve(&kafkaConfig)
+	if !kafkaConfig.KafkaLifespan.EnableExpiryNotifications {
+		return nil
+	}
+	return c.validate()
+}
+
+func (c *NotificationConfig) validate() error {
+	switch c.Notifier {
+	case NotifierWebhook:
+		if c.WebhookURL == "" {
+			return fmt.Errorf("notification-webhook-url is required when using the %s notifier", NotifierWebhook)
+		}
+		return nil
+	case NotifierSMTP:
+		if _, _, err := net.SplitHostPort(c.SMTPAddress); err != nil {
+			return fmt.Errorf("notification-smtp-address must be a host:port address when using the %s notifier: %v", NotifierSMTP, err)
+		}
+		if c.SMTPFrom == "" {
+			return fmt.Errorf("notification-smtp-from is required when using the %s notifier", NotifierSMTP)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported notifier %q, must be one of %s or %s", c.Notifier, NotifierWebhook, NotifierSMTP)
+	}
+}
+
+func (c *NotificationConfig) SMTPConfig() notification.SMTPConfig {
+	return notification.SMTPConfig{
+		Address:  c.SMTPAddress,
+		From:     c.SMTPFrom,
+		To:       c.SMTPTo,
+		Username: c.SMTPUsername,
+		Password: c.SMTPPassword,
+	}
+}
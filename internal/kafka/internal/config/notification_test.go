@@ -0,0 +1,131 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_ReadFilesNotificationConfig(t *testing.T) {
+	secretFile, err := os.CreateTemp("", "notification-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(secretFile.Name())
+	if _, err := secretFile.WriteString("secret\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		modifyFn     func(config *NotificationConfig)
+		wantToken    string
+		wantPassword string
+		wantErr      bool
+	}{
+		{
+			name: "should not read files when they are not set",
+		},
+		{
+			name: "should read the webhook token",
+			modifyFn: func(config *NotificationConfig) {
+				config.WebhookTokenFile = secretFile.Name()
+				config.SMTPPasswordFile = "invalid"
+			},
+			wantToken: "secret",
+		},
+		{
+			name: "should read the smtp password",
+			modifyFn: func(config *NotificationConfig) {
+				config.Notifier = NotifierSMTP
+				config.WebhookTokenFile = "invalid"
+				config.SMTPPasswordFile = secretFile.Name()
+			},
+			wantPassword: "secret",
+		},
+		{
+			name: "should return an error when the webhook token file does not exist",
+			modifyFn: func(config *NotificationConfig) {
+				config.WebhookTokenFile = "invalid"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewNotificationConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(config)
+			}
+			err := config.ReadFiles()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(config.WebhookToken).To(Equal(tt.wantToken))
+			g.Expect(config.SMTPPassword).To(Equal(tt.wantPassword))
+		})
+	}
+}
+
+func Test_ValidateNotificationConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(config *NotificationConfig)
+		wantErr  bool
+	}{
+		{
+			name: "should accept a webhook notifier with a url",
+			modifyFn: func(config *NotificationConfig) {
+				config.WebhookURL = "https://notifications.example.com"
+			},
+		},
+		{
+			name:    "should require the webhook url",
+			wantErr: true,
+		},
+		{
+			name: "should accept an smtp notifier with an address and sender",
+			modifyFn: func(config *NotificationConfig) {
+				config.Notifier = NotifierSMTP
+				config.SMTPAddress = "smtp.example.com:587"
+				config.SMTPFrom = "noreply@example.com"
+			},
+		},
+		{
+			name: "should require the smtp port",
+			modifyFn: func(config *NotificationConfig) {
+				config.Notifier = NotifierSMTP
+				config.SMTPAddress = "smtp.example.com"
+				config.SMTPFrom = "noreply@example.com"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should require the smtp sender",
+			modifyFn: func(config *NotificationConfig) {
+				config.Notifier = NotifierSMTP
+				config.SMTPAddress = "smtp.example.com:587"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should reject an unsupported notifier",
+			modifyFn: func(config *NotificationConfig) {
+				config.Notifier = "pigeon"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewNotificationConfig()
+			if tt.modifyFn != nil {
+				tt.modifyFn(config)
+			}
+			g.Expect(config.validate() != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
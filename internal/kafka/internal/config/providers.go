@@ -55,7 +55,8 @@ func (r Region) getLimitSetForInstanceTypeInRegion(t string) (*int, *errs.Servic
 func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 	counter := 1
 	totalCapacityUsed := 0
-	regionCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegion(r.Name)
+	clusterConfig := dataplaneClusterConfig.GetClusterConfig()
+	regionCapacity := clusterConfig.GetCapacityForRegion(r.Name)
 
 	// verify that Limits set in this configuration matches the capacity of clusters listed in the data plane configuration
 	for k, v := range r.SupportedInstanceTypes {
@@ -65,7 +66,7 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 		}
 
 		if len(r.SupportedInstanceTypes) == 1 {
-			capacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
+			capacity := clusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
 			if *v.Limit != capacity {
 				return fmt.Errorf("limit for instance type '%s'(%d) does not match the capacity in region %s(%d)", k, *v.Limit, r.Name, capacity)
 			}
@@ -75,8 +76,8 @@ func (r Region) Validate(dataplaneClusterConfig *DataplaneClusterConfig) error {
 		// ensure that limit is within min and max capacity
 		// min: the total capacity of clusters that support only this instance type
 		// max: the total capacity of clusters that supports this instance type
-		minCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, true)
-		maxCapacity := dataplaneClusterConfig.ClusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
+		minCapacity := clusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, true)
+		maxCapacity := clusterConfig.GetCapacityForRegionAndInstanceType(r.Name, k, false)
 		if minCapacity > *v.Limit || maxCapacity < *v.Limit {
 			return fmt.Errorf("limit for %s instance type (%d) does not match cluster capacity configuration in region '%s': min(%d), max(%d)", k, *v.Limit, r.Name, minCapacity, maxCapacity)
 		}
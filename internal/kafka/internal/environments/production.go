@@ -9,6 +9,8 @@ func NewProductionEnvLoader() environments.EnvLoader {
 		"enable-ocm-mock":                   "false",
 		"enable-sentry":                     "true",
 		"enable-deny-list":                  "true",
+		"enable-rate-limiting":              "true",
+		"rate-limit-store":                  "postgres",
 		"max-allowed-instances":             "1",
 		"mas-sso-realm":                     "rhoas",
 		"mas-sso-base-url":                  "https://identity.api.openshift.com",
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x73\xdb\x36\xd6\xe8\xef\xf9\x2b\x70\xd5\xfb\x8d\xbe\xaf\xd7\x92\x29\x59\x7e\x44\xb3\xdd\x19\x27\x71\x5a\x6f\x1b\x27\xb1\x9d\xa6\xd9\x4e\x47\x86\x48\x48\x42\x4c\x02\x0c\x00\xda\x56\x7a\xfb\xbf\x7f\x83\x07\x49\x90\x04\x29\xca\x56\x12\xbb\x6b\xef\xec\x34\x92\xf0\x38\xe7\xe0\xe0\xe0\xbc\x70\x40\x63\x44\x60\x8c\xc7\x60\xa7\xef\xf5\x3d\xf0\x1d\x20\x08\x05\x40\x2c\x30\x07\x90\x83\x19\x66\x5c\x80\x10\x13\x04\x04\x05\x30\x0c\xe9\x35\xe0\x34\x42\xe0\xf8\xc5\x11\x97\x5f\x5d\x12\x7a\xad\x5b\xcb\x0e\x04\x98\xe1\x40\x40\xfd\x24\x42\x44\xf4\x9f\x7c\x07\x0e\xc3\x10\x20\x12\xc4\x14\x13\xc1\x41\x80\x66\x98\xa0\x00\x2c\x10\x43\xe0\x1a\x87\x21\x98\x22\x10\x60\xee\xd3\x2b\xc4\xe0\x34\x44\x60\xba\x94\x33\x81\x84\x23\xc6\xfb\xe0\x78\x06\x84\x6a\x2b\x27\x30\xd0\x51\x70\x89\x50\xac\x21\xc9\x47\xee\xc4\x0c\x5f\x41\x81\x3a\x5b\x00\x06\x12\x07\x14\xc9\xa6\x62\x81\x40\x27\x82\x04\xce\x51\xd0\xe3\x88\x5d\x61\x1f\xf1\x1e\x8c\x71\xcf\xb4\xef\x2f\x61\x14\x76\xc0\x0c\x87\xe8\x09\x26\x33\x3a\x7e\x02\x80\xc0\x22\x44\x63\xf0\x33\x9c\x5d\x42\x70\xa6\x3b\x81\x97\x21\x42\x02\xbc\x52\x43\xb1\x27\x00\x5c\x21\xc6\x31\x25\x63\x30\xe8\x1f\xf4\xbd\x27\x00\x04\x88\xfb\x0c\xc7\x42\x7d\xd9\xd0\x57\xe3\x72\x8a\xb8\x00\x87\x6f\x8e\x25\x90\x1a\x3e\xd3\x07\x13\x2e\x20\xf1\x11\xef\x3f\x91\xf0\x22\xc6\x25\x48\x3d\x90\xb0\x70\x0c\x16\x42\xc4\x7c\xbc\xbd\x0d\x63\xdc\x97\xd4\xe6\x0b\x3c\x13\x7d\x9f\x46\x4f\x00\x28\x41\xf0\x0a\x62\x02\xfe\x3b\x66\x34\x48\x7c\xf9\xcd\xff\x00\x3d\x9c\x7b\x30\x2e\xe0\x1c\xad\x1a\xf2\x4c\xc0\x39\x26\x73\xe7\x40\xe3\xed\xed\x90\xfa\x30\x5c\x50\x2e\xc6\x07\x9e\xe7\x55\xbb\x67\xbf\xe7\x3d\xb7\xab\xad\xfc\x84\x31\x44\x04\x08\x68\x04\x31\x79\x12\x43\xb1\x50\x14\x90\x60\x6e\x5f\x4a\x12\xf1\x49\x34\x8f\xc4\xf6\xd5\x60\xac\x7a\xcf\x91\xd0\xff\x00\x92\x01\x19\x94\xc3\x1c\x07\x63\xf9\xfd\xaf\x7a\x8d\x5e\x21\x01\x03\x28\xa0\x69\xc5\x10\x8f\x29\xe1\x88\xa7\xdd\x00\xe8\x0c\x3d\xaf\x93\x7f\x04\xc0\xa7\x44\x20\x22\xec\xaf\x00\x80\x71\x1c\x62\x5f\x4d\xb0\xfd\x91\x53\x52\xfc\x15\x00\xee\x2f\x50\x04\xcb\xdf\x02\xf0\x7f\x19\x9a\x8d\x41\xf7\xbb\x6d\x9f\x46\x31\x25\x88\x08\xbe\xad\xdb\xf2\xed\x12\x88\x5d\xab\x73\x81\x2c\xa6\x1d\x88\x8a\xb8\xf0\x24\x8a\x20\x5b\x8e\xc1\x29\x12\x09\x23\x5c\x31\xfc\x55\xb9\xad\x9b\x7c\xdb\x88\x31\xca\xf8\xf6\x9f\x38\xf8\x6b\x25\x29\x8f\x64\xdb\x67\xcb\xe3\xe0\x3e\x12\x51\x01\x57\x4b\xba\x1f\x91\x00\x0a\x55\x29\x5c\x32\x04\x9c\x94\xcb\x9a\xe1\xb4\x99\x80\x73\x0b\xc5\x9e\x6e\xc1\xcd\x17\x31\x64\x30\x42\xc2\xec\xd1\xb4\x89\x86\xb4\x53\x80\x34\x6f\xb9\x8d\x83\x4e\xf3\x82\xb4\x5b\x0b\x7e\x6f\x17\xe2\x17\xcc\x45\xed\x62\xc8\x1f\x01\x9d\x81\x98\x72\x8e\xa5\xc0\x2f\x10\xd4\xb9\x28\x61\xb9\x8b\x14\x9b\x85\x6e\x35\x8b\x54\x43\x65\xfd\xb1\x1d\xdb\x2b\x99\x7c\x5f\xd9\x5e\x01\x77\x8a\x3e\x25\xa8\x48\x70\xf9\x87\x6e\x60\x14\x87\x36\x9c\xe9\x9f\xdd\xeb\x47\x24\x4e\x0d\x46\x47\xba\x43\xb5\xbd\x1b\x86\x74\xfc\x02\x10\x66\x8c\x32\x2c\xb5\x73\xbe\xc7\x62\xf1\x12\xe2\x10\x05\xcf\x19\x52\xb4\x39\x13\x50\x24\x7c\x13\xb0\x34\x8c\x5b\xcb\x9c\xfa\x04\x66\x7a\x00\x30\xa3\x09\x09\x94\xcc\x78\x91\x2f\xf6\xc8\x1b\xdc\x13\x19\xd7\xbc\xca\x23\x6f\x70\x5b\x2a\xe6\x5d\x6b\x09\x75\x98\x88\x05\x10\xf4\x12\x11\xa9\xcd\x60\x72\x05\xc3\x4c\x62\x2a\x22\xed\x3c\x10\x22\xed\xdc\x9e\x48\x3b\xab\x88\xf4\x8e\x23\x06\x08\x15\x00\x26\x62\x41\x19\xfe\xac\xb5\x57\xe8\xfb\x88\x6b\xc9\x66\x14\x52\x9b\x70\xa3\x07\x42\xb8\xd1\xed\x09\x37\x5a\x45\xb8\x13\x5a\xda\x89\xd7\x58\x2c\x00\x8f\x91\x8f\x67\x18\x05\xe0\xf8\x05\x40\x37\x98\x0b\x9e\x13\x6e\xf7\xde\xa8\x1e\xcd\x84\xdb\xf5\xbc\xdb\x12\x2e\xef\x5a\xcf\x71\x04\xdd\xc4\xc8\x17\x28\x30\x9a\x0c\xf5\x95\x3a\x9d\xe9\x3c\xc8\x4f\x18\x16\x4b\xfb\xac\x7c\x86\x20\x43\x6c\x0c\x7e\x07\x7f\xd4\x1d\xc2\xb0\xb4\x1c\xb9\x48\x0c\x50\x88\x04\x72\x1e\x9e\xfa\xa7\xf2\xf9\xe9\xd6\x98\x30\x19\x83\x4f\x09\x62\x4b\x0b\x31\x02\x23\x34\x06\x90\x2f\x89\x5f\x87\xee\x1b\xc4\x66\x94\x45\x6a\x2b\x41\x65\xe4\x00\x4c\xa4\x21\xaa\x7a\x2d\x18\x25\x34\xe1\xd2\xba\x22\xca\x5a\x69\x5a\x66\xb1\x8c\xd1\x18\x4c\x29\x0d\x11\x24\xd6\x2f\x12\x65\xcc\x50\x30\x06\x82\x25\xa8\x51\x09\x18\xde\x3f\x06\x2c\x8f\xf4\xdd\x09\x05\xcf\x35\x60\x75\x34\x7d\xa1\x96\xad\x20\xcb\x1f\xc6\xce\x1a\x79\x9e\x82\x1d\x53\x72\x7b\xd1\x54\x1e\xa2\xde\x1c\x93\x07\x9e\xc2\xd7\x28\x9b\xe5\xad\xf6\xa8\x2a\x3c\xaa\x0a\x8f\xaa\x82\x56\x15\xb4\x4c\xb9\x83\xc2\x50\x18\xe0\x3f\x54\x6d\xb8\x1b\x11\xcb\x03\xdc\x5e\x85\x48\x95\x03\x3d\x5c\x93\x72\xd0\x4e\xdf\x88\xa1\xf0\x17\xe3\xf2\xe8\xef\xe2\x00\x0a\x94\x0d\x9e\x3a\x45\x0b\xae\x99\x76\xda\x4c\x41\x29\x49\xd4\xb0\x55\xa3\x5e\x81\xfe\x8c\x06\xd6\x58\x45\xaa\x68\x70\xe8\x35\x41\x0c\xd0\x19\x50\x2e\x84\x27\x0d\x5c\xd3\xcc\x33\x6e\x8e\x59\x69\xea\x6b\x28\x2a\x06\xff\x1a\x3a\x4a\x91\xdb\x1d\xb6\xaf\x26\x50\xd9\xea\x7d\x50\x3e\x8d\x37\x94\x7f\x59\xa7\x46\x45\x25\x2a\xd0\xf1\x19\x0c\x52\x86\x7a\x00\x82\xe5\x15\xe6\x1c\x93\xf9\x9b\x54\x2d\xbf\x83\xea\x54\x33\x54\xb7\x5e\x21\x5a\x43\x4f\xb8\xbf\x14\x5c\xad\x3d\x81\xb5\xd4\xa7\x8a\x46\x54\x55\x14\x30\xb7\x75\x05\xbe\x52\x57\xb8\xcf\xc4\xdb\xa8\x56\x55\x51\x8a\xdc\xfa\x81\x76\xec\x29\xed\x40\x91\xcb\xd2\x10\x1e\x04\xcd\x36\xea\x7b\xa9\xe8\x40\x6b\xa9\x03\xf7\x99\x50\x1b\xf4\xb5\x54\xdd\x16\xad\xc2\x3c\x4d\xf1\x07\x3d\x50\x4c\xb9\x3b\xf6\xe0\x33\x94\x6a\x2a\xe6\xe7\xbf\x89\xeb\x64\x95\xaa\xa5\xb7\xa8\x15\xe2\xfc\x7a\xfa\x55\xaa\x40\xc0\x65\x48\x61\x50\x64\xb4\x3a\x36\x7b\x77\x76\x8a\xe6\xb8\xca\xdf\x2b\x18\x2c\xed\x56\x13\x31\x39\x7a\x77\xab\x51\xd3\x6e\x75\xa3\xde\x48\xa2\x61\x71\x86\x3f\xd7\x6b\x46\xcd\x13\x54\x47\xb8\x95\x1e\xfa\x8d\x7c\x65\x0f\x40\xb9\x2c\x6b\x45\xbe\x8f\xe2\x87\xea\x8f\x4b\x83\x6f\x77\x50\x2a\x4b\x43\x3c\xfa\xe3\x1e\xfd\x71\x29\x91\x36\xec\x8f\xcb\x86\x7d\x05\x6f\x0e\xc3\x90\x5e\xa3\xe0\xd8\x78\x1d\x4e\x11\xf4\x17\x28\xb8\xc3\x7c\xab\xc6\x74\x02\x72\x8e\x58\xc4\x4f\xa8\x48\x65\xc0\x1d\xe6\xaf\x19\xaa\xd9\x1f\x39\xa3\x6c\x8a\x83\x00\x11\x80\xb0\x58\x20\x06\xa6\xc8\x87\x09\x47\x4a\x69\x48\xaa\x86\x48\xad\xd3\x12\xd0\x62\xdf\x08\xde\xe0\x28\x89\x00\x49\xa2\xa9\xf6\xa7\x64\x49\x6f\x40\x2c\xa0\x00\x3e\x24\x60\x8a\x8c\x0e\xa4\x9c\x11\x2a\xcb\x50\xcd\xb9\x80\x1c\x4c\x11\x22\x80\x69\x0a\xf6\xeb\xb5\xff\xfb\xcb\xbb\x5f\x32\x7a\x7a\xbe\x40\xa9\x9a\x85\x02\x79\xfe\xd2\x84\xf9\x08\x04\x14\x71\xd2\x15\xda\x05\x6a\xd3\xec\xe9\x03\xa1\xd9\xd3\x13\x18\xa1\xe7\x94\xcc\x42\xec\x8b\xdb\xd3\xcf\x35\x4c\xbd\xb0\x94\xf4\x50\x2d\x73\xbe\x0b\x90\xd0\x06\x11\x26\x8a\x9b\x7d\x73\x44\x49\x3e\x56\x6c\x9a\x92\xbc\xde\xc4\xba\xaf\x44\xfe\xb2\xd1\xe9\x43\x02\x92\x3a\x73\x12\x5c\x2f\x70\x98\xd2\x92\xcc\x15\x61\x0b\x7e\x65\x33\xe8\x9a\x11\x6c\xa5\x3e\x54\x9d\xd4\xaa\x99\x95\xf5\xe5\x88\x78\xa7\x49\x67\x85\x7e\xdc\x65\xa9\xa5\x59\x62\x7c\x2d\x10\xd7\xf6\xcf\x1e\x36\x83\xf4\x55\xd9\xca\xd6\x60\x8b\xd9\x7e\x7f\x27\xdf\xe8\xb1\xd6\x8d\xde\x4a\xeb\xfa\x0e\x2a\xac\x63\x98\x47\x9f\xe8\xdd\x5c\xa2\xf7\x17\xef\x7b\x16\x24\x7e\xf4\xed\xb5\x39\xa9\x9a\xd2\xb8\xbb\x75\xfe\xbd\x18\xce\xad\xa5\x5a\xd9\x9c\xe3\xcf\xeb\x34\xa7\x2c\x40\xec\xd9\x72\x9d\x09\x10\x64\xfe\xa2\x5b\xe3\x73\xf4\x43\x9a\x04\x93\x98\xd1\x2b\x1c\x20\x47\x8a\x79\x63\xe2\x35\x4f\xe2\x98\x32\xc9\x27\x6a\x18\x90\x0d\x53\x73\x1c\x3e\x97\xad\xde\x94\x1a\xdd\xfa\x58\xec\x0e\x3d\xaf\x5b\xcb\xc4\x1a\x5e\x14\xb4\x06\x16\x7c\x4d\xae\x2e\x50\xa2\x78\x52\x76\x47\xde\xa0\x1e\xad\x47\xc9\xaf\x89\xb4\xdb\xb4\xf6\x8f\x02\xec\x1b\x08\xb0\x16\xd2\x45\x5d\xad\xd8\x66\xca\x15\x7d\x6b\x51\x63\xba\x6b\xab\x0a\xd5\x6e\xeb\x36\x22\x48\x3b\xc5\xef\x8b\x20\x4a\x31\xfb\x66\xf2\x48\x93\xe3\x51\x1a\x3d\x4a\xa3\xec\xef\xab\x49\xa3\x15\xe1\xd2\x62\xe3\x2f\xa5\x7b\xb9\x62\xa6\x01\x8a\x19\xf2\xa1\x28\x85\xaf\x40\x16\x4e\x4d\x5d\x94\x13\xb1\x8c\x51\x1d\x0f\xfc\xff\x5e\x81\x7c\xe7\x8b\xf2\xad\x5e\x15\x2d\x95\x5a\xfb\x0c\x87\x02\x31\x25\xda\x18\xe2\x49\x28\x38\x98\x2e\x9f\x14\x7a\xbf\x38\x7a\x73\x7a\xf4\xfc\xf0\xfc\xf8\xf5\x09\x38\x79\x7d\x7e\xfc\xfc\x48\xc1\x6e\x81\x91\x5f\xa1\xce\xa0\xb7\x87\xa8\x8f\xd6\x72\xc1\x30\x99\x5b\x3f\xe4\xb1\xbb\x19\x0c\xb9\x8d\x9f\x9b\x69\x02\x74\x85\x42\x29\x74\x27\x05\x80\xca\xdc\x73\x05\xc3\x04\x8d\x41\x27\x6b\xde\x29\x34\x90\x3d\x03\xc8\x82\x76\x83\xa4\xad\xeb\xe2\xea\x85\x41\xf8\xf6\x9f\xc5\x53\xe9\xaf\xf4\x0b\x2d\x7e\xab\x57\xff\x5a\x9e\x4b\x8e\xf5\xe4\x00\x92\x00\x48\x2e\xe3\x66\x5d\xb5\xd3\xba\x24\xf7\x65\x23\x3d\x79\xcd\xa1\x95\xc6\x06\xce\xe5\x98\xcf\x96\x85\x33\xec\x90\x18\xb9\x7d\xd7\x53\xac\xd9\xcb\xd4\x70\x8a\x6d\x10\x71\xf0\x35\x45\xe1\x59\x8a\x81\x42\xa0\x40\xe3\x75\x7c\x57\xcf\x8b\x38\xd1\xf4\x1c\x4f\x83\x20\x19\xa1\x1e\x80\xb8\x1f\x79\xde\x3b\x92\x01\x5c\xc8\x19\xb8\x8d\x87\xab\x6e\x2c\x57\x54\xcb\x6e\x9c\xf2\xf6\x66\xa6\x2e\x8d\xf6\xe8\x63\x5b\xcf\xc7\xf6\xe8\x2a\xba\xbd\x6e\x23\x15\x8a\x18\x8a\x45\x45\x69\x28\x1e\x41\xce\xf3\xb6\xa4\x6c\xac\x71\x66\x17\x56\xe8\xf8\x45\x4b\x4b\xa9\x05\xbc\x15\x59\xbd\x71\x68\x4f\x60\x84\x56\xc1\x9b\x1f\x19\xae\xc3\xde\xb8\x3a\x27\xd0\xf7\x69\x42\x44\xd5\xcc\x5c\x37\x5d\xce\x0f\x31\x22\x62\x52\xd8\xfb\xf5\x5a\xd1\x6d\x11\xcf\x66\xc9\xb0\x37\x21\x72\x83\x87\x54\x0d\xa7\x52\x25\x14\x0c\xa3\x2b\xd4\x50\x76\xa0\x62\x8d\x7e\xbd\x03\x55\x83\x7c\xa8\x21\x6e\xac\xf6\x50\x55\x27\x8a\xe8\xf2\x7a\x03\xf4\xbe\x8a\x93\x6f\x99\x9d\xd3\x1d\x79\x3b\x0f\x84\x48\xf7\x2b\x10\x52\xb1\xdc\xef\x2b\xe1\x1e\xc2\xfd\xf0\x72\xb5\x95\xb4\x57\x8d\x55\x53\x14\x17\xb5\x95\x5e\x60\xb3\x8c\xb0\x13\xa5\x57\x27\x11\x9f\x95\xa4\x6a\x39\xe8\xfc\x15\x32\x8a\x8b\x68\x3b\x93\x4e\xeb\xd8\x80\xb7\xe4\xb1\x6c\xe9\x9d\x73\xdd\x3a\x3f\xf7\xbe\x9c\x2c\xed\x77\x8d\xe1\x18\xb3\xda\x6b\xef\x9c\xe2\xb4\xab\x36\x51\x99\xb7\x4c\x96\xda\xe3\x49\xf6\x78\x92\xad\x7d\x92\xfd\xb2\x52\x2d\x7a\x3c\xb8\x36\x77\x70\x39\x2e\xd8\x14\xb7\x7e\xbb\x03\xce\x91\x5d\x56\x5a\xbf\x96\x36\x8b\xbb\x04\xd9\x1d\xdd\xe7\x7f\x0f\x81\xee\x98\x67\x2d\x21\xfe\x6c\x79\xbc\x32\xc9\x39\xd7\x3c\xca\x46\xd8\xba\x77\xd8\x57\x29\x3d\xd6\x5d\xf3\xb6\xbc\x95\x59\x4e\xf5\xb0\x65\x6d\x7f\x44\xc2\xd5\xcc\x88\xdb\x4a\x2d\x44\x97\xd9\x99\x5d\x86\x9c\xe3\x2b\x29\xb1\xd3\xae\x76\x79\x9f\x2f\xc2\x98\xa3\x7b\x22\xdd\x1a\x8b\xe0\x3c\x1e\xe9\x7f\xaf\x23\xfd\x0e\x44\xba\xef\xc6\x29\xf8\x13\xfc\xf5\xf7\x3d\xb4\xb5\x40\xba\xb3\x70\xcd\x6b\x97\xd4\x49\xd7\xd6\xc7\xf7\x36\x43\x1c\x89\x89\xcf\x50\x80\x88\xc0\x30\x74\x5c\xec\x7d\x3c\xd1\xe5\x89\xde\x53\x94\xfa\xc2\xc6\xd9\xa9\x9c\x03\x58\xab\xf1\x28\xc3\x1f\x65\xf8\xa3\x0c\xbf\x4f\x32\x5c\x89\x81\xe2\xae\x7e\xce\x50\x50\x57\xcb\xb9\x5e\x41\xe6\x48\xf0\xf4\x06\x56\xba\xdd\xc1\x8c\xb2\x75\xc5\x3a\xa7\xed\x13\xa3\x01\xe7\x34\x8f\x50\x61\x32\xa3\x75\x06\x00\xa7\xe5\x0c\xe8\x15\x98\xfd\xcd\x52\x9f\x2d\x02\x3c\xa6\x19\x3e\xa6\x19\xaa\xbf\x8d\xc9\xaa\x27\x00\x7c\x27\xff\x0f\xce\x17\x88\x23\x00\x59\x7e\x29\xb9\x37\x83\x3e\x26\x73\xc0\x50\xa8\x2e\x0f\x67\x4f\x84\x98\x3e\x2b\x2a\xc2\x6f\x47\x48\x30\xec\xf3\x6d\x15\x25\x9e\x30\x48\xe6\x68\x95\x50\xe0\xc0\x74\x32\x66\x34\x8e\x10\x47\x0c\x23\x0e\x54\x77\x1d\x70\x96\x32\x48\xa7\x50\x65\xae\x85\xb2\xcc\x78\xa5\x47\x79\xb6\x3c\x95\xdd\xde\x5a\x61\xea\x2f\x9d\xb3\xfc\xaf\xb3\xd7\x27\x00\x32\x06\x97\x52\x8e\xbc\x61\x34\x42\x62\x81\x92\x1c\x31\x3a\xfd\x88\x7c\xc1\xc1\x8c\xd1\x08\xd0\xa9\x94\xaf\x50\x50\x86\x93\xe8\x5b\xb0\x9d\x21\x54\x4e\xa6\xc7\x64\xe6\x47\x29\x93\xfd\xdd\xcf\x64\xe6\xda\xc6\x41\xa2\x85\xc0\x1a\x5d\x30\x11\x72\x03\x86\x6b\x74\xd1\xe9\x99\xbc\xb9\x26\x95\x43\x02\xae\x29\xfb\x74\x7a\xa8\x58\x5f\xe4\xe9\xbc\x4c\xf1\x28\xf4\x56\x09\x3d\x9b\x50\x8f\x62\xef\x51\xec\x65\x7f\x0f\x4c\xec\xdd\x42\x20\xcd\x50\x20\xa5\x47\x0b\x7d\x0c\x86\x61\xb6\x8b\x31\x01\xdc\x67\x30\x46\xea\x7d\xb9\x19\x65\x11\x14\xc6\x4c\xd4\xc1\x8e\x4b\x9d\xd8\x1e\xb8\x44\x54\x3a\xa5\xd9\x7c\x5f\x49\x32\x69\xa1\x69\x21\x00\x6d\xf1\x24\xd0\x8d\x30\x78\xac\x62\x4b\xd9\x74\x3b\x0e\x21\x6e\xcd\x90\xce\x24\xc6\xee\xa8\x09\xec\x87\x55\xd5\xe1\x6b\x56\xbc\x7d\x94\xc8\x6d\x24\xf2\xa8\x14\x04\x74\x94\x83\xc4\x81\x72\xc7\xa9\xc2\xad\x0f\x82\x42\x1b\x2d\xf0\xf4\x78\x66\x7d\xd9\x33\xeb\x49\xfe\x93\xec\x69\x70\xd1\x83\xbc\x56\x3a\xe0\x29\x9a\x21\x86\x88\x9f\x81\xa9\xc5\xa4\x56\x10\xd3\xe9\x99\x3c\x39\x04\xb6\xf1\xc4\x81\x8d\x97\x53\xb6\x5e\x62\xb2\xba\xd1\x42\x22\xd1\xd4\x48\x6a\x82\xe3\xec\xdc\x31\x79\x7e\x16\x15\xe4\x2c\xd6\xc7\x18\xce\x91\xf5\x91\xe3\xcf\xf6\x47\x41\x05\x0c\xad\xcf\x58\xa0\x88\xaf\x87\x78\x2b\xac\x24\x14\xd5\x46\xd2\xb8\x99\x5b\xd7\x15\x24\x70\xab\x5b\x29\x98\x57\x37\x53\xa8\x54\x9b\x29\x2b\xc0\xfa\xb6\xd2\x0c\x38\xf9\x28\xe5\xfa\x12\x93\x68\x2d\x48\x6d\x85\x74\x0c\x18\x86\xaf\x67\xab\xd8\xb2\x71\x38\xb3\x34\x55\xf2\xd7\x2d\x01\x50\xfb\x3e\xa8\xec\xac\x9a\x6b\x0a\x92\x6f\xa0\x43\x0a\xd4\x36\xcf\xf4\xa4\x49\x91\xcb\x9d\x9d\xb2\x87\x21\x6f\x45\x10\xd9\xf1\x0e\x54\x70\xac\x66\xdd\xc2\xd7\x36\x6f\x66\x00\x85\x9e\x86\xd0\xae\x8d\xf5\x95\x56\xbf\xba\xe1\x75\x73\x86\x60\x22\x16\x88\x08\x23\xe5\x27\x88\x48\x1d\x38\x28\x35\x8b\x92\x50\xe0\x09\xfc\xdc\x82\x92\x5c\x3d\xa3\x58\xa6\x4d\xe1\x38\xea\xfc\x0a\xc3\x04\xf1\x31\xf8\x1d\x9a\x62\x93\x5b\x20\x66\x28\x86\x92\x17\xb6\x74\x4c\x82\x63\x4a\xd4\x27\x86\x60\xb0\xdc\x02\x33\xf5\x56\xe3\x96\xba\xc6\x6c\x7e\xde\xd2\xb1\x7e\x4c\xe6\x7f\x80\x4e\x5b\x96\x2c\x5e\xb7\x6a\x06\x33\xbd\x82\xa4\xef\x75\x26\xa6\x8a\x7e\x80\xe2\x90\x2e\xfb\xe0\x25\x65\xe9\xb1\x05\x0e\xdf\x9f\xb5\x86\x20\xa5\xa5\x9b\xdb\xaa\x45\xb2\x81\xb9\xe5\xd4\x86\xa4\xd9\x6d\x6f\xab\x34\x86\xa9\x5d\xef\x97\x22\x3e\x05\x04\xc6\x20\xe1\x3d\x04\xb9\xe8\x0d\x94\xdd\xb3\x0e\x3e\xea\xc5\x93\xd6\x22\x41\xdd\xa4\x6a\xdb\x78\x4a\xa9\xe0\x82\xc1\x78\xa2\xdf\x93\x9e\x2c\xac\x94\x89\x95\xbd\x61\x10\x61\x32\x81\x31\x4e\x7b\x27\x2c\x6c\xea\x0c\x9a\x08\x7c\xbe\x40\xc6\x0a\x54\xc3\x9a\x07\xae\x41\xc2\x42\xc9\x13\xb1\xa9\x95\x6e\xb7\xc8\x84\x1f\x07\xa8\x3f\x07\xd0\x0f\xcd\x2b\xde\x11\x22\x02\x20\xe1\xf7\xd5\xa0\xea\xea\x7a\xb6\x6e\xf0\x0a\xe2\x50\x59\xa1\xd7\x0b\x44\xac\x1a\x87\x59\x6d\xc9\x59\x12\x86\xcb\x7c\x8f\xa0\x00\xe0\x3e\x02\x58\x98\x62\xa7\x1c\x40\xd0\x55\x7b\xa6\xab\xb6\x22\x2a\x62\x65\x32\xd1\x27\xb0\x42\x46\x6d\x2d\x8e\x41\x00\x05\xea\x09\x1c\xa1\xb6\x64\x46\x37\x31\x66\x88\x6f\x72\x48\x00\x48\x12\x2a\x32\x54\xae\x13\x82\xf4\xfd\x99\x4d\xce\xa7\x85\xcb\x64\xcd\xd3\x2d\x7d\xde\xbd\x6d\xfb\xc6\x0a\x06\xed\x7a\x4d\xd6\xda\x3e\x75\xc2\xbd\xbd\xe4\x51\xfc\x3c\xe1\x82\x32\x38\x47\x93\xb2\x9a\xd5\x38\xf9\x94\xd1\x6b\xbe\x7a\xd3\x15\xcf\x0e\xfc\x19\xb5\x51\x15\x72\xda\xcc\x19\xe2\x7c\x22\x16\x8c\x26\xf3\x45\x9c\x88\x49\x8c\xd8\x84\x23\xbf\xf5\x10\xe8\xce\x23\x28\xb5\x72\x12\xc1\x9b\x89\x4f\x09\x41\xea\xa5\x84\x1a\x55\xa2\xac\x6a\xca\x3f\xd9\x31\x86\x4c\xe0\x5b\xf4\x0b\xa0\x80\x13\x86\xa4\xdd\x26\x97\x37\x46\x0c\xd3\xf6\xd4\x2b\x82\x3c\x81\x42\xa0\x28\x16\xbc\x99\x00\x2e\x50\xa6\x38\x0c\x31\x99\x4f\xf4\xd9\x6a\x12\x2a\xd6\x59\xc7\x08\xb2\x4b\x24\xe2\x10\xfa\x6d\xf9\xcb\xf9\x7a\xa5\x4b\x65\x6a\x2a\xa9\x5f\xd5\xc6\xbe\xb4\xfa\xe9\x04\x5b\x19\x42\xa0\x53\x86\xa3\x78\x00\x2b\x43\x08\x74\x06\x9d\xca\x86\xa9\x7e\xab\x0d\x9d\xca\xd7\x52\x69\x2d\x93\xf7\x2e\xaf\x10\x7c\x59\x5d\xba\x44\xfe\xfc\xaf\x79\x21\x6c\x98\x35\xfa\xbf\x6a\x31\xfd\x0a\x09\x28\xf7\xcb\x57\x52\xb8\x9b\x56\xfa\xf0\xcd\xb1\x01\xaa\xb4\x40\xf2\xc7\xab\xd2\xaa\x2d\x34\x58\x0e\x07\x78\xa7\x64\xc7\x85\x61\x8d\xf0\xe9\xe9\x91\x75\xef\xb2\x5e\xd7\x34\xc3\x76\x5d\x17\x9b\x65\xcb\xbc\x5a\x6f\x68\xd6\x02\xf8\xb5\x98\xc3\xb9\x8c\x8e\x77\x5d\xd2\x91\x8b\x97\xfa\xd4\x20\x4a\x3d\x16\x79\xfd\x74\x30\xa5\xc1\x12\x70\xa4\xef\xe5\x1b\x82\x81\x37\xaf\xcf\xce\xcd\x18\x2e\x57\x8b\x3c\xc5\x9f\xd8\xa8\xaf\x74\x96\xd4\x9b\x2d\x15\x2d\xb5\x54\x22\xe1\x7a\x81\x4c\xea\x8b\x56\x24\xfd\x30\xe1\x42\x7e\x6f\x34\xce\xb4\x82\x3e\xb6\x4f\x7e\xa7\xd4\x75\x19\x2e\xa5\x3b\x11\x69\x89\xa9\x3e\x38\x9e\x49\x9d\x14\xf3\xfc\xe9\xac\x2d\x05\x44\x51\xdd\xc5\x73\x42\x99\x6c\x2e\x01\x3f\x7c\x73\x0c\x60\x22\x68\x04\xa5\xc2\x22\xb5\xdc\x00\x09\xc4\x22\x4c\x54\xd1\x7f\xcc\x4d\x67\x15\x6b\x95\x63\x75\xe3\x10\x92\x2e\x80\x42\x30\x3c\x4d\x04\xea\x54\x30\xa8\xea\x34\xb5\x45\xbc\xca\x9a\x55\x01\xb3\xae\x84\x8f\x58\x15\x29\x0a\xb4\xec\x83\x63\x01\xa2\x84\x0b\xe0\x53\xc2\x4d\xa6\x5d\x48\xaf\x11\xeb\xf9\x90\x23\x00\xc3\x78\x01\x49\x12\x21\x26\xad\xb0\x05\x64\xd0\x17\x88\x71\x40\x19\xe8\x76\x7b\xdd\xee\x96\xd4\xd4\x99\xb9\x61\x03\x89\x6e\x3f\x45\xc2\x6e\xbd\xa5\x4a\x24\xa1\xf4\x51\xb2\xb4\x55\x65\x54\xdd\xce\x87\x44\x79\xc3\xa7\x08\x84\x94\xcc\x55\x15\x31\x48\xc0\xce\xd0\x9a\xbe\xdf\x5d\xb5\xe0\x55\xbb\xd3\xf1\x8a\x80\x2a\x70\xb4\x39\x26\x6b\xa3\xb3\x16\xa0\x78\xbf\x40\xea\xe5\x89\x5c\x93\xa9\x8c\x21\xd9\xd0\x0c\x23\x69\x4e\xa8\x50\xfc\xc9\x91\xda\xb3\x92\x0b\xb6\x1a\xbb\x53\xdb\x10\xcb\xaa\x5b\xe5\xa6\xb6\xde\xe0\x00\x5d\x21\xb6\x04\xbb\x20\xc2\x24\x11\x88\x6b\xa6\x0e\xd0\x0c\x26\xa1\x30\xac\x8b\x79\xd9\x9e\xa9\xe3\xd3\x1a\x03\x48\x72\x7c\x2d\x29\xb4\xf5\x29\x9b\xe8\x80\xa0\x09\x64\xd2\x19\xf8\x47\xc1\x8a\xf8\x67\xff\x1f\x46\xd3\xfe\xe7\xaa\xe5\x68\xa3\xda\x95\xaa\x97\x48\xe9\x93\x26\xd5\xe2\xdc\x6d\x12\x27\xcc\x5f\x40\xf3\xbc\x47\x0a\xce\xaa\xe9\x6b\xe9\x50\xa3\x31\x16\x40\xb1\xda\x58\x0c\x9a\xad\x1f\xe6\x19\x4c\x01\xa0\x2b\x19\xd3\x09\xca\x8a\xc2\x61\xb5\xba\xc6\x3a\x4a\x43\xb1\x66\xdd\x5a\x0a\x5c\x3d\x78\x12\xba\x75\x54\xba\x46\x18\x36\x7f\x7c\xaf\x5b\xa6\xad\xbb\x62\x35\x9c\x07\x7a\xf7\xac\xa9\x70\x5d\x77\xbd\x03\xba\x61\x4b\x74\xdf\x11\xfc\x49\x6e\x7e\x95\x81\x3e\xc3\xfa\x3d\x1b\x87\x44\x91\x53\xad\x96\xca\x01\xe6\x71\x08\x97\x15\x57\x40\x71\xce\x9f\x92\x08\x2a\x51\x16\x28\x6f\x12\x71\x56\x53\x6a\x40\xbb\x76\x7a\x55\xca\xaf\x7e\xde\xca\x23\x14\xd9\xe8\xba\x06\x60\xee\xe0\xd2\x8a\x94\x0a\x6b\x37\xce\xdf\x22\xf0\xe2\xe4\xa7\x75\x78\xe9\x2c\x2b\xff\x59\xfd\xbe\x1d\xef\x9c\x59\x05\x44\xbf\x1c\xcb\x60\xee\xa2\xea\xa6\x78\xe6\x85\x6e\x65\x31\xcb\x6d\xe7\x6b\xe7\x98\x29\xce\xfe\xca\x3c\xf9\x64\xfa\x82\xbc\x2f\x88\x11\x03\x1c\xf9\x94\x04\x16\xff\xc8\xa3\xbb\x3d\x80\x15\x59\xbb\x1e\x73\x3c\x5b\x0a\xc4\x95\x8f\xfd\x58\xa0\x28\x1f\xbe\x95\xfb\xc8\x8d\x27\x7a\x40\x68\xae\xf4\x71\xb9\x51\x84\x91\xd2\x02\x24\x2b\xc9\x01\x2c\x25\x8b\xdf\x1a\xc3\xb2\x03\xca\xe1\x07\x2b\xfb\x29\xdd\xc0\xc9\x4e\xc0\xb8\x36\xef\x1b\xbd\xeb\x9d\x82\xed\x08\x9d\xf7\xfd\x92\x74\xae\xfa\x1b\x1b\x28\x9d\x75\x03\xba\xdb\xad\x01\x2b\x5b\xa5\xed\xbd\x98\x0e\xe8\x70\x12\xd9\xaa\x7f\xda\x7b\x13\x5b\xd1\x45\xc0\x08\x71\xee\xf2\xa4\xbb\xe9\x66\x5a\xab\x09\xee\x1d\x87\x62\x32\xc1\x64\xc2\x97\xc4\x9f\x30\xa4\xb2\x84\xea\xf9\xb4\xf3\x0a\x93\xca\x73\x7e\x3d\xd9\x17\xa4\x7d\xfb\x55\xe3\xbd\x4c\x40\xd3\x54\x2d\xf3\x0c\xfa\x82\xd6\xfb\x41\x3a\xa7\x79\x5b\xa0\xdb\xb6\x24\xe0\x6a\x30\x32\xf5\x69\x02\x3f\x4f\x22\x1a\x34\x69\x43\x69\xf1\x9e\x43\x3d\x37\x0e\xb1\x58\x82\x7f\x53\x82\x80\xea\xa8\x9f\x32\xac\x83\x25\x9d\xc9\x58\x92\x31\xe5\x1c\x4b\xf0\xaf\x74\xa8\x17\x32\x04\x3a\x1c\x93\x79\x88\x3a\x5b\xa0\xa3\xbc\x33\x1d\x07\x03\xb4\xd0\x9f\x9c\x1b\x2b\xc4\x33\xc4\x63\x48\x26\x7a\x1f\x34\x60\x79\xae\xaa\x60\x47\x58\x64\x7d\x52\x55\xf3\xb2\x88\x95\x8a\x9b\xaa\xc1\x94\x01\xae\x6a\x22\x67\x2f\xab\x8b\x34\xe0\x99\x35\x27\xd2\xa2\x4e\xe3\x8b\x2d\x76\x58\xad\xb5\xf8\x29\xa1\x02\x4a\x29\xc1\x93\xa8\xc1\x95\xd0\x7d\x2b\xdb\x81\xb4\x5d\xf6\xa8\xe4\xed\xf6\xbb\x9e\xb4\x1c\xeb\x73\x4d\xa8\xea\xb4\x2b\x3b\x79\xbd\x19\x4b\x0b\xe6\xc3\x18\xfa\x58\x2c\x5b\x20\xfa\x42\x4a\xe4\x38\x84\x04\x65\xae\x9a\xb4\xf7\xa6\xd0\x8f\xa0\x50\x09\xd0\x93\x6a\x42\x48\x59\xda\xe9\x86\x20\x44\x57\x28\xcc\x8c\x14\x39\x0b\x78\xae\x5f\xf9\xec\x70\x21\x57\xb5\x03\x28\x03\x9d\x98\xa1\x2b\x8c\xae\x5d\xac\x6e\x11\x64\x95\x24\x5b\x33\x4b\x6f\x2a\x3b\xaf\xce\x1d\xcb\x63\xd0\x98\x88\xbd\x91\xfa\xbe\xf2\xc0\xcf\xb7\x0a\x30\x55\x00\xf9\xf6\x11\xa6\x02\x48\x0f\x25\xc4\x54\x00\xba\x93\xaf\x71\xfe\x68\xca\x37\x5d\xe1\x1c\x8c\x7b\xb2\xbe\xb5\x05\xdf\xef\xef\xea\x6a\x90\x3b\xd5\xfd\xeb\x76\x06\x14\x0b\xff\x67\x82\xa9\x4d\xd6\x6b\x71\xa0\x63\x12\x48\xad\x05\xe9\xa2\x05\xea\x5c\x48\xed\x70\xcd\x08\x7d\xf0\xde\xb8\x9d\xbb\xdd\x02\x60\xdd\x2e\x08\x31\xb9\x6c\x61\x9a\xdf\xc6\x51\x65\x26\xdf\x90\x9f\xc1\x2e\xed\x5d\x8a\x56\xcd\x28\x4b\x07\x01\x71\xc2\x62\xca\x9d\x27\x7f\x69\xda\x36\xae\xb0\x19\xc3\x88\x04\xe1\xd2\x81\x5d\x11\x86\x2d\x05\x44\x9a\xd9\x77\x01\xaf\xf9\xc5\x6a\x08\x56\x45\x29\xba\x69\x98\xc2\x81\xb3\x15\x9d\x50\xe8\xab\xfc\x42\x4c\xe6\x00\x12\xf0\xfa\xec\x45\x16\x65\xaa\x02\x51\x0c\x1b\xb8\x22\x8d\x76\x3a\xa7\xc5\xd9\x6e\x36\x7e\x91\x7f\x92\xa4\x81\x69\x74\x47\xfd\xdb\xff\x76\x3c\xae\x61\xee\x76\x1f\x1c\x73\x1b\xfa\xb9\x98\xba\xc4\x65\x27\x7d\xf0\x2b\x66\x73\x4c\x30\xdc\x34\xb7\xe5\x6f\x90\x6c\x84\xcb\xf4\x64\x2a\xa8\x55\xae\x79\x9f\x5b\x46\xf5\xf1\x82\x72\xfc\x15\x80\x3a\x24\x5a\xbe\x94\xc4\x2d\x7f\x76\xaa\xb1\x6a\x94\xfb\x9b\x78\x2b\xa9\x4c\x8c\xdb\x5a\x53\xa9\x7a\xdd\x66\x5f\x5c\xe7\xab\xc7\x54\x9c\x2a\xd3\xcd\x43\x34\xd3\x6e\xc2\xbb\xfb\xcc\x9b\xce\x40\xbd\xe1\x9e\x9b\x59\xa5\x2a\x21\x55\xe6\x4e\x4b\x39\xa3\xbf\x49\x61\xd6\xda\x78\xa1\x96\x4f\x8d\x47\xdd\x14\xe4\x39\x2c\x16\x4d\x96\x46\xe3\xab\xc3\xb3\xde\xd9\xd9\xeb\x2c\xf7\x42\xb3\xc1\x73\x63\xb9\xa8\x8b\x9a\x85\x88\x6d\xf7\x36\xfa\xd7\xe6\xae\x54\x54\x33\xdf\x8a\x98\x9a\xdc\xe1\x39\x22\xea\xe2\x68\x00\x92\x54\x34\xd5\x3c\xf9\x50\xbe\x2d\xb5\x56\x76\x75\x71\xee\xd6\x43\xd9\xdd\x36\x33\x62\xf6\xb0\x45\xeb\xbc\x40\xd3\x83\x23\x9f\x55\xcb\xc2\x6d\x28\x21\xbd\xf1\x5d\xb7\x3c\x61\x7a\xba\x6c\x0f\xf5\xa6\x73\xac\xd7\x4f\x76\x74\x56\xc4\xeb\x38\xb6\x62\xe9\x16\x4a\x69\x47\xba\x33\x9e\x04\x35\x28\x56\xab\x68\x75\x1b\x44\xc4\xfa\x49\x4f\xeb\xa5\xe4\x34\xec\x19\xf7\x71\xee\x66\xf0\xe2\x24\x87\xf6\xe7\x8c\x12\xeb\x4d\x55\x59\xbe\x35\x96\xce\x95\xb0\xea\x96\xce\xee\x25\xe4\xf9\x12\xc2\xb2\x37\x4e\x1d\x79\xd9\xd1\x82\x89\x39\x36\xd7\x8d\x62\xd6\x65\xb5\x17\x01\x71\xcc\xdd\xce\xb5\x9f\x3a\xb5\xf4\xf5\x86\x06\x9d\x67\x16\xc2\x39\xc0\xfa\x10\x95\x7a\xcd\xb5\xad\x71\xa7\x58\xa6\x2b\x58\x24\x02\x26\x25\x4d\xc9\x4c\xd6\x4e\x17\xaa\x91\x1e\x99\xbf\x79\xb2\x22\x64\x9e\xc6\xcb\x73\x07\xb5\x33\x72\xae\x7c\xc5\xbe\x76\x88\x59\x67\xa3\xa5\xf0\x98\x3b\x23\xf0\x52\xa9\x75\xe9\x31\x9a\x30\x86\xe4\x7f\x53\x12\xe4\x4f\xed\xc1\x50\xbb\x6d\xf9\xa6\x9c\xc6\xae\x6d\xef\x7e\x14\xb3\x57\x26\x8f\x43\x36\x35\x73\xf6\x7f\xec\x19\x5f\x7b\x8c\x16\x01\xd0\xcd\xbe\x8a\x4e\xd1\x52\x0a\x37\xce\xe2\x3c\xb4\x8b\xd3\xa8\x26\x77\x9d\xe7\xd6\xc7\x7d\x75\x79\x1d\x2f\x7c\x68\x0b\x44\x57\x99\x6b\xbf\xa0\xb7\xd6\x17\x5a\xc0\x24\xc5\x82\xaa\x36\x27\x60\x14\x6f\x42\xf9\x6b\xa4\xac\x0d\x4e\x50\xf4\x26\xd4\x2e\x5a\x75\xd3\x6f\x24\x6d\xce\xb8\x44\xab\xa3\x57\x5d\x9a\x8e\x84\xf9\x35\xea\x0d\xa7\x62\x6a\x0d\x07\x67\xd9\x41\xd2\x48\xd7\x6f\xea\x0d\x75\xa3\x6a\x93\xb0\xee\x66\x71\xa1\x9c\x00\x28\x15\x09\xf8\xae\x50\x87\x31\xad\x62\x93\xd6\x63\xfc\x4e\xf3\x45\x5e\x1d\xb4\x46\x3d\x3d\x7b\x0d\xca\xf5\x43\xbf\xd1\x69\xb0\x52\x46\x76\x0a\x32\xd2\x2a\x10\xdb\xfa\x9a\xed\x14\x72\xe4\xba\x4c\x57\xa4\x89\x6c\x05\x12\x16\xb6\xde\xe9\xaa\xb8\xcc\x5a\x97\xf4\x3e\x5e\x5f\xd6\xb0\xa3\xeb\x8e\x24\x0c\x71\x30\xc1\x9c\x27\xad\x4d\xb2\x5b\x58\x3b\x39\xa7\xa4\x8a\xb2\xee\xa5\x86\x70\x56\x82\xdc\xa4\x88\x71\x4e\xe0\xb8\xd9\x33\x20\xd3\xf8\x6c\xdf\xfb\x29\x48\xde\xa0\x51\xe8\x09\x7a\xf0\xf1\x6c\x3e\x7c\xfe\xcb\xe7\x59\xd2\x42\x26\x35\x4a\xa4\x0a\x08\x5f\x4c\x18\x3d\x10\xb9\x95\x53\xc2\xd8\x4c\xd9\xe7\x35\x03\xbf\x5a\x36\x55\x23\xbf\x15\x0e\x81\x41\xa0\x32\xae\x60\xf8\xa6\x86\xd0\x4e\x4a\xe9\x64\x8e\xea\xf8\xad\x3d\x78\xee\x44\x1e\x3d\xac\x5e\xfe\xe2\x14\x2d\xf1\xce\x74\x86\xdb\x05\xbd\xb3\x79\xab\xdd\x75\x0e\x90\xa3\x77\x40\x93\x69\x88\x1a\x4c\x09\x35\xa0\xbd\xa7\xcb\x85\x0e\xbf\xc0\xae\x2e\x4f\xf1\x4d\xf6\xb5\x0d\xc4\x7f\xfa\xce\xb6\x69\xd1\xb1\x99\xe1\xa5\xae\xc3\x87\x29\x39\x45\x3c\x09\x45\x91\xe1\x2d\x34\xec\x11\xee\x99\x34\xb8\xdf\xbb\x4e\xb9\x25\xde\xa9\xf2\x0b\x25\xbf\x61\x4b\xf2\x7d\xa7\xfc\x2f\x84\x5e\x6b\x73\x4f\x5d\x87\x5a\x20\x40\x49\xb8\xb4\xa2\x38\x33\x8c\x42\x1d\xa4\xd2\xa5\x1e\xb2\xee\x15\x1b\x71\xbd\x3b\x43\x7f\xa3\xab\x65\x15\x1a\xac\xbc\x40\xf6\xa4\x5a\xfc\x2c\xdf\xf1\xfa\x79\xeb\xac\xb2\x60\xe5\x96\x9f\x7e\x39\x9c\x21\x9f\xb2\xec\x55\x87\x52\xe5\x37\xc7\x52\x94\x9e\x10\x77\xbc\x2d\x9a\xd6\x35\x2e\xc2\x91\x7e\x6b\x0d\x63\xbf\xc7\x5d\x81\x2e\x44\x64\x2e\x16\x4a\xb9\xc6\x91\x72\xe6\x19\x32\x29\x1e\xba\x5e\x60\x7f\x21\x17\x83\xe9\xd7\x19\x24\xb9\xa3\x42\xa9\x4e\xe7\xa3\xa7\x6e\xfc\xca\x9b\xd0\xbd\x05\xb3\xf0\xe8\x6e\x2e\x38\x74\x02\xec\x18\x0c\xf2\xaf\x74\x96\xef\x18\x8c\x76\x86\x9e\xf9\xb6\x5a\x06\xaf\x4c\x22\x90\x6d\x71\x33\x7a\x5a\xe8\xb9\xb4\x96\xe6\xdb\xb6\x34\x4c\xdb\x5b\x29\x9a\x60\x8a\xc4\x35\x42\x44\xa7\x6f\x67\x05\xf2\xbf\x2c\xc5\x76\xbc\x56\x24\x1b\x78\x07\x5e\x3d\xcd\xca\x24\xb1\x68\x66\xc6\x37\x95\x65\x8b\x34\x33\x5f\xb6\x21\x59\x9a\xd7\x9b\x5a\xae\x82\x82\x19\x12\xfe\xa2\x0f\x5e\xca\xff\x14\x8a\xcb\x2a\xa7\x2c\x8a\x62\xb1\xec\xeb\x7e\x88\x08\x55\xf9\x1f\xb2\x7c\xe3\x0b\xc4\x08\x4c\xfb\x28\x78\xb2\x4d\xee\xa6\x6b\xf1\xa0\xad\xa9\x59\x57\x89\x78\x18\x2a\xa7\x05\x68\xed\xea\x7a\x9a\x06\x56\xd5\xbf\x46\x02\xbc\x81\x73\xc9\x34\x01\xba\xa9\xb0\x84\x9d\x13\xd0\x42\x4a\x54\x97\xaf\x5c\xf3\xcf\x2c\x5d\x9a\x8c\x66\xe7\xce\x6b\xa0\xad\xda\x84\x8d\x40\x9f\xe4\xd9\xe7\x92\x5e\x92\xd7\x11\xf4\x17\x36\xd2\x1b\x44\xa3\x9c\xe3\x9f\xa1\xe1\x79\x1a\x11\xca\x02\xc4\x9e\x2d\x9d\xce\x0d\x2b\xf7\xe1\xcc\x64\x48\x9b\xb3\x52\x76\x02\xd3\x25\xf0\x19\x16\x88\x61\xa8\x93\xc3\xf9\x92\x08\x78\x93\x25\xd2\x64\xa2\x1e\x60\x6e\x01\x14\xe1\x10\x32\x9d\xfe\x5e\xec\x82\xc0\x45\x3a\xf0\x05\xf0\x43\x98\x70\xe5\xac\x80\x04\x9c\xbd\xfd\x45\xd7\x91\x8a\x10\x11\xf9\xb9\x73\x24\xe9\xa6\x2b\xb8\x9b\x90\x84\xea\xaf\x3d\xa0\x90\x2c\xd3\x61\x67\x34\x0c\xe9\x35\x26\x73\x70\x71\x69\xd5\x59\xe0\x17\xfa\x94\xe7\xe3\x3c\x47\xe3\x7b\x77\xb9\x2f\xeb\x77\x57\x41\x2f\xeb\xe7\x62\x8d\x84\xc2\x0f\x2a\x51\x60\x62\x95\xce\xfd\xde\xf2\xba\x5a\x5f\x2e\x18\x9a\x59\x1f\x0b\x1d\xdc\x81\x8c\xef\xab\xb5\xf1\xbe\xb7\x43\x9d\xf2\x23\x65\x73\x48\x30\x4f\x2b\x21\xda\xbf\x48\x8d\xc6\xfa\xbc\xb2\x1c\xdf\xf7\x26\x74\x63\x7d\xa1\xf3\xab\xad\x2f\xf2\x02\x59\xd6\x97\xa6\x58\x55\x4e\x6e\xab\xfa\xdb\x96\x75\x3c\x4a\xc9\x55\xd4\x46\xb8\xbd\xb4\x62\x81\x30\x53\xf8\x6d\x81\xc4\x5c\xe6\xce\xd7\x58\xb3\x94\xb5\xa6\x17\x17\x17\xfc\x53\xbe\x46\xca\x09\x06\xb9\x6f\xff\x9e\x37\x3e\x5f\x1f\x08\x30\x81\x24\x98\x64\xde\x77\x89\xf7\x5d\xe0\xda\xb2\xb8\xa2\x1e\xce\x63\xcd\xda\xf6\x1e\x23\x5d\x91\x7a\xf4\x82\x2d\xa9\x08\xe2\x99\x55\xed\x42\xea\x88\x52\xfe\xab\x0a\x18\xf9\xd2\xe9\x90\xa3\x34\x55\xf4\x59\x60\x61\x28\x01\xea\x67\x92\x25\x0e\x69\x50\x54\x66\xab\xd2\xa6\x24\x4c\x80\x25\x70\x52\xec\x3a\x35\x32\x52\x0b\x51\x33\xc0\x5d\xe5\x20\x17\x4b\xa9\x73\xca\x63\x5e\x4b\x6b\x04\x99\xbf\x70\xcb\xb8\x5c\xc4\xa9\x46\xb9\x48\xb3\x78\xa2\x59\xb6\xad\x90\x69\xea\xd6\x7f\x51\xa0\xe5\x73\x16\x04\x1b\x38\x94\xbc\x92\x1a\x1f\x3c\x8d\x08\x6b\xe8\xd5\xea\x5c\x14\xc5\xcb\xc5\x16\xb8\x90\x84\x93\xff\x55\xbb\x58\xfe\x43\xef\xcd\x0b\x5d\x83\xe3\x42\x6f\xcc\x8b\x7c\x6c\x69\xcd\x42\x06\x05\x65\x7a\xc1\x2f\xfe\xf1\x4f\xd9\xeb\x87\x0b\xc5\x32\x17\xbf\x1c\xff\x7c\x74\x91\x8b\xd8\xb4\xd7\x47\x8a\x89\x69\x7f\x78\xf2\xe2\x42\x8f\xfd\xfa\xf4\xa2\x0f\x7e\xa2\xd7\xd2\x30\xd8\x02\x4b\x9a\x28\x31\x2c\xb1\x84\xa9\x96\x24\xf1\x1d\x78\xa6\xbb\xaa\x07\x61\xb0\x51\x6b\x6f\xd1\xf8\x28\x63\x26\xd7\x56\xac\xda\x26\xe6\x19\x60\xc5\x56\x17\xd1\xb2\xa7\x04\xbb\x86\xcb\x8a\xa2\xab\xc4\xd9\xb6\x9b\xb1\xb8\x13\x7f\x00\xe9\xa8\xba\x98\x49\x81\xf0\xe0\x07\x00\xaf\xb9\xdd\xf9\xf7\xb8\xf7\x47\x7b\xd0\xa1\x9e\x43\x45\xd3\x55\xd9\x15\xf3\x2c\xc9\x45\xb4\xbc\x25\xb8\x21\xbe\x44\x20\x5a\xfe\xd7\x70\xf7\x8b\xc8\x0b\x25\x0d\xab\x46\x22\xb7\xe4\x08\x14\x59\xd4\x51\x15\x8a\x8c\x11\x8b\x30\xe7\x2a\xf6\x47\x01\x47\xfa\xf9\x2b\x66\xde\x0e\xb0\x96\xfe\x84\x0a\xd4\x4f\x01\xd4\xc7\x79\x5e\x67\x5e\xb2\xb1\xa9\x17\xae\x52\x0c\xd2\xde\xf5\x62\xc9\xa8\x63\x8a\xcd\x6a\x84\x8d\x5b\xb0\x38\xb4\xa7\x82\xdc\x00\x65\x71\xd6\x82\x45\x3a\xb7\x15\x5a\xe9\xd3\x0d\x2a\xfd\x29\x85\xc9\xbc\xdd\x60\x8f\x29\xcd\x6e\xf5\xad\xf9\x52\x7f\x78\x69\x0c\x9c\x7f\xbd\x4f\x2b\x40\xe9\x09\x17\x42\xc4\x4f\xca\x98\xbe\x3b\x2b\x5c\x8e\x48\x87\x2f\xf9\x70\x4c\x1d\x1e\xd0\xc9\x8a\xb4\xe6\x7e\xc5\x52\x61\x28\xd0\xb1\x30\x4f\x17\xa4\x63\xe2\xae\x30\xc6\x22\x2b\x8b\x76\xf4\x6e\xad\xa9\x51\xd2\xbb\x46\x9b\x9a\xfa\x26\x0e\xb1\x8f\xc5\x19\xfe\x8c\xbe\x30\xe6\x72\xd2\x89\xe2\x93\xfc\x27\x55\x43\x47\xdd\x29\x23\x01\x64\x41\xff\x66\x50\x2d\x04\xd6\x0c\x96\x76\xfb\xe2\xb3\x0f\x7b\xa7\x6f\x77\xfe\xf5\xf3\xf1\xc1\x5b\xef\xf5\x79\xf4\xf1\xed\xcb\x60\x87\xfa\x2f\x4f\xe7\xf9\x5c\xc6\x99\x5c\x82\x60\x65\xc5\xb5\xed\x56\x83\x9b\x9b\x75\xa0\xa3\xea\xbd\xb6\xa5\x4c\x56\xc6\xab\xec\x1d\xab\x27\xb5\x76\xbc\x81\x8e\x54\xbd\x4d\x49\x50\xbd\xac\x0d\xcb\x9d\xff\xe4\xae\xe2\x6b\xb7\xed\x0d\x30\x5f\xee\xb1\x4f\x3b\x1f\x2f\xf1\xc1\x27\x8f\x8a\xe8\xe3\xa7\x99\x44\x77\xc6\xe6\x7d\x18\xc7\xbc\x1f\x5d\xf6\xa6\x42\xcc\xbd\x8f\x64\xb0\xef\x2d\xe2\xfe\xcd\x6e\x72\xd0\xe7\x83\x7e\x80\xae\xf8\x02\xcf\x44\x9f\x32\x8b\x30\xce\xca\xbf\xa0\x23\xb7\x20\x1f\x6f\x6f\xab\x9f\x7b\xfa\xa7\x5e\x74\xd9\x43\xe6\x7f\x7e\xaf\xd7\xfb\xf3\xaf\x30\xf8\xb3\xf7\x57\x8f\xf4\xae\xe2\x5e\x6f\x1a\x8a\x79\x9f\x2d\x14\x41\xfb\x3e\x8d\x3a\x56\xba\xba\x95\xb6\x01\x3a\x43\x6f\xe8\xf5\x06\x5e\xcf\xdb\x3d\x1f\x0c\xc7\xbb\x83\xf1\x70\xd4\xf7\x76\x77\x06\xa3\xe1\xbf\x73\xb0\xac\xca\xb5\x95\x1e\x7b\xe3\x9d\xbd\xfe\xce\xde\x70\xe8\x1d\x58\x3d\xd2\x12\xb3\xa0\x33\xec\xef\xf5\xbd\xfc\x87\x62\xd6\x1d\x48\x79\x39\xa7\xb9\x55\x7d\x35\x47\xdc\xa7\x84\xd3\x10\xf5\x19\x0a\x16\x50\x48\x84\xb6\xad\x17\x1e\x7a\x66\x41\xf8\x36\x17\x0c\xc1\x88\xe7\xcc\x58\xbb\x3a\xdb\x01\xe4\x8b\x29\x85\xcc\x7a\xd7\xa7\xd6\xcf\x5a\x64\xb8\xb4\xd8\x2b\xb8\x19\x58\x68\xd5\xd6\xd3\x00\x9d\xe1\x2b\x8b\xa9\xea\x0b\x6f\x94\x1a\xba\x4b\x57\x80\x81\x67\x79\x9a\x8a\xd5\x16\x2a\xbf\xb9\xcb\x1e\x80\xce\x9b\xc1\xe8\x45\xa7\xd0\xb2\xa9\x10\x41\x61\xd8\xda\xba\x5a\xa0\x33\x18\xee\x8c\x76\xf7\xf6\x0f\x9e\x7a\x83\xa1\x3d\x7a\x5e\xf0\xca\xda\xd0\xb6\xcc\x7a\xa9\xaa\x18\x3f\x37\x59\x3f\x67\x4a\x38\x3c\x2c\x39\xa6\xeb\x30\x3f\x0a\xb2\xaf\x21\xc8\xbe\xaa\x1c\x2b\x16\xd8\x06\x1d\x68\x5e\x92\xb0\xf4\xda\x34\xbb\x3c\xcb\x5a\x2b\x33\xc3\x2a\x91\xd7\x42\xec\xb4\xaa\x87\xd6\xb0\x57\x02\x74\x85\x42\x1a\x5b\x2e\x9b\xc2\x9d\x30\x70\xce\xb0\xf5\x2a\x4a\x25\xed\xf7\x77\xeb\xdf\x00\xfc\x59\xf8\xa4\x27\xb8\x19\x6c\x95\xbe\x2d\x4e\xd0\x19\x74\xca\x0d\x9a\x44\xe6\x9f\x1d\x75\x6f\xbd\x33\x06\x3b\x83\xd1\xee\xfe\xf0\xc0\xfb\xab\xdc\xbd\x41\x8e\xb6\xe8\x5d\x23\x5c\x77\x3c\xcf\x2b\x37\xad\xab\xd3\x63\x4d\x33\xf0\xf6\x77\xf6\x47\x83\x03\x4f\xfe\x55\xe6\x72\x48\xe9\x36\x93\x14\xa5\xb5\xab\xc3\x2a\xa1\x5d\xee\x53\xaa\x26\x01\x2a\x6b\x66\x55\x7e\x00\x1d\xb6\xa0\x1c\x5e\x56\x26\xae\x16\x6b\xa8\x8e\x53\xa9\x18\x03\xfe\x04\x16\xb1\x46\x07\xbb\xfb\x7b\x55\x32\xb9\x0a\xb3\x54\xc7\x76\x14\x53\xa9\x36\x72\x94\x3a\x29\x31\xb1\xfc\xcb\x8a\x90\x54\x7f\xd1\x45\x49\xca\x3f\xfc\x51\x45\xb4\x58\x2b\x02\x74\x75\xc1\x87\x62\x16\x5b\x01\x55\x1d\xc0\x70\xd5\x0f\xa8\xd9\xbf\xae\x22\x08\x9d\xe2\x49\xe8\x32\x20\x0a\xdf\x95\x36\xe3\x61\x04\x3f\x53\x02\xde\xa3\x69\x9a\xc4\x6a\xb5\xad\x4a\x9f\xea\x65\xf8\x16\xa0\xda\x37\xd1\x33\x40\x1d\x27\x5b\x09\xb4\x77\x67\xe0\x08\x72\xb1\x05\xac\x8b\xa5\x4d\xb0\x81\xa6\xeb\x9b\xe0\xf7\xcc\x58\xea\xfc\x91\x9f\x1b\xe9\x8d\xc6\x02\x4b\x54\xa4\x5a\x51\x6a\xe7\x03\x39\x77\x62\xf9\xb2\x87\x8e\xa0\x97\x5a\x96\x2f\x56\x80\xdf\x3b\x37\x83\xce\x16\xe8\xdc\x0c\x2d\xf0\x00\xf8\xeb\x49\x91\x59\x9a\x6e\xd6\xd4\xac\x84\xa1\x66\xb4\xec\xc1\x38\xee\x71\x8b\x84\xc5\xec\xce\x72\xea\xf5\x8c\x32\x10\x2d\x01\x8c\x63\xd7\xa5\xab\x36\x4a\x59\x45\xf5\x2a\x0e\xd1\x4a\x07\x4b\xf5\x12\xdd\x85\x6f\x0f\x2a\xdc\x7d\x67\xc4\x40\xe1\x42\x02\xe8\x9c\x1d\xf6\x06\x43\xf9\xbf\xca\xcf\xe6\x12\x9f\x1c\x52\xfe\xa3\xaa\x93\x49\x53\xbd\x97\x70\x7b\x57\x5a\xd9\xf8\x8d\xbf\xa7\x8a\xc8\xa0\xe7\x8d\x7a\xde\xfe\xf9\x60\x6f\x3c\x1c\x8d\xbd\xc1\xff\xf3\x76\xc7\x3b\xc6\x6a\xaa\x26\x89\xae\x58\x73\xc8\x27\x9c\x53\x4b\xfb\x4b\x13\x70\x73\xfd\x4b\xdf\x25\x17\xcb\x3e\x8c\xb1\x65\x54\xe5\x7d\x54\xae\x6c\x4d\x7b\x1a\x23\xa2\x55\x3e\x65\x87\x25\x62\xb1\xcd\x10\x0c\x23\xbe\xcd\x16\x14\xf2\xed\x98\x51\x41\x7d\x1a\x6e\xcb\x86\x38\xe8\x99\x63\x6a\xdb\x47\x4c\x70\xdb\xbe\x49\x13\x78\x37\x3c\x8f\x1a\xd8\x32\x45\xed\x4c\xde\xdb\x4d\xa5\xb3\x72\x1d\x1b\xe2\xd9\xf2\x38\xf8\xcf\xda\x14\x5f\x8b\xe9\x9b\x6e\x2a\xdc\x85\xd4\xd5\x9b\x00\x8f\x24\x2f\x64\x23\x56\x52\xb1\x6b\xa8\x5d\xcd\x28\x9c\xa8\x33\x7c\x32\x19\x83\xdc\x42\x45\x6c\x32\x65\xf4\x12\x31\x41\x63\xec\x9b\xcc\x92\x89\x52\x05\xa5\xb2\x57\x7c\x8d\x11\x28\xe7\x76\xf4\x19\x4f\x30\x9d\x98\xd8\xb7\x19\x2c\x75\xf1\x58\x6d\xd5\x88\x63\x30\x49\x15\x51\x36\xa1\xb3\x19\x47\x82\xdb\x3b\xbf\x94\xa2\xdc\xb3\x12\x15\xc1\x60\x6f\x30\xd8\xdb\xf7\x86\x52\xe9\xf7\x0a\x47\xb5\xf1\xd8\x1f\x8c\x06\xbb\xa3\x55\xbd\xf7\x6a\x7b\xef\x1e\x1c\x1c\xac\xea\xfd\xb4\xb6\xf7\xfe\xde\x70\x68\xaf\x8b\x23\x95\xf6\xe1\xae\xcc\xca\x55\xa8\xac\xc0\xc8\xf3\x5e\xa8\x47\xf3\x56\xe9\xa0\x5a\x0a\x78\x3b\x15\x39\x60\xbd\x63\x08\x9a\xb7\xbd\x0a\x1d\xf1\xed\xc2\x20\xea\xb5\x49\xd0\xf9\xf9\xf0\xe5\xcf\x87\x67\xbd\x57\x3f\xbe\x3a\xef\x15\x7e\xcf\x3c\x04\x67\x4b\xe2\x2f\x18\x25\x34\xe1\x00\xfa\x69\xaa\xa5\xaa\x18\x98\xaa\xa9\x3a\x5a\x07\xa5\xa5\xf3\x83\x2a\x25\x92\x45\xd8\xac\x4d\x6f\xbf\x40\x09\x3a\x03\xfc\xfe\x18\x47\x9f\x7e\xf4\xd9\x8b\xe4\x97\xbd\x01\x7c\x77\x73\xfc\xef\x4f\xcf\xce\x3f\x9d\x9c\x1a\xc9\x33\xf2\xbc\xd4\x81\xf6\x48\x1f\x37\x7d\x8e\x75\x74\xb0\xc5\x0e\x52\x43\x0e\x37\x40\xa2\x61\x33\x85\x86\x2e\x02\x69\x6f\xa8\x7a\x79\x00\x32\x8e\x0a\xc1\xef\x31\x78\x47\xd2\x4a\xa0\xea\xf6\x75\xc1\x05\xa5\xf3\x46\x2b\x2e\xc2\x31\x28\xce\x39\x06\xab\xa6\xc8\x53\x9a\x7d\x1a\x26\x11\xd1\xe1\x62\x39\xb8\x89\x6e\x82\x2e\x0e\xba\xfd\xdc\x2d\x65\xb7\x53\x21\xff\xb1\xf1\x66\x6e\x99\x94\x9b\xa2\x43\x34\xfd\x56\x5b\xcd\x7d\xf0\x56\x07\x70\xf5\xfa\x8c\x01\x0e\xc0\x0f\x60\x60\x13\xa7\xbc\xda\xe1\xfb\x17\x3f\x26\xcb\xe9\x31\x3b\x22\x37\xec\x10\x45\xfb\xc3\xd1\xfc\xd3\xe5\x25\x7e\x71\x95\xad\xb6\x85\x45\x3b\xf5\x59\x8d\xbc\xe3\xdd\x7d\xd1\xed\x31\x1c\x8b\x6e\xff\x9c\x2d\x7a\x0a\x62\x71\x23\xd4\x12\xc0\x7f\x7a\xe0\x2d\xc4\xd5\xfc\xca\x27\x4f\x2f\x67\xbb\x83\xc0\x23\x9e\x0b\xf3\x36\x46\xbb\xc6\xbb\xaa\x37\xad\x8f\xf7\xa0\x19\xef\x81\x03\x6f\x0d\xe0\x26\xb0\x5e\xf1\xf6\xbd\x73\x87\x6f\x00\xe9\x61\x33\xd2\x43\x17\xd2\x91\x06\x55\xe5\x98\xe7\xb2\x2d\x7d\x06\x05\xd7\x13\xa0\x05\xdf\x97\x1f\x67\x77\xe1\xbd\x7f\x77\xb4\xf7\x1b\xb1\xde\x77\x20\x7d\x9e\x17\x62\x41\x01\x60\x88\xd3\x84\xf9\x08\x04\x14\xa9\xa4\x12\x74\x93\xdd\x51\x1a\x79\x23\x75\xd4\xaf\x08\xb1\x7f\x3b\x54\x4c\xec\xca\x60\xa0\x92\x70\x70\xf0\x43\x77\x80\x7f\xde\x09\x92\x5f\x3f\x1c\x5f\x5d\xed\x7e\xb8\xfa\x25\x5c\x7e\x1e\x44\x3f\x9e\xee\xfc\x6b\xf9\xe9\xa4\x9b\x3f\xf1\xdf\x70\x84\x7d\x78\xbd\x3f\x1f\xce\xf7\x7e\x3a\x0f\xde\xfd\xfc\x0e\x0e\x2f\xf9\x4f\x07\xc3\xcb\xb7\x2f\x76\x96\x29\x5d\x06\x6d\x8e\xf6\x0d\x30\xf5\xa0\x99\xa9\x07\x2e\xa6\xce\x0f\xa6\x2b\xc4\xf0\x6c\x09\xfe\xf5\xfe\x5c\xdb\xf8\x63\x70\x9a\x5e\x07\x91\x96\x35\x65\xf8\xb3\xb9\xde\x2f\x7f\x6d\x47\x99\x9d\x77\x8b\xa3\xc5\x75\xf4\xdb\xb3\xf8\xfd\x9b\xd9\xf1\x30\x3c\x41\x97\x71\x30\xfa\xf7\x8b\x94\x32\x3b\x2d\x28\x33\xba\x3b\x61\x46\x8d\x74\x19\xb9\xc8\xc2\x11\x03\xdd\x19\xa5\xbd\x29\x64\xdd\x54\xd5\x49\xe9\xa0\x0f\x61\xe8\xfb\xfa\x41\x85\xac\xd0\x40\xbf\x41\x04\x7c\xd8\x79\x87\x8f\x16\x9f\x89\x45\x8b\x8f\x71\x30\xfa\xf0\x3c\xa3\xc5\x2b\x78\x63\x72\xf0\xd2\x48\xd1\xa9\x76\x4b\xb6\x20\xd2\xee\xdd\x89\xb4\xdb\x48\xa4\xdd\xd5\x44\x5a\xc0\xac\x90\x8d\x95\x15\x98\x97\x60\xdf\x03\xd0\xa4\x18\xea\x78\xbf\x94\xa5\x09\xc1\x82\xaf\x24\xdb\xe5\x8d\x24\xdb\xaf\x6f\xd0\xf1\x90\x9e\xa0\x8f\xc1\xce\x6f\xcf\x32\xaa\x9d\x23\x16\xf1\x13\x2a\x0e\xcd\xd3\xde\x6d\xf6\xda\x70\x03\x7b\x6d\xd8\xbc\xd7\x86\xce\x53\xd3\xec\x27\x21\x61\x06\x0b\x78\x85\xcc\xab\x69\x88\x80\xf4\x69\xf2\x5a\x5a\x5c\xfe\xf6\xfc\xf3\x7b\x45\x82\x94\x16\xbf\x5c\xbd\x7c\xfa\xf1\xd5\xdb\x0f\x29\x2d\x9e\x9e\xc0\x08\x3d\xa7\x64\x16\x62\xbf\x8d\xd3\x77\x67\x6f\x03\xda\xc3\x5e\xb3\xf6\xb0\x57\x27\x88\xb3\x42\xe0\x4a\x49\xc5\x1c\xc0\x50\xa5\x15\xa9\xc2\xe4\xb5\x44\xd8\xbb\xfc\xe0\x49\x86\xf8\x9c\x53\xe3\x03\x5a\x04\x3b\x47\x46\xa4\xec\x7a\x5e\x0b\xc4\x9f\xde\x1d\xef\xa7\x8d\x68\x3f\x75\x4a\xda\xbc\xf6\x3c\x2a\x4e\x57\x11\x9c\xe8\x28\x5d\xdb\xbd\x0f\xf3\xc5\xec\xd5\xd3\xf9\x8f\xa7\xfc\xa7\xab\xa3\xf7\x19\x96\xad\x8f\xda\x6f\x82\xab\xce\xe2\x4c\x9f\xcb\x07\xd2\x24\xe4\x48\x8c\xc1\xeb\xe7\xaf\x7a\x47\xbf\xf5\x9e\x8e\x4d\xb4\x5d\xbf\x6f\x2f\x31\xc9\xdb\xa0\x1b\xd1\x2b\x64\x38\xdc\x78\x3b\x21\x09\xc2\xe8\x93\xf7\x69\xe6\xef\x73\x2c\xe0\x2e\x0f\x3f\x5e\x1d\xd8\xbe\x0b\x69\xe4\xa4\x0c\x25\xd1\x1e\xcc\x77\x83\x83\x83\x4f\x5e\xc8\xfc\xe0\x6a\x34\xdf\x87\xe1\x74\x9f\x87\xb3\x39\xf9\xb8\x13\x2c\xa6\xfc\xe3\x7f\xfd\x9f\xff\x3e\xfa\xed\xfc\xf4\x10\x7c\xaf\x71\xec\x2b\xa2\xfc\x90\x17\x6b\xb5\xc6\xc6\x1c\x74\x47\xde\xa8\xbb\xa5\xb0\x57\x1f\x9f\xff\xf2\xee\xec\xfc\xe8\x34\x3d\x40\xbc\x51\x57\xa5\x85\x66\xeb\x68\x57\x7d\x95\xed\x07\xf3\x5d\xca\x76\xbd\x2b\x9c\x78\xfb\x14\xc9\x55\x5a\xb0\x4b\x7f\xb8\x17\xcc\x67\xe2\xe3\x00\xfa\x5d\xdb\xed\x93\xd6\x99\xec\xae\x42\xc2\x52\x4f\xfe\xa7\xe9\x14\x3e\xe7\xef\xd9\x72\x8f\xf0\x4f\xd3\x21\x3f\x89\x5e\x7e\xdc\x9d\xfe\x16\xbf\xd8\x7f\x0e\x3b\x4f\xfe\x37\x00\x00\xff\xff\x06\x32\x07\xb8\x6e\xfb\x00\x00")
+var _kasFleetManagerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x7d\x6b\x73\xdb\x46\xb2\xe8\x77\xff\x0a\x5c\xee\x3d\xc5\xdd\x5c\x91\x22\xf5\xb2\xac\xda\xdd\x2a\xd9\x92\x13\x6d\x62\xcb\x91\xe4\x78\xb3\x5b\x29\x1a\x04\x86\x24\x2c\x10\xa0\xf1\x90\xcc\xe4\xe4\xbf\xdf\xee\x79\x00\x33\xc0\x00\x1c\x52\x94\x44\xd9\xc8\x9e\x53\x89\x88\x79\xf4\xf4\xf4\xf4\x6b\xba\x7b\xc2\x19\x09\xec\x99\x77\x64\xed\x76\x7b\xdd\x9e\xf5\x17\x2b\x20\xc4\xb5\x92\x89\x17\x5b\x76\x6c\x8d\xbc\x28\x4e\x2c\xdf\x0b\x88\x95\x84\x96\xed\xfb\xe1\xad\x15\x87\x53\x62\x9d\x9d\x9c\xc6\xf8\xd3\x75\x00\xbf\xd0\xd6\xd8\x21\xb0\x42\x36\x9c\xe5\x86\x4e\x3a\x25\x41\xd2\x7d\xf6\x17\xeb\xd8\xf7\x2d\x12\xb8\xb3\xd0\x0b\x92\xd8\x72\xc9\x08\x86\x73\xad\x09\x89\x88\x75\xeb\xc1\xb7\x21\xb1\x5c\x2f\x76\xc2\x1b\x12\xd9\x43\x9f\x58\xc3\x39\xce\x64\xa5\x31\x89\xe2\xae\x75\x36\x82\xf1\xb1\x2d\x4e\xc0\xa1\x83\x79\x09\x99\x31\x48\xf2\x91\x5b\xb3\xc8\xbb\xb1\x13\xd2\xda\xb2\x6c\x17\xd7\x40\xa6\xd8\x14\xfe\x6d\xb5\xa6\x76\x60\x8f\x89\xdb\x81\x31\x6f\x3c\x87\xc4\x1d\x00\xb2\xc3\xdb\x77\xe7\xf6\xd4\x6f\xc1\x5a\x7d\xf2\xcc\x0b\x46\xe1\xd1\x33\xcb\x4a\xbc\xc4\x27\x47\xd6\x8f\xf6\xe8\xda\xb6\x2e\x59\x27\xeb\xb5\x4f\x48\x62\xbd\xa1\x43\x45\xd0\x08\x00\x8e\xbd\x30\x38\xb2\xfa\xdd\xc3\x6e\x0f\x7e\x70\x49\xec\x44\xde\x2c\xa1\x3f\xd6\xf4\x65\x6b\xb9\x20\x80\xdb\xe3\x77\x67\x08\x24\x83\x8f\xf7\xf1\x82\x38\xb1\x03\x80\xb2\xfb\x0c\xe1\x85\x59\x10\xa4\x8e\x95\x46\xfe\x91\x35\x49\x92\x59\x7c\xb4\xbd\x0d\x0b\xe8\x22\xb6\xe3\x89\x37\x4a\xba\x4e\x38\x85\x26\x05\x08\xde\xd8\x5e\x60\xfd\x75\x16\x85\x6e\xea\xe0\x2f\x7f\xb3\xd8\x70\xfa\xc1\x60\xce\x31\x59\x34\xe4\x25\x34\xf2\x82\xb1\x76\x20\x18\xc7\x0f\x1d\xdb\x9f\x84\x71\x72\x74\xd8\xeb\xf5\xca\xdd\xb3\xef\x79\xcf\xed\x72\x2b\x27\x8d\x22\xa0\x1d\x20\xa2\x29\xac\xe0\xd9\xcc\x4e\x26\x14\x03\x08\xe6\xf6\x35\xa2\x28\x1e\x4c\xc7\xd3\x64\xfb\xa6\x7f\x44\x7b\x8f\x49\xc2\xfe\xc3\x42\x02\x8c\x6c\x1c\xe6\xcc\x3d\xc2\xdf\x7f\x61\x7b\xf4\x86\x24\xb6\x6b\x27\x36\x6f\x15\x91\x78\x16\x06\x31\x89\x45\x37\xcb\x6a\xed\xf4\x7a\xad\xfc\x4f\xcb\x72\xc2\x20\x01\x28\xe4\x9f\x2c\xcb\x9e\xcd\x7c\xcf\xa1\x13\x6c\x7f\x8a\x01\x58\xe5\xab\x65\xc5\x0e\x50\x9d\x5d\xfc\xd5\xb2\xfe\x6f\x44\x46\x47\x56\xfb\x2f\xdb\x80\x55\x98\x19\xc6\x8d\xb7\x59\xdb\x78\xbb\x00\x62\x5b\xea\xac\xa0\x85\xb7\xb3\xa6\xea\x5a\xe2\x74\x3a\xb5\xa3\xf9\x11\xd0\x53\x92\x46\x41\x4c\x09\xfe\xa6\xd8\x56\x8f\xbe\x6d\x12\x45\x61\x14\x6f\xff\xe1\xb9\x7f\x2e\x44\xe5\x29\xb6\x7d\x39\x3f\x73\x37\x11\x89\x14\xb8\x4a\xd4\x7d\x0f\x67\x8f\x2e\x15\x99\x4b\xb6\x00\x2d\xe6\xb2\x66\x9e\x68\x06\x24\x2f\x2d\xb1\xc3\x5a\xc4\xfc\x87\x99\x1d\xd9\x80\x64\x7e\x46\x45\x13\x06\x69\x4b\x81\x34\x6f\xb9\xed\xb9\xad\xfa\x0d\x31\xdb\x8b\x78\x63\x37\xe2\x27\x2f\x4e\x2a\x37\x03\x3f\x5a\xe1\xc8\x9a\x85\x71\xec\x21\xc3\x57\x10\xaa\xdd\x14\xbf\xd8\x05\xd9\xa6\xd2\xad\x62\x93\x2a\xb0\xcc\xfe\x34\x23\x7b\xca\x93\x37\x95\xec\x29\x70\x17\xe4\x73\x4a\x54\x84\xe3\x3f\xe4\x8b\x3d\x9d\xf9\x32\x9c\xe2\x1f\xb9\x17\x1c\x8d\x0b\xbe\xa2\x53\xd6\xa1\xdc\x5e\x0f\x83\x18\x5f\x01\x82\x8f\xd1\x36\x9d\xf3\x83\x97\x4c\x5e\xdb\x20\x7a\xdd\x57\x11\xa1\xb8\x01\x11\x93\xa4\xf1\x3a\x60\xa9\x19\xb7\x92\x38\x99\x04\x8e\xd8\x00\xd6\x28\x4c\x03\x97\xf2\x8c\x93\x7c\xb3\xf7\x7a\xfd\x0d\xe1\x71\xf5\xbb\x0c\x70\xae\x8a\xc5\xbc\x6b\x25\xa2\x8e\xd3\x64\x02\x9a\xcb\x35\x09\x50\x9b\xf1\x82\x1b\xdb\xcf\x38\x26\x45\xd2\xee\x13\x41\xd2\xee\xea\x48\xda\x5d\x84\xa4\xf7\xa0\x27\x59\x41\x98\x58\x36\x60\x2b\x8c\xbc\xdf\x99\xf6\x6a\x3b\xa0\xdc\x31\xce\xc6\x15\x52\x19\x71\x7b\x4f\x04\x71\x7b\xab\x23\x6e\x6f\x11\xe2\xde\x86\x85\x93\x78\x0b\x7c\xc2\x8a\x67\xc4\xf1\x46\x1e\x20\xf1\xec\x04\x40\x03\xa1\x10\xe7\x88\xdb\xdf\x18\xd5\xa3\x1e\x71\x00\xe7\xaa\x88\xcb\xbb\x56\x53\x5c\x40\xbe\x00\x96\x12\xc0\x11\xd3\x64\x42\x87\xaa\xd3\x99\xce\x43\xe0\x4f\x2f\x99\xcb\xb2\xf2\x25\xb1\x23\x12\x1d\x59\xff\xb5\x7e\xab\x12\xc2\x76\x61\x3b\x72\x96\xe8\x12\x1f\x94\x1a\xad\xf0\x64\x9f\x8a\xf2\x53\xaf\x31\x79\x00\x3b\x0c\x1d\xcd\xa5\x85\x05\xd0\xee\x08\xcc\xd0\x79\xe0\x54\x2d\xf7\x1d\x89\x46\x61\x34\xa5\x47\xc9\xa6\x46\x0e\x8c\x84\x86\x28\xed\x35\x89\xc2\x20\x4c\x63\xb4\xae\x02\x6a\xad\xd4\x6d\x73\x32\x9f\xc1\x6c\xc3\x30\xf4\x89\x1d\x48\x5f\x70\xc9\x1e\x20\xf0\xc8\x4a\xa2\x94\xd4\x2a\x01\x3b\x8f\x43\x80\xe7\x02\xe9\xe6\x44\x98\x75\x59\x95\x14\x8b\x03\xc8\x53\x4f\x88\xed\x2a\xdb\x8b\xff\xfc\x14\xb2\x55\x17\x67\x52\xb6\xf3\x0a\xb6\x11\xcd\x3d\xd4\xf5\x70\x4b\x33\x72\x02\xdc\xdb\xce\x35\x5a\x9e\xf8\x33\x25\x2c\xf8\xd5\x10\x77\x6c\x67\xe3\x24\x82\xfe\x55\x84\x74\xc2\x87\xa4\xbc\x79\x06\xc7\x67\x8b\x4d\x49\xa8\xf8\xc7\x49\x23\x7a\x14\xe0\xef\x0c\x28\x99\x69\xf7\x9e\x08\xd3\xee\x89\x85\xae\xce\xbc\x8b\x43\x54\x1b\xac\xa8\x12\xb0\xed\x63\xea\x78\x91\x19\x35\xca\x54\xa3\x4c\x35\xca\x14\x53\xa6\xe8\xa1\x22\x77\x50\xa9\x94\x01\xbe\x51\xc5\xea\x6e\x48\x2c\x0e\xb0\xba\x92\x25\xd4\x27\x36\x5c\x9d\xfa\x64\xa6\x91\x81\x4c\x74\x26\x47\xc5\xd1\xdf\xcf\x80\xbb\x92\x6c\x70\xe1\x36\x56\x9c\x57\x66\xfa\x9e\xa2\xb6\xa5\x74\xd8\xb2\xdb\x83\x82\xfe\x32\x74\xa5\xb1\x54\xac\x30\x70\xc2\x5b\xd0\xb5\x50\x80\x53\x27\xcb\xb3\x1a\xaa\xa9\xa7\x19\x3d\xc5\x2c\x74\x86\x30\x28\x4a\x2e\x91\x25\xb4\x38\x95\xda\x35\xde\x01\x86\xa0\xa2\x5f\xe0\x49\x79\x7d\xde\x85\xf1\xfd\xba\x7d\x4a\x2a\x91\x82\xc7\x97\xb6\x2b\x08\xea\x09\x30\x96\x37\x5e\x1c\x83\xea\xf8\x4e\x18\x2e\x77\x50\x9d\x2a\x86\x6a\x57\x2b\x44\x4b\xe8\x09\x4f\x59\x7b\xb2\x96\x52\x9f\x4a\x1a\x51\x59\x51\x00\xfc\x48\xba\x42\xbc\x50\x57\xf8\x66\xb4\xaa\x92\x52\xa4\xd7\x0f\x98\xeb\x93\x6a\x07\x14\x5d\x92\x86\xf0\xed\x79\xa7\x4a\x3a\xd0\x52\xea\xc0\x37\xe2\x8d\x2a\x3b\x76\x8c\x2e\xc2\x16\xde\xd0\x6c\x83\x98\x4e\xc2\x88\x03\x38\xc3\xdb\x65\x9d\xda\xc2\x5b\x15\xf5\x16\xc9\x95\x45\xbf\xa3\x32\x16\xa7\x40\xce\x81\x0b\xfb\x55\xd6\x9c\x32\xf2\x56\xb6\xf8\x15\x7e\xf6\x63\xc5\xfb\x80\x3a\x4e\x49\xf7\xba\x9d\x78\xce\x04\x99\x4f\x3e\x87\x9b\x46\x45\xc7\x85\x35\x8e\x6c\x68\x0c\xe0\x7b\xa1\x8b\xe3\x78\x49\x9c\x8f\x81\xfe\x8a\x2d\x5c\x4f\x3a\xc5\x8e\x5e\xd2\x5d\x4a\x99\x5b\x51\xab\xe1\xf8\x7b\xd2\x6a\x0d\xdf\xe3\x47\xd4\x6c\xd0\x83\x55\x20\x09\x2e\x89\x70\x95\x6e\xea\x03\x82\x47\xc0\x22\x34\x2e\xac\x0d\x76\x1a\xdd\x41\xe3\xa1\xc8\x78\x1b\x26\x97\xe2\x3c\x34\x2a\xcf\x2a\x0e\x23\x03\x8d\x67\x29\xd7\x48\xa3\xee\xac\xe8\x0e\x69\x74\x9e\x46\xe7\x79\x00\x9d\x87\xab\x0f\x0b\x74\x1e\xde\xaa\x52\xe7\xe1\x4c\x37\xd6\xfa\x88\xf4\x9a\xce\xa5\x63\xc3\x42\x2d\x37\xbc\x0d\xa0\x57\x3b\x22\xb6\x3b\x6f\x6b\xb4\x1c\x9f\xd0\x20\x4c\xa6\xa0\x80\xba\x03\x72\x17\xe3\x07\x75\x02\x70\x1a\xde\x10\x1a\x2d\x0a\xe3\x71\x88\xa1\x5b\x1b\xfa\x50\xf7\x55\x40\x63\x35\x03\xb5\x01\x71\xc5\xf7\x90\xca\xd0\x84\xea\x54\x08\x9b\x4b\x61\x7b\x10\x95\x28\xd7\xe1\x9e\xb0\x4e\xc4\x69\x60\x23\x75\x22\x4a\x5d\xdf\x8c\x0a\x74\x81\xab\x6d\xd4\x9f\x46\xfd\x69\xd4\x9f\x46\xfd\x69\xd4\x9f\x05\x2e\x9f\x74\x6a\xe0\xf1\x81\x46\x75\x0e\x1f\xf8\xbc\x9a\xbf\x87\x6b\x41\x75\x5d\x6d\xe7\xda\x4a\x67\x0b\x15\x1e\xe1\xc6\x29\xa9\x3b\x74\x00\xd1\x86\x2a\x59\x55\xfa\x0e\xce\x52\xc8\x0c\x29\xbb\x97\xec\x0a\xe7\xd2\xc4\xbe\xa1\xc9\x3b\x43\x92\x7b\x78\x70\x18\x98\xf2\xa1\xdc\x4a\xb0\x0b\x4f\xdd\xab\x04\x4b\xd8\x4c\xa7\x52\x46\x06\x61\xc4\x28\xe6\x9b\x76\x32\xd1\x9d\xc2\x24\xb2\x46\xcb\x6a\xb4\xac\x46\xcb\x6a\xb4\xac\x46\xcb\xaa\xd3\xb2\x68\xa8\x4e\x3c\xf1\x66\x83\x24\xb2\x83\x78\x94\xcd\x50\xa9\x72\x39\x98\x4c\xc3\x54\xae\x73\xd1\xf9\x8a\xf7\x2d\xeb\x5f\x94\xda\x19\x6b\x10\x13\x68\x2f\xcd\x90\x8b\x00\x63\x99\xc0\x67\xcc\xfa\x7d\x66\x26\x02\xd1\xfd\x14\x53\xe7\x13\x0b\x39\x4a\x83\xc4\xf3\xe9\x64\x01\xb9\xe5\xbf\xb1\x68\x5e\x15\x84\xae\x75\x1e\xf8\x2c\xa6\x37\x8b\x55\x4a\xca\xc3\x03\xb9\x61\x42\x73\x34\xb6\x03\x2f\x66\x51\xac\xb6\x0b\x9a\x9c\xe5\xc0\xcf\xe2\x24\xdb\xd2\xa8\x02\x0f\x5c\xf3\xc3\x95\xd0\xd5\xf2\x95\x29\x23\x49\x5a\x19\xd0\x6a\x04\xfa\x22\xd5\x90\x6c\x31\xc7\x90\x80\xfc\x26\x08\xd6\x9c\xce\x87\xed\x78\x60\xf2\xb2\x5a\xdb\xa2\xb0\x2d\xb6\xec\x8c\x14\xf2\x9d\x92\x72\x4c\x1f\x2e\x7c\xab\x44\x55\x77\x89\xe4\xea\x2f\x52\x4e\x35\xab\xe6\x08\x7b\x1c\x1e\xa3\x47\xc1\x92\x5a\x6b\xa9\xff\x9d\x34\xd6\xaa\xd1\x4c\x75\x57\x83\x20\xf0\xaf\x55\x2b\x3d\x63\x7a\xa4\x11\x06\x1b\xe5\xb4\x51\x4e\x1b\xe5\x74\xc3\x94\xd3\xbd\xde\x8b\x9a\x63\x99\xcb\x0c\x2c\xe7\xe1\x53\x27\x0e\x2d\x80\x42\x6d\xef\xb2\x46\xb1\x01\x08\x6c\xd4\xed\xc7\x54\xb7\xc9\x0d\xf6\x33\x2b\x38\x70\x4a\xdb\xd6\xd5\x44\x98\x78\xe8\xcd\x9b\xeb\x14\x6a\x9d\x02\x8d\x55\x17\x38\x23\xa4\x89\xf0\x96\x33\xb1\x83\x31\xfa\x38\x03\xd7\x8a\xbd\x71\x00\xc7\x1d\x34\xcd\x84\xa7\x51\xc6\x7a\xad\x78\xcb\x9a\x82\x69\x00\xbc\xc2\xc1\x02\x2d\xb4\x3c\x50\xd7\x3a\xb5\x9d\x89\x45\x17\x87\x1f\xc2\xc8\x8d\xad\xdb\x49\x08\x7a\x6b\x8a\x61\xb7\x5e\x42\xa7\xb8\x9d\xcc\xef\xd9\xdf\x78\x9c\x95\x89\x60\x30\x13\x19\x85\x0f\xaf\xc6\xd1\x1d\x54\x0b\x61\x34\x72\xbe\x91\xf3\x8d\x9c\x6f\x9c\x50\xdf\x94\x54\x94\x9b\xb6\xab\x9a\xce\xec\x71\xb6\x21\x0b\x1b\xc7\x70\xea\xdb\x75\xf2\x76\x50\xe9\xd8\x5a\x24\x76\x4b\xb6\x5a\x5c\x5d\x11\x41\x95\x36\x65\x57\x42\x5c\x2f\x86\xb3\x66\x58\xad\x0e\x84\x1d\x4f\x51\xc1\x4f\xd4\x75\xc4\x2f\x0a\xa5\x76\x5a\x89\x1c\xe7\x3d\x00\x84\x18\xdd\x56\x99\x0b\xa3\x6b\x9d\x97\xdc\x57\xa8\xaa\xc6\x21\x70\x49\x42\xab\xec\x19\xcd\xc0\x7e\xf6\x54\x1f\xd6\x03\x4b\xf3\x6a\xfc\x6e\x80\x87\xa6\x11\xf3\x8d\x98\x7f\x30\x31\xdf\x48\x2b\x93\xca\x38\x75\x85\xf9\x8c\xc4\xd0\x7d\x09\xa2\x25\xaa\xce\x95\xf8\x8c\x3e\xcc\x45\x2d\xd1\xa3\xf1\x69\x2f\x9b\x6e\xbe\x72\xb0\x87\x66\x6e\x5d\x09\xb5\xc6\xa3\xde\xf8\x83\xd7\x21\x40\x4c\xcc\x1d\x0d\x49\x36\x96\x4f\x23\x4b\x96\x90\x25\x35\x15\xce\x1c\x9a\x16\x6a\xce\xab\x45\x1a\xa9\x21\xaf\x56\x76\xc3\xe4\xd2\x7a\x8b\x57\xaa\x62\xfa\x7f\xd6\x28\xbf\x7c\xaf\xbb\xd3\x66\x8b\x11\x55\xbc\xf3\x8b\xed\x47\x12\x1c\x0c\x1c\x9f\x6c\xb0\xf0\x30\x8d\xda\xd3\xac\x8e\xc7\xef\x21\xa6\x37\xe1\x5e\xa0\x11\x38\xf7\x64\xb1\xf0\x43\x95\xd4\xd0\x42\xe3\xa9\x6c\x44\x77\x23\xba\x9f\xc8\x55\x5e\xa5\x45\xb7\xcd\xe2\xb3\x16\xc4\xcf\xb1\x46\xe6\x0a\xc3\x31\x8f\x5f\xbb\x93\xc2\x90\x87\xc3\xa1\x98\x67\x20\x60\xda\x41\x96\x88\x29\xc5\xb0\x41\xf3\xcf\x69\x98\xd8\x15\x41\x71\xc0\xff\x41\xc2\xe3\xe3\x0f\xae\x65\x8f\x6d\xfc\x5d\x9d\x62\x8b\x5e\xfd\x0d\x09\x20\x97\xbb\x26\x6b\xd4\x95\xc7\x52\x2d\x44\x28\xdd\xd7\xac\x59\xf0\xcd\x6d\x94\x8b\xaf\x46\xb9\xe0\x1b\xab\x9e\x68\x60\xed\x13\x9b\x7d\x20\x41\x98\x8e\x27\xfc\xf8\x6e\x6c\x08\x4a\xa3\x6a\x7c\x65\x71\x50\x57\x0b\xe2\xa8\x91\x3c\x59\xac\x09\xc6\x99\xd0\x70\x6f\xd9\x24\xbd\xb5\xe3\xc7\x8d\xb9\x6d\x42\xa4\x36\x5b\xaf\x72\x89\x83\x0f\x90\x2d\x50\xac\x78\x2b\x73\xcd\xea\x84\x75\x58\xab\x6a\xc5\x81\x28\xbb\x50\x0c\xd2\x17\x1e\x4b\x17\xe2\x30\x37\x5e\x96\x46\x11\x7a\xca\x8a\x50\xa3\xd9\x34\x9a\x4d\xe3\x44\x79\x2a\xc2\x3e\x22\x0c\x41\xc4\x1d\xcc\x6c\x6f\x99\xac\xc3\x8b\xac\xe7\x3b\xe8\x58\xba\x5f\xa1\x0d\x51\xa6\xe7\x33\x58\x38\x43\x1e\x47\x94\x47\x35\x79\x20\xa0\x6f\x43\x68\x39\xc6\x78\xe7\xaa\xa4\xc3\x59\xe4\xe1\xd8\x1a\x47\x08\x83\xca\xb5\x46\x51\xc8\x1e\xa0\x11\x4d\x45\x84\x25\x26\xd0\x6d\xf1\x60\x15\xa0\x13\x17\xbf\x01\xae\xe8\xc4\xca\x8f\x0c\x04\xa9\xd8\x2e\xe0\xd1\x9a\xf9\xe8\xac\xc1\xe0\x6c\x92\x24\x60\xc9\xc7\x4c\x8b\x40\x90\xf3\x35\x80\x5e\x00\x3c\xf0\x06\x0e\x35\x34\x77\xb0\x0c\x40\x90\x8f\x80\xf3\xd3\x61\x88\xe5\xf8\x29\x5e\x08\xb1\x21\xf2\x89\xf3\x8a\x15\x1e\xcb\xcd\xa2\xaa\x79\x38\xf3\x9c\x2c\x16\x4c\x2c\x0a\x00\xdf\xca\x62\xd2\x40\x34\x81\xda\x3e\x0c\xc3\x24\x06\xae\x32\xe3\xcf\x81\x5a\xf8\xb2\x27\x7c\xbb\xb5\xe7\xd4\x4d\x14\xfa\xbc\x0c\x86\x3c\xce\xaa\xbe\x1f\xb3\x34\xc6\xe2\xb6\x3f\x4a\x0e\xa3\x4a\xa3\x66\x09\x8c\xdf\xcc\xab\x48\x06\x9b\x26\xdc\x73\x8f\x58\x2d\x44\xde\xc0\xa5\x8b\x86\xc8\x9d\xef\x58\x2d\x44\x33\xd4\x57\x91\x78\xd9\xa8\xc2\xeb\x51\x85\xe1\x98\x0f\x3d\xd7\x05\x44\x11\x8f\x66\x99\x0f\x09\x4d\xbb\xc9\x83\x82\xd5\x3a\xf8\x95\x71\x94\xe8\x48\x94\xfb\x4e\xed\x2f\xde\x34\x9d\x5a\x41\x3a\x1d\x32\xd7\x8e\x1c\x70\x6c\x27\x22\x2b\x5d\x08\x41\xfa\x48\x16\xcc\x45\xe7\x44\x97\xcf\x90\x10\x4c\x93\xb7\xb1\x20\x4d\xf7\x9b\xd4\xce\x6b\x73\x07\x01\x0d\x23\x58\x72\x92\xe3\xca\x05\xee\x4f\x75\x4d\xae\x1e\x38\xfc\xdd\x52\xc4\x3d\x6a\x0d\xfa\xd8\xec\xc6\x63\xf6\x34\x94\xe8\x45\xa1\x9e\x2a\xab\x37\xce\x3a\x28\x88\xce\xf8\x61\x63\xf2\x2b\x66\x7f\x6c\x81\xdd\x44\xe3\x37\xd1\xf8\x4d\x34\x7e\x13\x8d\xbf\xd0\xed\xb0\x44\x28\xbe\xca\x61\x4c\xe2\xf0\x8b\x56\xcd\x83\x05\xe1\x17\x27\xde\x84\x08\xfc\xa7\x63\x51\x35\xb2\x62\x4d\x81\xf7\x45\x32\x6c\xbc\xce\x8d\xcc\x58\x4f\xd4\xbd\xf4\xae\xf8\x62\xb6\x5c\x7e\x82\xb3\x9a\x2d\xab\x6f\x14\x86\x40\xaf\xfa\x4c\x58\xee\xa6\x8a\xf0\x41\x27\x2c\xde\xe6\xc5\xd0\x03\x23\xe2\x14\xf7\xe8\xc9\xdb\x4b\x5e\x0f\x83\x85\xcd\x61\x55\x60\xb7\xaa\xf8\x1c\x73\x52\xd7\x85\xe7\xb3\x55\x2f\xfd\x1a\xd4\xb7\xe9\x58\x64\xbb\xee\x36\x8c\xbd\x61\xec\x0d\x63\xff\xe6\xae\x13\x59\xe0\xd0\xc8\xf6\x7c\x60\xb9\xd1\x82\xcb\x45\xd1\xcc\x50\x9e\x5c\xde\xda\x33\x66\xf3\x46\xa1\x4f\xea\x4a\x26\x94\x4e\x4a\xd5\x15\x63\x7e\x1f\x57\xac\x31\x2f\x85\x51\x4b\xf7\x70\x46\xd7\x70\x54\xe4\xcc\x42\x2f\x48\x98\xb1\x0e\x72\xa3\x7e\xb2\x69\x8a\x0f\x53\x13\x51\x8d\x7e\x75\x41\x85\x08\xc5\xaa\x4f\x88\xd4\xee\xa3\x18\x3c\x00\x00\x5e\x85\xde\x3c\x4e\x58\x48\xad\xc1\xb3\x38\x98\xa9\x72\x83\x36\xec\xcd\x9c\x46\xa4\x36\x22\xb5\x11\xa9\x5f\x9f\x48\xbd\x25\xc3\x49\x18\x5e\x0f\xe2\x74\x98\x61\xda\x2c\x40\xe7\x03\xeb\x79\x29\x75\x2c\x3f\x48\x47\xbf\x0d\xa9\x41\xc6\x67\x12\x11\x22\xbe\x37\x22\xce\xdc\xf1\x09\xaf\xdc\x27\x24\x0e\x46\x92\xa4\x91\x64\x7d\xc9\x72\xa7\x4a\xa6\xf2\x21\x98\x8d\xe6\x7b\x20\x0a\xd0\x3e\x8b\xad\x57\x7e\x98\xba\xac\xb8\x22\x9a\x2e\x20\x37\x53\x27\x49\xf1\xe3\xbf\x2e\xcf\xdf\x5a\xd3\xd0\x25\x5b\xb4\x1c\x22\x91\x1f\xbf\x26\xb0\xc0\x44\x4c\x2f\x23\x66\x2b\x7f\xf0\x37\x44\x71\x19\x51\x27\xa0\x74\x1f\xea\xd8\xbe\xdf\xb5\x5e\x33\x89\xc4\x21\xf1\x78\xe0\x0e\x34\x8e\x3c\x31\x0f\x08\x4e\xa0\x65\xba\x51\x9e\xed\xd3\xd7\x61\xc2\xd1\x88\xc9\xec\x34\xf2\x99\x78\xc6\xdb\xd9\x49\x92\xcc\x58\xd5\x46\x1e\x62\xc3\xca\x8d\xcf\xd2\x21\x50\xb4\xa8\x25\xc5\xa5\x77\xb5\x90\x8e\xc5\x46\xe4\xfb\xb0\xee\x88\x1c\x4e\x0e\x0a\xbe\x1e\x3c\x20\x47\x43\x93\xf7\x54\x51\x5c\xbb\x5c\x7e\x3d\xfe\x18\xec\x48\xb3\x72\x73\xe6\xa4\xe9\xbc\x2a\xb3\xaa\x1e\xaa\x89\x64\x69\xae\x17\x8b\x41\xdd\x5a\x8e\xd5\x5c\x2b\x36\x6a\xcf\x72\x31\x15\x1a\xa6\x63\x10\x53\x81\xd2\xfd\x56\xc3\xc6\xeb\x34\x8f\x7b\x0f\xb5\xd0\x02\xb4\x21\x02\xa5\x89\xb4\x68\x58\x61\xc3\x0a\x9b\x08\x8b\x2a\xb3\xd1\x2c\xc0\x42\xc3\x58\xea\x03\x2c\x74\x2c\xf1\x21\xe2\x2b\xb4\x2a\xfe\x23\x87\x57\xd4\xaa\xf9\x0d\x47\x6e\x38\xf2\x23\x64\x1e\x6a\xcf\x67\xe3\xd3\x6c\x24\xda\x3a\xe3\x3f\x0c\xa5\x46\x1e\xff\xb1\x50\x6a\x94\x73\xff\x58\x0d\x1d\xd9\x6f\xa7\xf1\x01\xb2\x24\x3c\x64\x0f\x49\x42\xa6\xb4\xae\x90\x1d\xcc\xa7\x61\xb4\x96\xfa\x46\x7b\x4d\x90\x43\xc3\xed\x1b\x6e\xdf\x70\xfb\xe6\x06\xeb\x4e\x37\x58\xa2\x98\x8c\xe0\xe4\xa6\x56\xc9\x49\xd6\xa3\xee\x31\x2e\x3e\xee\xdc\xf2\xc3\x31\x8b\xfd\xd0\x9d\x0a\xcd\xeb\x59\x0f\xed\x45\x72\x8b\xcb\x79\x0c\x63\x85\xe3\x74\xde\xb8\x8f\x1a\xf1\xd5\x88\xaf\x46\x7c\x35\x8f\x63\x3d\xcc\xe3\x58\x99\x88\x5b\x2c\xfd\xce\xb3\xa6\x66\xd7\x26\x4a\x00\x78\x3e\x8f\xa8\x47\x52\x1b\xc3\xf1\x88\x62\x31\x2c\x2e\xf3\x41\x09\x36\x43\x72\x23\x08\x9b\x8c\xd5\xe6\x3e\xa5\xb9\x4f\xd1\xf2\x6a\xb3\x4b\x94\x8c\x97\xd4\x5e\x9d\x04\x15\x8c\xba\xde\x0f\x96\x37\x43\xd3\xa6\x30\x86\xc8\x03\xc2\x78\xf3\x11\x89\x48\xe0\x88\xf0\x37\x62\xb5\x7f\x0a\x19\x49\xb4\xad\x09\xb1\xdd\x3c\x8a\x5c\xb0\xec\xfc\x6f\xfe\x28\x29\x8d\xa6\xcb\xea\x73\x2f\x9b\xec\xb4\xb4\x24\xc8\xb0\xf6\xd8\xd7\x38\x19\x20\xe6\xe4\x9e\x75\x59\x95\xe8\x8b\x03\x34\x12\xa8\x91\x40\x1b\x61\x92\xe5\xfc\xa6\xb1\xc3\x1a\xb1\xfd\xa8\xb9\x65\x4b\x94\xa6\xfc\xba\x12\x6e\xcd\xea\x2d\x3e\x52\x7d\x45\x0a\xdc\x3b\x7b\xee\x87\xb6\xab\x12\x5a\x15\x99\xbd\xbf\xbc\xa0\x45\x36\xcd\x28\x39\x23\x30\xd1\xad\x74\x1c\xd9\x3f\xa7\xef\x57\x1a\x55\x74\xab\x1a\xf5\x0b\x22\xcd\x4b\x2e\x41\x68\x54\x9e\x8c\xfa\x09\xca\x23\xac\x14\xee\xae\x66\x58\x3f\x74\x06\x5d\x21\x4c\x7f\x11\x1f\x51\x68\x03\xce\xeb\x05\x5f\xd2\x1d\x0b\x86\xd0\xf1\x34\x3b\xa5\xaa\x44\xc5\x92\x95\xa5\x88\xf6\xcd\x15\x53\xbd\x57\xbc\xd2\xdc\xea\xe2\xaa\x38\x44\xfb\x0e\xa1\xfc\x25\xdd\xf3\x1b\x51\x27\x97\x52\xb2\x4b\xba\xe7\x37\xa2\x3a\x4a\xc3\xbe\xb1\xbf\x1c\xfb\x7e\x78\x4b\xdc\x33\x9e\x21\x7b\xc1\xaa\x4d\xde\x61\xbe\x45\x63\x6a\x01\xb9\x22\xd1\x34\x7e\x1b\x26\x82\x07\xdc\x61\xfe\x8a\xa1\xda\x5f\x55\x0d\xd0\x92\xfa\xff\x8d\x68\xf0\x1a\xc7\x4a\xf6\xf0\x4d\xe6\x20\xb7\xdc\x90\xc4\x41\x3b\x61\x76\x4e\x75\x39\xd1\xcd\xc5\xd9\x8b\xb7\xa0\xd6\xbe\xe2\xe5\x4d\x57\xc7\x9f\x6e\x98\xf6\xba\xea\xa9\x52\x32\x15\x28\xaf\x36\xb1\xbe\x09\x47\x67\x19\x99\x81\x95\x56\x99\x93\xe8\xa0\xf3\x05\x2e\x83\xb1\x54\xfa\x82\x93\xf2\x52\xee\x3a\x4d\xdd\xfe\xf2\x60\x92\xcf\xd3\xa0\x1c\x2a\xed\x17\xd7\x55\xf2\x7b\xf0\x1a\xa9\x0a\x48\x8f\xa6\x47\x97\xae\x98\x6a\xd6\xf0\xd2\x76\x0b\xfb\xb9\xc9\xa7\xe1\x8c\xe9\x46\x3f\xa3\x75\x7d\x07\x15\x56\x33\x4c\xe3\x10\xbd\x9b\x43\xf4\x9b\x51\x4a\xef\xe8\xf9\x6d\x7c\x7b\x1b\x77\x25\x67\xd8\x3c\x8c\x5c\x7c\x5e\x6e\x99\x09\x40\xc4\x38\x93\xaa\x5b\x3f\x07\xcb\x53\x0c\x68\x4d\x41\x37\x5b\x67\x9d\x04\x64\x85\x33\x98\xc0\x89\xd3\xd9\x2c\x8c\x90\x4e\xe8\x30\x56\x36\x4c\x85\x38\xa4\xb5\x30\xde\x15\x1a\xad\x2c\x16\xdb\x20\x16\xdb\x95\x44\x7c\x21\x0a\x63\x98\x02\xfb\xa0\x54\xad\x60\x42\x95\x94\x6d\xe0\x74\xed\x86\xf3\xd7\x73\xfe\xf6\x7e\xdd\xde\x37\x0c\x6c\x43\x62\x0a\x0a\xdc\x85\x85\x44\xf3\xf7\xaf\x56\x65\x35\xbc\x7b\x9e\x18\xa3\x3f\xd6\x26\x2c\xe8\x42\x79\x89\xeb\xb1\x19\x51\xe1\x61\xb0\x87\xe7\x47\x0c\x1d\x0d\x37\x6a\xb8\xd1\xc3\x73\x23\x83\xb0\xd5\xfb\xd7\xbd\x74\x77\xa6\x58\x70\x99\xd0\x4a\x79\xca\xf5\x55\x7e\x9d\x2a\x5c\x94\x03\xbc\xef\xac\xa2\x81\xff\xed\x28\xe8\xd3\xbc\xce\x8b\xbd\x51\x6b\x1f\x79\x3e\xc0\x26\x62\x96\x52\x3f\x89\xad\xe1\xfc\x99\xd2\xfb\xe4\xf4\xdd\xc5\xe9\xab\xe3\xab\xb3\xf3\xb7\xd6\xdb\xf3\xab\xb3\x57\xa7\x14\x76\x09\x0c\xeb\xd6\xf3\x7d\xf4\x94\xe6\xd0\x3f\x33\xba\xad\x8d\x93\xc8\x0b\xc6\xda\xcb\xda\x91\xed\xc7\xf2\xfa\xf4\x44\xe3\x92\x1b\xe2\x23\xd3\x1d\x28\x00\x15\xa9\x07\x18\x45\x0a\xd3\xb5\xb2\xe6\x2d\xf5\x9e\x16\x7a\xba\x76\xe4\x9a\x0d\x22\x5a\x57\xdd\xab\x2b\x83\x80\x10\x52\xa5\xd2\x9f\xe2\x07\xc6\x7e\xff\x5c\x55\x2e\x69\xf6\x93\x55\x4d\x43\x2a\x8b\xf9\xbe\x32\xa7\x75\x81\xef\xb3\xd2\x6a\xe3\xbc\xc4\x4b\x51\x68\x89\xbb\x81\x2b\x1c\xf3\xe5\x5c\x91\x61\xc7\x01\xe7\xdb\xf7\xeb\x65\xaa\x91\x62\x6b\x5c\xf8\x83\xb2\xc2\x4b\xb1\x02\xba\x00\x05\xc7\xcb\xf8\xae\x5e\xa9\x6b\x0a\x85\x1c\x17\x97\x20\x19\xa2\x9e\xc6\xdd\xec\xfb\x20\x03\x58\x89\x19\x58\xc5\xc3\x55\x35\x56\x7b\xc1\xc4\x82\xb6\xd7\x33\x75\x61\xb4\xc6\xc7\xb6\x9c\x8f\xad\x71\x15\xad\xae\xdb\xa0\x42\x31\xb3\x93\x49\x49\x69\x50\x45\xd0\xa2\xe0\xa8\x25\x65\xb6\xb2\x43\x67\x27\x86\x96\x92\x01\xbc\x25\x5e\xbd\x76\x68\xf1\x0e\x6e\x11\xbc\xb9\xc8\xd0\x09\x7b\xee\xea\x1c\xd8\x8e\x13\xa6\xb0\x69\x25\x71\xbe\x6c\xb8\x9c\xe3\x7b\xb0\xf9\x03\xe5\xec\x57\x6b\x45\xab\x2e\x3c\x9b\x25\x5b\x3d\xbf\x22\xe7\xeb\x40\xd5\x70\x28\x2a\xb8\xde\x64\xe7\xcb\xc4\x1a\x7d\x38\x81\xca\x40\x3e\x66\x10\xab\x32\x74\xa1\x3a\xa1\x2e\x37\xae\x36\x40\x9b\xe8\x9c\xb2\x34\x02\x24\xed\xb6\x9b\x8b\x90\xe5\x2f\x42\x4a\x96\xfb\xb7\x79\x03\xbf\x48\x8c\x9b\x19\x15\x89\x3d\x56\x78\xaa\xe8\x55\x61\xd5\xa8\xec\xc2\x20\xed\x53\xcb\x23\xe4\x40\xe9\xc5\x41\xc4\x97\x05\xae\x5a\xbc\x74\x7e\x80\x88\x62\x75\xd9\xda\xa0\xd3\x2a\x32\x88\xed\x25\xc3\x72\xb5\x73\xad\x1c\x9f\xbb\x29\x92\xc5\xfc\xd4\x70\x8a\xe1\xbb\xbd\xf4\xc9\x51\xa7\x5d\x74\x88\x8a\xb4\x55\x2c\xc5\xdd\x48\xb2\x46\x92\x99\x4a\xb2\x9f\x16\xaa\x45\x8d\xe0\x5a\x9f\xe0\xd2\x24\xd8\xa8\x47\xdf\x4c\xc0\x69\xa2\xcb\x0a\xfb\x67\x68\xb3\xe8\x13\x70\xef\xe8\x3e\xff\x3a\x18\xba\x7d\x47\x26\x8e\xc9\xca\x8b\x88\x2a\xd7\x3c\x8a\x46\xd8\xf2\x25\x5f\xeb\x95\x1e\x29\x75\xda\x94\xb6\x32\xcb\xa9\x1a\xb6\xac\xed\xf7\x24\xd1\x35\xe3\xec\x56\x59\x33\x36\xd5\x99\x9d\xd9\x93\x24\x63\xef\x06\x39\xb6\xab\xa9\x95\x78\x2f\x84\xb9\xb7\x21\xdc\xad\xb6\x00\x62\x23\xd2\xbf\x2e\x91\xde\xff\x7a\x8d\x53\xeb\x0f\xeb\xcf\xaf\x57\x68\x33\x86\x74\x67\xe6\x9a\xd7\x6e\xad\xe2\xae\xc6\xe2\x7b\x1b\xd8\x1a\x49\x06\xa0\x4d\xb8\xec\x85\x25\x4d\x62\x6f\x23\xd1\x51\xa2\x77\x28\xa6\xee\xd9\x38\xbb\xc0\x39\x2c\x69\x37\x1a\x1e\xde\xf0\xf0\x86\x87\x6f\x12\x0f\xa7\x6c\x40\x3d\xd5\x60\x48\xb9\xf1\xd2\x0a\x32\x0c\x13\x8b\x0c\x2c\x71\xdc\x31\x69\x71\x59\xb6\x1e\x87\xe6\x81\xd1\x16\xb4\xce\x6f\xa8\xbc\x60\x14\x56\x19\x00\x71\x58\x8c\x80\x5e\xb0\xb2\xaf\x2c\xf4\x59\x42\x40\x13\x66\xd8\x84\x19\xae\x97\x57\xc1\xff\xfd\x05\xff\x1f\x23\xec\x62\x42\x0b\xe5\x8b\xa4\xe4\xce\xc8\x76\x30\x83\x30\x22\x3e\x4d\x1e\x26\x81\x4b\x5f\xab\x8e\x79\x9f\xea\x8a\x2c\x4c\x9f\x9b\xe2\xd5\xab\x13\x6f\xd3\x5b\xe2\x41\x64\x07\x63\xb2\x38\x54\x8c\x77\xe2\x66\xb4\x37\x05\xa0\x68\x55\x7f\xda\x9d\x5d\x38\x23\x0f\x62\x21\x54\x99\x6b\xa1\xc8\x33\xde\xb0\x51\x5e\xce\x2f\xb0\xdb\xcf\xd2\x35\xf5\x7d\xc7\x2c\xd3\x37\x4a\xed\x28\xb2\xe7\xc8\x47\xe0\xdc\xc2\x82\x26\x24\xcd\x17\x16\x0e\x3f\x01\xcd\x01\x7b\x85\x4f\xf0\x07\xf2\x57\x3b\x01\xc9\x98\x4e\x1f\x83\xec\x38\xa2\x72\x34\x35\xc1\xcc\x0d\x97\xd9\xf0\x60\xe6\xca\xc6\x6e\xca\x98\xc0\x12\x5d\x80\x9d\xe1\x01\xf4\x97\xe8\xc2\xc2\x33\xe3\xd6\xb2\x1c\x70\x49\xde\xc7\xc2\x43\x93\xe5\x59\x1e\x8b\xcb\x4c\x1a\xa6\xb7\x88\xe9\xc9\x88\x6a\xd8\x5e\xc3\xf6\x9e\x2a\xdb\x5b\x81\x21\x8d\xc0\xcc\x03\xee\x61\xa0\x8f\xd9\xbe\x9f\x9d\x62\x7c\x8d\xdd\x89\xec\x19\xb1\x87\x3e\x41\xfb\x70\x6a\x27\xdc\x4c\x64\x97\x1d\xd7\x2c\xb0\xdd\xd5\xb1\x28\x31\x25\x3f\x7c\x0f\xc4\x99\x18\xd3\x94\x16\x60\xcb\xec\x29\x21\x5f\x12\xbe\x8e\x45\x64\x89\x4d\xb7\x67\xbe\xed\x19\x13\xa4\x36\x88\x11\x38\x4b\x0d\xd8\x4f\xab\xaa\xc3\x1b\x2f\x8e\x61\x6d\xef\x04\x25\xde\x21\xf8\xbc\x62\xa8\x86\x23\x2f\xc7\x91\xf7\x0a\x97\x80\x9a\x72\x90\x9e\x4b\xdd\x71\xb4\x96\xf2\xb7\x57\xe0\xa9\x91\x59\xf7\x2b\xb3\x9e\xe5\x9f\xb0\x27\x5f\x0b\x1b\xe4\x9c\xea\x80\x17\xa2\x02\xb9\x18\x99\xb1\x49\xa6\x20\x8a\xe9\x23\x94\x1c\x89\x27\xaf\xd3\x73\xe5\x75\x69\x79\xeb\xb5\x17\x2c\x6e\x34\xc1\x45\xd4\x35\x42\x4d\x50\x0e\x8f\xa4\x71\x7e\x12\x16\x70\x16\xe9\x4f\x4c\xa0\x94\x3d\x91\xde\xef\xf2\x9f\x49\x98\xd8\xbe\x1c\x34\x9f\x90\x69\xbc\xdc\xc2\x8d\x56\x85\x50\x94\x1b\xa1\x71\x33\x96\xd2\x15\x10\xb8\xc5\xad\x28\xcc\x8b\x9b\xd1\xa5\x94\x9b\x51\x2b\x40\xfa\xb5\xd4\xcc\xd2\xd2\x51\x56\x64\x5d\x25\x12\xa6\x05\xd1\xa3\x20\xc6\x00\x85\xe4\x7c\xb4\x88\x2c\x6b\x87\xe3\x5b\x53\x46\x7f\xd5\x16\xb0\x73\xef\x96\x4e\x56\x45\x9a\x02\xd2\x8d\xad\xe1\x02\x95\xcd\x33\x3d\x69\xa0\x52\xb9\xb6\x13\x45\x86\x4c\xa4\x4b\x21\x04\x3b\xde\x01\x0b\x9a\xdd\xac\xda\xf8\xca\xe6\xf5\x04\x40\x97\xc7\x20\x94\x6b\x63\x3d\xd0\xee\x97\x0f\x3c\x6b\x0e\x1b\x0a\x2a\x06\xde\x8c\x30\x2e\x3f\x20\x01\xea\xc0\x6e\xa1\xd9\x34\xf5\x13\x6f\x60\xff\x6e\x80\x49\x30\x3d\x93\xb4\x84\x1b\x45\x1c\xb5\x7e\xc1\x74\xdd\x18\x14\x61\xf1\xda\xc2\x16\x0c\x47\x80\xe5\x02\x2d\x6c\xb1\x3b\x89\x18\x5a\xd2\xbf\x00\x42\x77\xbe\x05\xfa\x7b\xcc\x9f\x44\xcd\xfe\x1b\xbb\x61\x62\xf4\x94\xfe\x38\xb2\x3d\x1f\x7f\xc1\x3c\x67\xde\x7f\x8b\x05\x03\xc0\xe7\xdf\xac\x96\x29\xcd\xaa\xf9\x58\xf5\xeb\x10\x39\x4a\x2c\xf1\x33\x8d\xd9\x1d\x20\x40\xe0\x87\xf3\xae\xf5\x1a\x04\x2d\x97\x45\xd6\xf1\x87\x4b\x63\x08\x04\xb2\xf5\xe4\x58\xae\xa2\x6d\xf1\x34\x28\x13\x9c\x67\xe9\xe0\x52\xed\x0c\x5e\xe3\xde\x29\x5c\x09\x29\x0b\x38\x82\xd5\x75\xe0\xf0\x27\x9d\x3e\x35\x8c\x96\x59\x4f\x78\x1b\x94\x11\x59\xd9\x9a\xa6\x5a\x99\x36\x06\x64\x24\xf0\xb3\x3d\x1b\xa0\xe7\x85\x44\x83\x89\x14\x53\xb1\xb0\x37\x7d\x96\x6e\x00\x96\xa5\xe8\x9d\x46\x7e\x5d\x67\xab\x0e\xc1\x98\xc8\xcf\xcc\x44\x3a\xac\xc5\x86\xb4\x60\x48\xa4\x89\x19\x2f\xa6\x2e\xb7\x90\x9e\xaa\x22\xdd\xb1\x65\x3b\x3e\x56\x53\x07\x81\x37\xc5\xb7\xa8\x48\xe2\x74\xe9\xa0\x34\xb7\x3d\xdb\x37\xfb\x06\xe8\x9c\x9a\xa9\xb7\x70\x6c\xa5\x22\x88\x59\xf1\xc9\x51\xea\xfb\xf3\xfc\x10\x61\x15\xca\x2e\x01\x8e\xc5\xab\xa1\x62\x48\x4b\x9b\x1e\xaa\x36\x3d\xab\x44\x5d\x15\x0f\x55\x1f\xd8\x25\x34\x32\x73\xf2\x08\xeb\xae\x93\x0e\x5e\x60\x98\xa2\x19\x74\x4f\x60\x3d\xf1\x3a\x87\x04\x32\x81\x55\x22\x1a\x4a\xf9\x86\x3c\x24\x11\x79\x19\xb2\x4b\x37\xf5\xb5\xab\x29\xed\x1d\x4e\x6f\xd9\xd9\x33\x30\x39\x93\x29\x66\xb5\x83\x21\x26\xf1\x18\x64\x39\x69\xe0\xe3\xa5\x3f\xa0\x98\x95\xf6\x4c\x42\x9a\xe4\x4e\x46\xf8\x0e\xb3\x75\x1e\xc0\x76\x60\xc8\x09\x9e\x1b\x75\xac\x98\x47\x2b\xb9\x96\x9b\x46\xa2\xa6\xa5\x00\xdf\x1a\x47\xb6\x83\x0f\x41\x47\x5e\x28\x72\x01\xbd\x48\xcd\xae\x7f\x10\x7c\xa6\x33\x77\xdd\x24\xc1\x98\xf5\x60\x49\x75\x02\xce\x53\xec\x2d\xd1\xbe\xb6\x64\x84\x59\xaf\xc1\x52\xec\xa8\x4a\x9a\x9a\x73\x72\xca\x1f\x06\x48\x41\xc0\x07\x06\x45\xbd\xb6\x9e\x17\x46\xe1\x6d\xbc\x98\x89\xa9\xc2\x1a\x26\x30\xd1\xcd\x72\xdc\x8c\x81\xbe\xe3\x41\x32\x89\xc2\x74\x3c\x99\xa5\xc9\x00\x0b\x7c\xc4\xc4\x31\x1e\x82\xdc\x79\x04\xaa\xc7\x0f\xa6\xf6\x97\x01\xd8\xac\x01\x71\xa4\x57\x05\x17\xe9\xf6\x54\xbc\x42\x47\x50\x35\x12\x6f\x85\x7e\xf8\xe6\x04\x50\x2d\x1a\xca\xb8\xbd\xec\x6c\x1a\x03\xae\x82\x3c\xe0\x6f\xb6\xc7\xf5\x08\xd0\x81\x32\x04\x61\x00\x83\x0f\x98\xae\xc2\x23\x58\x96\xd9\xc7\xa9\x1d\x5d\x93\x64\xe6\xdb\x8e\x39\x7d\xcd\x22\xef\xc6\x4e\x48\x2d\x23\xfd\x30\x21\xb4\x0c\x78\x52\xae\x6a\xe3\xe1\x83\x8c\xc0\x09\xa9\x14\xa2\x02\x2c\x44\xf9\x28\x74\x0e\x3a\xb6\x05\xab\xba\x8e\xb1\x70\xc6\x2f\xef\x5e\x01\xe7\x23\x1a\x46\x55\x7d\x76\xf8\x20\x03\x71\x9d\x3f\x10\xd1\x98\xba\x33\x5c\xe2\xff\x81\x94\x6f\x5e\xc8\x32\x17\x03\x66\x31\x43\xb4\x02\xb9\x0a\x33\xc6\x7e\x65\x3f\x15\x96\x8e\xb1\x07\xa2\x50\xb9\x3d\xb6\xf1\x77\x43\xd1\x5e\x31\x60\x85\x24\x37\xdd\x49\xc6\x64\xb0\x6c\xb5\x37\x3e\x32\x97\x03\x25\x23\xc5\xcc\x58\xa1\xb0\xbf\xa2\x93\xb5\x0a\xdc\x92\x39\x97\x40\x10\xcc\x6c\x2f\xd2\xd0\x6f\x69\x93\xf2\x12\x06\x79\x67\x0b\x3b\x57\x50\x1c\x7a\xc8\x79\x01\xf9\x2d\xcb\xc3\x07\xe4\xe6\xe6\xbc\x3c\x73\x7d\x0d\xa2\xd0\x27\x86\x16\x0d\xec\x18\xde\x33\x6c\xe1\x05\x40\x88\xb5\x88\xe6\xbf\xb1\xbd\xc6\x31\x04\xf0\x05\x40\xf1\x5a\x14\xf4\x41\x2f\x89\x8b\xcb\x32\xd4\xb1\x89\xce\x41\xa6\xdb\x93\xba\x07\x46\x24\xdb\xf4\x1c\x35\xf6\x78\xe2\xcd\xae\x22\x3b\x88\x47\xe4\xb1\x7d\x14\x8c\x60\x97\xe0\x6f\x78\x1d\x3b\xd0\xda\x1d\xea\x13\x7c\xd8\xa2\x6a\x53\xc4\x19\x4c\x38\x0e\xac\x5b\x3b\xce\xab\xe6\x2f\x07\x89\xf4\xe2\xec\x32\xcb\x48\x42\x83\x45\xd0\x48\x54\x3d\xf9\x0b\xd0\x23\x6a\x98\x2e\x33\xeb\x8a\x00\x67\xe8\x19\x0c\xe7\xe6\x4a\xc8\x32\x1e\x03\xee\x04\x00\xab\x0d\xd9\xb3\xed\x6f\x59\x99\x5b\x20\x77\x26\xb8\xc4\x01\xae\x8c\xff\xe5\x20\x2a\x7c\x50\xc1\xf0\x14\x72\x6c\x30\x66\x1d\x84\x54\x52\xa9\xef\x94\x03\xa3\x6e\x17\x67\x68\x83\x7e\x9f\x78\x3e\x7d\xf9\x8a\xda\x6d\xec\x03\x7d\x88\x82\x4c\xcd\xfd\x0a\xeb\x37\xac\xd6\xae\x98\xaf\xc8\x47\x4a\xec\xc2\x80\xa3\xdc\xb7\xa3\x4f\xbb\x14\xea\x72\xb6\x5a\xd5\x10\xa9\xbb\x49\x9d\xcf\x56\xab\xdf\x2a\xe9\xcc\xe5\x5f\x99\x73\xb9\xf4\x33\x3a\x0a\x4d\x4a\x02\x98\x21\xb4\xfd\x60\x9e\xcc\x0a\x49\x6f\x24\xeb\x4b\xd0\xd7\xd1\x41\xc1\x0d\xaa\xa6\xd1\xd3\x51\xa9\xdd\x2c\x3f\xd4\x0a\xa7\x37\xe3\xc9\x76\xa9\xe6\x5c\x7e\xb4\x31\xd4\xb3\xfa\x4a\x42\x77\x05\x22\xf8\x6d\xcd\xad\x85\x8e\x25\xdf\x99\x1d\x6b\x8f\x63\x3d\x1b\x56\xe5\x98\xcc\xc4\xb8\x38\x0b\xc8\x2d\xf3\xbc\x75\xad\x13\x32\xb2\x69\xf9\x48\xc4\x5c\xe1\x01\x74\xbd\xf4\x33\x61\x96\x13\xfb\x86\xf0\x5a\x44\x9c\x23\xd2\xc2\x7e\x82\x4d\x3e\x5b\xc8\x6d\x34\xaa\x26\x57\x4b\x84\x0e\xf4\x0e\x54\xa0\x47\x56\x3d\x96\x73\x5e\x72\xed\x6f\x50\xa5\xb0\xe8\xee\xad\x05\x81\x50\xe3\x82\xfb\x80\x58\x0d\xa8\xd8\x58\x82\x0a\x5d\x73\xa5\x89\xa7\x1e\x5e\x4d\x88\xa9\x93\x70\x46\x03\xdb\x46\xc2\x00\xc1\x05\x81\xe9\x46\xb8\x2b\x9e\x12\x91\x7d\xcd\xcc\x37\x63\x7b\x93\x4e\x01\xa2\x8a\x8d\x5e\x0b\xdd\x05\x19\xa7\xbe\x8d\x9e\xe7\x19\x7a\x0a\x24\x1a\xe5\xa0\x89\xc1\x58\xd0\x9d\x0c\x25\xa7\xef\x0c\x1d\x85\x95\x1a\xa3\x73\x1e\x38\x68\x1e\xc5\xe9\x14\x2c\xf3\x71\x14\xa6\xb3\x41\x38\x1a\x61\x32\x89\xb1\xf5\xcb\xdb\x67\x16\x25\x1f\xcd\xa2\xa3\xc5\x54\xd3\xc8\xd6\x01\x7a\xe5\x2d\x28\x29\xc6\x86\xae\xed\x7b\x76\x3c\x30\xf2\x7d\xab\x51\x38\xa2\x87\x70\x4e\x63\x0f\x18\xed\xd6\x9e\xb3\x27\x89\x7c\x46\x80\xa1\x82\xd4\x22\x67\x38\x4b\xb2\x7a\x96\xdc\xa9\xa9\xb7\x52\xe3\x25\x9d\x81\x48\x4f\x0b\x1d\xb5\x5e\x6e\xa4\xfb\x36\x00\x8f\x7d\xa9\x1f\x41\xd0\x2b\x70\x8c\x07\x71\x8a\x7e\xc5\xea\x9c\xca\x7f\xcb\xba\x9c\xfa\xfd\xd1\x15\xb9\x32\x38\x1b\xa2\xc5\x69\xf1\xf8\x34\x54\x38\x15\xf4\xca\xbd\x5f\x4d\x79\x63\x47\xc7\xb2\x4b\x3e\x9c\xec\xe1\xaa\x22\x0b\x31\x54\xdf\x38\xc7\x52\xf3\xe7\xb8\x60\x44\xf7\x9e\xf6\x83\x52\xc9\x52\xb7\x23\x7c\x54\x19\x93\x06\xf8\x93\x9f\x09\xce\x89\x49\x85\xe7\xe8\x99\xa1\x3f\xd2\x40\xa0\x69\x59\x40\x71\x95\x47\xf5\x0f\x02\x8e\x25\x59\x5b\x35\xe5\x16\xbf\x20\x9b\xa6\xb0\x99\xae\x37\x42\x25\x3c\x93\xc3\xea\x10\x92\xee\xb0\x08\xd0\x1a\xfd\xe0\x81\x74\x83\xae\x75\xec\xfb\xf2\x48\x8a\x84\x06\xbd\xd6\x65\x4a\xf4\x42\x94\x9b\xe9\x0e\xeb\xd4\x1b\x98\x7f\x91\xc3\xc7\x7d\xca\x20\xa1\x8b\xf5\x48\xab\xf4\x89\x2a\x35\xfc\xf4\x46\x0a\x07\x7c\x2a\x8e\x3f\xdd\x4d\x5f\x85\x27\x89\xb9\x9d\x06\xce\x04\x53\xc4\xb6\x2c\x7a\x19\x82\x37\xef\xa0\xe7\x86\x69\x42\xe2\x01\x17\xf1\x5b\x20\x98\xc7\x91\xed\x42\x1b\x7a\xfd\x03\x0d\x03\x82\x5b\xec\xd2\x1b\xa4\x2d\x1e\x46\xc0\xa4\xf7\x56\xa6\x91\xfc\x66\xec\x19\xb2\x9d\x24\x34\x70\xf1\xdd\x4e\x80\x75\xda\x2c\xc8\x04\xf6\x9b\xdc\x50\x58\x81\xbd\xb6\xe3\x79\x0c\x22\xa0\x9d\xf1\x5a\xfa\x29\x16\x8d\xe9\xd3\xa8\x79\x39\x1f\x2f\x89\x89\x3f\xba\xa7\x80\x2f\x38\x95\x37\x5e\x08\x58\x35\xf0\xe9\x5d\xd2\x26\x15\xde\x57\x76\x79\x8e\xd7\xf0\xac\x15\xdb\x25\xe9\x32\x1d\x56\xd5\x56\x76\xb0\xcd\x97\xbd\x46\xaf\x63\x2d\x84\xf6\x48\x3c\xce\x40\xe7\x7d\x34\x37\xe0\x8a\x4a\x1e\x3d\xdd\x65\xdd\x8e\xfe\xfc\xe8\x2a\x5d\x06\xc5\x86\x68\x72\x32\xb2\x9e\x86\x02\x47\x21\x66\x4b\x3f\x17\x61\x46\x8f\x1c\x6a\x98\x07\x83\x2a\x91\xc6\x7c\x2c\x3b\x1a\x93\xa4\xf0\x63\xf1\x52\xb3\xd2\x47\xa3\x8c\x5c\xcf\xfe\x51\x54\xda\x28\xa4\x27\x51\x18\x00\xa7\x42\xee\x8b\x6a\x04\x98\xc5\xce\x75\xce\x2d\xb3\x21\x8f\xb8\x0c\x12\x51\x39\x5b\x96\xe3\x83\xea\x83\x37\xf6\x8e\xed\x13\x60\xfb\x5b\x16\xbf\xd3\x0f\xa3\x01\x97\x14\xc8\x95\xf3\x1f\x45\x57\x63\x81\xc0\x90\xa1\x27\xd3\x25\x77\x49\x70\xba\x05\x42\x91\xdf\x79\x88\x9b\x0e\xeb\x36\x8c\xae\x81\xbb\x81\x22\x74\x1d\xab\xf8\xb0\x70\xc1\x18\xe3\x62\x45\x69\x10\xe4\x7d\x3c\x7c\xde\xc2\x71\x08\xc1\x88\x29\x58\x3e\x33\xea\x5b\xe6\xb6\x36\x09\xd2\xa9\xee\x6a\x99\x83\xa6\xf9\xc2\x01\xd0\x7c\xc9\x20\xd1\x7c\x63\x80\x15\xa4\x56\x48\x83\x52\x6a\x71\xf4\x8e\x44\x0e\xe0\x1c\x58\x90\x10\x08\x39\x4e\x5c\x55\xc5\xad\x0f\xde\xc8\x39\x3d\x7c\xdd\xdd\x51\x83\x63\xe4\x80\xf0\x0a\x0d\x18\x05\xb2\x00\x01\x17\x93\x46\x14\x22\x9b\xaf\x2c\x07\x8b\x2a\x07\xd4\x4b\xc2\x92\x3e\x80\x32\x30\x4e\x44\xbc\xc0\x62\x67\x3b\x98\x2f\x84\xe9\xf6\x34\x8a\x0d\xab\xcb\xbb\x5f\xd3\xe5\x16\x3d\x08\x51\x62\x10\x0d\x48\x23\x01\xc5\x19\xe0\x9d\x40\x9b\x8a\xa2\x39\xc5\x56\x9a\xa8\xdb\xff\x30\xfe\xa6\x10\x85\x8f\x29\xf4\x2a\x79\x6a\x0e\xe6\x03\xc0\xbc\xbc\x62\x92\x49\x2a\x45\x2f\xc9\x7e\x7d\x4c\xb5\x44\x01\xe2\xf1\xb5\x92\x22\xa6\x36\x5e\x29\xc9\x00\x66\x0b\xff\x40\x86\x93\x30\xbc\xbe\x4c\x87\x39\xe5\x6e\xe0\x3d\x8f\xde\x08\x8b\x19\xd4\x43\x6e\x88\xdd\xb2\xb5\x18\xf3\xb5\x25\xc2\x33\xa9\x65\xc1\xde\x2a\xab\x3f\xf3\xf4\xa1\x2d\x2e\x13\xb8\xf5\x07\x5a\x87\x77\x43\xd8\x55\xa3\x0c\x67\xee\xe8\xe0\x0d\xd1\xa5\x90\x37\xa6\x61\x2f\x18\x96\x38\xbf\x2b\xa5\xd4\xdd\x56\x45\xa4\x9e\x89\xfd\x48\xe6\x62\x39\x3f\xbc\x39\x7e\xd5\xb9\xfc\xe1\x78\x67\xff\x00\x0e\xd5\x38\x00\x93\x2c\xca\x44\x30\x87\x1b\x76\x13\x03\xae\x82\x04\x13\xa1\xf1\xf7\x36\x27\xb0\xce\xa5\xe8\xd1\xb6\x26\xc4\xa6\x59\x0d\xe7\x2c\x0a\x91\xe7\x51\x67\x61\x3e\xb1\x44\x8b\x28\x01\x8b\x35\xdb\x37\xdf\xae\xd3\x1c\x2a\x85\x91\x6a\xbe\x3f\x26\x4b\xad\x00\xe7\xf1\x99\x6b\x35\x1e\x37\x9e\xcd\x6a\x40\xaf\xdc\xfb\xd5\x9c\xf7\x19\xf3\x43\x0d\x49\xe1\x7c\x86\x4e\x7a\xe0\x7f\x35\xce\xf6\x02\x77\x54\x80\xfa\xe1\xea\xea\xdd\x25\x4b\xa8\x51\xb9\x17\x16\x52\x35\x0a\xa9\xa8\xe0\xa7\x05\x0b\xf1\x3e\x98\x69\xd7\xca\xfc\x8e\xd4\x9e\xec\x2a\xbe\x2b\x57\xb2\x21\xab\xbf\xd0\x3b\x83\x78\x66\x3b\xa4\x3b\x9b\xd8\x31\x11\x4d\x14\x4f\xa3\x71\xb2\x6a\x09\x41\x9c\x44\x4e\x18\xf4\xf3\x47\x96\xc7\x6c\xab\x16\x04\x32\xe4\xe1\xc0\x34\x03\x8f\x3a\x3d\x72\xf4\x2f\x2f\x65\xef\x35\x64\x71\x2b\x87\x0c\xff\xd3\x76\x07\xa0\xcf\x27\x4b\x39\x8a\x79\xc2\x80\x79\xa2\x40\x40\xbe\x24\x22\xcf\x60\x99\xbb\x75\xec\x27\xa6\x63\xc6\xa5\xf0\x10\xf0\x35\xcc\xd7\x65\x40\xa0\x7d\x5a\x03\xe1\xfd\x18\x53\xa2\xec\xca\x40\x97\xfc\xac\x73\x01\x63\xbb\x3c\xf2\x9c\x75\x16\xac\x80\x5a\xd8\x7c\x05\xc6\x1b\xb3\xd8\xd0\x3f\x65\x85\x1a\x46\x66\x73\x68\x30\x90\x51\xdb\x83\xa1\x75\x73\x74\x20\xc1\xc4\x74\xfa\x8f\xf8\xb6\x01\xba\x8f\x0c\xca\xc6\xe8\x3d\x05\xdc\x3d\x15\x9d\x47\x80\x5d\x4e\xb1\xdf\x80\x08\x95\x0c\x8e\x8d\x09\x4d\xd1\xbf\xff\xb5\xd9\x31\x29\x14\x66\xb6\xfc\x5f\x58\x2a\xe8\x1b\x92\xd8\x78\x29\xfb\x40\xca\x4a\xdd\x4e\x1f\xbf\x3b\xe3\x40\x15\x36\x08\x3f\xde\x14\x76\x6d\xc2\xc0\xd2\x54\x35\x6b\x15\xbc\x7e\xbe\x5f\x91\xe0\xd8\x61\x23\xb3\xde\xad\x12\x4a\xab\x67\xd8\xae\xea\x22\x93\x6c\x91\x56\xab\xab\x87\x54\x02\xf8\x60\xae\x25\xdd\x36\x6a\xa2\x70\x96\x31\x74\x86\xa1\x3b\x67\x0e\x05\x10\xf2\x1c\x61\xd6\xbb\xf3\xcb\xab\x1a\xc3\x46\x8a\x30\x32\xac\x80\x53\x5d\x6a\xa2\x14\x9c\x53\xc8\x48\x04\xc3\x82\xd7\x33\x66\xb7\xd2\xfc\x4e\x2a\x4b\x25\x14\xd9\x86\x8b\xc3\xb0\x75\xc5\x26\x0a\x0f\xdd\xcc\x22\x42\x83\xb4\xba\xd6\xd9\x88\x27\xb9\xf3\x92\x11\xa8\xc9\x26\xa5\x3c\x46\x6f\x1c\x60\x78\x0a\x0b\x4b\x81\x63\x81\x25\xf2\x43\x10\xff\x9e\x63\x63\x65\x02\x17\xab\x2a\x4d\xbd\x80\x26\xd0\xc0\x58\xac\x73\x16\xaf\xd3\xc6\xf8\x8a\x36\x2a\x3b\x60\x65\xa6\x09\x69\x19\x84\xb0\xb8\x19\x8c\x05\xad\xa4\x36\xca\xaa\x5d\x0c\xb3\x52\x70\x49\x03\x5d\x69\x94\x13\xc6\xe0\xf0\xf2\xe9\x7e\x78\x4b\xa2\x8e\x63\x63\x41\x69\x1f\x8c\xb0\x00\x63\x73\xb0\x72\xc6\xc4\x8e\x6c\x07\xab\x45\xd1\x80\x8c\x76\xa7\xdd\xde\x62\x37\x07\xac\xe6\x29\x06\xc5\x63\xfb\x21\x49\xe4\xd6\x5b\xf4\xdd\x7b\xd0\xac\xd5\x56\xa5\x51\x59\x3b\x07\xbe\x63\xd8\x2d\xa0\xd8\x0f\xc1\xf0\x43\x92\x85\x9f\x76\x77\xa4\xe9\xbb\xed\x45\x1b\x6e\x1e\x09\xb6\x3e\x22\x33\xc9\x8b\xd7\x46\x44\xe5\xd9\xd2\xa5\x31\x90\x0c\xf9\x30\x88\x73\x40\x0c\xa5\x4f\x8c\x0d\xa1\x09\x22\x29\xd9\xaa\xed\x1e\x06\xfa\x54\x4c\x51\x1e\x85\x1d\x70\x34\x0e\xa3\xb9\xb5\x6f\x01\xc1\x62\x50\x50\x45\xac\x55\x41\x15\x5e\x22\xd4\x8a\xf2\x06\xa0\xf8\x4a\x54\xb0\x8a\x21\xd8\x84\x55\x79\xe4\xd5\x29\x81\x18\xff\xae\x54\x2a\xf8\x67\xf7\xef\x3c\x9b\xff\x9f\x8b\xb6\xc3\x24\x7d\xbc\xf0\x24\x35\x72\x1f\xf1\x52\x82\x97\x97\xba\x99\xa5\x91\x83\xae\x08\x8a\x4a\xd3\xa8\xc4\x4a\x3c\x54\x64\xa5\x2b\xa0\x48\x6d\x24\x02\x95\xb3\x6c\x04\x4c\x40\x16\xc1\xca\xa0\x68\x92\xdd\xef\x3d\xd1\xbd\x6b\x1d\x57\x25\x7c\x8f\x31\x24\x30\x40\x7c\x0f\x7d\x60\x0c\x27\x6f\x2f\x61\x78\x27\x8c\xdc\x98\x72\x06\x31\x25\x45\x0b\xae\x9b\x46\xaa\x51\xa2\x21\xe2\xd8\xc6\xe2\xed\x07\xb4\xe2\x2b\x22\xf6\xd7\x1f\x49\x58\x97\x6e\x5e\x69\x49\x6a\x14\x81\x25\x53\xcc\x2f\xc5\x33\x17\xf4\xcb\x19\x5f\x1f\xf5\xec\xd5\x5a\x03\xcb\xe8\x7d\xca\xe9\x8b\x97\xd2\xc1\xab\xc1\x43\xe8\x96\xd1\xca\x6b\x61\x58\xbf\x06\x56\xf1\x8e\x47\xe5\x72\xda\x0b\x76\x43\xab\x93\xb5\xb3\xf6\xa5\xe4\xbe\x6c\xc8\x15\xcb\x2b\xaa\xf3\xbc\x0f\xbc\xcf\x48\xe1\xf4\x65\x18\xd0\x66\xaa\x52\xc1\x71\xaa\xc5\x82\xd5\xf5\x62\x38\x70\x0b\x22\xbb\xdb\x3f\xa4\x53\x9b\x4a\x23\x97\xb2\x06\x25\xca\xdb\x64\xd9\xd5\x81\xc7\xc0\xfc\xab\xbd\xda\x6d\xe0\x2d\xe2\x0d\x98\x62\xde\x19\x76\x94\x8a\x4f\x30\x5d\x98\x96\x9b\xad\x9d\xdf\xc0\xc7\xac\xa5\xa7\x65\x68\xe9\x12\x60\xd3\xd1\xd0\xa5\x54\x18\xa7\x9e\x76\xf2\x11\xee\x93\x64\x00\x5b\x1a\xac\xae\x8b\x66\x4e\x58\x2b\x89\x58\x56\x9d\xcf\xac\x7e\x8f\x3a\xfb\x1b\xfb\x8b\x37\x4d\xa7\xa2\xaf\x95\xf7\xc5\xda\x54\x3c\xa0\x5e\xa2\x1f\xea\x16\x35\x06\xb0\xc4\x6b\x97\x23\x8e\x97\x73\x50\xca\xa8\xa7\xfd\x0c\x43\xa0\xf3\x9b\x9e\xd5\xd7\x49\x9e\xd0\x32\x17\x96\x42\xd2\x2f\xd1\x9e\x52\x45\x0e\x49\x09\x07\x90\xf4\xe4\x78\xe5\x15\x16\xbd\xdc\x9a\x72\x49\xc5\x72\x56\x7a\xe0\xa8\xea\xc2\x2b\x60\x6d\x1a\xbe\xab\x6b\x47\x99\x21\x3a\xef\x7b\x9f\x78\x2e\x97\xa5\xaa\xc1\x74\xd6\x4d\x54\x9a\x5b\x15\xb0\xa2\x63\xc1\xbc\xd8\x95\x06\x3a\x0f\xc0\x93\xac\x37\xd1\x7b\x1d\x47\x51\x87\xc0\x29\x9c\x78\x5d\xc1\x35\x3d\xde\x78\x6b\x3a\xc1\xc6\x51\xa8\x17\x0c\xe0\xff\x68\x1a\x12\xcf\xaf\xab\xb9\xeb\x7e\xe3\x05\x74\x45\x41\xca\xab\x24\x01\xd8\x1d\xec\x2b\x72\xf3\xe2\x6e\x6b\x21\x02\xe5\x4a\x49\xa3\x52\x32\x8b\x3a\xe1\x45\xde\xd6\x62\x6d\x0d\x11\xb8\x18\x8c\x4c\x7d\x1a\xd8\xbf\x0f\xa6\xa1\x5b\xa7\x0d\xfd\xc4\x55\xa1\x63\x36\xb7\xe7\x7b\xc9\xdc\xfa\x0f\x20\xdd\xa2\x1d\x59\x81\xaf\x2a\x58\xc4\x4c\xdc\x5c\x9a\x85\x71\xec\x21\xf8\x37\xac\xc2\x2a\x86\x00\xb4\xb0\xe8\xbf\x4f\x5a\x5b\x56\x8b\x3a\xd8\x5a\xdd\xf6\x5a\xee\xe8\xe9\x8d\xa9\x37\xc2\x28\x80\x60\xc0\xce\x41\x5c\xef\xdf\xf2\xe1\x2c\x25\x59\x1f\xa1\x6a\x5e\x17\x4c\xd6\x80\x1f\xaa\x98\xfa\x50\x68\x6e\xb7\xf0\xf0\xb1\x60\x6f\xc5\xc8\x0e\xd0\x29\x22\xca\x7a\x1a\x9c\xb0\x4a\x53\xef\x73\x0a\x5c\x51\x64\xcb\xd5\x30\xac\x9f\xb1\x9d\xc8\x85\xe3\x31\xfa\x2b\x9f\x77\x36\x69\xf1\xba\x5f\x37\x21\xb6\xb1\xf2\x1c\xaa\x55\x39\xa1\x63\xcf\x6c\x07\x08\xcc\x60\xa1\x27\x25\xb3\x3d\xeb\xbd\xae\xe5\x4f\x31\x1c\x0e\xa1\x29\xc7\x30\x14\xb9\x1d\x6b\x68\xf9\xb0\xdd\x7e\x66\xa4\xe0\x2c\xd6\x2b\x20\xa5\x21\x92\x79\x82\xbb\xda\x42\x47\x46\x8b\xe6\x7e\x91\xdb\x56\x3d\x42\x16\x71\xb2\x25\xab\xe7\x0f\xb1\xf3\x91\x01\x05\x4a\x31\xf7\x07\x7b\xf4\x77\x1a\x32\x22\xde\x9f\x7c\xcc\x3b\xc2\x12\x20\x8f\x7f\x49\xa8\x80\xf4\x54\x6e\x09\x15\xa0\x5b\xf9\x1e\x5f\x50\x77\xf6\xa3\xef\x70\x0e\xc6\x86\xec\x2f\x03\xe8\x49\xed\x2e\x03\xb9\x55\x3e\xbf\x7a\x67\xc0\x2b\xb5\xa0\x79\x7b\x89\xd7\x28\xd4\x81\xce\x02\x97\x16\x2a\x60\xc9\x50\x54\x2e\x08\x3b\x9c\x11\x42\xd7\xfa\xc0\x6f\x0e\xda\x6d\x05\xb0\x76\x9b\xfa\x7a\x0d\x4c\xf3\x55\x1c\x55\x7c\xf2\x35\xf9\x19\xde\x56\x97\x40\x45\xa7\x10\x1f\x04\x1d\xec\xa0\xf3\x10\x83\xbb\x26\x13\x57\xd8\x28\xf2\x48\xe0\xfa\x73\xcd\xea\x54\x18\xb6\x28\x10\xa2\xa0\xfe\x47\xfb\x36\xfe\xb8\x18\x82\x45\x17\x4d\x6d\xd9\x91\x5f\x58\xb3\x74\xc1\x44\x97\x4f\xcb\xfa\xa3\xf7\x1c\xa0\x3e\xbf\x3c\xc9\x2e\x0a\xdb\x0b\x5c\xe3\xba\xcb\x62\xf9\x99\x05\x89\xb2\xf5\x64\x7c\x92\xff\xc5\x82\xf1\xf2\x3a\x0b\x76\xb1\x68\xff\x43\xd2\x38\x83\xb9\xdd\x7e\x72\xc4\xcd\xf1\xa7\x23\xea\x02\x95\xbd\xed\x5a\xbf\x78\xd1\x18\xcc\x24\x7b\xdd\xd4\xc6\x81\x58\x17\x95\xb1\xc9\xe8\xcd\xcd\x51\xe1\xc6\x26\xb7\x8c\xaa\xef\x0b\x8a\x57\xe8\x96\x55\xb5\x08\xeb\x7f\x3b\x0a\xf3\xbe\xd2\x3b\xcc\x63\xc9\x9f\x2d\x34\x56\xb6\xe4\xee\x33\xa5\xff\xc9\xe9\xbb\x8b\xd3\x57\xc7\x57\x67\xe7\x6f\xad\xb7\xe7\x57\x67\xaf\x4e\x31\xb6\x45\x06\x34\xbb\x95\xcd\x81\x5c\x9b\x35\x25\xd4\x6b\x93\x73\x71\x9b\xef\x5e\x44\x2f\xc6\x32\xdd\xdc\x27\x23\xe6\x26\xbc\xbb\xcf\xbc\x4e\x06\xb2\x03\xf7\x8a\xcf\x8a\xaa\x04\xaa\xcc\x2d\x43\x3e\xc3\x7e\x11\x30\x33\x6d\x9c\x15\x85\x38\x66\x37\xbb\x15\x1e\x75\x5e\x38\x82\x37\x92\xae\xfd\xad\x37\xc7\x97\x9d\xcb\xcb\xf3\x2c\x7c\x86\x91\xc1\x2b\x6e\xb9\xd0\x07\x14\x95\x4b\xf7\xf6\xe3\xc6\x94\x2f\x88\x26\x6f\xf3\xaa\x68\x63\x12\xd0\x07\x1d\x5d\x2b\x15\xac\x29\xaf\x8a\xc3\x70\xc1\xaf\xc2\xdb\x77\x79\xd4\x44\x9d\xbb\x6d\x1e\xd6\x5b\x4a\x99\xbb\xe3\x88\xac\xd8\xe0\x32\x55\x56\x78\x0f\x7d\x22\xd7\x9a\xde\x81\xa9\x89\xea\x91\x63\x8d\x97\xa8\x33\xbc\x01\xe1\xc9\xea\x79\x53\xa2\x93\xd5\x4f\x75\xc9\x39\x6d\x83\xd2\x5a\x15\x84\xba\x9e\xb8\xb5\xe5\xa2\xaa\x6a\xce\x8c\x5e\x9c\xeb\x09\x5c\x9d\xe4\x58\xfe\x3b\xc3\xc4\x72\x53\x95\xb6\x6f\x89\xad\xd3\x95\x4e\xd7\x73\x67\xfd\x16\xc6\xf9\x16\xda\x45\x6f\x1c\x15\x79\x99\x68\xf1\x02\x2e\x36\x97\xbd\xc5\xac\x7a\xfc\x44\x05\xe4\x7a\x85\xab\x66\xea\xda\x17\x4e\x2d\xf6\x16\x41\x8d\xce\x33\xf2\xed\x31\x4c\x40\x85\x28\xea\x35\xb7\xb2\xc6\x2d\x56\x29\x76\x50\x45\x02\xcf\xe6\xcc\x35\x25\x3e\x59\xfb\x2e\x31\x81\x99\xbf\x79\xb0\xe0\xca\x5c\xdc\x97\xe7\x0e\x6a\xed\xcd\x39\xf5\x15\x3b\xcc\x21\x26\xc9\x46\x49\xe1\xe1\x49\xa6\xf6\x35\x55\xeb\x84\x18\xc5\x07\x38\xf1\xdf\x02\x05\x18\xc8\xc3\xda\xdb\x3e\x73\xdb\xc6\xeb\x72\x1a\xeb\x8e\xbd\x42\x1f\xd2\xef\x05\xf4\x68\x78\x53\x3d\x65\x7f\xb3\x32\xbe\x52\x8c\xaa\x00\xb0\x66\x0f\xa2\x53\x18\x72\xe1\xe5\x85\xb6\x3a\x4d\x28\xbf\xa2\xb0\xea\x3c\x2b\x8b\xfb\xf2\xf6\x4a\xd3\x67\x07\x92\x97\xf5\xc1\x92\xe0\xed\xfb\xd7\x17\x0c\x60\xa2\x35\x87\xa0\x27\x1c\xc2\xe9\x6c\x1d\xca\x5f\x2d\x66\x65\x70\x5c\xd5\x9b\x50\xb9\x69\xe5\x43\xbf\x96\xb0\x39\xee\x12\x2d\x8f\xde\x5a\xec\x6b\xec\x2c\x08\xab\xd3\xb2\xa9\x25\x1c\x9c\xba\xf2\x51\xd5\x6f\x61\x3d\xa6\x37\x54\xbf\xd4\x96\x41\x19\x2e\x4d\xf1\xad\xfc\xf1\xde\xbf\xd0\x13\xd2\x19\x81\x44\x02\x51\x25\x5e\x97\x8f\x88\x4f\x0f\xd1\x5f\x18\x5d\xc4\x61\xbd\xd7\x15\x2d\xc2\xcc\xfd\xf9\xb8\xd2\x60\x21\x8f\x6c\x29\x3c\x32\x0e\x33\x47\x9a\x71\x52\xee\x10\x33\xb1\x35\x45\x3d\x54\x9c\x60\x2b\x4c\x5d\x6f\x9b\x3f\xc2\x72\x4d\x82\xa5\xde\x72\xfb\x74\x7b\x1d\x9b\x3f\xa5\x87\x0f\xc9\x0f\xbc\x38\x4e\x8d\x4d\xb2\x15\xac\x9d\x9c\x52\x84\xa2\xcc\x7a\xd1\x21\xde\x30\xea\xba\xc0\x1c\xf6\x9f\xd3\x45\x09\x99\x2b\xb0\x18\xed\x04\x9a\xe4\xac\x7e\x30\x9c\x5d\x3e\xef\xfd\xe0\xa6\xef\xc8\x9e\xdf\x4b\xc2\xc3\x4f\x97\xe3\x9d\x57\x3f\xfd\x3e\x4a\x0d\x78\x52\x2d\x47\x2a\x81\x70\x6f\xcc\xe8\x89\xf0\xad\x1c\x13\xdc\x66\xca\xfe\x5e\xf2\xe2\x97\xf1\xa6\xa3\xc5\x61\x35\xb6\xcb\xea\xb4\xda\xfe\xbb\x0a\x44\x6b\x31\xc5\x82\x39\xee\xf0\x0c\xb8\x3e\x90\x87\x0d\xcb\xb6\x5f\x9d\xc2\x70\xdd\x99\xce\xb0\xda\xa5\x77\x36\x6f\xb9\x3b\x8b\x01\xd2\xf4\x76\xc3\x14\xcc\x81\x1a\x53\x82\x0e\x28\x9f\x69\x16\xe2\x9d\xdc\xe7\xa9\x2e\x4e\xf1\x28\xe7\x5a\x06\xe2\x5b\x3f\xd9\x32\x2e\x5a\x32\x31\xbc\x26\x2e\xaf\xfd\x75\x41\x62\xbc\x9d\x78\x56\xb1\x0c\x79\x84\x0d\xe3\x06\x9b\x7d\xea\xa8\x5b\xe2\x3d\xad\x97\x58\xf0\x1b\x1a\xa2\xcf\xe4\x45\x23\xe9\xd1\x25\x5d\x8a\x44\xd8\xd5\x5d\x04\x5d\x13\x32\x8b\xe9\xc3\x86\xcc\x48\x64\xb5\x42\x95\xe7\x89\xf8\xb3\x6d\xb1\xf2\xd6\x1e\x56\xf3\x22\x79\xa4\x1a\x7b\x72\x09\x9f\x8d\x1a\x24\xd9\xbb\x44\xe2\x55\xce\x78\xe5\x24\xaf\xaf\x28\x2d\xf1\x49\x65\x67\x49\x3f\x68\x2d\x08\xb6\x78\x40\x19\xfa\x0b\x81\x30\xd2\x40\xb5\xe0\xbb\xd6\xa9\xed\x4c\x44\x03\x96\xa4\x3b\xcc\x9e\xd1\xa4\x1e\x43\x54\x2b\x10\xd2\xf0\x96\xdf\xab\x8a\xd8\xb5\xaa\xf7\xae\x2e\xc5\x6c\xfc\xfd\xef\x88\x14\xc2\x20\x53\x9e\xe5\xc8\x3c\x73\x72\x38\xdc\x92\xac\x6a\xe9\x78\xed\x2c\x5c\x3b\xe5\xf5\x3c\xff\x7a\x76\x79\x7e\x78\xd0\xeb\xff\x8d\xae\x0c\xc6\xb1\x3d\x5a\xc7\x95\xc6\x73\x23\x06\xe8\x0b\x06\x8b\xfd\xec\xf4\xb2\xd8\x1c\x0e\xfe\x8c\xd8\xb2\x70\xd0\x44\x6a\x91\xbb\x4c\xbe\x60\x25\x52\x8a\xba\x29\x5f\x56\x31\x00\x7d\xa1\xf7\x99\xc7\x6a\x23\x22\x4b\xe1\x7f\x5a\xc4\x29\xf1\xda\x00\x17\xed\xb5\x32\x23\x5f\x35\xb0\x5d\x40\x93\xc7\x5a\xd7\x07\xb8\x2f\x07\xe0\xee\x0e\xde\xaa\xcf\xec\x08\xec\x59\xcc\xf6\x64\x60\xe4\xca\x03\xb5\x84\xe1\xef\x67\x15\x39\xdf\xac\x94\x16\x4b\x2a\xe5\x6d\xd8\xf9\x95\x54\xcf\xf2\x6e\x78\xd0\x7b\x66\x27\x93\xa2\x98\xca\x79\x86\x20\x16\x15\x0e\xf1\xab\x34\xcc\xe7\x34\xaf\x2a\x55\x82\xce\x27\xc1\x38\x99\xd0\x43\x87\x55\xaa\x60\x0f\x39\xe3\xa3\x94\xc7\x8e\x2c\xb0\x57\x56\xd5\x91\x51\x17\x53\x3e\xaa\x01\xab\x5a\x5f\x11\xcb\x7a\x22\xc8\x22\x2d\xf6\xe5\xc8\x7b\xdc\xdf\x23\xab\x2f\x93\x0a\xfb\x69\x6f\x77\xa7\xa7\xde\x3a\x49\x54\x5b\x44\x51\xae\x2d\xf0\xd1\x11\xa4\x08\x7e\x29\xec\x25\xff\xd5\x14\x87\xa2\xbd\x14\xed\x0d\x5c\x33\xb9\x25\x44\xa4\x0b\xcb\xd2\xf4\xfe\x30\xb6\xdb\x33\x42\x59\xbf\x77\xd8\xab\xc6\x59\x11\x25\x12\xce\xf8\xf8\x23\xcf\xcf\xcf\x81\xc0\x19\xff\xd1\x04\x65\x22\x45\x40\x38\xc1\x80\xbc\x46\x24\x71\x26\x5d\xeb\x35\xfe\x0b\x85\x4b\xf6\x4d\xae\xf3\x47\xfb\x01\x43\xc3\xa2\xa4\x54\x8c\x08\x49\x03\x13\xe3\xcd\x0e\xeb\x43\xe1\xc9\xc4\xb6\x1e\xaf\xaa\xce\x5e\xd2\xd6\xeb\x98\xfa\x91\xf5\x5f\xeb\xb7\x67\x59\x74\xa9\x82\x03\xfc\xc5\x04\x01\xef\x90\x65\x82\xa5\x42\xbe\x94\x48\x42\x0e\x2f\x32\xe0\x12\xe5\xed\x93\xa1\x92\xb6\x4e\xc4\xb5\xca\x69\x38\x0c\x68\x49\xce\xd6\x02\xfd\x36\x4f\x64\x41\x7c\x21\xad\xe3\x95\xa1\xbc\xe8\x35\x2e\xa3\x98\x2e\x94\x2d\xa3\xd7\x63\x0b\x01\x66\x4a\xa2\x97\x73\xad\x96\x23\x85\x51\x5d\x72\x2d\x83\xd7\xda\xc7\x4e\xa8\xef\x40\x5b\x20\x1a\xcf\x66\xaa\x75\x3c\x0f\x12\xfb\x4b\x16\x93\x97\xb1\x7a\xd0\x38\x25\x80\xa6\x1e\xbe\x8f\x24\x1e\x3b\x92\xba\x10\xeb\xa3\x18\xf8\xa3\xe5\xf8\xf8\x36\x0c\xbd\xdc\x0c\xac\xcb\x9f\x7f\x62\x6f\x04\xe0\xeb\x37\xb9\x26\x49\x75\x2c\x8a\x68\x71\xbb\x49\xfb\x8b\x57\x3e\xb3\x02\xbd\xa3\x10\xd5\x2c\x94\xfb\x1f\xaf\xa5\xaa\x3b\xf1\x47\x38\x6d\xc4\xc7\xfc\x94\x6c\xc8\xef\x2c\xed\xa3\x85\xd2\x77\xf6\x96\x8e\x3d\xf3\xc4\xf7\xbc\x5c\x28\x7e\x56\x2b\xe6\x28\x1f\xd8\xa3\x0c\x9e\x2b\xff\x98\x5d\xe0\x48\x3f\x62\x61\x24\xe9\x4f\xa5\x83\xfe\x4e\xf4\xbb\xac\x48\x8e\xf4\x93\xf2\x9e\xd8\x77\x56\xe1\xa5\x56\xf9\x8b\xf4\xa0\x2c\xfe\x5d\x65\x74\x28\x4d\xc6\x72\x59\xf9\xef\x78\xb9\x49\xe9\x87\xbc\x38\xbe\xf4\xe3\x0d\x2b\x3f\x95\xa3\xfb\x75\x1e\xc9\xb8\x25\x89\x47\xe4\x5c\x85\xc2\x0f\xf2\xd6\x02\x70\x5e\x44\xd7\xb7\x95\x29\xbd\xf9\x1e\x33\x92\x92\xf6\xf4\xe3\xc7\x8f\xf1\x67\x5f\x89\x0d\xb1\xec\xd8\x91\xbf\xe7\x8d\xaf\x96\x07\xc2\x1a\xd8\x81\x3b\xc8\x2e\xf2\xd8\x23\x4b\xab\xc3\xb5\x25\x51\x45\x35\x9c\x67\xe2\x35\xc9\xfc\x8c\x05\xed\x44\x5c\x0e\xb8\xf4\x65\x05\x6f\x24\xd5\x3e\x42\xab\x0f\xf9\x3f\x7b\x1b\x23\xe7\x22\xac\x5e\x24\x7d\x7c\x17\x65\x81\xb4\x42\x04\xa8\x9b\x71\x96\x99\x8f\x95\x28\x65\x59\x5b\xe6\x36\x05\x66\x22\x33\x1c\xb1\xba\x56\x05\x8f\x64\x4c\x94\x0f\x70\x57\x3e\x18\x27\x73\x34\x0b\x51\xcc\x33\x6e\x4d\xec\xc8\x99\xe8\x79\x5c\xce\xe2\x68\xa3\x9c\xa5\x49\x34\x51\xcf\xdb\x16\xf0\x34\x5a\x03\x46\x65\x68\xf9\x9c\x0a\x63\xc3\x52\xbd\xcc\x1c\xa4\x6c\x49\x04\x97\x30\xe8\xe9\xee\x7c\x54\xd9\xcb\xc7\x2d\xeb\x23\x22\x0e\xff\x4d\x4f\x31\xfe\x07\x3b\x9b\x1f\x59\x45\xa6\x8f\xec\x60\x7e\xcc\xc7\x46\xf3\x17\x80\x4f\xc2\x88\x6d\xf8\xc7\xbf\xff\x13\x7b\xfd\xe3\x23\x25\x99\x8f\x3f\x9d\xfd\x78\xfa\x31\x67\xb1\xa2\xd7\x27\xd0\xbc\x78\xfb\xe3\xb7\x27\x1f\xd9\xd8\xe7\x17\x30\xee\x0f\xf0\xfd\x06\xfd\x21\xf3\x30\xa5\x6c\x18\x57\x69\x67\xb6\x13\xac\xb7\xdf\xe3\xdd\x69\x75\x20\xbe\x1a\xba\xf7\x12\x8e\x4f\x33\x62\xd2\x1d\xc5\xb2\xb7\x01\x74\xed\x44\x84\x71\x7d\x9c\xce\x3b\x94\xb1\x33\xb8\xa4\x80\x1c\x1a\x83\x6f\x7a\x18\xd5\x93\xf8\x0f\x4b\x8c\xca\x4a\x5b\x29\x88\x87\xaf\x30\xb2\xdc\xf9\xbf\xb3\xce\x6f\xe6\xa0\xdb\x6c\x0e\x1a\x98\x43\x8b\x70\xc5\xec\x77\x58\xc9\x8a\xe0\xfa\xde\x35\x98\x14\xf3\xff\xd9\xd9\xbf\x17\x7e\x41\xb9\x61\xd9\x2b\x11\x4b\x7c\xc4\x4e\x32\xf7\x87\x35\xb1\xa9\x9d\x38\xf5\xd8\x0b\x88\x58\x95\x9b\x10\x4a\x3e\xa2\xac\xbd\xb4\xf5\x6f\xc3\x84\x74\x05\x80\x4c\x9c\xd3\xda\x1e\xf4\x06\x10\xc9\x98\xbf\x0e\xc3\x1e\x7d\xe1\xbd\xab\xd9\x12\x57\xc7\x28\x99\x55\x30\x1b\x3d\x63\xd1\x68\x4f\x0a\xdf\x28\xb1\x33\x03\x12\x69\xad\xca\xb4\xc0\xfe\xa1\xb9\x8d\x34\x92\x52\xc0\xf4\x12\xe0\xc9\xdd\xa0\x74\x4c\x74\xa4\xd1\x5f\xf9\x8f\xec\x8f\xd7\xdc\xc0\xf9\xd7\x87\x2b\xc5\xf7\x33\x49\x92\xd9\xb3\xe2\x4a\xdf\x5f\x2a\x79\x56\x62\xf8\x82\x3b\x98\x57\x65\xb3\x5a\x69\xdc\x21\x76\x9c\x74\xa4\x0c\xaf\x42\x99\x40\xab\x25\xad\x5c\x6c\x48\x8b\x87\x70\x80\xe6\x94\x64\x45\x32\x4f\xdf\x2f\x35\x35\x49\x3b\xb7\x64\x5d\x53\x7f\xc1\x3c\x6f\x2f\xc1\x04\xcf\x7b\x5e\x39\x4e\xca\xde\x3d\x6f\xa9\x15\xd5\x68\x7a\x2a\xbe\xf5\xe9\x76\xbf\xf4\xcb\x65\x21\xeb\xc1\x62\x37\x48\xde\xe5\xaf\x07\x17\x3f\xef\xfe\xeb\xc7\xb3\xc3\x9f\x7b\xe7\x57\xd3\x4f\x3f\xbf\x76\x77\x43\xe7\xf5\xc5\xb8\xf5\xac\x70\x2f\x55\x80\x60\x61\xfd\xcd\x6d\xa3\xc1\x79\x92\xae\xd5\xa2\x6f\xaf\x9b\x62\x26\x2b\xea\x58\xf4\x77\x57\xa3\x9a\x5d\x01\xc0\x38\xa0\x7a\x33\x97\x30\xdf\xd6\x9a\xed\xce\x3f\xe9\xdf\x22\x97\xdb\x76\xfa\x5e\x3c\x3f\x88\x3e\xef\x7e\xba\xf6\x0e\x3f\xf7\xc2\x64\xfa\xe9\xf3\x08\x97\x3b\x8a\xc6\x5d\x7b\x36\x8b\xbb\xd3\xeb\xce\x30\x49\xc6\xbd\x4f\x41\xff\x79\x6f\x32\xeb\x7e\xd9\x4f\x0f\xbb\x71\xbf\xeb\x92\x9b\x78\xe2\x8d\x92\x2e\xe8\xd8\xf9\x8c\x3a\x43\x01\x26\xc4\x23\x18\x1f\x6d\x6f\xd3\xcf\x1d\xf6\xa9\x03\x23\x13\xfe\x3f\xa7\xd3\xe9\xfc\xf1\xa7\xef\xfe\xd1\xf9\xb3\x13\x74\x6e\x66\x9d\xce\xd0\x4f\xc6\xdd\x68\x42\x11\xda\x05\xf1\xdd\x92\x32\x5f\xa4\x08\x30\xab\xb5\xd3\xdb\xe9\x75\xfa\xbd\x4e\x6f\xff\xaa\xbf\x73\xb4\xdf\x3f\xda\xd9\xeb\xf6\xf6\x77\xfb\x7b\x3b\xff\xc9\xc1\x92\x5e\xad\x2a\xf5\x38\x38\xda\x3d\xe8\xee\x1e\xec\xec\xf4\x0e\xa5\x1e\x5c\x6b\xc7\xe6\xdd\x83\x6e\xaf\x55\x11\xc0\x6b\x09\x5a\xce\x71\x1e\x85\xb7\x71\x69\xe1\x98\x25\x1e\xfa\xa4\x0b\xfc\x17\x64\x06\x2e\x08\x48\x30\xab\xb9\xd0\xe1\x1b\x12\x6f\xc3\x6e\x11\x7b\x1a\xe7\xc4\x58\xb9\x3b\xdb\xae\x1d\x4f\x86\x21\x4c\xdd\x5a\x7c\x73\xa2\x12\x1c\x2f\x48\x78\x64\x7d\xe9\x9b\x94\x20\x02\x14\xbc\x91\x88\x8a\x98\x36\xd4\x57\xc1\x01\xcd\xa8\x57\x55\xb8\xa5\xf4\x4d\xef\x91\xb7\x5a\xef\xfa\x7b\x27\x2d\x63\xd7\xaf\x32\x6c\x65\x95\x45\xe0\x2b\x3b\xbb\x7b\xfb\x07\xcf\x0f\x5f\xf4\xfa\x3b\x2d\x6d\xf9\x43\xe9\x40\xcb\x3c\xeb\x35\x7d\xbc\xeb\x15\x0f\x20\x64\xc5\xe9\x9f\x16\x1f\x2b\xbe\x0b\xd8\x30\xb2\xfb\x63\x64\x0f\xca\xc7\xd8\xc6\x0e\xf8\x83\xc1\x80\x7f\xfe\x76\xb0\xa4\xd7\x8a\x44\x95\x2c\x00\xb6\x48\x0c\x8b\x58\x9e\x01\xdb\x31\x2a\xad\x58\x73\x56\x5c\x2c\x76\x81\xb7\x76\xfa\xf4\x52\xeb\x0a\x8c\x57\xbf\xba\xe8\xde\x7f\x95\x7b\xd1\x3f\x8a\x11\x17\x94\x15\x6e\x15\x23\x05\x95\x09\x5a\xfd\x56\xb1\x41\x1d\xcb\xfc\xa3\x45\x6f\xb3\x5a\x47\x16\xec\xe0\xfe\xf3\x9d\xc3\xde\x9f\xc5\xee\xe4\x4e\xbd\x2b\x98\xeb\x6e\xaf\xd7\x2b\x36\xad\x2a\xf9\x25\x4d\xd3\xef\x3d\xdf\x7d\xbe\xd7\x3f\xec\xe1\x3f\x7f\xea\x06\x28\x70\x69\x93\x49\x54\x6e\xad\xeb\xb0\x88\x69\x17\xfb\x14\x0a\xd3\x58\x7d\x7d\x03\x46\xa6\xad\x08\x98\x84\x7d\x5d\x9a\xb8\x5c\xf7\xa5\x3c\x4e\xa9\xf8\x94\xf5\x87\x25\x21\x6b\xef\x70\xff\xf9\x41\x19\x4d\xba\x1a\x4f\xe5\xb1\x35\x75\x99\xca\x8d\x34\x55\x93\x0a\x44\x8c\xff\x64\xf5\x8c\xca\x5f\x58\x7d\xa3\xe2\x87\xdf\xca\x0b\x55\xcb\xce\xd0\xd7\xbb\x87\x6a\xbc\x94\x65\x29\x4b\xfd\xad\x5c\xe6\xa1\xfe\xfc\xea\xea\xa9\xb4\x54\x49\xa8\x33\x20\x94\xdf\x0a\x87\xf1\x78\x6a\xff\x0e\x8c\xea\x03\x19\x8a\x78\x78\xa9\x6d\x99\xfb\x94\xeb\x6a\x18\x80\x2a\x17\xb5\xc8\x00\xd5\x48\xb6\x02\x68\xef\x2f\xad\x53\x68\xb1\x65\x49\x39\xea\x75\xb0\xd5\x66\x82\x5b\xff\xcd\x8c\xa5\xd6\x6f\xe5\xe4\x68\x85\x24\x4a\x5c\x4d\xe5\xda\xf9\x40\xda\x93\x58\xcc\x1b\x63\x31\x31\x85\x96\xc5\x1c\x2d\x00\x0f\x4c\xb8\x2d\xab\xf5\x65\x47\x02\x0f\xe8\xe5\x99\x4a\x2c\x75\x49\x7a\x15\x3b\xc1\xb1\x39\x9d\x77\x40\x78\x77\x62\x09\x85\x6a\xa0\x78\x31\x8b\x03\x2f\x9e\xa7\x73\x0b\x3a\xe9\xf2\x37\x4d\x94\xb2\x92\xea\xa5\x0e\x61\xa4\x83\x09\xbd\x84\x75\x01\x65\xac\xb5\xf6\x85\xa9\xb9\x4d\x00\xe5\x71\xa7\xbf\x83\xff\x2b\x7d\xe6\xf9\xc0\x38\x24\xfe\x47\x59\x27\x43\x53\xbd\x83\x2e\xac\xd6\x33\x4d\x62\x4f\xed\x77\xa1\x88\xf4\x3b\xbd\xbd\x4e\xef\xf9\x55\xff\x00\xf4\x96\xa3\x5e\xff\xff\xf5\xf6\x8f\x76\xb9\xd5\x54\x8e\x37\x5f\xb0\xe7\x80\xc8\x38\x0e\x25\xed\x4f\xc4\xf2\xe7\xfa\x17\x2b\x4b\x91\xcc\x41\xb5\xf3\x24\xa3\x2a\xef\x43\xc3\xee\x2b\xda\x83\x1e\x11\x30\x95\x8f\xda\x61\xa0\xbb\x6c\xc3\x72\x7c\x30\xbb\x40\x64\x80\xea\x0c\x6a\x6f\x12\x3a\xa1\xbf\x8d\x0d\x3d\xb7\xc3\xc5\xd4\xb6\x43\xa2\x24\x96\xed\x1b\x91\x0b\xb0\xe6\x79\xe8\xc0\xad\x67\xda\xa4\x80\xd5\xa6\x6a\xe5\xf1\xfd\x2a\x35\xbf\x9c\x9f\xb9\xdf\xd6\xa1\x78\x28\xa2\xaf\x4b\x7a\xba\x0b\xaa\xcb\x49\x45\x0d\xca\x5b\xfa\xcc\x95\x7a\x6c\x97\x83\x93\x07\x54\x86\x0f\x06\xfc\x75\x07\x61\xf9\x0d\x23\x38\x8f\x11\x8d\x9a\xe3\x91\x25\x2c\xc0\x0d\x95\x3d\xaa\x86\x4b\x43\xa0\x73\x7b\xfa\xbb\x37\xf0\xc2\x01\xbf\xfb\xe6\x83\x09\x17\x8f\x1c\x29\x82\x23\x1e\xc1\xac\x5c\x11\x8d\x06\xe1\x68\x14\x13\x29\x72\xb6\x9c\xed\xd0\x91\x62\x9e\xad\xfe\x41\xbf\x7f\xf0\xbc\xb7\x83\x4a\x7f\xaf\x98\x47\x84\x1e\xfb\xc3\xbd\xfe\xfe\xde\xa2\xde\x07\x95\xbd\xf7\x0f\x0f\x0f\x17\xf5\x7e\x51\xd9\xfb\x39\x98\xa2\x55\xd9\x07\x4f\x7e\x67\x16\xee\x42\x69\x07\xa8\xe1\x7b\x2e\x02\xa6\xaf\x78\xbc\xf4\x9d\xbc\x44\x93\x12\xab\xd0\x4f\x62\xee\x36\xd2\x45\x74\x6f\x9b\x01\x40\xbb\x9b\xbb\xb5\xf0\x61\xa1\x81\x81\x53\x88\xb5\x53\xc3\x38\x70\x8e\xdd\x83\xbd\xde\x4e\x6f\x57\x96\xc6\xf9\x70\x41\x88\xf1\xe0\x7c\x48\x95\xa1\x60\x33\x83\xd1\xb8\x4f\x44\x70\xa6\x1a\x00\x33\x17\x1a\x7f\x8a\xb3\x55\xe3\xde\xe9\xed\x74\x7a\xbb\x57\xfd\xfd\xa3\xde\xe1\x51\xff\xa0\xdb\xef\xed\xd6\xfa\x76\x2a\x9b\xd3\x6d\xa6\xcf\xaa\xde\x89\x7e\x3c\x3d\xfd\xd0\x81\x57\xdf\xd9\xdc\xdc\xc8\xdf\xcb\x95\xfc\x6a\xcc\xe0\x6d\xc5\xf3\x58\x11\x62\x99\x83\x4a\xf8\xa4\x78\x8d\x2a\x3b\xe6\xee\x28\x5a\x37\x90\x55\x30\xc2\x8b\x56\xb0\x6e\xd2\xd1\xc8\x73\x68\xd6\x7e\x44\xe2\x30\x8d\xc0\x08\x6c\x4b\x77\x4d\x58\xf7\x34\x04\x08\xf2\x0d\x42\x25\x14\x9d\xfa\xca\x2e\x55\xbb\x40\xcd\xb7\x8f\xfb\x82\x99\x5d\x4f\xdc\x77\xb6\x77\xb7\x83\xfd\x49\xbf\x31\xea\x0c\x4b\x9c\xea\x28\xeb\x38\x98\x41\xcf\xba\x23\xfd\x69\x29\x97\xed\x0c\x78\xaa\x1d\xcd\x07\xcb\xd2\x08\x0b\x74\x35\xee\x79\x2d\xb9\x9e\x3d\xbc\x16\x87\x85\xb0\x28\x76\xe8\xd6\xfd\x4e\x1a\x17\x7d\x2f\x19\xd3\x1e\x47\x61\x3a\x13\xac\xbb\x58\x6e\xc4\xf7\x00\x4d\x55\x7e\xe5\x1a\xfc\x74\xd9\x43\xd1\x7a\xaf\xf1\xfd\x1d\xf9\xf3\x19\xcf\xd6\xba\x13\x61\x85\x25\xc2\xca\xc6\x35\x22\xa7\x50\xb4\xae\x23\x21\x69\x92\xac\xfd\x40\xbe\xc6\x18\xb8\xc4\x27\xea\x9c\x89\x1d\x8d\xd5\x9a\x55\xe6\xc4\xa4\x1e\x12\xf9\xf7\x35\xde\x92\x50\x87\x62\x1a\xa8\xbc\x03\x38\x0a\x75\xe2\x1e\x59\xfb\xbd\xfb\xa0\x81\x17\xe5\xe6\x34\x70\xc6\xac\xb9\xe6\x71\xf9\x3b\x11\x8f\x5f\x22\x9e\xca\x77\xed\x17\x20\x9f\x3f\xd3\x3e\x88\xa5\x8e\x75\x7b\xe1\x2f\xc5\x94\x54\x8b\x9c\x87\x7d\x50\xbb\x98\xcf\x1b\x97\xde\xda\xac\x78\x81\xbe\xc3\x09\xb6\xf0\xf8\xbb\xc2\xc7\x98\x6b\x65\xd7\xe9\x93\x17\xa3\x03\x7b\x6f\xd8\x1b\xed\x90\xe7\xee\xa1\xbd\xef\x1c\x0c\xfb\xee\x0b\xd2\x1b\xed\xd9\xcf\x87\x3b\xce\xa1\xbb\x4f\x0e\x46\x7d\xbb\x37\x7c\xe1\xec\xba\xcf\xc9\xde\x68\xc7\x3e\x18\x1e\x3a\x7d\xb7\x07\x3d\x57\x62\x21\xfa\x97\x81\x57\xdb\xdc\x69\xd5\xe6\xaa\x0f\xf8\xe6\xc8\x5a\x30\x5e\xa0\x43\xee\xd1\x42\x7c\x56\x2a\x53\xd9\x23\xeb\xd6\x4e\x6e\xc2\x16\x9e\x51\x2f\xe2\xab\xdf\x2b\x9f\x9f\xe2\xc3\xe6\x26\x67\x4e\x7d\x97\x1c\xce\xfa\x6e\xbe\x36\xfa\x60\xb8\xd5\xe2\x94\xc5\x9b\xba\x84\x3f\x1d\xc9\xd6\x83\x3d\x8e\x84\x47\xc0\x7a\x1f\x64\x6e\xd4\x95\x76\x7d\xaf\xd7\x3b\x63\x61\x62\xab\x58\x13\x3b\x65\xff\x02\x7d\xd2\xdc\xe8\xdc\xd2\xd5\xc6\xdb\xf2\x18\x0c\x25\xad\x1f\x8f\x5f\xff\x78\x7c\xd9\x79\xf3\xfd\x9b\xab\x8e\xfc\x39\x53\xeb\x68\xb6\x6a\x59\x31\xb7\xdc\x90\xc4\xfc\xf9\x4c\x7c\x3d\x13\xc3\x25\xe7\xa0\xcb\xf1\xc8\xed\xdf\x0b\xa2\x29\x97\x27\x9c\xfc\x3e\x9c\x79\xd3\xcf\xdf\x3b\xd1\x49\xfa\xd3\x41\xdf\x7e\xff\xe5\xec\x3f\x9f\x5f\x5e\x7d\x7e\x7b\x61\x67\xb8\x3a\xe1\xb2\xc6\xe4\x74\xc8\x16\xc0\xaa\xc8\x51\x06\xd1\x60\xa7\xaf\x9a\x19\x1c\x3d\x97\xa0\xb8\x4c\xa2\x30\x00\x85\x15\x55\x64\x9e\xb1\x4a\x53\x1d\xc5\xdd\x00\x0b\x91\xb4\x51\xc5\xf9\x07\x2d\x05\x9b\x85\x35\xde\x09\x3f\x54\x68\xbe\x0d\x93\xcb\x34\xc6\x43\x47\xdc\x0d\x26\xa2\x34\x10\x0f\x92\xc0\x39\x03\x3b\x42\xbc\x98\x51\x23\xc5\x8f\xd8\x13\x8c\xfc\xad\x60\x8c\x1e\x74\xd3\xbc\xa8\x2f\x23\x0d\x91\x48\xc1\x07\x75\xd7\x82\xcf\x0b\x8c\x0c\x7b\x12\xb8\x8c\xd9\xce\x1b\xe3\x92\xc6\xbc\x09\x8c\x72\xd4\xc5\x82\x7a\xd6\x84\x3b\x50\xe0\x11\xbc\xa7\x42\x8b\x60\x6e\x18\xa3\x2f\x43\x55\x01\x85\x6c\x98\xbb\x21\x50\x84\x20\x35\xcc\x4e\x8f\x1f\x2e\x38\x0d\x7c\x90\x8c\xb6\xd6\x80\xa2\x9d\x7a\x0c\xed\xe8\x10\xc4\xe2\xc9\xe8\x4b\xbe\x76\x14\x13\x25\x7d\xe0\x08\x15\x08\x4e\x79\xb4\x14\xa6\x12\xc4\xc3\x32\x6f\x4b\xca\xf1\x91\xa5\xce\x79\x64\x2d\x9a\x22\xaf\x12\xee\x84\x7e\x3a\x0d\x58\xc0\x3d\x0e\xce\xe3\xc3\xad\xb6\xe7\xb6\xbb\x79\x60\x8f\xdc\x8e\x26\x4d\x1c\xf1\x78\xb0\x2d\x9e\xb4\xa4\x86\x94\x89\x5f\x99\x86\xd4\xb5\x7e\x66\x21\xf0\x6c\x7f\x30\x23\xda\xfa\x87\xd5\x97\x91\x53\xdc\x6d\xff\xc3\xc9\xf7\xe9\x7c\x78\x16\x9d\x06\x5f\xa2\x63\x32\x7d\xbe\xb3\x37\xfe\x7c\x7d\xed\x9d\xdc\x64\xbb\x2d\xad\xc2\xec\x02\x92\x8e\xbc\xdb\xbb\xfb\xa6\xcb\x63\x68\x36\x5d\xfe\x9c\x6d\xba\x00\x51\x3d\x08\x95\x08\x70\x5e\x1c\xf6\x26\xc9\xcd\xf8\xc6\x09\x5e\x5c\x8f\xf6\xc1\x8c\x08\x7a\xba\x95\x9b\x84\x3d\xb0\x75\xaf\x81\x91\xee\xd6\x33\xd2\x5d\x1d\x23\x65\x00\xae\x63\xd5\x6f\x30\xf3\x22\x18\xbf\x13\xac\x62\x83\xa5\xc7\x94\x81\x4a\xb3\xf4\x73\xde\x26\x9e\x15\xf7\xdc\xbb\xd0\xfd\x9e\xc1\xba\x9f\xdf\x7d\xd9\xcf\x6b\x57\xfd\x5c\xb3\xe8\xab\xbc\x2a\x36\x71\x33\xa7\x2d\x35\x01\x30\x2d\x87\x7c\xc9\x0a\x46\xc1\x22\xa8\xde\x4e\x36\x75\x29\xdc\x1f\xcb\x57\x40\x6d\x3d\xcf\xfd\x47\xbb\xef\xfd\xb8\xeb\xa6\xbf\xfc\x7a\x76\x73\xb3\xff\xeb\xcd\x4f\xfe\xfc\xf7\xfe\xf4\xfb\x8b\xdd\x7f\xcd\x3f\xbf\x6d\x53\x0a\x1f\x85\x69\x50\x27\xe2\x7f\x3d\x7f\x3e\xde\x19\x1f\xfc\x70\xe5\xbe\xff\xf1\xbd\xbd\x73\x1d\xff\x70\xb8\x73\xfd\xf3\xc9\xee\x5c\xe0\xa5\x6f\x22\xda\xd7\x40\xd4\xfd\x7a\xa2\xee\x6b\x6d\xbc\x4c\x30\xdd\x90\xc8\x1b\xcd\x31\x13\x87\x45\x49\x1c\x59\x17\xa2\x44\x0e\xc6\x26\x84\x11\xb7\xec\xd8\x57\x33\xcc\xec\xbe\x9f\x9c\x4e\x6e\xa7\xff\x7e\x39\xfb\xf0\x6e\x74\xb6\xe3\xbf\x25\xd7\x33\x77\xef\x3f\x27\x02\x33\xbb\x06\x98\xd9\xbb\x3b\x62\xf6\x6a\xf1\xb2\x57\x65\xfa\xb6\x47\x61\xd8\x19\xda\x51\x5b\xa8\x3a\x02\x0f\x4c\x08\x63\xb5\xa5\x38\x96\xab\xbe\x76\x6b\x58\x00\xe0\xc2\x3b\x9d\xfc\x1e\x48\xb8\xf8\x04\xb8\xf8\xf5\x55\x86\x8b\x37\xf6\x17\x9e\xc5\x28\x62\x6d\x2f\x58\x60\x97\x01\x92\xf6\xef\x8e\xa4\xfd\x5a\x24\xed\x2f\x46\x12\x5e\xf1\xf0\x50\x34\x29\xaf\x32\xaf\xd1\x72\x90\x55\x11\x62\x19\x13\xc8\x4b\xd3\xc0\x4b\xe2\x85\x68\xbb\xfe\x82\x68\xfb\xe5\x1d\x39\xdb\x09\x01\x6d\xee\xee\xbf\x5f\x66\x58\xbb\x22\xd1\x34\x06\xfb\xe3\x98\xd6\xbe\x32\x42\x96\x1c\xe7\xb0\xf2\x59\xdb\xa9\x3f\x6b\x3b\x5a\xa9\xc9\xcf\x53\x82\x30\x03\xbe\x6e\x08\x77\xa3\x60\xde\x22\x87\xbf\x12\x17\xd7\xff\x7e\xf5\xfb\x07\x8a\x02\x81\x8b\x9f\x6e\x5e\xbf\xf8\xf4\xe6\xe7\x5f\x05\x2e\x5e\xe0\xc3\x3c\x58\x0c\xca\xf7\x1c\x93\x5b\xc6\xdd\x83\x35\x68\x0f\x07\xf5\xda\xc3\x41\xe5\x75\xa1\x78\x95\x91\x2a\xa9\x70\xc0\x6c\x9f\x19\xa9\xf8\x4a\x64\x25\x12\x0e\xae\x7f\xed\x21\x41\xfc\x9e\x63\xe3\x57\x32\x71\x77\x4f\x39\x4b\xd9\xef\xf5\x0c\x16\xfe\xe2\xee\xeb\x7e\x51\xbb\xec\x17\x5a\x4e\x9b\x57\xc0\x22\xea\x74\x25\xc6\x49\x4e\xc5\xde\x1e\xfc\x3a\x9e\x8c\xde\xbc\x18\x7f\x7f\x11\xff\x70\x73\xfa\x21\x5b\xa5\xb1\xa8\x7d\x94\xb5\xb2\x3c\x58\xe6\xab\x61\x59\xc1\x4e\x8c\x3e\xf7\xf3\x57\x6f\x3a\xa7\xff\xee\xbc\x38\xe2\x17\xc4\xc8\x46\x69\x2b\x92\xb7\x21\x5f\x92\x8e\x92\x23\xf2\xa5\xb7\xeb\x07\xae\x3f\xfd\xdc\xfb\x3c\x72\x9e\xc7\x5e\x62\xef\xc7\xfe\xa7\x9b\x43\xa2\x96\x6f\xca\x08\x0a\x97\xdd\x1f\xef\xbb\x87\x87\x9f\x7b\x7e\xe4\xb8\x37\x7b\xe3\xe7\xb6\x3f\x7c\x1e\xfb\xa3\x71\xf0\x69\xd7\x9d\x0c\xe3\x4f\xff\xf3\x7f\xfe\x7a\xfa\xef\xab\x8b\x63\xeb\x3b\xb6\xc6\x2e\x45\xca\x3f\xf2\x97\xb3\xe4\x5b\xad\xd8\x6a\x83\x72\xd3\xde\xa2\xab\xa7\x7f\xbe\xfa\xe9\xfd\xe5\xd5\xe9\x85\x10\x20\xf0\x91\x26\xd6\x66\xfb\x28\x3f\xc1\x85\xed\x01\x9c\x30\xda\xef\xdd\x78\x69\xef\x79\x48\x70\x97\x26\xd1\xb5\xb3\x73\xe0\x8e\x47\xc9\xa7\xbe\xed\xb4\xe5\x1b\x0c\x71\x65\xde\x5e\xb4\x08\x49\x3d\xf9\x5b\x9d\x14\xbe\x8a\x3f\x44\xf3\x83\x20\xfe\x3c\xdc\x89\xdf\x4e\x5f\x7f\xda\x1f\xfe\x7b\x76\xf2\xfc\x15\x98\xd8\xff\x1f\x91\xc1\xac\x42\x38\xf0\x01\x00")
 
 func kasFleetManagerYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -93,7 +93,7 @@ func kasFleetManagerYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 64366, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "kas-fleet-manager.yaml", size: 127032, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/configreload"
+)
+
+type adminConfigHandler struct {
+	configReloader *configreload.ConfigReloader
+}
+
+func NewAdminConfigHandler(configReloader *configreload.ConfigReloader) *adminConfigHandler {
+	return &adminConfigHandler{
+		configReloader: configReloader,
+	}
+}
+
+func (h adminConfigHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return presenters.PresentConfigStatusList(h.configReloader.Status()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+// Reload reloads the reloadable configurations of the instance serving the request. A configuration which fails to
+// reload is reported in its status rather than as an error, as the other configurations are reloaded regardless.
+func (h adminConfigHandler) Reload(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return presenters.PresentConfigStatusList(h.configReloader.Reload()), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
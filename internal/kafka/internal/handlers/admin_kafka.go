@@ -10,39 +10,50 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/gorilla/mux"
 )
 
 type adminKafkaHandler struct {
-	kafkaService   services.KafkaService
-	accountService account.AccountService
-	providerConfig *config.ProviderConfig
+	kafkaService       services.KafkaService
+	kafkaExpiryService services.KafkaExpiryService
+	accountService     account.AccountService
+	providerConfig     *config.ProviderConfig
+	operationService   operations.OperationService
 }
 
-func NewAdminKafkaHandler(kafkaService services.KafkaService, accountService account.AccountService, providerConfig *config.ProviderConfig) *adminKafkaHandler {
+func NewAdminKafkaHandler(kafkaService services.KafkaService, kafkaExpiryService services.KafkaExpiryService, accountService account.AccountService, providerConfig *config.ProviderConfig, operationService operations.OperationService) *adminKafkaHandler {
 	return &adminKafkaHandler{
-		kafkaService:   kafkaService,
-		accountService: accountService,
-		providerConfig: providerConfig,
+		kafkaService:       kafkaService,
+		kafkaExpiryService: kafkaExpiryService,
+		accountService:     accountService,
+		providerConfig:     providerConfig,
+		operationService:   operationService,
 	}
 }
 
 func (h adminKafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var kafkaRequest *dbapi.KafkaRequest
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
-			kafkaRequest, err := h.kafkaService.Get(ctx, id)
+			var err *errors.ServiceError
+			kafkaRequest, err = h.kafkaService.Get(ctx, id)
 			if err != nil {
 				return nil, err
 			}
-			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
+			return h.presentKafka(kafkaRequest)
+		},
+		ETag: func(result interface{}) string {
+			return kafkaETag(kafkaRequest)
 		},
 	}
 	handlers.HandleGet(w, r, cfg)
@@ -73,7 +84,7 @@ func (h adminKafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 			}
 
 			for _, kafkaRequest := range kafkaRequests {
-				converted, err := presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
+				converted, err := h.presentKafka(kafkaRequest)
 				if err != nil {
 					return nil, err
 				}
@@ -91,14 +102,19 @@ func (h adminKafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "deleting kafka requests"),
+			handlers.ValidateIfMatch(r, currentKafkaETag(r.Context(), h.kafkaService, mux.Vars(r)["id"])),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.kafkaService.RegisterKafkaDeprovisionJob(ctx, id)
-			return nil, err
+			operation, err := registerKafkaDeletion(ctx, h.kafkaService, h.operationService, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(operation), nil
 		},
+		Location: operationLocation,
 	}
 
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
@@ -123,6 +139,9 @@ func (h adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				}
 				return nil
 			},
+			handlers.ValidateIfMatch(r, func() (string, *errors.ServiceError) {
+				return kafkaETag(kafkaRequest), nil
+			}),
 			ValidateKafkaUpdateFields(
 				&kafkaUpdateReq,
 			),
@@ -172,13 +191,55 @@ func (h adminKafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 			updateRequired = update(&kafkaRequest.DesiredKafkaIBPVersion, kafkaUpdateReq.KafkaIbpVersion) || updateRequired
 			updateRequired = update(&kafkaRequest.KafkaStorageSize, kafkaUpdateReq.KafkaStorageSize) || updateRequired
 
+			// the kafka is only updated if it did not change since the If-Match header was checked against its version
 			if updateRequired {
 				err3 := h.kafkaService.VerifyAndUpdateKafkaAdmin(ctx, kafkaRequest)
 				if err3 != nil {
-					return nil, err3
+					return nil, handlers.ConditionalWriteError(r.Header.Get(handlers.HeaderIfMatch), err3)
+				}
+			}
+			return h.presentKafka(kafkaRequest)
+		},
+		ETag: func(result interface{}) string {
+			return kafkaETag(kafkaRequest)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// presentKafka presents the kafka with its effective expiration time
+func (h adminKafkaHandler) presentKafka(kafkaRequest *dbapi.KafkaRequest) (*private.Kafka, *errors.ServiceError) {
+	expiresAt, err := h.kafkaExpiryService.GetExpirationTime(kafkaRequest)
+	if err != nil {
+		return nil, err
+	}
+	return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, expiresAt, h.accountService)
+}
+
+func (h adminKafkaHandler) ExtendLifespan(w http.ResponseWriter, r *http.Request) {
+	var extensionReq private.KafkaLifespanExtensionRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &extensionReq,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				if extensionReq.ExpiresAt.IsZero() {
+					return errors.Validation("expires_at is required")
 				}
+				return nil
+			},
+		},
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			kafkaRequest, err := h.kafkaService.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := h.kafkaExpiryService.ExtendLifespan(kafkaRequest, extensionReq.ExpiresAt); err != nil {
+				return nil, err
 			}
-			return presenters.PresentKafkaRequestAdminEndpoint(kafkaRequest, h.accountService)
+			return h.presentKafka(kafkaRequest)
 		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
@@ -3,19 +3,24 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	s "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	. "github.com/onsi/gomega"
 )
 
@@ -26,18 +31,69 @@ func GetHandlerParams(method string, url string, body io.Reader) (*http.Request,
 	return req, httptest.NewRecorder()
 }
 
+// kafkaExpiryServiceWithoutLifespan returns a kafka expiry service for kafkas whose instance size has no lifespan
+func kafkaExpiryServiceWithoutLifespan() *services.KafkaExpiryServiceMock {
+	return &services.KafkaExpiryServiceMock{
+		GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+			return nil, nil
+		},
+	}
+}
+
 func Test_Get(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
+		kafkaService       services.KafkaService
+		kafkaExpiryService services.KafkaExpiryService
+		accountService     account.AccountService
+		providerConfig     *config.ProviderConfig
 	}
 
+	createdAt := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	lifespanEnd := createdAt.Add(48 * time.Hour)
+
 	tests := []struct {
 		name           string
 		fields         fields
 		wantStatusCode int
+		wantExpiresAt  *time.Time
 	}{
+		{
+			name: "should present the end of the instance size lifespan as expiration time",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						kafka := &dbapi.KafkaRequest{}
+						kafka.CreatedAt = createdAt
+						return kafka, nil
+					},
+				},
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{
+					GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+						return &lifespanEnd, nil
+					},
+				},
+				accountService: account.NewMockAccountService(),
+			},
+			wantStatusCode: http.StatusOK,
+			wantExpiresAt:  &lifespanEnd,
+		},
+		{
+			name: "should return an error if the expiration time can't be computed",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{}, nil
+					},
+				},
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{
+					GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+						return nil, errors.GeneralError("test")
+					},
+				},
+				accountService: account.NewMockAccountService(),
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
 		{
 			name: "should successfully execute GET",
 			fields: fields{
@@ -68,12 +124,22 @@ func Test_Get(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig)
+			kafkaExpiryService := tt.fields.kafkaExpiryService
+			if kafkaExpiryService == nil {
+				kafkaExpiryService = kafkaExpiryServiceWithoutLifespan()
+			}
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, kafkaExpiryService, tt.fields.accountService, tt.fields.providerConfig, nil)
 			req, rw := GetHandlerParams("GET", "/{id}", nil)
 			h.Get(rw, req)
 			resp := rw.Result()
+			defer resp.Body.Close()
 			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
-			resp.Body.Close()
+			if tt.wantExpiresAt != nil {
+				var kafka private.Kafka
+				Expect(json.NewDecoder(resp.Body).Decode(&kafka)).To(Succeed())
+				Expect(kafka.ExpiresAt).ToNot(BeNil())
+				Expect(kafka.ExpiresAt.Equal(*tt.wantExpiresAt)).To(BeTrue())
+			}
 		})
 	}
 }
@@ -156,7 +222,7 @@ func Test_List(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, kafkaExpiryServiceWithoutLifespan(), tt.fields.accountService, tt.fields.providerConfig, nil)
 			req, rw := GetHandlerParams("GET", tt.args.url, nil)
 			h.List(rw, req)
 			resp := rw.Result()
@@ -168,9 +234,10 @@ func Test_List(t *testing.T) {
 
 func Test_Delete(t *testing.T) {
 	type fields struct {
-		kafkaService   services.KafkaService
-		accountService account.AccountService
-		providerConfig *config.ProviderConfig
+		kafkaService     services.KafkaService
+		accountService   account.AccountService
+		providerConfig   *config.ProviderConfig
+		operationService operations.OperationService
 	}
 
 	type args struct {
@@ -182,6 +249,7 @@ func Test_Delete(t *testing.T) {
 		fields         fields
 		args           args
 		wantStatusCode int
+		wantLocation   string
 	}{
 		{
 			name: "should successfully accept kafka deletion request",
@@ -190,12 +258,22 @@ func Test_Delete(t *testing.T) {
 					RegisterKafkaDeprovisionJobFunc: func(ctx context.Context, id string) *errors.ServiceError {
 						return nil
 					},
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{Meta: api.Meta{ID: id}}, nil
+					},
+				},
+				operationService: &operations.OperationServiceMock{
+					CreateFunc: func(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError) {
+						operation.ID = "operation-id"
+						return operation, nil
+					},
 				},
 			},
 			args: args{
 				url: "/kafkas/{id}?async=true",
 			},
 			wantStatusCode: http.StatusAccepted,
+			wantLocation:   "/api/kafkas_mgmt/v1/operations/operation-id",
 		},
 		{
 			name: "should return an error if async flag is not set to true when deleting kafka",
@@ -210,11 +288,12 @@ func Test_Delete(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, kafkaExpiryServiceWithoutLifespan(), tt.fields.accountService, tt.fields.providerConfig, tt.fields.operationService)
 			req, rw := GetHandlerParams("DELETE", tt.args.url, nil)
 			h.Delete(rw, req)
 			resp := rw.Result()
 			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
+			Expect(resp.Header.Get("Location")).To(Equal(tt.wantLocation))
 			resp.Body.Close()
 		})
 	}
@@ -228,8 +307,9 @@ func Test_Update(t *testing.T) {
 	}
 
 	type args struct {
-		url  string
-		body []byte
+		url     string
+		body    []byte
+		ifMatch string
 	}
 
 	tests := []struct {
@@ -359,6 +439,47 @@ func Test_Update(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "should return a precondition failed error if the If-Match header does not match the version of the kafka",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{
+							Status:  constants.KafkaRequestStatusPreparing.String(),
+							Version: 2,
+						}, nil
+					},
+				},
+			},
+			args: args{
+				url:     "/kafkas/{id}",
+				body:    []byte(`{"kafka_ibp_version": "2.8.0"}`),
+				ifMatch: `"1"`,
+			},
+			wantStatusCode: http.StatusPreconditionFailed,
+		},
+		{
+			name: "should return a precondition failed error if the kafka changes before it is updated",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return &dbapi.KafkaRequest{
+							Status:  constants.KafkaRequestStatusPreparing.String(),
+							Version: 2,
+						}, nil
+					},
+					VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.Conflict("unable to update kafka: it has been changed concurrently or is being deleted")
+					},
+				},
+			},
+			args: args{
+				url:     "/kafkas/{id}",
+				body:    []byte(`{"kafka_ibp_version": "2.8.0"}`),
+				ifMatch: `"2"`,
+			},
+			wantStatusCode: http.StatusPreconditionFailed,
+		},
 		{
 			name: "should successfully upgrade kafka",
 			fields: fields{
@@ -386,8 +507,11 @@ func Test_Update(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.accountService, tt.fields.providerConfig)
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, kafkaExpiryServiceWithoutLifespan(), tt.fields.accountService, tt.fields.providerConfig, nil)
 			req, rw := GetHandlerParams("PATCH", tt.args.url, bytes.NewBuffer(tt.args.body))
+			if tt.args.ifMatch != "" {
+				req.Header.Set(handlers.HeaderIfMatch, tt.args.ifMatch)
+			}
 			h.Update(rw, req)
 			resp := rw.Result()
 			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
@@ -395,3 +519,91 @@ func Test_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_ExtendLifespan(t *testing.T) {
+	type fields struct {
+		kafkaService       services.KafkaService
+		kafkaExpiryService services.KafkaExpiryService
+		accountService     account.AccountService
+	}
+
+	getKafka := &services.KafkaServiceMock{
+		GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+			return &dbapi.KafkaRequest{}, nil
+		},
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		body           []byte
+		wantStatusCode int
+	}{
+		{
+			name: "should extend the lifespan of the kafka",
+			fields: fields{
+				kafkaService: getKafka,
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{
+					ExtendLifespanFunc: func(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError {
+						kafkaRequest.ExpiresAt = &expiresAt
+						return nil
+					},
+					GetExpirationTimeFunc: func(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+						return kafkaRequest.ExpiresAt, nil
+					},
+				},
+				accountService: account.NewMockAccountService(),
+			},
+			body:           []byte(`{"expires_at": "2030-01-01T00:00:00Z"}`),
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "should return an error if the expiration time is missing",
+			fields: fields{
+				kafkaService:       getKafka,
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{},
+			},
+			body:           []byte(`{}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "should return an error if the kafka can't be found",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.NotFound("test")
+					},
+				},
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{},
+			},
+			body:           []byte(`{"expires_at": "2030-01-01T00:00:00Z"}`),
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "should return an error if the lifespan can't be extended",
+			fields: fields{
+				kafkaService: getKafka,
+				kafkaExpiryService: &services.KafkaExpiryServiceMock{
+					ExtendLifespanFunc: func(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError {
+						return errors.BadRequest("kafka has no lifespan")
+					},
+				},
+			},
+			body:           []byte(`{"expires_at": "2030-01-01T00:00:00Z"}`),
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAdminKafkaHandler(tt.fields.kafkaService, tt.fields.kafkaExpiryService, tt.fields.accountService, nil, nil)
+			req, rw := GetHandlerParams("POST", "/kafkas/{id}/extend_lifespan", bytes.NewBuffer(tt.body))
+			h.ExtendLifespan(rw, req)
+			resp := rw.Result()
+			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
+			resp.Body.Close()
+		})
+	}
+}
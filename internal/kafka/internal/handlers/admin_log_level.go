@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+)
+
+// adminLogLevelHandler adjusts the log levels of the components of the instance serving the request, the levels are
+// not shared with the other instances and are lost on restart
+type adminLogLevelHandler struct{}
+
+func NewAdminLogLevelHandler() *adminLogLevelHandler {
+	return &adminLogLevelHandler{}
+}
+
+func (h adminLogLevelHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return presenters.PresentLogLevelList(logger.DefaultLevel(), logger.ComponentLevels()), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h adminLogLevelHandler) Update(w http.ResponseWriter, r *http.Request) {
+	component := mux.Vars(r)["component"]
+	var logLevelUpdateRequest private.LogLevelUpdateRequest
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &logLevelUpdateRequest,
+		Validate: []handlers.Validate{
+			func() *errors.ServiceError {
+				if logLevelUpdateRequest.Level < 0 {
+					return errors.FieldValidationError("log level must be greater than or equal to 0")
+				}
+				return nil
+			},
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			logger.SetLevel(component, logLevelUpdateRequest.Level)
+			return presenters.PresentLogLevel(component, logger.Level(component)), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+func (h adminLogLevelHandler) Reset(w http.ResponseWriter, r *http.Request) {
+	component := mux.Vars(r)["component"]
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			logger.ResetLevel(component)
+			return presenters.PresentLogLevel(component, logger.Level(component)), nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusOK)
+}
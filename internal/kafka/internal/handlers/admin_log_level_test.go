@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+)
+
+func Test_adminLogLevelHandler_Update(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		wantStatusCode int
+		wantLevel      int32
+	}{
+		{
+			name:           "should set the log level of the component",
+			body:           []byte(`{"level": 5}`),
+			wantStatusCode: http.StatusOK,
+			wantLevel:      5,
+		},
+		{
+			name:           "should return an error if the log level is negative",
+			body:           []byte(`{"level": -1}`),
+			wantStatusCode: http.StatusBadRequest,
+			wantLevel:      logger.DefaultLevel(),
+		},
+		{
+			name:           "should return an error if the body is malformed",
+			body:           []byte(`{"level": "high"}`),
+			wantStatusCode: http.StatusBadRequest,
+			wantLevel:      logger.DefaultLevel(),
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer logger.ResetLevel("test")
+			h := NewAdminLogLevelHandler()
+			req, rw := GetHandlerParams(http.MethodPatch, "/log_levels/{component}", bytes.NewBuffer(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"component": "test"})
+			h.Update(rw, req)
+			resp := rw.Result()
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(tt.wantStatusCode))
+			Expect(logger.Level("test")).To(Equal(tt.wantLevel))
+			if tt.wantStatusCode == http.StatusOK {
+				var level private.LogLevel
+				Expect(json.NewDecoder(resp.Body).Decode(&level)).To(Succeed())
+				Expect(level).To(Equal(private.LogLevel{Component: "test", Level: tt.wantLevel}))
+			}
+		})
+	}
+}
+
+func Test_adminLogLevelHandler_Reset(t *testing.T) {
+	RegisterTestingT(t)
+	logger.SetLevel("test", 5)
+	defer logger.ResetLevel("test")
+
+	h := NewAdminLogLevelHandler()
+	req, rw := GetHandlerParams(http.MethodDelete, "/log_levels/{component}", nil)
+	req = mux.SetURLVars(req, map[string]string{"component": "test"})
+	h.Reset(rw, req)
+	resp := rw.Result()
+	defer resp.Body.Close()
+
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(logger.Level("test")).To(Equal(logger.DefaultLevel()))
+	Expect(logger.ComponentLevels()).To(BeEmpty())
+}
@@ -1,14 +1,17 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	config "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 
 	"github.com/gorilla/mux"
 
@@ -17,22 +20,26 @@ import (
 )
 
 type kafkaHandler struct {
-	service        services.KafkaService
-	providerConfig *config.ProviderConfig
-	authService    authorization.Authorization
-	kafkaConfig    *config.KafkaConfig
+	service          services.KafkaService
+	providerConfig   *config.ProviderConfig
+	authService      authorization.Authorization
+	kafkaConfig      *config.KafkaConfig
+	transferService  services.KafkaOwnershipTransferService
+	operationService operations.OperationService
 }
 
 func GetAcceptedOrderByParams() []string {
 	return []string{"bootstrap_server_host", "cloud_provider", "cluster_id", "created_at", "href", "id", "instance_type", "multi_az", "name", "organisation_id", "owner", "reauthentication_enabled", "region", "status", "updated_at", "version"}
 }
 
-func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig) *kafkaHandler {
+func NewKafkaHandler(service services.KafkaService, providerConfig *config.ProviderConfig, authService authorization.Authorization, kafkaConfig *config.KafkaConfig, transferService services.KafkaOwnershipTransferService, operationService operations.OperationService) *kafkaHandler {
 	return &kafkaHandler{
-		service:        service,
-		providerConfig: providerConfig,
-		authService:    authService,
-		kafkaConfig:    kafkaConfig,
+		service:          service,
+		providerConfig:   providerConfig,
+		authService:      authService,
+		kafkaConfig:      kafkaConfig,
+		transferService:  transferService,
+		operationService: operationService,
 	}
 }
 
@@ -51,9 +58,13 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 			ValidateCloudProvider(ctx, &h.service, &kafkaRequestPayload, h.providerConfig, "creating kafka requests"),
 			ValidateKafkaPlan(ctx, &h.service, h.kafkaConfig, &kafkaRequestPayload),
 			ValidateBillingCloudAccountIdAndMarketplace(ctx, &h.service, &kafkaRequestPayload),
+			ValidateKafkaConfig(ctx, &h.service, h.kafkaConfig, &kafkaRequestPayload),
 		},
 		Action: func() (interface{}, *errors.ServiceError) {
-			convKafka := presenters.ConvertKafkaRequest(kafkaRequestPayload)
+			convKafka, svcErr := presenters.ConvertKafkaRequest(kafkaRequestPayload)
+			if svcErr != nil {
+				return nil, svcErr
+			}
 
 			claims, _ := getClaims(ctx)
 			convKafka.Owner, _ = claims.GetUsername()
@@ -64,7 +75,7 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 			convKafka.CloudProvider, convKafka.Region, _ = getCloudProviderAndRegion(ctx, &h.service, &kafkaRequestPayload, h.providerConfig)
 
-			svcErr := h.service.RegisterKafkaJob(convKafka)
+			svcErr = h.service.RegisterKafkaJob(convKafka)
 			if svcErr != nil {
 				return nil, svcErr
 			}
@@ -77,16 +88,21 @@ func (h kafkaHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h kafkaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	var kafkaRequest *dbapi.KafkaRequest
 	cfg := &handlers.HandlerConfig{
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
-			kafkaRequest, err := h.service.Get(ctx, id)
+			var err *errors.ServiceError
+			kafkaRequest, err = h.service.Get(ctx, id)
 			if err != nil {
 				return nil, err
 			}
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
 		},
+		ETag: func(result interface{}) string {
+			return kafkaETag(kafkaRequest)
+		},
 	}
 	handlers.HandleGet(w, r, cfg)
 }
@@ -96,18 +112,74 @@ func (h kafkaHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Validate: []handlers.Validate{
 			handlers.ValidateAsyncEnabled(r, "deleting kafka requests"),
+			handlers.ValidateIfMatch(r, currentKafkaETag(r.Context(), h.service, mux.Vars(r)["id"])),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
 
-			err := h.service.RegisterKafkaDeprovisionJob(ctx, id)
-			return nil, err
+			operation, err := registerKafkaDeletion(ctx, h.service, h.operationService, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(operation), nil
 		},
+		Location: operationLocation,
 	}
 	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
 }
 
+// Suspend is the handler for suspending a ready kafka request
+func (h kafkaHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+
+			kafkaRequest, err := h.service.SuspendKafka(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Resume is the handler for resuming a suspended kafka request
+func (h kafkaHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+
+			kafkaRequest, err := h.service.ResumeKafka(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Restore is the handler for restoring a kafka request scheduled for deletion
+func (h kafkaHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (i interface{}, serviceError *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+
+			kafkaRequest, err := h.service.RestoreKafka(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
 func (h kafkaHandler) List(w http.ResponseWriter, r *http.Request) {
 	cfg := &handlers.HandlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
@@ -162,7 +234,11 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 		MarshalInto: &kafkaUpdateReq,
 		Validate: []handlers.Validate{
 			validateKafkaFound(),
+			handlers.ValidateIfMatch(r, func() (string, *errors.ServiceError) {
+				return kafkaETag(kafkaRequest), nil
+			}),
 			ValidateKafkaUserFacingUpdateFields(ctx, h.authService, kafkaRequest, &kafkaUpdateReq),
+			ValidateKafkaConfigUpdate(h.kafkaConfig, kafkaRequest, &kafkaUpdateReq),
 		},
 		Action: func() (i interface{}, serviceError *errors.ServiceError) {
 			updatedNeeded := false
@@ -171,24 +247,60 @@ func (h kafkaHandler) Update(w http.ResponseWriter, r *http.Request) {
 				updatedNeeded = true
 			}
 
+			// the kafka keeps its owner until the new owner accepts the transfer
 			if kafkaUpdateReq.Owner != nil && kafkaRequest.Owner != *kafkaUpdateReq.Owner {
-				kafkaRequest.Owner = *kafkaUpdateReq.Owner
+				claims, _ := getClaims(ctx)
+				requestedBy, _ := claims.GetUsername()
+				if _, err := h.transferService.Create(kafkaRequest, requestedBy, *kafkaUpdateReq.Owner, kafkaRequest.OrganisationId); err != nil {
+					return nil, err
+				}
+			}
+
+			// the provided kafka settings replace all the previously tuned ones
+			if kafkaUpdateReq.KafkaConfig != nil {
+				if err := kafkaRequest.SetKafkaConfig(presenters.ConvertKafkaConfig(kafkaUpdateReq.KafkaConfig)); err != nil {
+					return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to update kafka settings")
+				}
 				updatedNeeded = true
 			}
 
+			// the kafka is only updated if it did not change since the If-Match header was checked against its version
 			if updatedNeeded {
-				updateErr := h.service.Updates(kafkaRequest, map[string]interface{}{
+				updateErr := h.service.VersionedUpdates(kafkaRequest, map[string]interface{}{
 					"reauthentication_enabled": kafkaRequest.ReauthenticationEnabled,
-					"owner":                    kafkaRequest.Owner,
+					"kafka_config":             kafkaRequest.KafkaConfig,
 				})
 
 				if updateErr != nil {
-					return nil, updateErr
+					return nil, handlers.ConditionalWriteError(r.Header.Get(handlers.HeaderIfMatch), updateErr)
 				}
 			}
 
 			return presenters.PresentKafkaRequest(kafkaRequest, h.kafkaConfig)
 		},
+		ETag: func(result interface{}) string {
+			return kafkaETag(kafkaRequest)
+		},
 	}
 	handlers.Handle(w, r, cfg, http.StatusOK)
 }
+
+// kafkaETag returns the entity tag of a kafka, derived from the version of its state edited by the users and the admins
+// so that it does not change with the status updates of the data plane
+func kafkaETag(kafkaRequest *dbapi.KafkaRequest) string {
+	if kafkaRequest == nil {
+		return ""
+	}
+	return handlers.ETag(kafkaRequest.Version)
+}
+
+// currentKafkaETag returns the entity tag of the stored version of a kafka, for the If-Match header of a request to be checked against it
+func currentKafkaETag(ctx context.Context, kafkaService services.KafkaService, id string) func() (string, *errors.ServiceError) {
+	return func() (string, *errors.ServiceError) {
+		kafkaRequest, err := kafkaService.Get(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return kafkaETag(kafkaRequest), nil
+	}
+}
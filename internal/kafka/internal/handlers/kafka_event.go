@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type kafkaEventHandler struct {
+	service      services.KafkaEventService
+	kafkaService services.KafkaService
+}
+
+func NewKafkaEventHandler(service services.KafkaEventService, kafkaService services.KafkaService) *kafkaEventHandler {
+	return &kafkaEventHandler{
+		service:      service,
+		kafkaService: kafkaService,
+	}
+}
+
+// List is the handler for listing the history of a kafka. It serves both the users who can access the kafka and the admins.
+func (h kafkaEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			kafkaRequest, err := h.kafkaService.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			events, paging, err := h.service.ListByKafkaId(kafkaRequest.ID, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			eventList := public.KafkaEventList{
+				Kind:  "KafkaEventList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.KafkaEvent{},
+			}
+			for _, event := range events {
+				eventList.Items = append(eventList.Items, presenters.PresentKafkaEvent(event))
+			}
+
+			return eventList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/gorilla/mux"
+)
+
+type kafkaOwnershipTransferHandler struct {
+	service      services.KafkaOwnershipTransferService
+	kafkaService services.KafkaService
+	authService  authorization.Authorization
+}
+
+func NewKafkaOwnershipTransferHandler(service services.KafkaOwnershipTransferService, kafkaService services.KafkaService, authService authorization.Authorization) *kafkaOwnershipTransferHandler {
+	return &kafkaOwnershipTransferHandler{
+		service:      service,
+		kafkaService: kafkaService,
+		authService:  authService,
+	}
+}
+
+// Create is the handler for requesting the transfer of a kafka to another user
+func (h kafkaOwnershipTransferHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var transferReq public.KafkaOwnershipTransferRequest
+	ctx := r.Context()
+	kafkaRequest, kafkaGetError := h.kafkaService.Get(ctx, mux.Vars(r)["id"])
+	validateKafkaFound := func() handlers.Validate {
+		return func() *errors.ServiceError {
+			return kafkaGetError
+		}
+	}
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &transferReq,
+		Validate: []handlers.Validate{
+			validateKafkaFound(),
+			ValidateKafkaOwnershipTransferRequest(ctx, h.authService, kafkaRequest, &transferReq),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			toOrganisationId := kafkaRequest.OrganisationId
+			if transferReq.ToOrganisationId != nil && *transferReq.ToOrganisationId != "" {
+				toOrganisationId = *transferReq.ToOrganisationId
+			}
+
+			claims, _ := getClaims(ctx)
+			requestedBy, _ := claims.GetUsername()
+			transfer, err := h.service.Create(kafkaRequest, requestedBy, transferReq.ToOwner, toOrganisationId)
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h kafkaOwnershipTransferHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			transfer, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h kafkaOwnershipTransferHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+
+			transfers, paging, err := h.service.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			transferList := public.KafkaOwnershipTransferList{
+				Kind:  "KafkaOwnershipTransferList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.KafkaOwnershipTransfer{},
+			}
+			for _, transfer := range transfers {
+				transferList.Items = append(transferList.Items, presenters.PresentKafkaOwnershipTransfer(transfer))
+			}
+
+			return transferList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Accept is the handler for accepting the transfer of a kafka as its new owner
+func (h kafkaOwnershipTransferHandler) Accept(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			transfer, err := h.service.Accept(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Decline is the handler for declining the transfer of a kafka as its new owner
+func (h kafkaOwnershipTransferHandler) Decline(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			transfer, err := h.service.Decline(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// Cancel is the handler for withdrawing the transfer of a kafka
+func (h kafkaOwnershipTransferHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			transfer, err := h.service.Cancel(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusOK)
+}
+
+// Approve is the admin handler for approving the transfer of a kafka to another organisation
+func (h kafkaOwnershipTransferHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			transfer, err := h.service.Approve(mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaOwnershipTransfer(transfer), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
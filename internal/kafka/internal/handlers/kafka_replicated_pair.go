@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/gorilla/mux"
+)
+
+type kafkaReplicatedPairHandler struct {
+	service        services.KafkaReplicatedPairService
+	kafkaService   services.KafkaService
+	providerConfig *config.ProviderConfig
+	kafkaConfig    *config.KafkaConfig
+}
+
+func NewKafkaReplicatedPairHandler(service services.KafkaReplicatedPairService, kafkaService services.KafkaService, providerConfig *config.ProviderConfig, kafkaConfig *config.KafkaConfig) *kafkaReplicatedPairHandler {
+	return &kafkaReplicatedPairHandler{
+		service:        service,
+		kafkaService:   kafkaService,
+		providerConfig: providerConfig,
+		kafkaConfig:    kafkaConfig,
+	}
+}
+
+// Create is the handler for creating a primary and a secondary kafka in two regions
+func (h kafkaReplicatedPairHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var pairRequest public.KafkaReplicatedPairRequest
+	// the payload of the secondary kafka is derived from the one of the primary by ValidateKafkaReplicatedPairRequest
+	var secondaryPayload public.KafkaRequestPayload
+	ctx := r.Context()
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &pairRequest,
+		Validate: []handlers.Validate{
+			handlers.ValidateAsyncEnabled(r, "creating kafka replicated pairs"),
+			handlers.ValidateLength(&pairRequest.Primary.Name, "name", handlers.MinRequiredFieldLength, &MaxKafkaNameLength),
+			ValidKafkaClusterName(&pairRequest.Primary.Name, "name"),
+			ValidateKafkaClusterNameIsUnique(&pairRequest.Primary.Name, h.kafkaService, ctx),
+			ValidateKafkaClaims(ctx, ValidateUsername(), ValidateOrganisationId()),
+			ValidateCloudProvider(ctx, &h.kafkaService, &pairRequest.Primary, h.providerConfig, "creating kafka replicated pairs"),
+			ValidateKafkaPlan(ctx, &h.kafkaService, h.kafkaConfig, &pairRequest.Primary),
+			ValidateBillingCloudAccountIdAndMarketplace(ctx, &h.kafkaService, &pairRequest.Primary),
+			ValidateKafkaConfig(ctx, &h.kafkaService, h.kafkaConfig, &pairRequest.Primary),
+			ValidateKafkaReplicatedPairRequest(ctx, &h.kafkaService, h.providerConfig, &pairRequest, &secondaryPayload),
+			handlers.ValidateLength(&secondaryPayload.Name, "secondary_name", handlers.MinRequiredFieldLength, &MaxKafkaNameLength),
+			ValidKafkaClusterName(&secondaryPayload.Name, "secondary_name"),
+			ValidateKafkaClusterNameIsUnique(&secondaryPayload.Name, h.kafkaService, ctx),
+			ValidateCloudProvider(ctx, &h.kafkaService, &secondaryPayload, h.providerConfig, "creating kafka replicated pairs"),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			primary, err := h.convertKafkaRequest(ctx, &pairRequest.Primary)
+			if err != nil {
+				return nil, err
+			}
+			secondary, err := h.convertKafkaRequest(ctx, &secondaryPayload)
+			if err != nil {
+				return nil, err
+			}
+
+			pair := &dbapi.KafkaReplicatedPair{
+				MirroredTopics: pairRequest.MirroredTopics,
+			}
+			if pairRequest.SyncConsumerGroupOffsets != nil {
+				pair.SyncConsumerGroupOffsets = *pairRequest.SyncConsumerGroupOffsets
+			}
+
+			if err := h.service.Create(pair, primary, secondary); err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaReplicatedPair(pair), nil
+		},
+	}
+
+	// return 202 status accepted
+	handlers.Handle(w, r, cfg, http.StatusAccepted)
+}
+
+func (h kafkaReplicatedPairHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			pair, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaReplicatedPair(pair), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h kafkaReplicatedPairHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+
+			pairs, paging, err := h.service.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			pairList := public.KafkaReplicatedPairList{
+				Kind:  "KafkaReplicatedPairList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.KafkaReplicatedPair{},
+			}
+			for _, pair := range pairs {
+				pairList.Items = append(pairList.Items, presenters.PresentKafkaReplicatedPair(pair))
+			}
+
+			return pairList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// Delete is the handler for deleting a replicated pair along with both its kafkas
+func (h kafkaReplicatedPairHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Validate: []handlers.Validate{
+			handlers.ValidateAsyncEnabled(r, "deleting kafka replicated pairs"),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			err := h.service.Delete(r.Context(), mux.Vars(r)["id"])
+			return nil, err
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusAccepted)
+}
+
+// Failover is the handler for swapping the roles of the kafkas of a replicated pair
+func (h kafkaReplicatedPairHandler) Failover(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			pair, err := h.service.Failover(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentKafkaReplicatedPair(pair), nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusOK)
+}
+
+// convertKafkaRequest converts a validated payload to a kafka request of the user of the given ctx, like kafkaHandler.Create does
+func (h kafkaReplicatedPairHandler) convertKafkaRequest(ctx context.Context, payload *public.KafkaRequestPayload) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	convKafka, err := presenters.ConvertKafkaRequest(*payload)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := getClaims(ctx)
+	convKafka.Owner, _ = claims.GetUsername()
+	convKafka.OrganisationId, _ = claims.GetOrgId()
+	convKafka.OwnerAccountId, _ = claims.GetAccountId()
+
+	convKafka.InstanceType, convKafka.SizeId, _ = getInstanceTypeAndSize(ctx, &h.kafkaService, h.kafkaConfig, payload)
+	convKafka.CloudProvider, convKafka.Region, _ = getCloudProviderAndRegion(ctx, &h.kafkaService, payload, h.providerConfig)
+
+	return convKafka, nil
+}
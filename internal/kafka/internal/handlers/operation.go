@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
+	"github.com/gorilla/mux"
+)
+
+type operationHandler struct {
+	service operations.OperationService
+}
+
+func NewOperationHandler(service operations.OperationService) *operationHandler {
+	return &operationHandler{
+		service: service,
+	}
+}
+
+func (h operationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			operation, err := h.service.Get(r.Context(), mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentOperation(operation), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h operationHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			operationList, paging, err := h.service.List(r.Context(), listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			result := public.OperationList{
+				Kind:  "OperationList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.Operation{},
+			}
+			for _, operation := range operationList {
+				result.Items = append(result.Items, presenters.PresentOperation(operation))
+			}
+			return result, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// operationLocation returns the path of the operation returned by the action of an asynchronous request
+func operationLocation(result interface{}) string {
+	if operation, ok := result.(public.Operation); ok {
+		return operation.Href
+	}
+	return ""
+}
+
+// registerKafkaDeletion registers the deprovisioning of a kafka, and returns the operation tracking it until the
+// kafka is deleted
+func registerKafkaDeletion(ctx context.Context, kafkaService services.KafkaService, operationService operations.OperationService, id string) (*api.Operation, *errors.ServiceError) {
+	if err := kafkaService.RegisterKafkaDeprovisionJob(ctx, id); err != nil {
+		return nil, err
+	}
+
+	kafkaRequest, err := kafkaService.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return operationService.Create(ctx, &api.Operation{
+		Kind:           api.OperationKindKafkaDeletion.String(),
+		TargetKind:     api.OperationTargetKafka,
+		TargetId:       kafkaRequest.ID,
+		OrganisationId: kafkaRequest.OrganisationId,
+		Owner:          kafkaRequest.Owner,
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -12,12 +13,14 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -133,6 +136,38 @@ func getCloudProviderAndRegion(
 	return providerName, region.Name, nil
 }
 
+// ValidateKafkaReplicatedPairRequest validates the settings of a replicated pair and derives the payload of its secondary
+// kafka from the one of the primary, so that the secondary can then be validated like any other kafka
+func ValidateKafkaReplicatedPairRequest(ctx context.Context, kafkaService *services.KafkaService, providerConfig *config.ProviderConfig, pairRequest *public.KafkaReplicatedPairRequest, secondaryPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		if err := handlers.ValidateMinLength(&pairRequest.SecondaryRegion, "secondary_region", 1)(); err != nil {
+			return err
+		}
+		if pairRequest.SecondaryName == pairRequest.Primary.Name {
+			return errors.BadRequest("secondary_name must differ from the name of the primary kafka")
+		}
+		if pairRequest.MirroredTopics != "" {
+			if _, err := regexp.Compile(pairRequest.MirroredTopics); err != nil {
+				return errors.BadRequest("mirrored_topics is not a valid regular expression: %v", err)
+			}
+		}
+
+		providerName, primaryRegion, err := getCloudProviderAndRegion(ctx, kafkaService, &pairRequest.Primary, providerConfig)
+		if err != nil {
+			return err
+		}
+		if pairRequest.SecondaryRegion == primaryRegion {
+			return errors.BadRequest("secondary_region must differ from the region %s of the primary kafka", primaryRegion)
+		}
+
+		*secondaryPayload = pairRequest.Primary
+		secondaryPayload.Name = pairRequest.SecondaryName
+		secondaryPayload.Region = pairRequest.SecondaryRegion
+		secondaryPayload.CloudProvider = providerName
+		return nil
+	}
+}
+
 // ValidateCloudProvider returns a validator that validates provided provider and region
 func ValidateCloudProvider(ctx context.Context, kafkaService *services.KafkaService, kafkaRequest *public.KafkaRequestPayload, providerConfig *config.ProviderConfig, action string) handlers.Validate {
 	return func() *errors.ServiceError {
@@ -186,6 +221,42 @@ func ValidateKafkaPlan(ctx context.Context, kafkaService *services.KafkaService,
 	}
 }
 
+// ValidateKafkaConfig - validate that the requested Kafka settings are within the kafka config ranges of the requested plan
+func ValidateKafkaConfig(ctx context.Context, kafkaService *services.KafkaService, kafkaConfig *config.KafkaConfig, kafkaRequestPayload *public.KafkaRequestPayload) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaRequestPayload.KafkaConfig == nil {
+			return nil
+		}
+		instanceType, sizeId, err := getInstanceTypeAndSize(ctx, kafkaService, kafkaConfig, kafkaRequestPayload)
+		if err != nil {
+			return err
+		}
+		return validateKafkaConfigSettings(kafkaConfig, instanceType, sizeId, kafkaRequestPayload.KafkaConfig)
+	}
+}
+
+// ValidateKafkaConfigUpdate - validate that the updated Kafka settings are within the kafka config ranges of the size of the Kafka
+func ValidateKafkaConfigUpdate(kafkaConfig *config.KafkaConfig, kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *public.KafkaUpdateRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		if kafkaUpdateReq.KafkaConfig == nil {
+			return nil
+		}
+		return validateKafkaConfigSettings(kafkaConfig, kafkaRequest.InstanceType, kafkaRequest.SizeId, kafkaUpdateReq.KafkaConfig)
+	}
+}
+
+func validateKafkaConfigSettings(kafkaConfig *config.KafkaConfig, instanceType string, sizeId string, settings *public.KafkaConfig) *errors.ServiceError {
+	size, err := kafkaConfig.GetKafkaInstanceSize(instanceType, sizeId)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to get kafka instance size '%s' of instance type '%s'", sizeId, instanceType)
+	}
+	err = size.ValidateKafkaConfigSettings(*presenters.ConvertKafkaConfig(settings))
+	if err != nil {
+		return errors.FieldValidationError("Invalid kafka_config: %s", err.Error())
+	}
+	return nil
+}
+
 func ValidateKafkaUpdateFields(kafkaUpdateRequest *private.KafkaUpdateRequest) handlers.Validate {
 	return func() *errors.ServiceError {
 		if !(stringSet(&kafkaUpdateRequest.StrimziVersion) ||
@@ -209,14 +280,10 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 			return claimsErr
 		}
 
-		username, _ := claims.GetUsername()
-		orgId, _ := claims.GetOrgId()
-		isOrgAdmin := claims.IsOrgAdmin()
-		// only Kafka owner or organisation admin is allowed to perform the action
-		isOwner := (isOrgAdmin || kafkaRequest.Owner == username) && kafkaRequest.OrganisationId == orgId
-		if !isOwner {
-			return errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
+		if err := validateKafkaOwnerOrOrgAdmin(claims, kafkaRequest); err != nil {
+			return err
 		}
+		orgId, _ := claims.GetOrgId()
 
 		if kafkaUpdateReq.Owner != nil {
 			validationError := handlers.ValidateMinLength(kafkaUpdateReq.Owner, "owner", 1)()
@@ -237,6 +304,53 @@ func ValidateKafkaUserFacingUpdateFields(ctx context.Context, authService author
 	}
 }
 
+// ValidateKafkaOwnershipTransferRequest checks that the user is allowed to transfer the kafka and that the new owner
+// belongs to the organisation the kafka is transferred to, which defaults to the organisation of the kafka
+func ValidateKafkaOwnershipTransferRequest(ctx context.Context, authService authorization.Authorization, kafkaRequest *dbapi.KafkaRequest, transferReq *public.KafkaOwnershipTransferRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		claims, claimsErr := getClaims(ctx)
+		if claimsErr != nil {
+			return claimsErr
+		}
+
+		if err := validateKafkaOwnerOrOrgAdmin(claims, kafkaRequest); err != nil {
+			return err
+		}
+
+		if err := handlers.ValidateMinLength(&transferReq.ToOwner, "to_owner", 1)(); err != nil {
+			return err
+		}
+
+		toOrganisationId := kafkaRequest.OrganisationId
+		if transferReq.ToOrganisationId != nil && *transferReq.ToOrganisationId != "" {
+			toOrganisationId = *transferReq.ToOrganisationId
+		}
+
+		userValid, err := authService.CheckUserValid(transferReq.ToOwner, toOrganisationId)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to transfer kafka request")
+		}
+		if !userValid {
+			return errors.BadRequest("User %s does not belong in organization %s", transferReq.ToOwner, toOrganisationId)
+		}
+
+		return nil
+	}
+}
+
+// validateKafkaOwnerOrOrgAdmin checks that the user is the owner of the kafka or an admin of its organisation
+func validateKafkaOwnerOrOrgAdmin(claims auth.KFMClaims, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	username, _ := claims.GetUsername()
+	orgId, _ := claims.GetOrgId()
+	isOrgAdmin := claims.IsOrgAdmin()
+	// only Kafka owner or organisation admin is allowed to perform the action
+	isOwner := (isOrgAdmin || kafkaRequest.Owner == username) && kafkaRequest.OrganisationId == orgId
+	if !isOwner {
+		return errors.New(errors.ErrorUnauthorized, "User not authorized to perform this action")
+	}
+	return nil
+}
+
 func getClaims(ctx context.Context) (auth.KFMClaims, *errors.ServiceError) {
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
@@ -298,3 +412,34 @@ func ValidateKafkaStorageSize(kafkaRequest *dbapi.KafkaRequest, kafkaUpdateReq *
 		return nil
 	}
 }
+
+// ValidateWebhookSubscriptionRequest checks that the url of the webhook is absolute and, unless insecure urls are allowed,
+// uses https, and that the types of the events it subscribes to are known
+func ValidateWebhookSubscriptionRequest(ctx context.Context, webhookConfig *webhooks.Config, subscriptionReq *public.WebhookSubscriptionRequest) handlers.Validate {
+	return func() *errors.ServiceError {
+		// the secrets of the subscriptions can only be stored once the webhooks, and their encryption key, are enabled
+		if !webhookConfig.Enabled {
+			return errors.BadRequest("webhook subscriptions are not available: webhooks are not enabled")
+		}
+
+		webhookUrl, err := url.Parse(subscriptionReq.Url)
+		if err != nil || webhookUrl.Host == "" {
+			return errors.BadRequest("url %q is not a valid absolute url", subscriptionReq.Url)
+		}
+		if webhookUrl.Scheme != "https" && !(webhookConfig.AllowInsecureUrls && webhookUrl.Scheme == "http") {
+			return errors.BadRequest("url %q must use https", subscriptionReq.Url)
+		}
+		if !webhookConfig.AllowPrivateNetworkUrls {
+			if err := webhooks.ValidateHost(ctx, webhookUrl.Hostname()); err != nil {
+				return errors.BadRequest("url %q is not allowed: %v", subscriptionReq.Url, err)
+			}
+		}
+
+		for _, eventType := range subscriptionReq.EventTypes {
+			if !arrays.Contains(webhooks.EventTypes, eventType) {
+				return errors.BadRequest("event type %q is not supported. Supported event types are: %s", eventType, strings.Join(webhooks.EventTypes, ", "))
+			}
+		}
+		return nil
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 	"github.com/golang-jwt/jwt/v4"
 	. "github.com/onsi/gomega"
 )
@@ -509,3 +510,397 @@ func Test_Validation_ValidateKafkaUserFacingUpdateFields(t *testing.T) {
 		})
 	}
 }
+
+func Test_Validation_ValidateKafkaOwnershipTransferRequest(t *testing.T) {
+	username := "username"
+	orgId := "organisation_id"
+	otherOrgId := "other_organisation_id"
+	token := &jwt.Token{
+		Claims: jwt.MapClaims{
+			"username": username,
+			"org_id":   orgId,
+		},
+	}
+	kafka := &dbapi.KafkaRequest{
+		Owner:          username,
+		OrganisationId: orgId,
+	}
+
+	type args struct {
+		ctx         context.Context
+		kafka       *dbapi.KafkaRequest
+		transferReq public.KafkaOwnershipTransferRequest
+		userValid   bool
+	}
+
+	type result struct {
+		wantErr        bool
+		reason         string
+		checkedOrgId   string
+		checkedNewUser bool
+	}
+
+	tests := []struct {
+		name string
+		arg  args
+		want result
+	}{
+		{
+			name: "throw an error when user is not owner of the kafka",
+			arg: args{
+				ctx:         auth.SetTokenInContext(context.TODO(), token),
+				kafka:       &dbapi.KafkaRequest{Owner: "another-user", OrganisationId: orgId},
+				transferReq: public.KafkaOwnershipTransferRequest{ToOwner: "new-owner"},
+				userValid:   true,
+			},
+			want: result{
+				wantErr: true,
+				reason:  "User not authorized to perform this action",
+			},
+		},
+		{
+			name: "throw an error when the new owner is empty",
+			arg: args{
+				ctx:         auth.SetTokenInContext(context.TODO(), token),
+				kafka:       kafka,
+				transferReq: public.KafkaOwnershipTransferRequest{},
+				userValid:   true,
+			},
+			want: result{
+				wantErr: true,
+				reason:  "to_owner is not valid. Minimum length 1 is required.",
+			},
+		},
+		{
+			name: "throw an error when the new owner does not belong to the organisation",
+			arg: args{
+				ctx:         auth.SetTokenInContext(context.TODO(), token),
+				kafka:       kafka,
+				transferReq: public.KafkaOwnershipTransferRequest{ToOwner: "new-owner", ToOrganisationId: &otherOrgId},
+				userValid:   false,
+			},
+			want: result{
+				wantErr:        true,
+				reason:         "User new-owner does not belong in organization other_organisation_id",
+				checkedOrgId:   otherOrgId,
+				checkedNewUser: true,
+			},
+		},
+		{
+			name: "should check the new owner against the organisation of the kafka by default",
+			arg: args{
+				ctx:         auth.SetTokenInContext(context.TODO(), token),
+				kafka:       kafka,
+				transferReq: public.KafkaOwnershipTransferRequest{ToOwner: "new-owner"},
+				userValid:   true,
+			},
+			want: result{
+				checkedOrgId:   orgId,
+				checkedNewUser: true,
+			},
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authService := &authorization.AuthorizationMock{
+				CheckUserValidFunc: func(username, orgId string) (bool, error) {
+					return tt.arg.userValid, nil
+				},
+			}
+			err := ValidateKafkaOwnershipTransferRequest(tt.arg.ctx, authService, tt.arg.kafka, &tt.arg.transferReq)()
+			Expect(err != nil).To(Equal(tt.want.wantErr), "ValidateKafkaOwnershipTransferRequest() expected not to throw error but threw %v", err)
+			if tt.want.wantErr {
+				Expect(err.Reason).To(Equal(tt.want.reason))
+			}
+			Expect(len(authService.CheckUserValidCalls()) > 0).To(Equal(tt.want.checkedNewUser))
+			if tt.want.checkedNewUser {
+				Expect(authService.CheckUserValidCalls()[0].OrgId).To(Equal(tt.want.checkedOrgId))
+			}
+		})
+	}
+}
+
+func Test_Validation_ValidateKafkaConfigUpdate(t *testing.T) {
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &config.KafkaSupportedInstanceTypesConfig{
+			Configuration: config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id: "standard",
+						Sizes: []config.KafkaInstanceSize{
+							{
+								Id:                          "x1",
+								MaxDataRetentionPeriod:      "P14D",
+								MaxConnectionAttemptsPerSec: 100,
+								MaxMessageSize:              "1Mi",
+								KafkaConfigRanges: &config.KafkaConfigRanges{
+									MinDataRetentionPeriod:      "PT1H",
+									MinMessageSize:              "1Ki",
+									MinConnectionAttemptsPerSec: 1,
+								},
+							},
+							{
+								Id:                          "x2",
+								MaxDataRetentionPeriod:      "P14D",
+								MaxConnectionAttemptsPerSec: 100,
+								MaxMessageSize:              "1Mi",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		kafka              *dbapi.KafkaRequest
+		kafkaUpdateRequest public.KafkaUpdateRequest
+		wantErr            bool
+	}{
+		{
+			name:               "do not throw an error when kafka config is not updated",
+			kafka:              &dbapi.KafkaRequest{InstanceType: "standard", SizeId: "x2"},
+			kafkaUpdateRequest: public.KafkaUpdateRequest{},
+			wantErr:            false,
+		},
+		{
+			name:  "do not throw an error when kafka config is within the ranges of the size",
+			kafka: &dbapi.KafkaRequest{InstanceType: "standard", SizeId: "x1"},
+			kafkaUpdateRequest: public.KafkaUpdateRequest{
+				KafkaConfig: &public.KafkaConfig{MaxDataRetentionPeriod: "P7D", MessageMaxBytes: 2048},
+			},
+			wantErr: false,
+		},
+		{
+			name:  "throw an error when kafka config is outside the ranges of the size",
+			kafka: &dbapi.KafkaRequest{InstanceType: "standard", SizeId: "x1"},
+			kafkaUpdateRequest: public.KafkaUpdateRequest{
+				KafkaConfig: &public.KafkaConfig{MaxConnectionAttemptsPerSec: 200},
+			},
+			wantErr: true,
+		},
+		{
+			name:  "throw an error when kafka config cannot be tuned for the size",
+			kafka: &dbapi.KafkaRequest{InstanceType: "standard", SizeId: "x2"},
+			kafkaUpdateRequest: public.KafkaUpdateRequest{
+				KafkaConfig: &public.KafkaConfig{MessageMaxBytes: 2048},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateKafkaConfigUpdate(kafkaConfig, tt.kafka, &tt.kafkaUpdateRequest)()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.Code).To(Equal(errors.ErrorFieldValidationError))
+			}
+		})
+	}
+}
+
+func Test_Validation_ValidateKafkaReplicatedPairRequest(t *testing.T) {
+	developerMap := config.InstanceTypeMap{
+		"developer": {},
+	}
+	providerConfig := &config.ProviderConfig{
+		ProvidersConfig: config.ProviderConfiguration{
+			SupportedProviders: config.ProviderList{
+				config.Provider{
+					Name:    "aws",
+					Default: true,
+					Regions: config.RegionList{
+						config.Region{
+							Name:                   "us-east-1",
+							Default:                true,
+							SupportedInstanceTypes: developerMap,
+						},
+						config.Region{
+							Name:                   "eu-west-1",
+							SupportedInstanceTypes: developerMap,
+						},
+					},
+				},
+			},
+		},
+	}
+	var kafkaService services.KafkaService = &services.KafkaServiceMock{
+		AssignInstanceTypeFunc: func(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError) {
+			return types.DEVELOPER, nil
+		},
+	}
+	ctx := auth.SetTokenInContext(context.TODO(), &jwt.Token{
+		Claims: jwt.MapClaims{
+			"username": "username",
+			"org_id":   "organisation_id",
+		},
+	})
+
+	tests := []struct {
+		name          string
+		pairRequest   public.KafkaReplicatedPairRequest
+		wantReason    string
+		wantSecondary public.KafkaRequestPayload
+	}{
+		{
+			name: "throw an error when the secondary region is empty",
+			pairRequest: public.KafkaReplicatedPairRequest{
+				Primary:       public.KafkaRequestPayload{Name: "primary"},
+				SecondaryName: "secondary",
+			},
+			wantReason: "secondary_region is not valid. Minimum length 1 is required.",
+		},
+		{
+			name: "throw an error when the secondary is named like the primary",
+			pairRequest: public.KafkaReplicatedPairRequest{
+				Primary:         public.KafkaRequestPayload{Name: "primary"},
+				SecondaryName:   "primary",
+				SecondaryRegion: "eu-west-1",
+			},
+			wantReason: "secondary_name must differ from the name of the primary kafka",
+		},
+		{
+			name: "throw an error when the mirrored topics are not a regular expression",
+			pairRequest: public.KafkaReplicatedPairRequest{
+				Primary:         public.KafkaRequestPayload{Name: "primary"},
+				SecondaryName:   "secondary",
+				SecondaryRegion: "eu-west-1",
+				MirroredTopics:  "orders-(",
+			},
+			wantReason: "mirrored_topics is not a valid regular expression: error parsing regexp: missing closing ): `orders-(`",
+		},
+		{
+			name: "throw an error when the secondary is in the default region of the primary",
+			pairRequest: public.KafkaReplicatedPairRequest{
+				Primary:         public.KafkaRequestPayload{Name: "primary"},
+				SecondaryName:   "secondary",
+				SecondaryRegion: "us-east-1",
+			},
+			wantReason: "secondary_region must differ from the region us-east-1 of the primary kafka",
+		},
+		{
+			name: "derive the payload of the secondary from the one of the primary",
+			pairRequest: public.KafkaReplicatedPairRequest{
+				Primary:         public.KafkaRequestPayload{Name: "primary", Region: "us-east-1", Plan: "developer.x1"},
+				SecondaryName:   "secondary",
+				SecondaryRegion: "eu-west-1",
+			},
+			wantSecondary: public.KafkaRequestPayload{Name: "secondary", CloudProvider: "aws", Region: "eu-west-1", Plan: "developer.x1"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			var secondaryPayload public.KafkaRequestPayload
+			err := ValidateKafkaReplicatedPairRequest(ctx, &kafkaService, providerConfig, &tt.pairRequest, &secondaryPayload)()
+			if tt.wantReason != "" {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Reason).To(Equal(tt.wantReason))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(secondaryPayload).To(Equal(tt.wantSecondary))
+		})
+	}
+}
+
+func Test_Validation_ValidateWebhookSubscriptionRequest(t *testing.T) {
+	tests := []struct {
+		name                    string
+		disabled                bool
+		allowInsecureUrls       bool
+		allowPrivateNetworkUrls bool
+		subscriptionReq         public.WebhookSubscriptionRequest
+		wantReason              string
+	}{
+		{
+			name:            "throw an error when the webhooks are not enabled",
+			disabled:        true,
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "https://203.0.113.10/webhooks"},
+			wantReason:      "webhook subscriptions are not available: webhooks are not enabled",
+		},
+		{
+			name:            "throw an error when the url is not absolute",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "/webhooks"},
+			wantReason:      `url "/webhooks" is not a valid absolute url`,
+		},
+		{
+			name:            "throw an error when the url does not use https",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "http://203.0.113.10/webhooks"},
+			wantReason:      `url "http://203.0.113.10/webhooks" must use https`,
+		},
+		{
+			name:              "accept a plain http url when insecure urls are allowed",
+			allowInsecureUrls: true,
+			subscriptionReq:   public.WebhookSubscriptionRequest{Url: "http://203.0.113.10/webhooks"},
+		},
+		{
+			name:            "throw an error when the url is a loopback address",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "https://127.0.0.1:8000/webhooks"},
+			wantReason:      `url "https://127.0.0.1:8000/webhooks" is not allowed: host 127.0.0.1 is not a public address`,
+		},
+		{
+			name:            "throw an error when the url is a private address",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "https://10.0.0.1/webhooks"},
+			wantReason:      `url "https://10.0.0.1/webhooks" is not allowed: host 10.0.0.1 is not a public address`,
+		},
+		{
+			name:            "throw an error when the url is a link-local address",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "https://169.254.169.254/latest/meta-data"},
+			wantReason:      `url "https://169.254.169.254/latest/meta-data" is not allowed: host 169.254.169.254 is not a public address`,
+		},
+		{
+			name:            "throw an error when the url is an unspecified ipv6 address",
+			subscriptionReq: public.WebhookSubscriptionRequest{Url: "https://[::]/webhooks"},
+			wantReason:      `url "https://[::]/webhooks" is not allowed: host :: is not a public address`,
+		},
+		{
+			name:                    "accept a private address when private network urls are allowed",
+			allowPrivateNetworkUrls: true,
+			subscriptionReq:         public.WebhookSubscriptionRequest{Url: "https://127.0.0.1:8000/webhooks"},
+		},
+		{
+			name: "throw an error when an event type is not supported",
+			subscriptionReq: public.WebhookSubscriptionRequest{
+				Url:        "https://203.0.113.10/webhooks",
+				EventTypes: []string{webhooks.EventTypeKafkaStatusChanged, "kafka.deleted"},
+			},
+			wantReason: `event type "kafka.deleted" is not supported. Supported event types are: kafka.status_changed, connector.status_changed, connector_namespace.phase_changed`,
+		},
+		{
+			name: "accept an https url with supported event types",
+			subscriptionReq: public.WebhookSubscriptionRequest{
+				Url:        "https://203.0.113.10/webhooks",
+				EventTypes: []string{webhooks.EventTypeKafkaStatusChanged},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			webhookConfig := &webhooks.Config{
+				Enabled:                 !tt.disabled,
+				AllowInsecureUrls:       tt.allowInsecureUrls,
+				AllowPrivateNetworkUrls: tt.allowPrivateNetworkUrls,
+			}
+			err := ValidateWebhookSubscriptionRequest(context.Background(), webhookConfig, &tt.subscriptionReq)()
+			if tt.wantReason != "" {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Reason).To(Equal(tt.wantReason))
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
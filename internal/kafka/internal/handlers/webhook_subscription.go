@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/presenters"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	coreServices "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
+	"github.com/gorilla/mux"
+)
+
+type webhookSubscriptionHandler struct {
+	service webhooks.WebhookService
+	config  *webhooks.Config
+}
+
+func NewWebhookSubscriptionHandler(service webhooks.WebhookService, config *webhooks.Config) *webhookSubscriptionHandler {
+	return &webhookSubscriptionHandler{
+		service: service,
+		config:  config,
+	}
+}
+
+// Create is the handler for subscribing a webhook to the events of the organisation of the user
+func (h webhookSubscriptionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var subscriptionReq public.WebhookSubscriptionRequest
+	ctx := r.Context()
+
+	cfg := &handlers.HandlerConfig{
+		MarshalInto: &subscriptionReq,
+		Validate: []handlers.Validate{
+			handlers.ValidateMinLength(&subscriptionReq.Url, "url", handlers.MinRequiredFieldLength),
+			ValidateWebhookSubscriptionRequest(ctx, h.config, &subscriptionReq),
+		},
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, username, err := getOrgAdmin(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			subscription := &webhooks.WebhookSubscription{
+				OrganisationId: orgId,
+				Owner:          username,
+				Url:            subscriptionReq.Url,
+			}
+			subscription.SetEventTypes(subscriptionReq.EventTypes)
+			if err := h.service.CreateSubscription(subscription); err != nil {
+				return nil, err
+			}
+
+			// the secret is only returned on creation
+			result := presenters.PresentWebhookSubscription(subscription)
+			result.Secret = subscription.Secret
+			return result, nil
+		},
+	}
+	handlers.Handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h webhookSubscriptionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, _, err := getOrgAdmin(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			subscription, err := h.service.GetSubscription(orgId, mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+			return presenters.PresentWebhookSubscription(subscription), nil
+		},
+	}
+	handlers.HandleGet(w, r, cfg)
+}
+
+func (h webhookSubscriptionHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, _, err := getOrgAdmin(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			subscriptions, paging, err := h.service.ListSubscriptions(orgId, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			subscriptionList := public.WebhookSubscriptionList{
+				Kind:  "WebhookSubscriptionList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.WebhookSubscription{},
+			}
+			for _, subscription := range subscriptions {
+				subscriptionList.Items = append(subscriptionList.Items, presenters.PresentWebhookSubscription(subscription))
+			}
+
+			return subscriptionList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+func (h webhookSubscriptionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, _, err := getOrgAdmin(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, h.service.DeleteSubscription(orgId, mux.Vars(r)["id"])
+		},
+	}
+	handlers.HandleDelete(w, r, cfg, http.StatusNoContent)
+}
+
+// ListDeliveries is the handler for the delivery log of a webhook subscription
+func (h webhookSubscriptionHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlers.HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			orgId, _, err := getOrgAdmin(r.Context())
+			if err != nil {
+				return nil, err
+			}
+
+			subscription, err := h.service.GetSubscription(orgId, mux.Vars(r)["id"])
+			if err != nil {
+				return nil, err
+			}
+
+			listArgs := coreServices.NewListArguments(r.URL.Query())
+			deliveries, paging, err := h.service.ListDeliveries(subscription.ID, listArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			deliveryList := public.WebhookDeliveryList{
+				Kind:  "WebhookDeliveryList",
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []public.WebhookDelivery{},
+			}
+			for _, delivery := range deliveries {
+				deliveryList.Items = append(deliveryList.Items, presenters.PresentWebhookDelivery(delivery))
+			}
+
+			return deliveryList, nil
+		},
+	}
+	handlers.HandleList(w, r, cfg)
+}
+
+// getOrgAdmin returns the organisation and the username of the user of the given ctx, who must be an admin of the organisation
+// as the webhooks receive the events of all the resources of the organisation
+func getOrgAdmin(ctx context.Context) (string, string, *errors.ServiceError) {
+	claims, err := getClaims(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	orgId, orgErr := claims.GetOrgId()
+	if orgErr != nil || orgId == "" {
+		return "", "", errors.New(errors.ErrorForbidden, "user does not belong to an organisation")
+	}
+	if !claims.IsOrgAdmin() {
+		return "", "", errors.New(errors.ErrorForbidden, "only an organisation admin can manage the webhook subscriptions")
+	}
+	username, _ := claims.GetUsername()
+	return orgId, username, nil
+}
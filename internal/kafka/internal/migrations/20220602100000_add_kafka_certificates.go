@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaCertificates() *gormigrate.Migration {
+	type KafkaCertificate struct {
+		api.Meta
+		KafkaID      string `gorm:"index"`
+		Hosts        string
+		Certificate  string
+		EncryptedKey string
+		NotAfter     time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220602100000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaCertificate{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaCertificate{})
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaCertificatesWorkerLease() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20220602100100",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Create(&api.LeaderLease{Expires: &db.KafkaAdditionalLeasesExpireTime, LeaseType: "kafka_certificates", Leader: api.NewID()}).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Unscoped().Where("lease_type = ?", "kafka_certificates").Delete(&api.LeaderLease{}).Error
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaExpiryFields() *gormigrate.Migration {
+	type KafkaRequest struct {
+		ExpiresAt                          *time.Time `json:"expires_at"`
+		ExpiryNotificationThresholdSeconds *int64     `json:"expiry_notification_threshold_seconds"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220603090000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			err := tx.Migrator().DropColumn(&KafkaRequest{}, "expires_at")
+			if err != nil {
+				return err
+			}
+
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "expiry_notification_threshold_seconds")
+		},
+	}
+}
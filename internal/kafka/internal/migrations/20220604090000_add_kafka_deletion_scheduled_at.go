@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaDeletionScheduledAt() *gormigrate.Migration {
+	type KafkaRequest struct {
+		DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220604090000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "deletion_scheduled_at")
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaConfig() *gormigrate.Migration {
+	type KafkaRequest struct {
+		KafkaConfig string `gorm:"type:jsonb"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220605090000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "kafka_config")
+		},
+	}
+}
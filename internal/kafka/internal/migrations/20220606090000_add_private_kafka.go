@@ -0,0 +1,40 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addPrivateKafka() *gormigrate.Migration {
+	type KafkaRequest struct {
+		Private                    bool `gorm:"default:false"`
+		PrivateEndpointServiceName string
+	}
+	type Cluster struct {
+		SupportsPrivateKafka bool `gorm:"default:false"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220606090000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaRequest{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&Cluster{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "private"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "private_endpoint_service_name"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&Cluster{}, "supports_private_kafka")
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaOwnershipTransfers() *gormigrate.Migration {
+	type KafkaOwnershipTransfer struct {
+		api.Meta
+		KafkaID            string `gorm:"index"`
+		FromOwner          string
+		FromOrganisationId string
+		ToOwner            string `gorm:"index"`
+		ToOrganisationId   string
+		RequestedBy        string
+		Status             string `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220607090000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaOwnershipTransfer{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaOwnershipTransfer{})
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaEvents() *gormigrate.Migration {
+	type KafkaEvent struct {
+		api.Meta
+		KafkaID        string `gorm:"index"`
+		Type           string
+		Actor          string
+		Reason         string
+		PreviousStatus string
+		Status         string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220608090000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&KafkaEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&KafkaEvent{})
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addKafkaReplicatedPairs() *gormigrate.Migration {
+	type KafkaReplicatedPair struct {
+		api.Meta
+		Owner                    string `gorm:"index"`
+		OrganisationId           string `gorm:"index"`
+		PrimaryKafkaID           string `gorm:"index"`
+		SecondaryKafkaID         string `gorm:"index"`
+		MirroredTopics           string
+		SyncConsumerGroupOffsets bool `gorm:"default:false"`
+		AliasHost                string
+		AliasKafkaID             string
+		FailedOverAt             *time.Time
+	}
+	type KafkaRequest struct {
+		ReplicatedPairId string `gorm:"index"`
+		ReplicationRole  string
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220609090000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaReplicatedPair{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&KafkaRequest{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "replicated_pair_id"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&KafkaRequest{}, "replication_role"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&KafkaReplicatedPair{})
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// addKafkaResourceVersion adds the version of the state of the kafkas edited by the users and the admins. The version is only
+// bumped by the trigger when such state changes, whatever the update, so that the status updates of the data plane
+// don't change it and the updates of stale kafkas can't set it back.
+func addKafkaResourceVersion() *gormigrate.Migration {
+	type KafkaRequest struct {
+		Version int64 `gorm:"not null;default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "20220610090000",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&KafkaRequest{}); err != nil {
+				return err
+			}
+			if err := tx.Exec(`
+				CREATE OR REPLACE FUNCTION kafka_requests_version_trigger() RETURNS TRIGGER LANGUAGE plpgsql AS '
+				BEGIN
+				NEW.version := OLD.version;
+				IF NEW.owner IS DISTINCT FROM OLD.owner
+					OR NEW.organisation_id IS DISTINCT FROM OLD.organisation_id
+					OR NEW.reauthentication_enabled IS DISTINCT FROM OLD.reauthentication_enabled
+					OR NEW.kafka_config IS DISTINCT FROM OLD.kafka_config
+					OR NEW.kafka_storage_size IS DISTINCT FROM OLD.kafka_storage_size
+					OR NEW.desired_kafka_version IS DISTINCT FROM OLD.desired_kafka_version
+					OR NEW.desired_strimzi_version IS DISTINCT FROM OLD.desired_strimzi_version
+					OR NEW.desired_kafka_ibp_version IS DISTINCT FROM OLD.desired_kafka_ibp_version
+					OR NEW.expires_at IS DISTINCT FROM OLD.expires_at THEN
+					NEW.version := OLD.version + 1;
+				END IF;
+				RETURN NEW;
+				END;'
+			`).Error; err != nil {
+				return err
+			}
+			return tx.Exec(`
+				CREATE TRIGGER kafka_requests_version_trigger BEFORE UPDATE ON kafka_requests
+				FOR EACH ROW EXECUTE PROCEDURE kafka_requests_version_trigger();
+			`).Error
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Exec(`DROP TRIGGER IF EXISTS kafka_requests_version_trigger ON kafka_requests`).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec(`DROP FUNCTION IF EXISTS kafka_requests_version_trigger`).Error; err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&KafkaRequest{}, "version")
+		},
+	}
+}
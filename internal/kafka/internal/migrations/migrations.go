@@ -2,6 +2,7 @@ package migrations
 
 import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/go-gormigrate/gormigrate/v2"
 )
 
@@ -79,13 +80,31 @@ var migrations = []*gormigrate.Migration{
 	dropKafkaSsoClientIdAndSecret(),
 	addAdminApiServerURL(),
 	addKafkaCloudAccountIdMarketplaceFields(),
+	addKafkaCertificates(),
+	addKafkaCertificatesWorkerLease(),
+	addKafkaExpiryFields(),
+	addKafkaExpiryNotificationsWorkerLease(),
+	addKafkaDeletionScheduledAt(),
+	addKafkaConfig(),
+	addPrivateKafka(),
+	addKafkaOwnershipTransfers(),
+	addKafkaEvents(),
+	addKafkaReplicatedPairs(),
+	addKafkaResourceVersion(),
+}
+
+var gormOptions = &gormigrate.Options{
+	TableName:      "migrations",
+	IDColumnName:   "id",
+	IDColumnSize:   255,
+	UseTransaction: false,
 }
 
 func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
-	return db.NewMigration(dbConfig, &gormigrate.Options{
-		TableName:      "migrations",
-		IDColumnName:   "id",
-		IDColumnSize:   255,
-		UseTransaction: false,
-	}, migrations)
+	return db.NewMigration(dbConfig, gormOptions, migrations)
+}
+
+// NewHealthCheck returns the health check of the migrations of the service being applied
+func NewHealthCheck(connectionFactory *db.ConnectionFactory) *environments.FuncHealthCheck {
+	return db.NewMigrationHealthCheck("kafka_migrations", connectionFactory, gormOptions, migrations)
 }
@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
@@ -9,7 +10,8 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 )
 
-func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountService account.AccountService) (*private.Kafka, *errors.ServiceError) {
+// PresentKafkaRequestAdminEndpoint presents a kafka to the admin API, expiresAt is its effective expiration time
+func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, expiresAt *time.Time, accountService account.AccountService) (*private.Kafka, *errors.ServiceError) {
 	reference := PresentReference(kafkaRequest.ID, kafkaRequest)
 
 	org, err := accountService.GetOrganization(fmt.Sprintf("external_id='%s'", kafkaRequest.OrganisationId))
@@ -57,6 +59,7 @@ func PresentKafkaRequestAdminEndpoint(kafkaRequest *dbapi.KafkaRequest, accountS
 		InstanceType:           kafkaRequest.InstanceType,
 		Namespace:              kafkaRequest.Namespace,
 		SizeId:                 kafkaRequest.SizeId,
+		ExpiresAt:              expiresAt,
 	}, nil
 }
 
@@ -89,7 +89,7 @@ func TestPresentKafkaRequestAdminEndpoint(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			converted, err := PresentKafkaRequestAdminEndpoint(tt.args.dbKafkaRequest, tt.args.accountService)
+			converted, err := PresentKafkaRequestAdminEndpoint(tt.args.dbKafkaRequest, tt.args.dbKafkaRequest.ExpiresAt, tt.args.accountService)
 			if !tt.wantErr && err != nil {
 				t.Errorf("unexpected error for PresentKafkaRequestAdminEndpoint: %v", err)
 				return
@@ -0,0 +1,23 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/configreload"
+)
+
+func PresentConfigStatusList(statuses []configreload.ConfigStatus) private.ConfigStatusList {
+	list := private.ConfigStatusList{
+		Kind:  "ConfigStatusList",
+		Items: []private.ConfigStatus{},
+	}
+	for _, status := range statuses {
+		list.Items = append(list.Items, private.ConfigStatus{
+			Name:            status.Name,
+			Files:           status.Files,
+			Checksum:        status.Checksum,
+			LoadedAt:        status.LoadedAt,
+			LastReloadError: status.LastReloadError,
+		})
+	}
+	return list
+}
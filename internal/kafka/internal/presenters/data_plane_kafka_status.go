@@ -28,13 +28,14 @@ func ConvertDataPlaneKafkaStatus(status map[string]private.DataPlaneKafkaStatus)
 			}
 		}
 		r = append(r, &dbapi.DataPlaneKafkaStatus{
-			KafkaClusterId:  k,
-			Conditions:      c,
-			Routes:          routes,
-			KafkaVersion:    v.Versions.Kafka,
-			StrimziVersion:  v.Versions.Strimzi,
-			KafkaIBPVersion: v.Versions.KafkaIbp,
-			AdminServerURI:  v.AdminServerURI,
+			KafkaClusterId:             k,
+			Conditions:                 c,
+			Routes:                     routes,
+			KafkaVersion:               v.Versions.Kafka,
+			StrimziVersion:             v.Versions.Strimzi,
+			KafkaIBPVersion:            v.Versions.KafkaIbp,
+			AdminServerURI:             v.AdminServerURI,
+			PrivateEndpointServiceName: v.PrivateEndpointServiceName,
 		})
 	}
 
@@ -14,7 +14,7 @@ import (
 )
 
 // ConvertKafkaRequest from payload to KafkaRequest
-func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafkarequest ...*dbapi.KafkaRequest) *dbapi.KafkaRequest {
+func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafkarequest ...*dbapi.KafkaRequest) (*dbapi.KafkaRequest, *errors.ServiceError) {
 	var kafka *dbapi.KafkaRequest
 	if len(dbKafkarequest) == 0 {
 		kafka = &dbapi.KafkaRequest{}
@@ -29,6 +29,7 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 
 	kafka.BillingCloudAccountId = shared.SafeString(kafkaRequestPayload.BillingCloudAccountId)
 	kafka.Marketplace = shared.SafeString(kafkaRequestPayload.Marketplace)
+	kafka.Private = kafkaRequestPayload.Private != nil && *kafkaRequestPayload.Private
 
 	if kafkaRequestPayload.ReauthenticationEnabled != nil {
 		kafka.ReauthenticationEnabled = *kafkaRequestPayload.ReauthenticationEnabled
@@ -36,7 +37,13 @@ func ConvertKafkaRequest(kafkaRequestPayload public.KafkaRequestPayload, dbKafka
 		kafka.ReauthenticationEnabled = true // true by default
 	}
 
-	return kafka
+	if kafkaRequestPayload.KafkaConfig != nil {
+		if err := kafka.SetKafkaConfig(ConvertKafkaConfig(kafkaRequestPayload.KafkaConfig)); err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to set kafka settings")
+		}
+	}
+
+	return kafka, nil
 }
 
 // PresentKafkaRequest - create KafkaRequest in an appropriate format ready to be returned by the API
@@ -63,6 +70,11 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, config *config.KafkaC
 		}
 	}
 
+	kafkaConfig, kafkaConfigErr := kafkaRequest.GetKafkaConfig()
+	if kafkaConfigErr != nil {
+		logger.Logger.Error(kafkaConfigErr)
+	}
+
 	displayName, err := getDisplayName(kafkaRequest.InstanceType, config)
 
 	if err != nil {
@@ -84,6 +96,7 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, config *config.KafkaC
 		CreatedAt:                   kafkaRequest.CreatedAt,
 		UpdatedAt:                   kafkaRequest.UpdatedAt,
 		ExpiresAt:                   expiresAt,
+		DeletionScheduledAt:         kafkaRequest.DeletionScheduledAt,
 		FailedReason:                kafkaRequest.FailedReason,
 		Version:                     kafkaRequest.ActualKafkaVersion,
 		InstanceType:                kafkaRequest.InstanceType,
@@ -100,9 +113,40 @@ func PresentKafkaRequest(kafkaRequest *dbapi.KafkaRequest, config *config.KafkaC
 		MaxConnectionAttemptsPerSec: int32(maxConnectionAttemptsPerSec),
 		BillingCloudAccountId:       kafkaRequest.BillingCloudAccountId,
 		Marketplace:                 kafkaRequest.Marketplace,
+		Private:                     kafkaRequest.Private,
+		PrivateEndpointServiceName:  kafkaRequest.PrivateEndpointServiceName,
+		KafkaConfig:                 PresentKafkaConfig(kafkaConfig),
+		ReplicatedPairId:            kafkaRequest.ReplicatedPairId,
+		ReplicationRole:             kafkaRequest.ReplicationRole,
 	}, nil
 }
 
+// ConvertKafkaConfig from the kafka settings of a payload to the kafka settings stored in a KafkaRequest
+func ConvertKafkaConfig(from *public.KafkaConfig) *dbapi.KafkaConfig {
+	if from == nil {
+		return nil
+	}
+	return &dbapi.KafkaConfig{
+		MaxDataRetentionPeriod:      from.MaxDataRetentionPeriod,
+		DefaultRetentionPeriod:      from.DefaultRetentionPeriod,
+		MessageMaxBytes:             from.MessageMaxBytes,
+		MaxConnectionAttemptsPerSec: int(from.MaxConnectionAttemptsPerSec),
+	}
+}
+
+// PresentKafkaConfig - create the kafka settings of a KafkaRequest in an appropriate format ready to be returned by the API
+func PresentKafkaConfig(from *dbapi.KafkaConfig) *public.KafkaConfig {
+	if from == nil {
+		return nil
+	}
+	return &public.KafkaConfig{
+		MaxDataRetentionPeriod:      from.MaxDataRetentionPeriod,
+		DefaultRetentionPeriod:      from.DefaultRetentionPeriod,
+		MessageMaxBytes:             from.MessageMaxBytes,
+		MaxConnectionAttemptsPerSec: int32(from.MaxConnectionAttemptsPerSec),
+	}
+}
+
 func setBootstrapServerHost(bootstrapServerHost string) string {
 	if bootstrapServerHost != "" {
 		return fmt.Sprintf("%s:443", bootstrapServerHost)
@@ -112,7 +156,7 @@ func setBootstrapServerHost(bootstrapServerHost string) string {
 
 func getDisplayName(instanceType string, config *config.KafkaConfig) (string, *errors.ServiceError) {
 	if config != nil && strings.Trim(instanceType, " ") != "" {
-		kafkaInstanceType, err := config.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(instanceType)
+		kafkaInstanceType, err := config.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(instanceType)
 		if err != nil {
 			return "", errors.NewWithCause(errors.ErrorGeneral, err, "Unable to get kafka display name for '%s' instance type", instanceType)
 		}
@@ -0,0 +1,22 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+func PresentKafkaEvent(event *dbapi.KafkaEvent) public.KafkaEvent {
+	reference := PresentReference(event.ID, event)
+
+	return public.KafkaEvent{
+		Id:             reference.Id,
+		Kind:           reference.Kind,
+		KafkaId:        event.KafkaID,
+		Type:           event.Type,
+		Actor:          event.Actor,
+		Reason:         event.Reason,
+		PreviousStatus: event.PreviousStatus,
+		Status:         event.Status,
+		CreatedAt:      event.CreatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+func PresentKafkaOwnershipTransfer(transfer *dbapi.KafkaOwnershipTransfer) public.KafkaOwnershipTransfer {
+	reference := PresentReference(transfer.ID, transfer)
+
+	return public.KafkaOwnershipTransfer{
+		Id:                 reference.Id,
+		Kind:               reference.Kind,
+		Href:               reference.Href,
+		KafkaId:            transfer.KafkaID,
+		FromOwner:          transfer.FromOwner,
+		FromOrganisationId: transfer.FromOrganisationId,
+		ToOwner:            transfer.ToOwner,
+		ToOrganisationId:   transfer.ToOrganisationId,
+		RequestedBy:        transfer.RequestedBy,
+		Status:             transfer.Status,
+		CreatedAt:          transfer.CreatedAt,
+		UpdatedAt:          transfer.UpdatedAt,
+	}
+}
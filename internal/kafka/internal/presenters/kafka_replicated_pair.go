@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+)
+
+func PresentKafkaReplicatedPair(pair *dbapi.KafkaReplicatedPair) public.KafkaReplicatedPair {
+	reference := PresentReference(pair.ID, pair)
+
+	return public.KafkaReplicatedPair{
+		Id:                       reference.Id,
+		Kind:                     reference.Kind,
+		Href:                     reference.Href,
+		Owner:                    pair.Owner,
+		PrimaryKafkaId:           pair.PrimaryKafkaID,
+		SecondaryKafkaId:         pair.SecondaryKafkaID,
+		MirroredTopics:           pair.MirroredTopics,
+		SyncConsumerGroupOffsets: pair.SyncConsumerGroupOffsets,
+		AliasBootstrapServerHost: pair.AliasHost,
+		FailedOverAt:             pair.FailedOverAt,
+		CreatedAt:                pair.CreatedAt,
+		UpdatedAt:                pair.UpdatedAt,
+	}
+}
@@ -89,7 +89,9 @@ func TestConvertKafkaRequest(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Expect(ConvertKafkaRequest(tt.args.kafkaRequestPayload, tt.args.dbKafkaRequests...)).To(Equal(tt.want))
+			got, err := ConvertKafkaRequest(tt.args.kafkaRequestPayload, tt.args.dbKafkaRequests...)
+			Expect(err).To(BeNil())
+			Expect(got).To(Equal(tt.want))
 		})
 	}
 }
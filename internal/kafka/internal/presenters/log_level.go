@@ -0,0 +1,25 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/admin/private"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+)
+
+func PresentLogLevel(component string, level int32) private.LogLevel {
+	return private.LogLevel{
+		Component: component,
+		Level:     level,
+	}
+}
+
+func PresentLogLevelList(defaultLevel int32, levels []logger.ComponentLevel) private.LogLevelList {
+	list := private.LogLevelList{
+		Kind:         "LogLevelList",
+		DefaultLevel: defaultLevel,
+		Items:        []private.LogLevel{},
+	}
+	for _, level := range levels {
+		list.Items = append(list.Items, PresentLogLevel(level.Component, level.Level))
+	}
+	return list
+}
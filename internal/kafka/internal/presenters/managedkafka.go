@@ -19,6 +19,7 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 			Labels: private.ManagedKafkaAllOfMetadataLabels{
 				Bf2OrgKafkaInstanceProfileType:          from.Labels["bf2.org/kafkaInstanceProfileType"],
 				Bf2OrgKafkaInstanceProfileQuotaConsumed: from.Labels["bf2.org/kafkaInstanceProfileQuotaConsumed"],
+				Bf2OrgSuspended:                         from.Labels["bf2.org/suspended"],
 			},
 		},
 		Spec: private.ManagedKafkaAllOfSpec{
@@ -31,6 +32,7 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 				MaxDataRetentionPeriod:      from.Spec.Capacity.MaxDataRetentionPeriod,
 				MaxConnectionAttemptsPerSec: int32(from.Spec.Capacity.MaxConnectionAttemptsPerSec),
 			},
+			KafkaConfig: getOpenAPIManagedKafkaKafkaConfig(from.Spec.KafkaConfig),
 			Oauth: private.ManagedKafkaAllOfSpecOauth{
 				TokenEndpointURI:       from.Spec.OAuth.TokenEndpointURI,
 				JwksEndpointURI:        from.Spec.OAuth.JwksEndpointURI,
@@ -44,6 +46,7 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 			Endpoint: private.ManagedKafkaAllOfSpecEndpoint{
 				Tls:                 getOpenAPIManagedKafkaEndpointTLS(from.Spec.Endpoint.Tls),
 				BootstrapServerHost: from.Spec.Endpoint.BootstrapServerHost,
+				Internal:            from.Spec.Endpoint.Internal,
 			},
 			Versions: private.ManagedKafkaVersions{
 				Kafka:    from.Spec.Versions.Kafka,
@@ -53,6 +56,7 @@ func PresentManagedKafka(from *v1.ManagedKafka) private.ManagedKafka {
 			Deleted:         from.Spec.Deleted,
 			Owners:          from.Spec.Owners,
 			ServiceAccounts: getServiceAccounts(from.Spec.ServiceAccounts),
+			Mirroring:       getOpenAPIManagedKafkaMirroring(from.Spec.Mirroring),
 		},
 	}
 
@@ -70,6 +74,29 @@ func getOpenAPIManagedKafkaEndpointTLS(from *v1.TlsSpec) *private.ManagedKafkaAl
 	return res
 }
 
+func getOpenAPIManagedKafkaMirroring(from *v1.MirroringSpec) *private.ManagedKafkaMirroring {
+	var res *private.ManagedKafkaMirroring
+	if from != nil {
+		res = &private.ManagedKafkaMirroring{
+			SourceBootstrapServerHost: from.SourceBootstrapServerHost,
+			Topics:                    from.Topics,
+			SyncConsumerGroupOffsets:  from.SyncConsumerGroupOffsets,
+		}
+	}
+	return res
+}
+
+func getOpenAPIManagedKafkaKafkaConfig(from *v1.KafkaConfigSpec) *private.ManagedKafkaKafkaConfig {
+	var res *private.ManagedKafkaKafkaConfig
+	if from != nil {
+		res = &private.ManagedKafkaKafkaConfig{
+			DefaultRetentionPeriod: from.DefaultRetentionPeriod,
+			MessageMaxBytes:        from.MessageMaxBytes,
+		}
+	}
+	return res
+}
+
 func getOpenAPIManagedKafkaOAuthTLSTrustedCertificate(from *v1.OAuthSpec) *string {
 	var res *string
 	if from.TlsTrustedCertificate != nil {
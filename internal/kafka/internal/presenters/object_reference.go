@@ -8,6 +8,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 )
 
 const (
@@ -21,6 +22,18 @@ const (
 	KindError = "Error"
 	// KindServiceAccount is a string identifier for the type api.ServiceAccount
 	KindServiceAccount = "ServiceAccount"
+	// KindKafkaOwnershipTransfer is a string identifier for the type dbapi.KafkaOwnershipTransfer
+	KindKafkaOwnershipTransfer = "KafkaOwnershipTransfer"
+	// KindKafkaEvent is a string identifier for the type dbapi.KafkaEvent
+	KindKafkaEvent = "KafkaEvent"
+	// KindKafkaReplicatedPair is a string identifier for the type dbapi.KafkaReplicatedPair
+	KindKafkaReplicatedPair = "KafkaReplicatedPair"
+	// KindWebhookSubscription is a string identifier for the type webhooks.WebhookSubscription
+	KindWebhookSubscription = "WebhookSubscription"
+	// KindWebhookDelivery is a string identifier for the type webhooks.WebhookDelivery
+	KindWebhookDelivery = "WebhookDelivery"
+	// KindOperation is a string identifier for the type api.Operation
+	KindOperation = "Operation"
 
 	BasePath = "/api/kafkas_mgmt/v1"
 )
@@ -41,6 +54,18 @@ func objectKind(i interface{}) string {
 		return KindError
 	case api.ServiceAccount, *api.ServiceAccount:
 		return KindServiceAccount
+	case dbapi.KafkaOwnershipTransfer, *dbapi.KafkaOwnershipTransfer:
+		return KindKafkaOwnershipTransfer
+	case dbapi.KafkaEvent, *dbapi.KafkaEvent:
+		return KindKafkaEvent
+	case dbapi.KafkaReplicatedPair, *dbapi.KafkaReplicatedPair:
+		return KindKafkaReplicatedPair
+	case webhooks.WebhookSubscription, *webhooks.WebhookSubscription:
+		return KindWebhookSubscription
+	case webhooks.WebhookDelivery, *webhooks.WebhookDelivery:
+		return KindWebhookDelivery
+	case api.Operation, *api.Operation:
+		return KindOperation
 	default:
 		return ""
 	}
@@ -54,6 +79,14 @@ func objectPath(id string, obj interface{}) string {
 		return fmt.Sprintf("%s/errors/%s", BasePath, id)
 	case api.ServiceAccount, *api.ServiceAccount:
 		return fmt.Sprintf("%s/service_accounts/%s", BasePath, id)
+	case dbapi.KafkaOwnershipTransfer, *dbapi.KafkaOwnershipTransfer:
+		return fmt.Sprintf("%s/kafka_ownership_transfers/%s", BasePath, id)
+	case dbapi.KafkaReplicatedPair, *dbapi.KafkaReplicatedPair:
+		return fmt.Sprintf("%s/kafka_replicated_pairs/%s", BasePath, id)
+	case webhooks.WebhookSubscription, *webhooks.WebhookSubscription:
+		return fmt.Sprintf("%s/webhook_subscriptions/%s", BasePath, id)
+	case api.Operation, *api.Operation:
+		return fmt.Sprintf("%s/operations/%s", BasePath, id)
 	default:
 		return ""
 	}
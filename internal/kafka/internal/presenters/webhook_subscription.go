@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/public"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
+)
+
+// PresentWebhookSubscription presents a webhook subscription without its secret, which is only returned on creation
+func PresentWebhookSubscription(subscription *webhooks.WebhookSubscription) public.WebhookSubscription {
+	reference := PresentReference(subscription.ID, subscription)
+
+	return public.WebhookSubscription{
+		Id:         reference.Id,
+		Kind:       reference.Kind,
+		Href:       reference.Href,
+		Owner:      subscription.Owner,
+		Url:        subscription.Url,
+		EventTypes: subscription.GetEventTypes(),
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func PresentWebhookDelivery(delivery *webhooks.WebhookDelivery) public.WebhookDelivery {
+	reference := PresentReference(delivery.ID, delivery)
+
+	return public.WebhookDelivery{
+		Id:            reference.Id,
+		Kind:          reference.Kind,
+		EventId:       delivery.EventId,
+		EventType:     delivery.EventType,
+		Status:        delivery.Status,
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastAttemptAt: delivery.LastAttemptAt,
+		ResponseCode:  int32(delivery.ResponseCode),
+		Error:         delivery.Error,
+		DeliveredAt:   delivery.DeliveredAt,
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
@@ -8,7 +8,10 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/configreload"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 
@@ -51,10 +54,19 @@ type options struct {
 	DB                          *db.ConnectionFactory
 	ClusterPlacementStrategy    services.ClusterPlacementStrategy
 	ClusterService              services.ClusterService
+	KafkaExpiryService          services.KafkaExpiryService
+	KafkaOwnershipTransfer      services.KafkaOwnershipTransferService
+	KafkaEvent                  services.KafkaEventService
+	KafkaReplicatedPair         services.KafkaReplicatedPairService
 	SupportedKafkaInstanceTypes services.SupportedKafkaInstanceTypesService
+	WebhookService              webhooks.WebhookService
+	WebhookConfig               *webhooks.Config
+	ConfigReloader              *configreload.ConfigReloader
+	OperationService            operations.OperationService
 
 	AccessControlListMiddleware *acl.AccessControlListMiddleware
 	AccessControlListConfig     *acl.AccessControlListConfig
+	IdempotencyMiddleware       *coreHandlers.IdempotencyMiddleware
 }
 
 func NewRouteLoader(s options) environments.RouteLoader {
@@ -77,7 +89,12 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 		return pkgerrors.Wrapf(err, "can't load OpenAPI specification")
 	}
 
-	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig)
+	kafkaHandler := handlers.NewKafkaHandler(s.Kafka, s.ProviderConfig, s.AuthService, s.KafkaConfig, s.KafkaOwnershipTransfer, s.OperationService)
+	kafkaOwnershipTransferHandler := handlers.NewKafkaOwnershipTransferHandler(s.KafkaOwnershipTransfer, s.Kafka, s.AuthService)
+	kafkaEventHandler := handlers.NewKafkaEventHandler(s.KafkaEvent, s.Kafka)
+	kafkaReplicatedPairHandler := handlers.NewKafkaReplicatedPairHandler(s.KafkaReplicatedPair, s.Kafka, s.ProviderConfig, s.KafkaConfig)
+	webhookSubscriptionHandler := handlers.NewWebhookSubscriptionHandler(s.WebhookService, s.WebhookConfig)
+	operationHandler := handlers.NewOperationHandler(s.OperationService)
 	cloudProvidersHandler := handlers.NewCloudProviderHandler(s.CloudProviders, s.ProviderConfig, s.Kafka, s.ClusterPlacementStrategy, s.KafkaConfig)
 	errorsHandler := coreHandlers.NewErrorsHandler()
 	serviceAccountsHandler := handlers.NewServiceAccountHandler(s.Keycloak)
@@ -123,6 +140,21 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1KafkasRouter.HandleFunc("/{id}", kafkaHandler.Update).
 		Name(logger.NewLogEvent("update-kafka", "update a kafka instance").ToString()).
 		Methods(http.MethodPatch)
+	apiV1KafkasRouter.HandleFunc("/{id}/restore", kafkaHandler.Restore).
+		Name(logger.NewLogEvent("restore-kafka", "restore a kafka instance scheduled for deletion").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/suspend", kafkaHandler.Suspend).
+		Name(logger.NewLogEvent("suspend-kafka", "suspend a kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/resume", kafkaHandler.Resume).
+		Name(logger.NewLogEvent("resume-kafka", "resume a suspended kafka instance").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/ownership_transfers", kafkaOwnershipTransferHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-ownership-transfer", "request the transfer of a kafka instance to another user").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkasRouter.HandleFunc("/{id}/events", kafkaEventHandler.List).
+		Name(logger.NewLogEvent("list-kafka-events", "list the events of a kafka instance").ToString()).
+		Methods(http.MethodGet)
 	apiV1KafkasRouter.HandleFunc("", kafkaHandler.List).
 		Name(logger.NewLogEvent("list-kafka", "list all kafkas").ToString()).
 		Methods(http.MethodGet)
@@ -131,8 +163,11 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1KafkasRouter.Use(authorizeMiddleware)
 
 	apiV1KafkasCreateRouter := apiV1KafkasRouter.NewRoute().Subrouter()
-	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).Methods(http.MethodPost)
+	apiV1KafkasCreateRouter.HandleFunc("", kafkaHandler.Create).
+		Name(logger.NewLogEvent("create-kafka", "create a kafka instance").ToString()).
+		Methods(http.MethodPost)
 	apiV1KafkasCreateRouter.Use(requireTermsAcceptance)
+	apiV1KafkasCreateRouter.Use(s.IdempotencyMiddleware.IdempotencyKey)
 
 	//  /kafkas/{id}/metrics
 	apiV1MetricsRouter := apiV1KafkasRouter.PathPrefix("/{id}/metrics").Subrouter()
@@ -154,6 +189,100 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1MetricsFederateRouter.Use(requireOrgID)
 	apiV1MetricsFederateRouter.Use(authorizeMiddleware)
 
+	//  /kafka_ownership_transfers
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "kafka_ownership_transfers",
+		Kind: "KafkaOwnershipTransferList",
+	})
+	apiV1KafkaOwnershipTransfersRouter := apiV1Router.PathPrefix("/kafka_ownership_transfers").Subrouter()
+	apiV1KafkaOwnershipTransfersRouter.HandleFunc("", kafkaOwnershipTransferHandler.List).
+		Name(logger.NewLogEvent("list-kafka-ownership-transfers", "list kafka ownership transfers").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaOwnershipTransfersRouter.HandleFunc("/{id}", kafkaOwnershipTransferHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-ownership-transfer", "get a kafka ownership transfer").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaOwnershipTransfersRouter.HandleFunc("/{id}", kafkaOwnershipTransferHandler.Cancel).
+		Name(logger.NewLogEvent("cancel-kafka-ownership-transfer", "cancel a kafka ownership transfer").ToString()).
+		Methods(http.MethodDelete)
+	apiV1KafkaOwnershipTransfersRouter.HandleFunc("/{id}/accept", kafkaOwnershipTransferHandler.Accept).
+		Name(logger.NewLogEvent("accept-kafka-ownership-transfer", "accept a kafka ownership transfer").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaOwnershipTransfersRouter.HandleFunc("/{id}/decline", kafkaOwnershipTransferHandler.Decline).
+		Name(logger.NewLogEvent("decline-kafka-ownership-transfer", "decline a kafka ownership transfer").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaOwnershipTransfersRouter.Use(requireIssuer)
+	apiV1KafkaOwnershipTransfersRouter.Use(requireOrgID)
+	apiV1KafkaOwnershipTransfersRouter.Use(authorizeMiddleware)
+
+	//  /kafka_replicated_pairs
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "kafka_replicated_pairs",
+		Kind: "KafkaReplicatedPairList",
+	})
+	apiV1KafkaReplicatedPairsRouter := apiV1Router.PathPrefix("/kafka_replicated_pairs").Subrouter()
+	apiV1KafkaReplicatedPairsRouter.HandleFunc("", kafkaReplicatedPairHandler.List).
+		Name(logger.NewLogEvent("list-kafka-replicated-pairs", "list kafka replicated pairs").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaReplicatedPairsRouter.HandleFunc("/{id}", kafkaReplicatedPairHandler.Get).
+		Name(logger.NewLogEvent("get-kafka-replicated-pair", "get a kafka replicated pair").ToString()).
+		Methods(http.MethodGet)
+	apiV1KafkaReplicatedPairsRouter.HandleFunc("/{id}", kafkaReplicatedPairHandler.Delete).
+		Name(logger.NewLogEvent("delete-kafka-replicated-pair", "delete a kafka replicated pair").ToString()).
+		Methods(http.MethodDelete)
+	apiV1KafkaReplicatedPairsRouter.HandleFunc("/{id}/failover", kafkaReplicatedPairHandler.Failover).
+		Name(logger.NewLogEvent("failover-kafka-replicated-pair", "swap the roles of the kafka instances of a replicated pair").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaReplicatedPairsRouter.Use(requireIssuer)
+	apiV1KafkaReplicatedPairsRouter.Use(requireOrgID)
+	apiV1KafkaReplicatedPairsRouter.Use(authorizeMiddleware)
+
+	apiV1KafkaReplicatedPairsCreateRouter := apiV1KafkaReplicatedPairsRouter.NewRoute().Subrouter()
+	apiV1KafkaReplicatedPairsCreateRouter.HandleFunc("", kafkaReplicatedPairHandler.Create).
+		Name(logger.NewLogEvent("create-kafka-replicated-pair", "create a kafka replicated pair").ToString()).
+		Methods(http.MethodPost)
+	apiV1KafkaReplicatedPairsCreateRouter.Use(requireTermsAcceptance)
+
+	//  /operations
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "operations",
+		Kind: "OperationList",
+	})
+	apiV1OperationsRouter := apiV1Router.PathPrefix("/operations").Subrouter()
+	apiV1OperationsRouter.HandleFunc("", operationHandler.List).
+		Name(logger.NewLogEvent("list-operations", "list the operations on kafka instances").ToString()).
+		Methods(http.MethodGet)
+	apiV1OperationsRouter.HandleFunc("/{id}", operationHandler.Get).
+		Name(logger.NewLogEvent("get-operation", "get an operation on a kafka instance").ToString()).
+		Methods(http.MethodGet)
+	apiV1OperationsRouter.Use(requireIssuer)
+	apiV1OperationsRouter.Use(requireOrgID)
+	apiV1OperationsRouter.Use(authorizeMiddleware)
+
+	//  /webhook_subscriptions
+	v1Collections = append(v1Collections, api.CollectionMetadata{
+		ID:   "webhook_subscriptions",
+		Kind: "WebhookSubscriptionList",
+	})
+	apiV1WebhookSubscriptionsRouter := apiV1Router.PathPrefix("/webhook_subscriptions").Subrouter()
+	apiV1WebhookSubscriptionsRouter.HandleFunc("", webhookSubscriptionHandler.Create).
+		Name(logger.NewLogEvent("create-webhook-subscription", "subscribe a webhook to the events of the organisation").ToString()).
+		Methods(http.MethodPost)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("", webhookSubscriptionHandler.List).
+		Name(logger.NewLogEvent("list-webhook-subscriptions", "list webhook subscriptions").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("/{id}", webhookSubscriptionHandler.Get).
+		Name(logger.NewLogEvent("get-webhook-subscription", "get a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("/{id}", webhookSubscriptionHandler.Delete).
+		Name(logger.NewLogEvent("delete-webhook-subscription", "delete a webhook subscription").ToString()).
+		Methods(http.MethodDelete)
+	apiV1WebhookSubscriptionsRouter.HandleFunc("/{id}/deliveries", webhookSubscriptionHandler.ListDeliveries).
+		Name(logger.NewLogEvent("list-webhook-deliveries", "list the deliveries of a webhook subscription").ToString()).
+		Methods(http.MethodGet)
+	apiV1WebhookSubscriptionsRouter.Use(requireIssuer)
+	apiV1WebhookSubscriptionsRouter.Use(requireOrgID)
+	apiV1WebhookSubscriptionsRouter.Use(authorizeMiddleware)
+
 	//  /service_accounts
 	v1Collections = append(v1Collections, api.CollectionMetadata{
 		ID:   "service_accounts",
@@ -170,9 +299,6 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.ListServiceAccounts).
 		Name(logger.NewLogEvent("list-service-accounts", "lists all service accounts").ToString()).
 		Methods(http.MethodGet)
-	apiV1ServiceAccountsRouter.HandleFunc("", serviceAccountsHandler.CreateServiceAccount).
-		Name(logger.NewLogEvent("create-service-accounts", "create a service accounts").ToString()).
-		Methods(http.MethodPost)
 	apiV1ServiceAccountsRouter.HandleFunc("/{id}", serviceAccountsHandler.DeleteServiceAccount).
 		Name(logger.NewLogEvent("delete-service-accounts", "delete a service accounts").ToString()).
 		Methods(http.MethodDelete)
@@ -187,6 +313,12 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	apiV1ServiceAccountsRouter.Use(requireOrgID)
 	apiV1ServiceAccountsRouter.Use(authorizeMiddleware)
 
+	apiV1ServiceAccountsCreateRouter := apiV1ServiceAccountsRouter.NewRoute().Subrouter()
+	apiV1ServiceAccountsCreateRouter.HandleFunc("", serviceAccountsHandler.CreateServiceAccount).
+		Name(logger.NewLogEvent("create-service-accounts", "create a service accounts").ToString()).
+		Methods(http.MethodPost)
+	apiV1ServiceAccountsCreateRouter.Use(s.IdempotencyMiddleware.IdempotencyKey)
+
 	//  /cloud_providers
 	v1Collections = append(v1Collections, api.CollectionMetadata{
 		ID:   "cloud_providers",
@@ -245,11 +377,14 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	// deliberately returns 404 here if the request doesn't have the required role, so that it will appear as if the endpoint doesn't exist
 	auth.UseOperatorAuthorisationMiddleware(apiV1DataPlaneRequestsRouter, s.Keycloak.GetRealmConfig().ValidIssuerURI, "id", s.ClusterService)
 
-	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.AccountService, s.ProviderConfig)
+	adminKafkaHandler := handlers.NewAdminKafkaHandler(s.Kafka, s.KafkaExpiryService, s.AccountService, s.ProviderConfig, s.OperationService)
+	adminConfigHandler := handlers.NewAdminConfigHandler(s.ConfigReloader)
+	adminLogLevelHandler := handlers.NewAdminLogLevelHandler()
 	adminRouter := apiV1Router.PathPrefix("/admin").Subrouter()
 	rolesMapping := map[string][]string{
 		http.MethodGet:    {auth.KasFleetManagerAdminReadRole, auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodPatch:  {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
+		http.MethodPost:   {auth.KasFleetManagerAdminWriteRole, auth.KasFleetManagerAdminFullRole},
 		http.MethodDelete: {auth.KasFleetManagerAdminFullRole},
 	}
 	adminRouter.Use(auth.NewRequireIssuerMiddleware().RequireIssuer([]string{s.Keycloak.GetConfig().OSDClusterIDPRealm.ValidIssuerURI}, errors.ErrorNotFound))
@@ -267,6 +402,30 @@ func (s *options) buildApiBaseRouter(mainRouter *mux.Router, basePath string, op
 	adminRouter.HandleFunc("/kafkas/{id}", adminKafkaHandler.Update).
 		Name(logger.NewLogEvent("admin-update-kafka", "[admin] update kafka by id").ToString()).
 		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/kafkas/{id}/extend_lifespan", adminKafkaHandler.ExtendLifespan).
+		Name(logger.NewLogEvent("admin-extend-kafka-lifespan", "[admin] extend kafka lifespan by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/kafkas/{id}/events", kafkaEventHandler.List).
+		Name(logger.NewLogEvent("admin-list-kafka-events", "[admin] list kafka events by id").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/kafka_ownership_transfers/{id}/approve", kafkaOwnershipTransferHandler.Approve).
+		Name(logger.NewLogEvent("admin-approve-kafka-ownership-transfer", "[admin] approve kafka ownership transfer by id").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/configs", adminConfigHandler.List).
+		Name(logger.NewLogEvent("admin-list-configs", "[admin] list the status of the reloadable configurations").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/configs/reload", adminConfigHandler.Reload).
+		Name(logger.NewLogEvent("admin-reload-configs", "[admin] reload the reloadable configurations").ToString()).
+		Methods(http.MethodPost)
+	adminRouter.HandleFunc("/log_levels", adminLogLevelHandler.List).
+		Name(logger.NewLogEvent("admin-list-log-levels", "[admin] list the log levels of the components").ToString()).
+		Methods(http.MethodGet)
+	adminRouter.HandleFunc("/log_levels/{component}", adminLogLevelHandler.Update).
+		Name(logger.NewLogEvent("admin-update-log-level", "[admin] update the log level of a component").ToString()).
+		Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/log_levels/{component}", adminLogLevelHandler.Reset).
+		Name(logger.NewLogEvent("admin-reset-log-level", "[admin] reset the log level of a component").ToString()).
+		Methods(http.MethodDelete)
 
 	return nil
 }
@@ -36,6 +36,8 @@ func (f *FirstReadyCluster) FindCluster(kafka *dbapi.KafkaRequest) (*api.Cluster
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		Private:               kafka.Private,
+		ReplicatedPairId:      kafka.ReplicatedPairId,
 	}
 
 	cluster, err := f.ClusterService.FindCluster(criteria)
@@ -61,6 +63,8 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 		MultiAZ:               kafka.MultiAZ,
 		Status:                api.ClusterReady,
 		SupportedInstanceType: kafka.InstanceType,
+		Private:               kafka.Private,
+		ReplicatedPairId:      kafka.ReplicatedPairId,
 	}
 
 	kafkaInstanceSize, e := f.KafkaConfig.GetKafkaInstanceSize(kafka.InstanceType, kafka.SizeId)
@@ -74,7 +78,7 @@ func (f *FirstSchedulableWithinLimit) FindCluster(kafka *dbapi.KafkaRequest) (*a
 		return nil, err
 	}
 
-	dataplaneClusterConfig := f.DataplaneClusterConfig.ClusterConfig
+	dataplaneClusterConfig := f.DataplaneClusterConfig.GetClusterConfig()
 
 	//#2 - collect schedulable clusters
 	clusterSchIds := []string{}
@@ -117,14 +121,15 @@ func searchClusterObjInArray(clusters []*api.Cluster, clusterId string) *api.Clu
 	return nil
 }
 
-// findClusterKafkaInstanceCount searches DB for the number of Kafka instance associated with each OSD Clusters
+// findClusterKafkaInstanceCount searches DB for the number of Kafka instance associated with each OSD Clusters,
+// with suspended Kafka instances weighted as configured
 func (f *FirstSchedulableWithinLimit) findClusterKafkaInstanceCount(clusterIDs []string) (map[string]int, *errors.ServiceError) {
 	if instanceLst, err := f.ClusterService.FindKafkaInstanceCount(clusterIDs); err != nil {
 		return nil, errors.NewWithCause(err.Code, err, "failed to find kafka instance count for clusters '%v'", clusterIDs)
 	} else {
 		clusterWithinLimitMap := make(map[string]int)
 		for _, c := range instanceLst {
-			clusterWithinLimitMap[c.Clusterid] = c.Count
+			clusterWithinLimitMap[c.Clusterid] = f.DataplaneClusterConfig.WeightedKafkaCapacityConsumed(c.Count, c.SuspendedCount)
 		}
 		return clusterWithinLimitMap, nil
 	}
@@ -64,6 +64,46 @@ func TestFirstReadyCluster_FindCluster(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "Find ready cluster supporting private kafkas for a private kafka",
+			fields: fields{
+				Kafka:                  config.NewKafkaConfig(),
+				DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				ClusterService: &ClusterServiceMock{
+					FindClusterFunc: func(criteria FindClusterCriteria) (cluster *api.Cluster, serviceError *errors.ServiceError) {
+						if !criteria.Private {
+							return nil, nil
+						}
+						return &api.Cluster{SupportsPrivateKafka: true}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{Private: true},
+			},
+			want:    &api.Cluster{SupportsPrivateKafka: true},
+			wantErr: false,
+		},
+		{
+			name: "Find ready cluster away from the other kafka of the replicated pair",
+			fields: fields{
+				Kafka:                  config.NewKafkaConfig(),
+				DataplaneClusterConfig: config.NewDataplaneClusterConfig(),
+				ClusterService: &ClusterServiceMock{
+					FindClusterFunc: func(criteria FindClusterCriteria) (cluster *api.Cluster, serviceError *errors.ServiceError) {
+						if criteria.ReplicatedPairId != "pair-id" {
+							return nil, nil
+						}
+						return &api.Cluster{ClusterID: "other-cluster"}, nil
+					},
+				},
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{ReplicatedPairId: "pair-id"},
+			},
+			want:    &api.Cluster{ClusterID: "other-cluster"},
+			wantErr: false,
+		},
 		{
 			name: "find ready cluster with error",
 			fields: fields{
@@ -176,6 +216,68 @@ func TestFirstScheduleWithinLimit_FindCluster(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "Find an available schedulable cluster when suspended kafkas do not count towards the limit",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType:  "manual",
+					ClusterConfig:                config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 1}}),
+					SuspendedKafkaCapacityWeight: 0,
+				},
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) (cluster []*api.Cluster, serviceError *errors.ServiceError) {
+						var res []*api.Cluster
+						res = append(res, &api.Cluster{ClusterID: "test01"})
+						return res, nil
+					},
+					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
+						var res2 []ResKafkaInstanceCount
+						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test01", Count: 1, SuspendedCount: 1})
+						return res2, nil
+					},
+				},
+				kafkaConfig: &defaultKafkaConf,
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{
+					SizeId:       "x1",
+					InstanceType: types.STANDARD.String(),
+				},
+			},
+			want:    &api.Cluster{ClusterID: "test01"},
+			wantErr: false,
+		},
+		{
+			name: "Failed to find an available schedulable cluster when suspended kafkas fully count towards the limit",
+			fields: fields{
+				DataplaneClusterConfig: &config.DataplaneClusterConfig{
+					DataPlaneClusterScalingType:  "manual",
+					ClusterConfig:                config.NewClusterConfig(config.ClusterList{config.ManualCluster{ClusterId: "test01", Schedulable: true, KafkaInstanceLimit: 1}}),
+					SuspendedKafkaCapacityWeight: 1,
+				},
+				ClusterService: &ClusterServiceMock{
+					FindAllClustersFunc: func(criteria FindClusterCriteria) (cluster []*api.Cluster, serviceError *errors.ServiceError) {
+						var res []*api.Cluster
+						res = append(res, &api.Cluster{ClusterID: "test01"})
+						return res, nil
+					},
+					FindKafkaInstanceCountFunc: func(clusterIds []string) (res []ResKafkaInstanceCount, error *errors.ServiceError) {
+						var res2 []ResKafkaInstanceCount
+						res2 = append(res2, ResKafkaInstanceCount{Clusterid: "test01", Count: 1, SuspendedCount: 1})
+						return res2, nil
+					},
+				},
+				kafkaConfig: &defaultKafkaConf,
+			},
+			args: args{
+				kafka: &dbapi.KafkaRequest{
+					SizeId:       "x1",
+					InstanceType: types.STANDARD.String(),
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
 		{
 			name: "Find an available schedulable cluster after one exceeds limit",
 			fields: fields{
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -31,6 +32,8 @@ type ClusterService interface {
 	// Update updates a Cluster. Only fields whose value is different than the
 	// zero-value of their corresponding type will be updated
 	Update(cluster api.Cluster) *apiErrors.ServiceError
+	// UpdateSupportsPrivateKafka sets whether private kafkas can be placed on a Cluster
+	UpdateSupportsPrivateKafka(cluster api.Cluster, supportsPrivateKafka bool) *apiErrors.ServiceError
 	FindCluster(criteria FindClusterCriteria) (*api.Cluster, *apiErrors.ServiceError)
 	// FindClusterByID returns the cluster corresponding to the provided clusterID.
 	// If the cluster has not been found nil is returned. If there has been an issue
@@ -193,6 +196,19 @@ func (c clusterService) Update(cluster api.Cluster) *apiErrors.ServiceError {
 	return nil
 }
 
+func (c clusterService) UpdateSupportsPrivateKafka(cluster api.Cluster, supportsPrivateKafka bool) *apiErrors.ServiceError {
+	if cluster.ID == "" {
+		return apiErrors.Validation("id is undefined")
+	}
+
+	// Update is used instead of Updates as the zero-value of supportsPrivateKafka has to be stored too
+	if err := c.connectionFactory.New().Model(&cluster).Update("supports_private_kafka", supportsPrivateKafka).Error; err != nil {
+		return apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to update private kafka support of cluster %s", cluster.ClusterID)
+	}
+
+	return nil
+}
+
 func (c clusterService) UpdateStatus(cluster api.Cluster, status api.ClusterStatus) error {
 	if status.String() == "" {
 		return apiErrors.Validation("status is undefined")
@@ -259,6 +275,11 @@ type FindClusterCriteria struct {
 	MultiAZ               bool
 	Status                api.ClusterStatus
 	SupportedInstanceType string
+	// Private restricts the clusters to the ones supporting private kafkas
+	Private bool
+	// ReplicatedPairId excludes the clusters already hosting a kafka of the given replicated pair, so that the
+	// kafkas of a pair never share a cluster
+	ReplicatedPairId string
 }
 
 func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster, *apiErrors.ServiceError) {
@@ -278,6 +299,16 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 		dbConn = dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
 
+	// filter by private kafka support
+	if criteria.Private {
+		dbConn = dbConn.Where("supports_private_kafka = ?", true)
+	}
+
+	// filter out the clusters of the other kafkas of the replicated pair
+	if criteria.ReplicatedPairId != "" {
+		dbConn = dbConn.Where("cluster_id NOT IN (?)", c.replicatedPairClusterIds(criteria.ReplicatedPairId))
+	}
+
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -292,6 +323,14 @@ func (c clusterService) FindCluster(criteria FindClusterCriteria) (*api.Cluster,
 	return &cluster, nil
 }
 
+// replicatedPairClusterIds returns the sub query selecting the clusters hosting the kafkas of the given replicated pair
+func (c clusterService) replicatedPairClusterIds(replicatedPairId string) *gorm.DB {
+	return c.connectionFactory.New().
+		Model(&dbapi.KafkaRequest{}).
+		Select("cluster_id").
+		Where("replicated_pair_id = ? AND cluster_id <> ''", replicatedPairId)
+}
+
 func (c clusterService) FindClusterByID(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
 	if clusterID == "" {
 		return nil, apiErrors.Validation("clusterID is undefined")
@@ -479,6 +518,8 @@ func (c clusterService) ListAllClusterIds() ([]api.Cluster, *apiErrors.ServiceEr
 type ResKafkaInstanceCount struct {
 	Clusterid string
 	Count     int
+	// SuspendedCount is the part of Count consumed by suspending and suspended kafkas, which are scaled down on the data plane
+	SuspendedCount int
 }
 
 func (c clusterService) GetExternalID(clusterID string) (string, *apiErrors.ServiceError) {
@@ -509,6 +550,7 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 	}
 
 	clusterIdCountMap := map[string]int{}
+	clusterIdSuspendedCountMap := map[string]int{}
 
 	var res []ResKafkaInstanceCount
 
@@ -518,6 +560,9 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 			return nil, apiErrors.NewWithCause(apiErrors.ErrorInstancePlanNotSupported, e, "failed to query kafkas")
 		}
 		clusterIdCountMap[k.ClusterID] += kafkaInstanceSize.CapacityConsumed
+		if isSuspendedKafka(k) {
+			clusterIdSuspendedCountMap[k.ClusterID] += kafkaInstanceSize.CapacityConsumed
+		}
 	}
 
 	// the query above won't return a count for a clusterId if that cluster doesn't have any Kafkas,
@@ -531,7 +576,7 @@ func (c clusterService) FindKafkaInstanceCount(clusterIDs []string) ([]ResKafkaI
 	}
 
 	for k, v := range clusterIdCountMap {
-		res = append(res, ResKafkaInstanceCount{Clusterid: k, Count: v})
+		res = append(res, ResKafkaInstanceCount{Clusterid: k, Count: v, SuspendedCount: clusterIdSuspendedCountMap[k]})
 	}
 
 	return res, nil
@@ -554,6 +599,14 @@ func (c clusterService) FindAllClusters(criteria FindClusterCriteria) ([]*api.Cl
 	if criteria.SupportedInstanceType != "" {
 		dbConn.Where("supported_instance_type like ?", fmt.Sprintf("%%%s%%", criteria.SupportedInstanceType))
 	}
+	// filter by private kafka support
+	if criteria.Private {
+		dbConn.Where("supports_private_kafka = ?", true)
+	}
+	// filter out the clusters of the other kafkas of the replicated pair
+	if criteria.ReplicatedPairId != "" {
+		dbConn.Where("cluster_id NOT IN (?)", c.replicatedPairClusterIds(criteria.ReplicatedPairId))
+	}
 	// we order them by "created_at" field instead of the default "id" field.
 	// They are mostly the same as the library we use (xid) does take the generation timestamp into consideration,
 	// However, it only down to the level of seconds. This means that if a few records are created at almost the same time,
@@ -607,7 +660,7 @@ type ClusterStatusCount struct {
 }
 
 func (c clusterService) CountByStatus(status []api.ClusterStatus) ([]ClusterStatusCount, *apiErrors.ServiceError) {
-	dbConn := c.connectionFactory.New()
+	dbConn := c.connectionFactory.NewReadOnly(context.Background())
 	var results []ClusterStatusCount
 	if err := dbConn.Model(&api.Cluster{}).Select("status as Status, count(1) as Count").Where("status in (?)", status).Group("status").Scan(&results).Error; err != nil {
 		return nil, apiErrors.NewWithCause(apiErrors.ErrorGeneral, err, "failed to count by status")
@@ -7,8 +7,11 @@ import (
 	"testing"
 	"time"
 
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/clusters/types"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/converters"
 	mocks "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/clusters"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
@@ -1311,6 +1314,39 @@ func Test_clusterService_FindKafkaInstanceCount(t *testing.T) {
 	}
 }
 
+func Test_clusterService_FindKafkaInstanceCount_SuspendedKafkas(t *testing.T) {
+	g := NewWithT(t)
+	kafka := func(id string, status constants2.KafkaStatus) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.ID = id
+			kafkaRequest.ClusterID = "test01"
+			kafkaRequest.InstanceType = "standard"
+			kafkaRequest.Status = status.String()
+		})
+	}
+	mocket.Catcher.Reset().
+		NewMock().
+		WithQuery(`SELECT * FROM "kafka_requests"`).
+		WithReply(converters.ConvertKafkaRequestList(dbapi.KafkaList{
+			kafka("ready", constants2.KafkaRequestStatusReady),
+			kafka("suspending", constants2.KafkaRequestStatusSuspending),
+			kafka("suspended", constants2.KafkaRequestStatusSuspended),
+			kafka("resuming", constants2.KafkaRequestStatusResuming),
+		}))
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+	c := clusterService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		kafkaConfig: &config.KafkaConfig{
+			SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+		},
+	}
+	got, err := c.FindKafkaInstanceCount([]string{"test01"})
+	g.Expect(err).To(BeNil())
+	// suspending kafkas are scaled down with suspended ones, resuming kafkas are scaled up again
+	g.Expect(got).To(Equal([]ResKafkaInstanceCount{{Clusterid: "test01", Count: 4, SuspendedCount: 2}}))
+}
+
 func Test_clusterService_FindAllClusters(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -111,6 +111,9 @@ var _ ClusterService = &ClusterServiceMock{}
 // 			UpdateStatusFunc: func(cluster api.Cluster, status api.ClusterStatus) error {
 // 				panic("mock out the UpdateStatus method")
 // 			},
+// 			UpdateSupportsPrivateKafkaFunc: func(cluster api.Cluster, supportsPrivateKafka bool) *serviceError.ServiceError {
+// 				panic("mock out the UpdateSupportsPrivateKafka method")
+// 			},
 // 		}
 //
 // 		// use mockedClusterService in code that requires ClusterService
@@ -208,6 +211,9 @@ type ClusterServiceMock struct {
 	// UpdateStatusFunc mocks the UpdateStatus method.
 	UpdateStatusFunc func(cluster api.Cluster, status api.ClusterStatus) error
 
+	// UpdateSupportsPrivateKafkaFunc mocks the UpdateSupportsPrivateKafka method.
+	UpdateSupportsPrivateKafkaFunc func(cluster api.Cluster, supportsPrivateKafka bool) *serviceError.ServiceError
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ApplyResources holds details about calls to the ApplyResources method.
@@ -386,6 +392,13 @@ type ClusterServiceMock struct {
 			// Status is the status argument value.
 			Status api.ClusterStatus
 		}
+		// UpdateSupportsPrivateKafka holds details about calls to the UpdateSupportsPrivateKafka method.
+		UpdateSupportsPrivateKafka []struct {
+			// Cluster is the cluster argument value.
+			Cluster api.Cluster
+			// SupportsPrivateKafka is the supportsPrivateKafka argument value.
+			SupportsPrivateKafka bool
+		}
 	}
 	lockApplyResources                          sync.RWMutex
 	lockCheckClusterStatus                      sync.RWMutex
@@ -417,6 +430,7 @@ type ClusterServiceMock struct {
 	lockUpdate                                  sync.RWMutex
 	lockUpdateMultiClusterStatus                sync.RWMutex
 	lockUpdateStatus                            sync.RWMutex
+	lockUpdateSupportsPrivateKafka              sync.RWMutex
 }
 
 // ApplyResources calls ApplyResourcesFunc.
@@ -1399,3 +1413,38 @@ func (mock *ClusterServiceMock) UpdateStatusCalls() []struct {
 	mock.lockUpdateStatus.RUnlock()
 	return calls
 }
+
+// UpdateSupportsPrivateKafka calls UpdateSupportsPrivateKafkaFunc.
+func (mock *ClusterServiceMock) UpdateSupportsPrivateKafka(cluster api.Cluster, supportsPrivateKafka bool) *serviceError.ServiceError {
+	if mock.UpdateSupportsPrivateKafkaFunc == nil {
+		panic("ClusterServiceMock.UpdateSupportsPrivateKafkaFunc: method is nil but ClusterService.UpdateSupportsPrivateKafka was just called")
+	}
+	callInfo := struct {
+		Cluster              api.Cluster
+		SupportsPrivateKafka bool
+	}{
+		Cluster:              cluster,
+		SupportsPrivateKafka: supportsPrivateKafka,
+	}
+	mock.lockUpdateSupportsPrivateKafka.Lock()
+	mock.calls.UpdateSupportsPrivateKafka = append(mock.calls.UpdateSupportsPrivateKafka, callInfo)
+	mock.lockUpdateSupportsPrivateKafka.Unlock()
+	return mock.UpdateSupportsPrivateKafkaFunc(cluster, supportsPrivateKafka)
+}
+
+// UpdateSupportsPrivateKafkaCalls gets all the calls that were made to UpdateSupportsPrivateKafka.
+// Check the length with:
+//     len(mockedClusterService.UpdateSupportsPrivateKafkaCalls())
+func (mock *ClusterServiceMock) UpdateSupportsPrivateKafkaCalls() []struct {
+	Cluster              api.Cluster
+	SupportsPrivateKafka bool
+} {
+	var calls []struct {
+		Cluster              api.Cluster
+		SupportsPrivateKafka bool
+	}
+	mock.lockUpdateSupportsPrivateKafka.RLock()
+	calls = mock.calls.UpdateSupportsPrivateKafka
+	mock.lockUpdateSupportsPrivateKafka.RUnlock()
+	return calls
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/golang/glog"
 )
@@ -38,6 +39,7 @@ type dataPlaneClusterService struct {
 	KafkaConfig            *config.KafkaConfig
 	ObservabilityConfig    *observatorium.ObservabilityConfiguration
 	DataplaneClusterConfig *config.DataplaneClusterConfig
+	OperationService       operations.OperationService
 }
 
 type dataPlaneComputeNodesKafkaCapacityAttributes struct {
@@ -103,7 +105,7 @@ func (d *dataPlaneClusterService) UpdateDataPlaneClusterStatus(ctx context.Conte
 	// We calculate the status based on the stats received by the KAS Fleet operator
 	// BEFORE performing the scaling actions. If scaling actions are performed later
 	// then it will be reflected on the next data plane cluster status report
-	err = d.setClusterStatus(cluster, status)
+	err = d.setClusterStatus(ctx, cluster, status)
 	if err != nil {
 		return errors.ToServiceError(err)
 	}
@@ -204,7 +206,7 @@ func (d *dataPlaneClusterService) updateDataPlaneClusterNodes(cluster *api.Clust
 	return desiredNodesAfterScaleActions, nil
 }
 
-func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) error {
+func (d *dataPlaneClusterService) setClusterStatus(ctx context.Context, cluster *api.Cluster, status *dbapi.DataPlaneClusterStatus) error {
 	remainingCapacity := true
 	if d.DataplaneClusterConfig.IsDataPlaneAutoScalingEnabled() {
 		var err error
@@ -256,6 +258,17 @@ func (d *dataPlaneClusterService) setClusterStatus(cluster *api.Cluster, status
 			if err != nil {
 				return err
 			}
+			if desiredStatus == api.ClusterComputeNodeScalingUp {
+				// the scale up is completed by the cluster manager once the cluster has capacity again
+				if _, svcErr := d.OperationService.Create(ctx, &api.Operation{
+					Kind:       api.OperationKindClusterScaleUp.String(),
+					TargetKind: api.OperationTargetCluster,
+					TargetId:   cluster.ClusterID,
+					State:      api.OperationStateRunning.String(),
+				}); svcErr != nil {
+					return svcErr
+				}
+			}
 		}
 		metrics.UpdateClusterStatusSinceCreatedMetric(*cluster, desiredStatus)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 
 	. "github.com/onsi/gomega"
 )
@@ -1040,7 +1041,7 @@ func Test_DataPlaneCluster_setClusterStatus(t *testing.T) {
 			f, spyReceivedStatus := tt.inputFactory()
 			g.Expect(f).ToNot(BeNil(), "dataPlaneClusterService is nil")
 
-			res := f.dataPlaneClusterService.setClusterStatus(f.cluster, f.status)
+			res := f.dataPlaneClusterService.setClusterStatus(context.Background(), f.cluster, f.status)
 			if res != nil != tt.wantErr {
 				t.Errorf("setClusterStatus() got = %+v, expected %+v", res, tt.wantErr)
 			}
@@ -1089,5 +1090,10 @@ func sampleValidApplicationConfigForDataPlaneClusterTest(clusterService ClusterS
 	return dataPlaneClusterService{
 		ClusterService:         clusterService,
 		DataplaneClusterConfig: dataplaneClusterConfig,
+		OperationService: &operations.OperationServiceMock{
+			CreateFunc: func(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError) {
+				return operation, nil
+			},
+		},
 	}
 }
@@ -14,7 +14,6 @@ import (
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
-	"github.com/golang/glog"
 	"github.com/pkg/errors"
 )
 
@@ -26,6 +25,7 @@ const (
 	statusError      kafkaStatus = "error"
 	statusRejected   kafkaStatus = "rejected"
 	statusDeleted    kafkaStatus = "deleted"
+	statusSuspended  kafkaStatus = "suspended"
 	statusUnknown    kafkaStatus = "unknown"
 	strimziUpdating  string      = "StrimziUpdating"
 	kafkaUpdating    string      = "KafkaUpdating"
@@ -37,16 +37,18 @@ type DataPlaneKafkaService interface {
 }
 
 type dataPlaneKafkaService struct {
-	kafkaService   KafkaService
-	clusterService ClusterService
-	kafkaConfig    *config.KafkaConfig
+	kafkaService      KafkaService
+	clusterService    ClusterService
+	kafkaConfig       *config.KafkaConfig
+	kafkaEventService KafkaEventService
 }
 
-func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig) *dataPlaneKafkaService {
+func NewDataPlaneKafkaService(kafkaSrv KafkaService, clusterSrv ClusterService, kafkaConfig *config.KafkaConfig, kafkaEventService KafkaEventService) *dataPlaneKafkaService {
 	return &dataPlaneKafkaService{
-		kafkaService:   kafkaSrv,
-		clusterService: clusterSrv,
-		kafkaConfig:    kafkaConfig,
+		kafkaService:      kafkaSrv,
+		clusterService:    clusterSrv,
+		kafkaConfig:       kafkaConfig,
+		kafkaEventService: kafkaEventService,
 	}
 }
 
@@ -63,20 +65,29 @@ func (d *dataPlaneKafkaService) UpdateDataPlaneKafkaService(ctx context.Context,
 	for _, ks := range status {
 		kafka, getErr := d.kafkaService.GetById(ks.KafkaClusterId)
 		if getErr != nil {
-			glog.Error(errors.Wrapf(getErr, "failed to get kafka cluster by id %s", ks.KafkaClusterId))
+			log.WithFields(logger.KafkaIDField, ks.KafkaClusterId).Error(errors.Wrapf(getErr, "failed to get kafka cluster by id %s", ks.KafkaClusterId))
 			continue
 		}
 		if kafka.ClusterID != clusterId {
-			log.Warningf("clusterId for kafka cluster %s does not match clusterId. kafka clusterId = %s :: clusterId = %s", kafka.ID, kafka.ClusterID, clusterId)
+			log.WithFields(logger.KafkaIDField, kafka.ID, logger.ClusterIDField, clusterId).Warningf("clusterId for kafka cluster %s does not match clusterId. kafka clusterId = %s :: clusterId = %s", kafka.ID, kafka.ClusterID, clusterId)
+			continue
+		}
+		s := getStatus(ks)
+		if isSuspensionStatus(kafka.Status) && s != statusDeleted {
+			// kafkas being suspended or resumed only follow the suspension state reported by the data plane
+			if e := d.setKafkaClusterSuspension(kafka, ks); e != nil {
+				log.Error(errors.Wrapf(e, "Error updating kafka %s status", ks.KafkaClusterId))
+			}
 			continue
 		}
 		var e *serviceError.ServiceError
-		switch s := getStatus(ks); s {
+		switch s {
 		case statusReady:
 			// Store the routes (and create them) when Kafka is ready. By the time it is ready, the routes should definitely be there.
 			e = d.persistKafkaRoutes(kafka, ks, cluster)
 			if e == nil {
 				kafka.AdminApiServerURL = ks.AdminServerURI
+				kafka.PrivateEndpointServiceName = ks.PrivateEndpointServiceName
 				e = d.setKafkaClusterReady(kafka)
 			}
 		case statusInstalling:
@@ -111,10 +122,10 @@ func (d *dataPlaneKafkaService) UpdateDataPlaneKafkaService(ctx context.Context,
 
 func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if !kafka.RoutesCreated {
-		logger.Logger.V(10).Infof("routes for kafka %s are not created", kafka.ID)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("routes for kafka %s are not created", kafka.ID)
 		return nil
 	} else {
-		logger.Logger.Infof("routes for kafka %s are created", kafka.ID)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("routes for kafka %s are created", kafka.ID)
 	}
 	// only send metrics data if the current kafka request is in "provisioning" status as this is the only case we want to report
 	shouldSendMetric, err := d.checkKafkaRequestCurrentStatus(kafka, constants2.KafkaRequestStatusProvisioning)
@@ -122,7 +133,7 @@ func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest)
 		return err
 	}
 
-	err = d.kafkaService.Updates(kafka, map[string]interface{}{"admin_api_server_url": kafka.AdminApiServerURL, "failed_reason": "", "status": constants2.KafkaRequestStatusReady.String()})
+	err = d.kafkaService.Updates(kafka, map[string]interface{}{"admin_api_server_url": kafka.AdminApiServerURL, "private_endpoint_service_name": kafka.PrivateEndpointServiceName, "failed_reason": "", "status": constants2.KafkaRequestStatusReady.String()})
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to update kafka cluster %s", kafka.ID)
 	}
@@ -138,23 +149,43 @@ func (d *dataPlaneKafkaService) setKafkaClusterReady(kafka *dbapi.KafkaRequest)
 
 func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaRequest, status *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
 	needsUpdate := false
+	// events are only recorded once the version fields are stored. The first versions reported for a kafka are not upgrades.
+	var events []*dbapi.KafkaEvent
+	newEvent := func(eventType dbapi.KafkaEventType, reason string, args ...interface{}) {
+		events = append(events, &dbapi.KafkaEvent{
+			KafkaID: kafka.ID,
+			Type:    eventType.String(),
+			Reason:  fmt.Sprintf(reason, args...),
+			Status:  kafka.Status,
+		})
+	}
+
 	prevActualKafkaVersion := status.KafkaVersion
 	if status.KafkaVersion != "" && status.KafkaVersion != kafka.ActualKafkaVersion {
-		logger.Logger.Infof("Updating Kafka version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualKafkaVersion, status.KafkaVersion)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Updating Kafka version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualKafkaVersion, status.KafkaVersion)
+		if kafka.ActualKafkaVersion != "" {
+			newEvent(dbapi.KafkaEventTypeUpgrade, "kafka version changed from %s to %s", kafka.ActualKafkaVersion, status.KafkaVersion)
+		}
 		kafka.ActualKafkaVersion = status.KafkaVersion
 		needsUpdate = true
 	}
 
 	prevActualKafkaIBPVersion := status.KafkaIBPVersion
 	if status.KafkaIBPVersion != "" && status.KafkaIBPVersion != kafka.ActualKafkaIBPVersion {
-		logger.Logger.Infof("Updating Kafka IBP version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualKafkaIBPVersion, status.KafkaIBPVersion)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Updating Kafka IBP version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualKafkaIBPVersion, status.KafkaIBPVersion)
+		if kafka.ActualKafkaIBPVersion != "" {
+			newEvent(dbapi.KafkaEventTypeUpgrade, "kafka ibp version changed from %s to %s", kafka.ActualKafkaIBPVersion, status.KafkaIBPVersion)
+		}
 		kafka.ActualKafkaIBPVersion = status.KafkaIBPVersion
 		needsUpdate = true
 	}
 
 	prevActualStrimziVersion := status.StrimziVersion
 	if status.StrimziVersion != "" && status.StrimziVersion != kafka.ActualStrimziVersion {
-		logger.Logger.Infof("Updating Strimzi version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualStrimziVersion, status.StrimziVersion)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Updating Strimzi version for Kafka ID '%s' from '%s' to '%s'", kafka.ID, prevActualStrimziVersion, status.StrimziVersion)
+		if kafka.ActualStrimziVersion != "" {
+			newEvent(dbapi.KafkaEventTypeUpgrade, "strimzi version changed from %s to %s", kafka.ActualStrimziVersion, status.StrimziVersion)
+		}
 		kafka.ActualStrimziVersion = status.StrimziVersion
 		needsUpdate = true
 	}
@@ -166,40 +197,46 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 		prevStrimziUpgrading := kafka.StrimziUpgrading
 		strimziUpdatingReasonIsSet := readyCondition.Reason == strimziUpdating
 		if strimziUpdatingReasonIsSet && !prevStrimziUpgrading {
-			logger.Logger.Infof("Strimzi version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevStrimziUpgrading, strimziUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Strimzi version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevStrimziUpgrading, strimziUpdatingReasonIsSet)
 			kafka.StrimziUpgrading = true
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "strimzi upgrade started")
 		}
 		if !strimziUpdatingReasonIsSet && prevStrimziUpgrading {
-			logger.Logger.Infof("Strimzi version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevStrimziUpgrading, strimziUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Strimzi version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevStrimziUpgrading, strimziUpdatingReasonIsSet)
 			kafka.StrimziUpgrading = false
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "strimzi upgrade finished")
 		}
 
 		prevKafkaUpgrading := kafka.KafkaUpgrading
 		kafkaUpdatingReasonIsSet := readyCondition.Reason == kafkaUpdating
 		if kafkaUpdatingReasonIsSet && !prevKafkaUpgrading {
-			logger.Logger.Infof("Kafka version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaUpgrading, kafkaUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Kafka version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaUpgrading, kafkaUpdatingReasonIsSet)
 			kafka.KafkaUpgrading = true
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "kafka upgrade started")
 		}
 		if !kafkaUpdatingReasonIsSet && prevKafkaUpgrading {
-			logger.Logger.Infof("Kafka version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaUpgrading, kafkaUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Kafka version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaUpgrading, kafkaUpdatingReasonIsSet)
 			kafka.KafkaUpgrading = false
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "kafka upgrade finished")
 		}
 
 		prevKafkaIBPUpgrading := kafka.KafkaIBPUpgrading
 		kafkaIBPUpdatingReasonIsSet := readyCondition.Reason == kafkaIBPUpdating
 		if kafkaIBPUpdatingReasonIsSet && !prevKafkaIBPUpgrading {
-			logger.Logger.Infof("Kafka IBP version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaIBPUpgrading, kafkaIBPUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Kafka IBP version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaIBPUpgrading, kafkaIBPUpdatingReasonIsSet)
 			kafka.KafkaIBPUpgrading = true
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "kafka ibp upgrade started")
 		}
 		if !kafkaIBPUpdatingReasonIsSet && prevKafkaIBPUpgrading {
-			logger.Logger.Infof("Kafka IBP version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaIBPUpgrading, kafkaIBPUpdatingReasonIsSet)
+			logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("Kafka IBP version for Kafka ID '%s' upgrade state changed from %t to %t", kafka.ID, prevKafkaIBPUpgrading, kafkaIBPUpdatingReasonIsSet)
 			kafka.KafkaIBPUpgrading = false
 			needsUpdate = true
+			newEvent(dbapi.KafkaEventTypeDataPlaneCondition, "kafka ibp upgrade finished")
 		}
 
 	}
@@ -217,6 +254,10 @@ func (d *dataPlaneKafkaService) setKafkaRequestVersionFields(kafka *dbapi.KafkaR
 		if err := d.kafkaService.Updates(kafka, versionFields); err != nil {
 			return serviceError.NewWithCause(err.Code, err, "failed to update actual version fields for kafka cluster %s", kafka.ID)
 		}
+
+		for _, event := range events {
+			d.kafkaEventService.Record(event)
+		}
 	}
 
 	return nil
@@ -244,7 +285,7 @@ func (d *dataPlaneKafkaService) setKafkaClusterFailed(kafka *dbapi.KafkaRequest,
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusFailed, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 		metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationCreate)
 	}
-	logger.Logger.Errorf("Kafka status for Kafka ID '%s' in ClusterID '%s' reported as failed by KAS Fleet Shard Operator: '%s'", kafka.ID, kafka.ClusterID, errMessage)
+	logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Errorf("Kafka status for Kafka ID '%s' in ClusterID '%s' reported as failed by KAS Fleet Shard Operator: '%s'", kafka.ID, kafka.ClusterID, errMessage)
 
 	return nil
 }
@@ -261,6 +302,25 @@ func (d *dataPlaneKafkaService) setKafkaClusterDeleting(kafka *dbapi.KafkaReques
 	return nil
 }
 
+func (d *dataPlaneKafkaService) setKafkaClusterSuspension(kafka *dbapi.KafkaRequest, ks *dbapi.DataPlaneKafkaStatus) *serviceError.ServiceError {
+	status := getStatus(ks)
+	switch {
+	case kafka.Status == constants2.KafkaRequestStatusSuspending.String() && status == statusSuspended:
+		if err := d.kafkaService.Updates(kafka, map[string]interface{}{"status": constants2.KafkaRequestStatusSuspended.String()}); err != nil {
+			return serviceError.NewWithCause(err.Code, err, "failed to update status %s for kafka cluster %s", constants2.KafkaRequestStatusSuspended, kafka.ID)
+		}
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("kafka cluster %s has been suspended", kafka.ID)
+	case kafka.Status == constants2.KafkaRequestStatusResuming.String() && status == statusReady:
+		return d.setKafkaClusterReady(kafka)
+	case kafka.Status == constants2.KafkaRequestStatusResuming.String() && status == statusError:
+		readyCondition, _ := ks.GetReadyCondition()
+		return d.setKafkaClusterFailed(kafka, readyCondition.Message)
+	default:
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).V(5).Infof("kafka cluster %s is %s and reported as %s", kafka.ID, kafka.Status, status)
+	}
+	return nil
+}
+
 func (d *dataPlaneKafkaService) reassignKafkaCluster(kafka *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if kafka.Status == constants2.KafkaRequestStatusProvisioning.String() {
 		// If a Kafka cluster is rejected by the kas-fleetshard-operator, it should be assigned to another OSD cluster (via some scheduler service in the future).
@@ -270,14 +330,27 @@ func (d *dataPlaneKafkaService) reassignKafkaCluster(kafka *dbapi.KafkaRequest)
 		if err := d.kafkaService.Update(kafka); err != nil {
 			return err
 		}
+		d.kafkaEventService.Record(&dbapi.KafkaEvent{
+			KafkaID: kafka.ID,
+			Type:    dbapi.KafkaEventTypeDataPlaneCondition.String(),
+			Reason:  "kafka rejected by the data plane, placement retried",
+			Status:  kafka.Status,
+		})
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusProvisioning, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 	} else {
-		logger.Logger.Infof("kafka cluster %s is rejected and current status is %s", kafka.ID, kafka.Status)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("kafka cluster %s is rejected and current status is %s", kafka.ID, kafka.Status)
 	}
 
 	return nil
 }
 
+// isSuspensionStatus returns whether the kafka status is one where the kafka is being, or has been, scaled down on the data plane
+func isSuspensionStatus(status string) bool {
+	return status == constants2.KafkaRequestStatusSuspending.String() ||
+		status == constants2.KafkaRequestStatusSuspended.String() ||
+		status == constants2.KafkaRequestStatusResuming.String()
+}
+
 func getStatus(status *dbapi.DataPlaneKafkaStatus) kafkaStatus {
 	for _, c := range status.Conditions {
 		if strings.EqualFold(c.Type, "Ready") {
@@ -299,6 +372,9 @@ func getStatus(status *dbapi.DataPlaneKafkaStatus) kafkaStatus {
 			if strings.EqualFold(c.Reason, "Rejected") {
 				return statusRejected
 			}
+			if strings.EqualFold(c.Reason, "Suspended") {
+				return statusSuspended
+			}
 		}
 	}
 	return statusInstalling
@@ -315,16 +391,16 @@ func (d *dataPlaneKafkaService) checkKafkaRequestCurrentStatus(kafka *dbapi.Kafk
 
 func (d *dataPlaneKafkaService) persistKafkaRoutes(kafka *dbapi.KafkaRequest, kafkaStatus *dbapi.DataPlaneKafkaStatus, cluster *api.Cluster) *serviceError.ServiceError {
 	if kafka.Routes != nil {
-		logger.Logger.V(10).Infof("skip persisting routes for Kafka %s as they are already stored", kafka.ID)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("skip persisting routes for Kafka %s as they are already stored", kafka.ID)
 		return nil
 	}
 
 	if len(kafkaStatus.Routes) < 1 {
-		logger.Logger.V(10).Infof("skip persisting routes for Kafka %s as they are not available", kafka.ID)
+		logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("skip persisting routes for Kafka %s as they are not available", kafka.ID)
 		return nil
 	}
 
-	logger.Logger.Infof("store routes information for kafka %s", kafka.ID)
+	logger.Logger.WithFields(logger.KafkaIDField, kafka.ID).Infof("store routes information for kafka %s", kafka.ID)
 	clusterDNS, err := d.clusterService.GetClusterDNS(cluster.ClusterID)
 	if err != nil {
 		return serviceError.NewWithCause(err.Code, err, "failed to get DNS entry for cluster %s", cluster.ClusterID)
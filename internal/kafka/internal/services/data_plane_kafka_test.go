@@ -405,6 +405,233 @@ func Test_dataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 				"rejected": 0,
 			},
 		},
+		{
+			name: "should only update the status of a suspended kafka once it is deleted",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{}, nil
+					},
+				},
+				kafkaService: func(c map[string]int) KafkaService {
+					return &KafkaServiceMock{
+						GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+							return &dbapi.KafkaRequest{
+								ClusterID:     "test-cluster-id",
+								Status:        constants2.KafkaRequestStatusSuspended.String(),
+								Routes:        []byte("[{'domain':'test.example.com', 'router':'test.example.com'}]"),
+								RoutesCreated: true,
+							}, nil
+						},
+						UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+							return nil
+						},
+						UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+							v, ok := values["status"]
+							if ok {
+								statusValue := v.(string)
+								c[statusValue]++
+							}
+							return nil
+						},
+						UpdateStatusFunc: func(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
+							if status == constants2.KafkaRequestStatusReady {
+								c["ready"]++
+							} else if status == constants2.KafkaRequestStatusDeleting {
+								c["deleting"]++
+							} else if status == constants2.KafkaRequestStatusFailed {
+								c["failed"]++
+							}
+							return true, nil
+						},
+					}
+				},
+			},
+			args: args{
+				clusterId: "test-cluster-id",
+				status: []*dbapi.DataPlaneKafkaStatus{
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "True",
+							},
+						},
+					},
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:    "Ready",
+								Status:  "False",
+								Reason:  "Error",
+								Message: testErrorCondMessage,
+							},
+						},
+					},
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "False",
+								Reason: "Deleted",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+			expectCounters: map[string]int{
+				"ready":    0,
+				"failed":   0,
+				"deleting": 1,
+				"rejected": 0,
+			},
+		},
+		{
+			name: "should update the status of a suspending kafka once it is suspended",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{}, nil
+					},
+				},
+				kafkaService: func(c map[string]int) KafkaService {
+					return &KafkaServiceMock{
+						GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+							return &dbapi.KafkaRequest{
+								ClusterID:     "test-cluster-id",
+								Status:        constants2.KafkaRequestStatusSuspending.String(),
+								Routes:        []byte("[{'domain':'test.example.com', 'router':'test.example.com'}]"),
+								RoutesCreated: true,
+							}, nil
+						},
+						UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+							return nil
+						},
+						UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+							v, ok := values["status"]
+							if ok {
+								statusValue := v.(string)
+								c[statusValue]++
+							}
+							return nil
+						},
+						UpdateStatusFunc: func(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
+							if status == constants2.KafkaRequestStatusReady {
+								c["ready"]++
+							} else if status == constants2.KafkaRequestStatusDeleting {
+								c["deleting"]++
+							} else if status == constants2.KafkaRequestStatusFailed {
+								c["failed"]++
+							}
+							return true, nil
+						},
+					}
+				},
+			},
+			args: args{
+				clusterId: "test-cluster-id",
+				status: []*dbapi.DataPlaneKafkaStatus{
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "True",
+							},
+						},
+					},
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "False",
+								Reason: "Suspended",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+			expectCounters: map[string]int{
+				"ready":     0,
+				"failed":    0,
+				"deleting":  0,
+				"rejected":  0,
+				"suspended": 1,
+			},
+		},
+		{
+			name: "should update the status of a resuming kafka once it is ready",
+			fields: fields{
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{}, nil
+					},
+				},
+				kafkaService: func(c map[string]int) KafkaService {
+					return &KafkaServiceMock{
+						GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+							return &dbapi.KafkaRequest{
+								ClusterID:     "test-cluster-id",
+								Status:        constants2.KafkaRequestStatusResuming.String(),
+								Routes:        []byte("[{'domain':'test.example.com', 'router':'test.example.com'}]"),
+								RoutesCreated: true,
+							}, nil
+						},
+						UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+							return nil
+						},
+						UpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+							v, ok := values["status"]
+							if ok {
+								statusValue := v.(string)
+								c[statusValue]++
+							}
+							return nil
+						},
+						UpdateStatusFunc: func(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
+							if status == constants2.KafkaRequestStatusReady {
+								c["ready"]++
+							} else if status == constants2.KafkaRequestStatusDeleting {
+								c["deleting"]++
+							} else if status == constants2.KafkaRequestStatusFailed {
+								c["failed"]++
+							}
+							return true, nil
+						},
+					}
+				},
+			},
+			args: args{
+				clusterId: "test-cluster-id",
+				status: []*dbapi.DataPlaneKafkaStatus{
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "False",
+								Reason: "Suspended",
+							},
+						},
+					},
+					{
+						Conditions: []dbapi.DataPlaneKafkaStatusCondition{
+							{
+								Type:   "Ready",
+								Status: "True",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+			expectCounters: map[string]int{
+				"ready":    1,
+				"failed":   0,
+				"deleting": 0,
+				"rejected": 0,
+			},
+		},
 	}
 
 	RegisterTestingT(t)
@@ -417,7 +644,7 @@ func Test_dataPlaneKafkaService_UpdateDataPlaneKafkaService(t *testing.T) {
 				"deleting": 0,
 				"rejected": 0,
 			}
-			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.fields.kafkaService(counter), tt.fields.clusterService, &config.KafkaConfig{}, &KafkaEventServiceMock{RecordFunc: func(event *dbapi.KafkaEvent) {}})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.args.clusterId, tt.args.status)
 			Expect(err).To(Equal(tt.want))
 			Expect(counter).To(Equal(tt.expectCounters))
@@ -695,7 +922,7 @@ func TestDataPlaneKafkaService_UpdateVersions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			v := versions{}
-			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{})
+			s := NewDataPlaneKafkaService(tt.kafkaService(&v), tt.clusterService, &config.KafkaConfig{}, &KafkaEventServiceMock{RecordFunc: func(event *dbapi.KafkaEvent) {}})
 			err := s.UpdateDataPlaneKafkaService(context.TODO(), tt.clusterId, tt.status)
 			if err != nil && !tt.wantErr {
 				t.Errorf("unexpected error %v", err)
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+)
+
+// NewDNSProviderFactory returns the factory of the dns provider selected in the DNSConfig
+func NewDNSProviderFactory(dnsConfig *config.DNSConfig, awsConfig *config.AWSConfig, awsClientFactory aws.ClientFactory) dns.ProviderFactory {
+	if dnsConfig.Provider == config.DNSProviderRFC2136 {
+		return dns.NewRFC2136ProviderFactory(dnsConfig.RFC2136Config())
+	}
+	return dns.NewRoute53ProviderFactory(awsClientFactory, aws.Config{
+		AccessKeyID:     awsConfig.Route53AccessKey,
+		SecretAccessKey: awsConfig.Route53SecretAccessKey,
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"sync"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
@@ -28,17 +30,16 @@ import (
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
-	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 )
 
 var kafkaDeletionStatuses = []string{constants2.KafkaRequestStatusDeleting.String(), constants2.KafkaRequestStatusDeprovision.String()}
-var kafkaManagedCRStatuses = []string{constants2.KafkaRequestStatusProvisioning.String(), constants2.KafkaRequestStatusDeprovision.String(), constants2.KafkaRequestStatusReady.String(), constants2.KafkaRequestStatusFailed.String()}
+var kafkaManagedCRStatuses = []string{constants2.KafkaRequestStatusProvisioning.String(), constants2.KafkaRequestStatusDeprovision.String(), constants2.KafkaRequestStatusReady.String(), constants2.KafkaRequestStatusSuspending.String(), constants2.KafkaRequestStatusSuspended.String(), constants2.KafkaRequestStatusResuming.String(), constants2.KafkaRequestStatusFailed.String()}
 
 type KafkaRoutesAction string
 
@@ -46,6 +47,9 @@ const KafkaRoutesActionCreate KafkaRoutesAction = "CREATE"
 const KafkaRoutesActionDelete KafkaRoutesAction = "DELETE"
 const CanaryServiceAccountPrefix = "canary"
 
+// ManagedKafkaSuspendedLabel is set on the ManagedKafka CR of suspended kafkas
+const ManagedKafkaSuspendedLabel = "bf2.org/suspended"
+
 type CNameRecordStatus struct {
 	Id     *string
 	Status *string
@@ -81,10 +85,24 @@ type KafkaService interface {
 	// Use this only when you want to update the multiple columns that may contain zero-fields, otherwise use the `KafkaService.Update()` method.
 	// See https://gorm.io/docs/update.html#Updates-multiple-columns for more info
 	Updates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
-	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError)
+	// VersionedUpdates updates the given fields of a kafka edited by the users or the admins, only if the kafka still has the
+	// version it was retrieved with, otherwise a conflict is returned. The version of the given kafka is updated.
+	VersionedUpdates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError
+	ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError)
 	GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error)
 	AssignInstanceType(owner string, organisationID string) (types.KafkaInstanceType, *errors.ServiceError)
+	// RegisterKafkaDeprovisionJob registers the kafka that the given ctx has access to for deprovisioning. Ready or suspended kafkas
+	// of an instance type with a deletion grace period are suspended instead, and are only deprovisioned once the grace period is over.
+	// The members of a replicated pair can only be deleted along with their pair.
 	RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError
+	// SuspendKafka asks the data plane to scale down a ready kafka that the given ctx has access to, keeping its storage
+	SuspendKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError)
+	// ResumeKafka asks the data plane to scale a suspended kafka that the given ctx has access to back up
+	ResumeKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError)
+	// RestoreKafka cancels the deletion of a suspended kafka that the given ctx has access to, resuming it
+	RestoreKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError)
+	// DeprovisionSuspendedKafkas registers the suspended kafkas whose deletion grace period is over for deprovisioning
+	DeprovisionSuspendedKafkas() *errors.ServiceError
 	// DeprovisionKafkaForUsers registers all kafkas for deprovisioning given the list of owners
 	DeprovisionKafkaForUsers(users []string) *errors.ServiceError
 	DeprovisionExpiredKafkas() *errors.ServiceError
@@ -92,6 +110,11 @@ type KafkaService interface {
 	CountByRegionAndInstanceType() ([]KafkaRegionCount, error)
 	ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest, *errors.ServiceError)
 	VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// TransferOwnership reserves the quota of the kafka against the new owner and makes them the owner of the kafka.
+	// The owner is changed in a transaction with the given update, e.g. of the status of the transfer, and only if the
+	// kafka still has its previous owner and is not being deleted, otherwise a conflict is returned.
+	// The quota reserved by the previous owner is released once the kafka has been transferred.
+	TransferOwnership(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *errors.ServiceError) *errors.ServiceError
 	ListComponentVersions() ([]KafkaComponentVersions, error)
 	HasAvailableCapacityInRegion(kafkaRequest *dbapi.KafkaRequest) (bool, *errors.ServiceError)
 	// GetAvailableSizesInRegion returns a list of ids of the Kafka instance sizes that can still be created according to the specified criteria
@@ -106,25 +129,25 @@ type kafkaService struct {
 	clusterService           ClusterService
 	keycloakService          sso.KeycloakService
 	kafkaConfig              *config.KafkaConfig
-	awsConfig                *config.AWSConfig
 	quotaServiceFactory      QuotaServiceFactory
 	mu                       sync.Mutex
-	awsClientFactory         aws.ClientFactory
+	dnsProviderFactory       dns.ProviderFactory
+	certificateService       KafkaCertificateService
 	authService              authorization.Authorization
 	dataplaneClusterConfig   *config.DataplaneClusterConfig
 	providerConfig           *config.ProviderConfig
 	clusterPlacementStrategy ClusterPlacementStrategy
 }
 
-func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, awsConfig *config.AWSConfig, quotaServiceFactory QuotaServiceFactory, awsClientFactory aws.ClientFactory, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
+func NewKafkaService(connectionFactory *db.ConnectionFactory, clusterService ClusterService, keycloakService sso.KafkaKeycloakService, kafkaConfig *config.KafkaConfig, dataplaneClusterConfig *config.DataplaneClusterConfig, quotaServiceFactory QuotaServiceFactory, dnsProviderFactory dns.ProviderFactory, certificateService KafkaCertificateService, authorizationService authorization.Authorization, providerConfig *config.ProviderConfig, clusterPlacementStrategy ClusterPlacementStrategy) *kafkaService {
 	return &kafkaService{
 		connectionFactory:        connectionFactory,
 		clusterService:           clusterService,
 		keycloakService:          keycloakService,
 		kafkaConfig:              kafkaConfig,
-		awsConfig:                awsConfig,
 		quotaServiceFactory:      quotaServiceFactory,
-		awsClientFactory:         awsClientFactory,
+		dnsProviderFactory:       dnsProviderFactory,
+		certificateService:       certificateService,
 		authService:              authorizationService,
 		dataplaneClusterConfig:   dataplaneClusterConfig,
 		providerConfig:           providerConfig,
@@ -165,7 +188,7 @@ func (k *kafkaService) capacityAvailableForRegionAndInstanceType(instTypeRegCapa
 
 	dbConn := k.connectionFactory.New()
 
-	var count int64
+	var count, suspendedCount int
 
 	var kafkas []*dbapi.KafkaRequest
 
@@ -182,7 +205,10 @@ func (k *kafkaService) capacityAvailableForRegionAndInstanceType(instTypeRegCapa
 		if e != nil {
 			return false, errors.NewWithCause(errors.ErrorInstancePlanNotSupported, e, errMessage)
 		}
-		count += int64(kafkaInstanceSize.CapacityConsumed)
+		count += kafkaInstanceSize.CapacityConsumed
+		if isSuspendedKafka(kafka) {
+			suspendedCount += kafkaInstanceSize.CapacityConsumed
+		}
 	}
 
 	kafkaInstanceSize, e := k.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
@@ -190,9 +216,12 @@ func (k *kafkaService) capacityAvailableForRegionAndInstanceType(instTypeRegCapa
 		return false, errors.NewWithCause(errors.ErrorInstancePlanNotSupported, e, errMessage)
 	}
 
-	count += int64(kafkaInstanceSize.CapacityConsumed)
+	count += kafkaInstanceSize.CapacityConsumed
+
+	// suspended kafkas are scaled down, so they only hold on to the weighted share of their capacity
+	count = k.dataplaneClusterConfig.WeightedKafkaCapacityConsumed(count, suspendedCount)
 
-	return instTypeRegCapacity == nil || count <= int64(*instTypeRegCapacity), nil
+	return instTypeRegCapacity == nil || count <= *instTypeRegCapacity, nil
 }
 
 func (k *kafkaService) GetAvailableSizesInRegion(criteria *FindClusterCriteria) ([]string, *errors.ServiceError) {
@@ -202,7 +231,7 @@ func (k *kafkaService) GetAvailableSizesInRegion(criteria *FindClusterCriteria)
 		return nil, err
 	}
 
-	supportedInstanceTypes := k.kafkaConfig.SupportedInstanceTypes.Configuration
+	supportedInstanceTypes := k.kafkaConfig.GetSupportedInstanceTypes()
 	instanceType, err := supportedInstanceTypes.GetKafkaInstanceTypeByID(criteria.SupportedInstanceType)
 	if err != nil {
 		err := errors.InstanceTypeNotSupported("unable to get available sizes in region: %s", err.Error())
@@ -269,7 +298,7 @@ func (k *kafkaService) AssignInstanceType(owner string, organisationId string) (
 // reserveQuota - reserves quota for the given kafka request. If a RHOSAK quota has been assigned, it will try to reserve RHOSAK quota, otherwise it will try with RHOSAKTrial
 func (k *kafkaService) reserveQuota(kafkaRequest *dbapi.KafkaRequest) (subscriptionId string, err *errors.ServiceError) {
 	if kafkaRequest.InstanceType == types.DEVELOPER.String() {
-		instType, err := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+		instType, err := k.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
 
 		if err != nil {
 			return "", errors.NewWithCause(errors.ErrorGeneral, err, "unable to reserve quota")
@@ -349,12 +378,11 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 		return err
 	}
 
-	dbConn := k.connectionFactory.New()
 	kafkaRequest.SubscriptionId = subscriptionId
 	kafkaRequest.Status = constants2.KafkaRequestStatusAccepted.String()
 
 	// when creating new kafka - default storage size is assigned
-	instanceType, instanceTypeErr := k.kafkaConfig.SupportedInstanceTypes.Configuration.GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+	instanceType, instanceTypeErr := k.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
 	if instanceTypeErr != nil {
 		return errors.InstanceTypeNotSupported(instanceTypeErr.Error())
 	}
@@ -371,7 +399,21 @@ func (k *kafkaService) RegisterKafkaJob(kafkaRequest *dbapi.KafkaRequest) *error
 	// the API is restarted this time changing the --quota-type flag to quota-management-list, when kafka A is deleted at this point,
 	// we want to use the correct quota to perform the deletion.
 	kafkaRequest.QuotaType = k.kafkaConfig.Quota.Type
-	if err := dbConn.Create(kafkaRequest).Error; err != nil {
+	// the request and its placement are recorded in the transaction of its creation
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(kafkaRequest).Error; err != nil {
+			return err
+		}
+		if err := recordKafkaStatusChange(tx, kafkaRequest.ID, "", kafkaRequest.Status, kafkaRequest.Owner, "kafka requested"); err != nil {
+			return err
+		}
+		return createKafkaEvent(tx, &dbapi.KafkaEvent{
+			KafkaID: kafkaRequest.ID,
+			Type:    dbapi.KafkaEventTypePlacement.String(),
+			Reason:  fmt.Sprintf("kafka placed on cluster %s", kafkaRequest.ClusterID),
+			Status:  kafkaRequest.Status,
+		})
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create kafka request") //hide the db error to http caller
 	}
 
@@ -510,16 +552,17 @@ func (k *kafkaService) GetById(id string) (*dbapi.KafkaRequest, *errors.ServiceE
 	return &kafkaRequest, nil
 }
 
-// RegisterKafkaDeprovisionJob registers a kafka deprovision job in the kafka table
-func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError {
+// getManageableKafka retrieves the kafka request that the given ctx is allowed to delete, restore, suspend or resume:
+// admins can manage any kafka, organisation admins the kafkas of their organisation and users the kafkas they own
+func (k *kafkaService) getManageableKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
 	if id == "" {
-		return errors.Validation("id is undefined")
+		return nil, errors.Validation("id is undefined")
 	}
 
 	// filter kafka request by owner to only retrieve request of the current authenticated user
 	claims, err := auth.GetClaimsFromContext(ctx)
 	if err != nil {
-		return errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
 	}
 
 	dbConn := k.connectionFactory.New()
@@ -536,13 +579,38 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 
 	var kafkaRequest dbapi.KafkaRequest
 	if err := dbConn.First(&kafkaRequest).Error; err != nil {
-		return services.HandleGetError("KafkaResource", "id", id, err)
+		return nil, services.HandleGetError("KafkaResource", "id", id, err)
 	}
+	return &kafkaRequest, nil
+}
+
+// RegisterKafkaDeprovisionJob registers a kafka deprovision job in the kafka table
+func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id string) *errors.ServiceError {
+	kafkaRequest, svcErr := k.getManageableKafka(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+	if kafkaRequest.ReplicatedPairId != "" {
+		return errors.BadRequest("unable to delete kafka %s: it is a member of replicated pair %s, which has to be deleted instead", id, kafkaRequest.ReplicatedPairId)
+	}
+
+	// ready or suspended kafkas of an instance type with a deletion grace period are only suspended so that they can still be restored.
+	// Deleting a kafka which is already scheduled for deletion deprovisions it straight away.
+	if kafkaRequest.DeletionScheduledAt == nil && (kafkaRequest.Status == constants2.KafkaRequestStatusReady.String() || isSuspendedKafka(kafkaRequest)) {
+		instanceType, err := k.kafkaConfig.GetSupportedInstanceTypes().GetKafkaInstanceTypeByID(kafkaRequest.InstanceType)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision kafka %s", id)
+		}
+		if instanceType.HasDeletionGracePeriod() {
+			return k.scheduleKafkaDeletion(kafkaRequest, time.Duration(*instanceType.DeletionGracePeriodSeconds)*time.Second, kafkaEventActor(ctx))
+		}
+	}
+
 	metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationDeprovision)
 
 	deprovisionStatus := constants2.KafkaRequestStatusDeprovision
 
-	if executed, err := k.UpdateStatus(id, deprovisionStatus); executed {
+	if executed, err := k.updateStatus(id, deprovisionStatus, kafkaEventActor(ctx), "kafka deleted"); executed {
 		if err != nil {
 			return services.HandleGetError("KafkaResource", "id", id, err)
 		}
@@ -553,22 +621,181 @@ func (k *kafkaService) RegisterKafkaDeprovisionJob(ctx context.Context, id strin
 	return nil
 }
 
+// scheduleKafkaDeletion suspends a kafka, if it is not suspended already, and schedules its deprovisioning once the grace period is over
+func (k *kafkaService) scheduleKafkaDeletion(kafkaRequest *dbapi.KafkaRequest, gracePeriod time.Duration, actor string) *errors.ServiceError {
+	deletionScheduledAt := time.Now().Add(gracePeriod)
+	updates := map[string]interface{}{
+		"deletion_scheduled_at": deletionScheduledAt,
+	}
+	if kafkaRequest.Status == constants2.KafkaRequestStatusReady.String() {
+		updates["status"] = constants2.KafkaRequestStatusSuspending.String()
+	}
+
+	reason := fmt.Sprintf("kafka deleted, deprovisioning scheduled at %s", deletionScheduledAt.Format(time.RFC3339))
+	if err := k.updateSuspension(kafkaRequest, updates, actor, reason); err != nil {
+		return errors.NewWithCause(err.Code, err, "unable to delete kafka %s", kafkaRequest.ID)
+	}
+
+	logger.Logger.WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("kafka %s has been suspended and will be deprovisioned at %s", kafkaRequest.ID, deletionScheduledAt.Format(time.RFC3339))
+	return nil
+}
+
+func (k *kafkaService) SuspendKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafkaRequest, svcErr := k.getManageableKafka(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if kafkaRequest.Status != constants2.KafkaRequestStatusReady.String() {
+		return nil, errors.BadRequest("unable to suspend kafka %s: only %s kafkas can be suspended", id, constants2.KafkaRequestStatusReady.String())
+	}
+
+	updates := map[string]interface{}{
+		"status": constants2.KafkaRequestStatusSuspending.String(),
+	}
+	if err := k.updateSuspension(kafkaRequest, updates, kafkaEventActor(ctx), "kafka suspended"); err != nil {
+		return nil, errors.NewWithCause(err.Code, err, "unable to suspend kafka %s", id)
+	}
+
+	kafkaRequest.Status = constants2.KafkaRequestStatusSuspending.String()
+	logger.NewUHCLogger(ctx).WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("kafka %s is being suspended", kafkaRequest.ID)
+	return kafkaRequest, nil
+}
+
+func (k *kafkaService) ResumeKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafkaRequest, svcErr := k.getManageableKafka(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !isSuspendedKafka(kafkaRequest) {
+		return nil, errors.BadRequest("unable to resume kafka %s: only %s kafkas can be resumed", id, constants2.KafkaRequestStatusSuspended.String())
+	}
+	if kafkaRequest.DeletionScheduledAt != nil {
+		return nil, errors.BadRequest("unable to resume kafka %s: the kafka is scheduled for deletion and has to be restored instead", id)
+	}
+
+	updates := map[string]interface{}{
+		"status": constants2.KafkaRequestStatusResuming.String(),
+	}
+	if err := k.updateSuspension(kafkaRequest, updates, kafkaEventActor(ctx), "kafka resumed"); err != nil {
+		return nil, errors.NewWithCause(err.Code, err, "unable to resume kafka %s", id)
+	}
+
+	kafkaRequest.Status = constants2.KafkaRequestStatusResuming.String()
+	logger.NewUHCLogger(ctx).WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("kafka %s is being resumed", kafkaRequest.ID)
+	return kafkaRequest, nil
+}
+
+func (k *kafkaService) RestoreKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+	kafkaRequest, svcErr := k.getManageableKafka(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !isSuspendedKafka(kafkaRequest) || kafkaRequest.DeletionScheduledAt == nil {
+		return nil, errors.BadRequest("unable to restore kafka %s: only kafkas scheduled for deletion can be restored", id)
+	}
+
+	updates := map[string]interface{}{
+		"status":                constants2.KafkaRequestStatusResuming.String(),
+		"deletion_scheduled_at": nil,
+	}
+	if err := k.updateSuspension(kafkaRequest, updates, kafkaEventActor(ctx), "kafka restored"); err != nil {
+		return nil, errors.NewWithCause(err.Code, err, "unable to restore kafka %s", id)
+	}
+
+	kafkaRequest.Status = constants2.KafkaRequestStatusResuming.String()
+	kafkaRequest.DeletionScheduledAt = nil
+	logger.NewUHCLogger(ctx).WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("kafka %s has been restored", kafkaRequest.ID)
+	return kafkaRequest, nil
+}
+
+// updateSuspension applies the given updates to a kafka being suspended, resumed or restored. The kafka is only updated
+// if neither its status nor its deletion schedule changed since it was retrieved, so that a concurrent change is not overridden.
+// The status change, if any, is recorded with the given actor and reason.
+func (k *kafkaService) updateSuspension(kafkaRequest *dbapi.KafkaRequest, updates map[string]interface{}, actor string, reason string) *errors.ServiceError {
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		dbConn := tx.
+			Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+			Where("status = ?", kafkaRequest.Status)
+		if kafkaRequest.DeletionScheduledAt == nil {
+			dbConn = dbConn.Where("deletion_scheduled_at IS NULL")
+		} else {
+			dbConn = dbConn.Where("deletion_scheduled_at IS NOT NULL")
+		}
+
+		dbConn = dbConn.Updates(updates)
+		if err := dbConn.Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka %s", kafkaRequest.ID)
+		}
+		if dbConn.RowsAffected == 0 {
+			return errors.BadRequest("the status of kafka %s has changed, try again", kafkaRequest.ID)
+		}
+
+		// the update only applies to the status it was read with, which is therefore the previous status
+		if status, ok := updates["status"]; ok {
+			if err := recordKafkaStatusChange(tx, kafkaRequest.ID, kafkaRequest.Status, fmt.Sprint(status), actor, reason); err != nil {
+				return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka %s", kafkaRequest.ID)
+			}
+		}
+		return nil
+	}); err != nil {
+		if svcErr, ok := err.(*errors.ServiceError); ok {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update kafka %s", kafkaRequest.ID)
+	}
+	return nil
+}
+
+// isSuspendedKafka returns whether the kafka is, or is being, scaled down on the data plane
+func isSuspendedKafka(kafkaRequest *dbapi.KafkaRequest) bool {
+	return kafkaRequest.Status == constants2.KafkaRequestStatusSuspending.String() ||
+		kafkaRequest.Status == constants2.KafkaRequestStatusSuspended.String()
+}
+
+func (k *kafkaService) DeprovisionSuspendedKafkas() *errors.ServiceError {
+	deletionScheduledAt := time.Now()
+	filter := func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.
+			Where("status IN (?)", []string{constants2.KafkaRequestStatusSuspending.String(), constants2.KafkaRequestStatusSuspended.String()}).
+			Where("deletion_scheduled_at <= ?", deletionScheduledAt)
+	}
+
+	deprovisioned, err := k.deprovisionKafkas(filter, "deletion grace period is over")
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision suspended kafkas")
+	}
+
+	if deprovisioned >= 1 {
+		glog.Infof("%v suspended kafkas are over their deletion grace period and are now deprovisioning", deprovisioned)
+		var counter int64 = 0
+		for ; counter < deprovisioned; counter++ {
+			metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationDeprovision)
+			metrics.IncreaseKafkaSuccessOperationsCountMetric(constants2.KafkaOperationDeprovision)
+		}
+	}
+
+	return nil
+}
+
 func (k *kafkaService) DeprovisionKafkaForUsers(users []string) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(&dbapi.KafkaRequest{}).
-		Where("owner IN (?)", users).
-		Where("status NOT IN (?)", kafkaDeletionStatuses).
-		Update("status", constants2.KafkaRequestStatusDeprovision)
+	filter := func(dbConn *gorm.DB) *gorm.DB {
+		return dbConn.
+			Where("owner IN (?)", users).
+			Where("status NOT IN (?)", kafkaDeletionStatuses)
+	}
 
-	err := dbConn.Error
+	deprovisioned, err := k.deprovisionKafkas(filter, "owner denied access to the service")
 	if err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to deprovision kafka requests for users")
 	}
 
-	if dbConn.RowsAffected >= 1 {
-		glog.Infof("%v kafkas are now deprovisioning for users %v", dbConn.RowsAffected, users)
+	if deprovisioned >= 1 {
+		glog.Infof("%v kafkas are now deprovisioning for users %v", deprovisioned, users)
 		var counter int64 = 0
-		for ; counter < dbConn.RowsAffected; counter++ {
+		for ; counter < deprovisioned; counter++ {
 			metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationDeprovision)
 			metrics.IncreaseKafkaSuccessOperationsCountMetric(constants2.KafkaOperationDeprovision)
 		}
@@ -580,13 +807,7 @@ func (k *kafkaService) DeprovisionKafkaForUsers(users []string) *errors.ServiceE
 func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 	dbConn := k.connectionFactory.New().Model(&dbapi.KafkaRequest{}).Session(&gorm.Session{})
 
-	var typesWithLifespan []string
-	for _, kafkaInstanceType := range k.kafkaConfig.SupportedInstanceTypes.Configuration.SupportedKafkaInstanceTypes {
-		if kafkaInstanceType.HasAnInstanceSizeWithLifespan() {
-			typesWithLifespan = append(typesWithLifespan, kafkaInstanceType.Id)
-		}
-	}
-
+	typesWithLifespan := kafkaInstanceTypesWithLifespan(k.kafkaConfig)
 	if len(typesWithLifespan) == 0 {
 		return nil
 	}
@@ -604,36 +825,39 @@ func (k *kafkaService) DeprovisionExpiredKafkas() *errors.ServiceError {
 	var kafkasToDeprovisionIDs []string
 	timeNow := time.Now()
 	for _, existingKafkaRequest := range existingKafkaRequests {
-		glog.V(10).Infof("Evaluating expiration time of kafka request '%s' with instance type '%s', ID '%s' and status '%s'", existingKafkaRequest.ID, existingKafkaRequest.InstanceType, existingKafkaRequest.SizeId, existingKafkaRequest.Status)
+		log := logger.Logger.WithFields(logger.KafkaIDField, existingKafkaRequest.ID).V(10)
+		log.Infof("Evaluating expiration time of kafka request '%s' with instance type '%s', ID '%s' and status '%s'", existingKafkaRequest.ID, existingKafkaRequest.InstanceType, existingKafkaRequest.SizeId, existingKafkaRequest.Status)
 		kafkaInstanceSize, err := k.kafkaConfig.GetKafkaInstanceSize(existingKafkaRequest.InstanceType, existingKafkaRequest.SizeId)
 		if err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision expired kafkas")
 		}
 		if kafkaInstanceSize.LifespanSeconds != nil {
-			glog.V(10).Infof("Kafka size associated to kafka ID '%s' has '%d' lifespanSeconds", existingKafkaRequest.ID, *kafkaInstanceSize.LifespanSeconds)
+			log.Infof("Kafka size associated to kafka ID '%s' has '%d' lifespanSeconds", existingKafkaRequest.ID, *kafkaInstanceSize.LifespanSeconds)
 			expTime := existingKafkaRequest.GetExpirationTime(*kafkaInstanceSize.LifespanSeconds)
-			glog.V(10).Infof("Expiration time of kafka ID '%s' is '%s'", existingKafkaRequest.ID, expTime)
+			log.Infof("Expiration time of kafka ID '%s' is '%s'", existingKafkaRequest.ID, expTime)
 			if timeNow.After(*expTime) {
-				glog.V(10).Infof("Kafka ID '%s' has expired", existingKafkaRequest.ID)
+				log.Infof("Kafka ID '%s' has expired", existingKafkaRequest.ID)
 				kafkasToDeprovisionIDs = append(kafkasToDeprovisionIDs, existingKafkaRequest.ID)
 			} else {
-				glog.V(10).Infof("Kafka ID '%s' still has not expired", existingKafkaRequest.ID)
+				log.Infof("Kafka ID '%s' still has not expired", existingKafkaRequest.ID)
 			}
 		}
 	}
 
 	if len(kafkasToDeprovisionIDs) > 0 {
 		glog.V(10).Infof("Kafka IDs to mark with status %s: %+v", constants2.KafkaRequestStatusDeprovision, kafkasToDeprovisionIDs)
-		db = dbConn.Where("id IN (?)", kafkasToDeprovisionIDs).
-			Updates(map[string]interface{}{"status": constants2.KafkaRequestStatusDeprovision})
-		err = db.Error
+		deprovisioned, err := k.deprovisionKafkas(func(dbConn *gorm.DB) *gorm.DB {
+			return dbConn.
+				Where("id IN (?)", kafkasToDeprovisionIDs).
+				Where("status NOT IN (?)", kafkaDeletionStatuses)
+		}, "kafka expired")
 		if err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "unable to deprovision expired kafkas")
 		}
-		if db.RowsAffected >= 1 {
-			glog.Infof("%v kafka_request's lifespans are over their lifespan and have had their status updated to deprovisioning", db.RowsAffected)
+		if deprovisioned >= 1 {
+			glog.Infof("%v kafka_request's lifespans are over their lifespan and have had their status updated to deprovisioning", deprovisioned)
 			var counter int64 = 0
-			for ; counter < db.RowsAffected; counter++ {
+			for ; counter < deprovisioned; counter++ {
 				metrics.IncreaseKafkaTotalOperationsCountMetric(constants2.KafkaOperationDeprovision)
 				metrics.IncreaseKafkaSuccessOperationsCountMetric(constants2.KafkaOperationDeprovision)
 			}
@@ -662,8 +886,8 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 		if err != nil {
 			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 		}
-		// Only delete the routes when they are set
-		if routes != nil && k.kafkaConfig.EnableKafkaExternalCertificate {
+		// Only delete the routes when they are set. Private kafkas have no public DNS records
+		if routes != nil && k.kafkaConfig.EnableKafkaExternalCertificate && !kafkaRequest.Private {
 			_, err := k.ChangeKafkaCNAMErecords(kafkaRequest, KafkaRoutesActionDelete)
 			if err != nil {
 				return err
@@ -685,7 +909,7 @@ func (k *kafkaService) Delete(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceE
 // List returns all Kafka requests belonging to a user.
 func (k *kafkaService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaList, *api.PagingMeta, *errors.ServiceError) {
 	var kafkaRequestList dbapi.KafkaList
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(ctx)
 	pagingMeta := &api.PagingMeta{
 		Page: listArgs.Page,
 		Size: listArgs.Size,
@@ -762,43 +986,353 @@ func (k *kafkaService) GetManagedKafkaByClusterID(clusterID string) ([]managedka
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka requests")
 	}
 
+	certificates := map[string]*dbapi.KafkaCertificate{}
+	if k.kafkaConfig.EnableKafkaExternalCertificate {
+		ids := make([]string, 0, len(kafkaRequestList))
+		for _, kafkaRequest := range kafkaRequestList {
+			ids = append(ids, kafkaRequest.ID)
+		}
+		var err *errors.ServiceError
+		if certificates, err = k.certificateService.GetByKafkaIds(ids); err != nil {
+			return nil, err
+		}
+	}
+
+	mirroring, svcErr := k.getMirroringSpecs(kafkaRequestList)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
 	var res []managedkafka.ManagedKafka
 	// convert kafka requests to managed kafka
 	for _, kafkaRequest := range kafkaRequestList {
-		mk, err := buildManagedKafkaCR(kafkaRequest, k.kafkaConfig, k.keycloakService)
+		mk, err := buildManagedKafkaCR(kafkaRequest, certificates[kafkaRequest.ID], k.kafkaConfig, k.keycloakService)
 		if err != nil {
 			return nil, err
 		}
+		mk.Spec.Mirroring = mirroring[kafkaRequest.ID]
 		res = append(res, *mk)
 	}
 
 	return res, nil
 }
 
+// getMirroringSpecs returns the mirroring of the secondary kafkas of replicated pairs among the given kafkas, indexed by kafka id.
+// A secondary is only given a mirroring once the bootstrap server of its primary is known.
+func (k *kafkaService) getMirroringSpecs(kafkaRequestList dbapi.KafkaList) (map[string]*managedkafka.MirroringSpec, *errors.ServiceError) {
+	mirroring := map[string]*managedkafka.MirroringSpec{}
+
+	var secondaryIds []string
+	for _, kafkaRequest := range kafkaRequestList {
+		if kafkaRequest.ReplicationRole == dbapi.KafkaReplicationRoleSecondary.String() {
+			secondaryIds = append(secondaryIds, kafkaRequest.ID)
+		}
+	}
+	if len(secondaryIds) == 0 {
+		return mirroring, nil
+	}
+
+	var pairs dbapi.KafkaReplicatedPairList
+	if err := k.connectionFactory.New().Where("secondary_kafka_id IN (?)", secondaryIds).Find(&pairs).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the replicated pairs of the secondary kafkas")
+	}
+	if len(pairs) == 0 {
+		return mirroring, nil
+	}
+
+	primaryIds := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		primaryIds = append(primaryIds, pair.PrimaryKafkaID)
+	}
+	var primaries dbapi.KafkaList
+	if err := k.connectionFactory.New().Select("id", "bootstrap_server_host").Where("id IN (?)", primaryIds).Find(&primaries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the primary kafkas of the replicated pairs")
+	}
+	bootstrapServerHosts := map[string]string{}
+	for _, primary := range primaries {
+		bootstrapServerHosts[primary.ID] = primary.BootstrapServerHost
+	}
+
+	for _, pair := range pairs {
+		if host := bootstrapServerHosts[pair.PrimaryKafkaID]; host != "" {
+			mirroring[pair.SecondaryKafkaID] = &managedkafka.MirroringSpec{
+				SourceBootstrapServerHost: host,
+				Topics:                    pair.MirroredTopics,
+				SyncConsumerGroupOffsets:  pair.SyncConsumerGroupOffsets,
+			}
+		}
+	}
+	return mirroring, nil
+}
+
 func (k *kafkaService) Update(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var previousStatus string
+		if kafkaRequest.Status != "" {
+			var err error
+			if previousStatus, err = lockKafkaStatus(tx, kafkaRequest.ID); err != nil {
+				return err
+			}
+		}
 
-	if err := dbConn.Updates(kafkaRequest).Error; err != nil {
+		dbConn := tx.
+			Model(kafkaRequest).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(kafkaRequest)
+		if err := dbConn.Error; err != nil {
+			return err
+		}
+		if dbConn.RowsAffected == 0 {
+			return nil
+		}
+		return recordKafkaStatusChange(tx, kafkaRequest.ID, previousStatus, kafkaRequest.Status, dbapi.KafkaEventActorSystem, statusChangeReason(kafkaRequest.Status, kafkaRequest.FailedReason))
+	}); err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
 	}
-
 	return nil
 }
 
 func (k *kafkaService) Updates(kafkaRequest *dbapi.KafkaRequest, fields map[string]interface{}) *errors.ServiceError {
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest).
-		Where("status not IN (?)", kafkaDeletionStatuses) // ignore updates of kafka under deletion
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var status, previousStatus string
+		if s, ok := fields["status"]; ok {
+			status = fmt.Sprint(s)
+			var err error
+			if previousStatus, err = lockKafkaStatus(tx, kafkaRequest.ID); err != nil {
+				return err
+			}
+		}
+
+		dbConn := tx.
+			Model(kafkaRequest).
+			Where("status not IN (?)", kafkaDeletionStatuses). // ignore updates of kafka under deletion
+			Updates(fields)
+		if err := dbConn.Error; err != nil {
+			return err
+		}
+		if dbConn.RowsAffected == 0 {
+			return nil
+		}
+		failedReason, _ := fields["failed_reason"].(string)
+		return recordKafkaStatusChange(tx, kafkaRequest.ID, previousStatus, status, dbapi.KafkaEventActorSystem, statusChangeReason(status, failedReason))
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
+	}
+	return nil
+}
+
+func (k *kafkaService) VersionedUpdates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *errors.ServiceError {
+	dbConn := k.connectionFactory.New()
+	update := dbConn.
+		Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: kafkaRequest.ID}}).
+		Where("version = ?", kafkaRequest.Version).
+		Where("status not IN (?)", kafkaDeletionStatuses). // kafkas under deletion can't be updated anymore
+		Updates(values)
+	if err := update.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
+	}
+	if update.RowsAffected == 0 {
+		return errors.Conflict("unable to update kafka %s: it has been changed concurrently or is being deleted", kafkaRequest.ID)
+	}
 
-	if err := dbConn.Updates(fields).Error; err != nil {
+	// read the version bumped by the update back
+	var versions []int64
+	if err := dbConn.Model(&dbapi.KafkaRequest{}).Where("id = ?", kafkaRequest.ID).Pluck("version", &versions).Error; err != nil {
 		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
 	}
+	if len(versions) > 0 {
+		kafkaRequest.Version = versions[0]
+	}
+	return nil
+}
+
+// lockKafkaStatus returns the status of the kafka, or an empty status if it doesn't exist. The kafka is locked until the
+// end of the given tx, so that its status cannot change before the status change is recorded in the same tx.
+func lockKafkaStatus(tx *gorm.DB, id string) (string, error) {
+	var statuses []string
+	if err := tx.Model(&dbapi.KafkaRequest{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		Pluck("status", &statuses).Error; err != nil {
+		return "", err
+	}
+	if len(statuses) == 0 {
+		return "", nil
+	}
+	return statuses[0], nil
+}
+
+// deprovisionKafkas changes the status of the kafkas matching the filter to deprovision and records their status changes
+// with the given reason in the same transaction. The kafkas are locked when they are selected, so that the recorded
+// previous statuses are the ones which are changed. It returns the number of deprovisioned kafkas.
+func (k *kafkaService) deprovisionKafkas(filter func(dbConn *gorm.DB) *gorm.DB, reason string) (int64, error) {
+	var deprovisioned int64
+	err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var kafkas []*dbapi.KafkaRequest
+		if err := filter(tx.Model(&dbapi.KafkaRequest{})).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "status").
+			Find(&kafkas).Error; err != nil {
+			return err
+		}
+		if len(kafkas) == 0 {
+			return nil
+		}
+
+		ids := make([]string, 0, len(kafkas))
+		for _, kafka := range kafkas {
+			ids = append(ids, kafka.ID)
+		}
+		update := tx.Model(&dbapi.KafkaRequest{}).
+			Where("id IN (?)", ids).
+			Update("status", constants2.KafkaRequestStatusDeprovision)
+		if err := update.Error; err != nil {
+			return err
+		}
+		for _, kafka := range kafkas {
+			if err := recordKafkaStatusChange(tx, kafka.ID, kafka.Status, constants2.KafkaRequestStatusDeprovision.String(), dbapi.KafkaEventActorSystem, reason); err != nil {
+				return err
+			}
+		}
+		deprovisioned = update.RowsAffected
+		return nil
+	})
+	return deprovisioned, err
+}
+
+// recordKafkaStatusChange adds a status change to the history of the kafka and writes it to the webhooks outbox with the
+// given tx, which must be the transaction of the change, unless its status did not change
+func recordKafkaStatusChange(tx *gorm.DB, kafkaId string, previousStatus string, status string, actor string, reason string) error {
+	if status == "" || status == previousStatus {
+		return nil
+	}
+	if err := createKafkaEvent(tx, &dbapi.KafkaEvent{
+		KafkaID:        kafkaId,
+		Type:           dbapi.KafkaEventTypeStatusChange.String(),
+		Actor:          actor,
+		Reason:         reason,
+		PreviousStatus: previousStatus,
+		Status:         status,
+	}); err != nil {
+		return err
+	}
+	return publishKafkaStatusChange(tx, kafkaId, previousStatus, status)
+}
+
+// publishKafkaStatusChange writes a status change of the kafka to the webhooks outbox with the given tx, which must be
+// the transaction of the change, unless its status did not change
+func publishKafkaStatusChange(tx *gorm.DB, kafkaId string, previousStatus string, status string) error {
+	if status == "" || status == previousStatus {
+		return nil
+	}
+
+	var kafkas []*dbapi.KafkaRequest
+	if err := tx.Unscoped().Select("id", "name", "organisation_id", "failed_reason").
+		Where("id = ?", kafkaId).Limit(1).Find(&kafkas).Error; err != nil {
+		return err
+	}
+	if len(kafkas) == 0 {
+		return nil
+	}
+
+	data := kafkaStatusChangedEventData{
+		Id:             kafkaId,
+		Name:           kafkas[0].Name,
+		PreviousStatus: previousStatus,
+		Status:         status,
+	}
+	if status == constants2.KafkaRequestStatusFailed.String() {
+		data.FailedReason = kafkas[0].FailedReason
+	}
+	return webhooks.RecordEvent(tx, kafkas[0].OrganisationId, webhooks.EventTypeKafkaStatusChanged,
+		fmt.Sprintf("/api/kafkas_mgmt/v1/kafkas/%s", kafkaId), kafkaId, data)
+}
+
+// kafkaStatusChangedEventData is the data of the kafka status change events delivered to the webhooks
+type kafkaStatusChangedEventData struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Status         string `json:"status"`
+	FailedReason   string `json:"failed_reason,omitempty"`
+}
+
+// statusChangeReason returns the reason of a status change made by the fleet manager, which is only known when the kafka failed
+func statusChangeReason(status string, failedReason string) string {
+	if status == constants2.KafkaRequestStatusFailed.String() {
+		return failedReason
+	}
+	return ""
+}
+
+func (k *kafkaService) TransferOwnership(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *errors.ServiceError) *errors.ServiceError {
+	transferredKafka := *kafkaRequest
+	transferredKafka.Owner = owner
+	transferredKafka.OrganisationId = organisationId
+	transferredKafka.OwnerAccountId = ownerAccountId
+
+	subscriptionId, err := k.reserveQuota(&transferredKafka)
+	if err != nil {
+		return err
+	}
+	transferredKafka.SubscriptionId = subscriptionId
+	transferredKafka.QuotaType = k.kafkaConfig.Quota.Type
+
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		dbConn := tx.Model(&dbapi.KafkaRequest{}).
+			Where("id = ?", kafkaRequest.ID).
+			Where("owner = ? AND organisation_id = ?", kafkaRequest.Owner, kafkaRequest.OrganisationId).
+			Where("status not IN (?)", kafkaDeletionStatuses).
+			Updates(map[string]interface{}{
+				"owner":            transferredKafka.Owner,
+				"organisation_id":  transferredKafka.OrganisationId,
+				"owner_account_id": transferredKafka.OwnerAccountId,
+				"subscription_id":  transferredKafka.SubscriptionId,
+				"quota_type":       transferredKafka.QuotaType,
+			})
+		if dbConn.Error != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, dbConn.Error, "failed to transfer kafka %s", kafkaRequest.ID)
+		}
+		if dbConn.RowsAffected == 0 {
+			return errors.Conflict("unable to transfer kafka %s: it has changed owner or is being deleted", kafkaRequest.ID)
+		}
+		if update == nil {
+			return nil
+		}
+		if svcErr := update(tx); svcErr != nil {
+			return svcErr
+		}
+		return nil
+	}); err != nil {
+		// the kafka still belongs to the previous owner, release the quota reserved for the new one
+		k.deleteQuota(api.QuotaType(transferredKafka.QuotaType), subscriptionId)
+		if svcErr, ok := err.(*errors.ServiceError); ok {
+			return svcErr
+		}
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to transfer kafka %s", kafkaRequest.ID)
+	}
 
+	// the quota service can return the same subscription when the kafka is already accounted to the new owner
+	if kafkaRequest.SubscriptionId != subscriptionId {
+		k.deleteQuota(api.QuotaType(kafkaRequest.QuotaType), kafkaRequest.SubscriptionId)
+	}
+
+	*kafkaRequest = transferredKafka
 	return nil
 }
 
+// deleteQuota releases a reserved quota. Failures are only logged as the quota no longer belongs to any kafka.
+func (k *kafkaService) deleteQuota(quotaType api.QuotaType, subscriptionId string) {
+	quotaService, factoryErr := k.quotaServiceFactory.GetQuotaService(quotaType)
+	if factoryErr != nil {
+		logger.Logger.Errorf("unable to release quota %q: %v", subscriptionId, factoryErr)
+		return
+	}
+	if err := quotaService.DeleteQuota(subscriptionId); err != nil {
+		logger.Logger.Errorf("unable to release quota %q: %v", subscriptionId, err)
+	}
+}
+
 func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
 	if !auth.GetIsAdminFromContext(ctx) {
 		return errors.New(errors.ErrorUnauthenticated, "User not authenticated")
@@ -874,84 +1408,105 @@ func (k *kafkaService) VerifyAndUpdateKafkaAdmin(ctx context.Context, kafkaReque
 		"desired_kafka_ibp_version": kafkaRequest.DesiredKafkaIBPVersion,
 	}
 
-	dbConn := k.connectionFactory.New().
-		Model(kafkaRequest)
-
-	if err := dbConn.Updates(updatableFields).Error; err != nil {
-		return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka")
+	if err := k.VersionedUpdates(kafkaRequest, updatableFields); err != nil {
+		return err
 	}
 
+	recordKafkaEvent(k.connectionFactory, &dbapi.KafkaEvent{
+		KafkaID: kafkaRequest.ID,
+		Type:    dbapi.KafkaEventTypeAdminUpdate.String(),
+		Actor:   kafkaEventActor(ctx),
+		Reason: fmt.Sprintf("desired strimzi version %s, kafka version %s, kafka ibp version %s and storage size %s",
+			kafkaRequest.DesiredStrimziVersion, kafkaRequest.DesiredKafkaVersion, kafkaRequest.DesiredKafkaIBPVersion, kafkaRequest.KafkaStorageSize),
+		Status: kafkaRequest.Status,
+	})
+
 	return nil
 }
 
 func (k *kafkaService) UpdateStatus(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
-	dbConn := k.connectionFactory.New()
+	return k.updateStatus(id, status, dbapi.KafkaEventActorSystem, "")
+}
 
-	if kafka, err := k.GetById(id); err != nil {
-		return true, errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
-	} else {
+// updateStatus changes the status of the kafka and records the change with the given actor and reason
+func (k *kafkaService) updateStatus(id string, status constants2.KafkaStatus, actor string, reason string) (bool, *errors.ServiceError) {
+	if id == "" {
+		return true, errors.Validation("id is undefined")
+	}
+
+	updated := true
+	if err := k.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		previousStatus, err := lockKafkaStatus(tx, id)
+		if err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update status")
+		}
+		if previousStatus == "" {
+			return errors.NotFound("failed to update status: kafka request with id='%s' not found", id)
+		}
 		// only allow to change the status to "deleting" if the cluster is already in "deprovision" status
-		if kafka.Status == constants2.KafkaRequestStatusDeprovision.String() && status != constants2.KafkaRequestStatusDeleting {
-			return false, errors.GeneralError("failed to update status: cluster is deprovisioning")
+		if previousStatus == constants2.KafkaRequestStatusDeprovision.String() && status != constants2.KafkaRequestStatusDeleting {
+			updated = false
+			return errors.GeneralError("failed to update status: cluster is deprovisioning")
 		}
-
-		if kafka.Status == status.String() {
+		if previousStatus == status.String() {
 			// no update needed
-			return false, errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
+			updated = false
+			return errors.GeneralError("failed to update status: the cluster %s is already in %s state", id, status.String())
 		}
-	}
 
-	if err := dbConn.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status).Error; err != nil {
-		return true, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka status")
+		if err := tx.Model(&dbapi.KafkaRequest{Meta: api.Meta{ID: id}}).Update("status", status).Error; err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka status")
+		}
+		if err := recordKafkaStatusChange(tx, id, previousStatus, status.String(), actor, reason); err != nil {
+			return errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka status")
+		}
+		return nil
+	}); err != nil {
+		if svcErr, ok := err.(*errors.ServiceError); ok {
+			return updated, svcErr
+		}
+		return updated, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to update kafka status")
 	}
-
 	return true, nil
 }
 
-func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+func (k *kafkaService) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
 	routes, err := kafkaRequest.GetRoutes()
 	if routes == nil || err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get routes")
 	}
 
-	domainRecordBatch := buildKafkaClusterCNAMESRecordBatch(routes, string(action))
+	records := buildKafkaClusterCNAMERecords(routes)
 
-	// Create AWS client with the region of this Kafka Cluster
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
+	// Create the dns provider for the region of this Kafka Cluster
+	dnsProvider, err := k.dnsProviderFactory.NewProvider(kafkaRequest.Region)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create dns provider")
 	}
 
-	changeRecordsOutput, err := awsClient.ChangeResourceRecordSets(k.kafkaConfig.KafkaDomainName, domainRecordBatch)
+	changeInfo, err := dnsProvider.ChangeRecords(k.kafkaConfig.KafkaDomainName, dns.Action(action), records)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create domain record sets")
 	}
 
-	return changeRecordsOutput, nil
+	return changeInfo, nil
 }
 
 func (k *kafkaService) GetCNAMERecordStatus(kafkaRequest *dbapi.KafkaRequest) (*CNameRecordStatus, error) {
-	awsConfig := aws.Config{
-		AccessKeyID:     k.awsConfig.Route53AccessKey,
-		SecretAccessKey: k.awsConfig.Route53SecretAccessKey,
-	}
-	awsClient, err := k.awsClientFactory.NewClient(awsConfig, kafkaRequest.Region)
+	dnsProvider, err := k.dnsProviderFactory.NewProvider(kafkaRequest.Region)
 	if err != nil {
-		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create aws client")
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create dns provider")
 	}
 
-	changeOutput, err := awsClient.GetChange(kafkaRequest.RoutesCreationId)
+	changeInfo, err := dnsProvider.GetChange(kafkaRequest.RoutesCreationId)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to CNAME record status")
 	}
 
+	status := string(changeInfo.Status)
 	return &CNameRecordStatus{
-		Id:     changeOutput.ChangeInfo.Id,
-		Status: changeOutput.ChangeInfo.Status,
+		Id:     &changeInfo.Id,
+		Status: &status,
 	}, nil
 }
 
@@ -969,7 +1524,7 @@ type KafkaRegionCount struct {
 }
 
 func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(context.Background())
 
 	var kafkas []*dbapi.KafkaRequest
 
@@ -986,11 +1541,15 @@ func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error
 		if err != nil {
 			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to count kafkas of '%s' instance type and '%s' size_id when setting capacity metrics", kafka.InstanceType, kafka.SizeId)
 		}
+		capacityConsumed := float64(instSize.CapacityConsumed)
+		if isSuspendedKafka(kafka) {
+			capacityConsumed *= k.dataplaneClusterConfig.SuspendedKafkaCapacityWeight
+		}
 
 		for i, result := range results {
 			if result.CloudProvider == kafka.CloudProvider && result.ClusterId == kafka.ClusterID &&
 				result.InstanceType == kafka.InstanceType && result.Region == kafka.Region {
-				results[i].Count = result.Count + float64(instSize.CapacityConsumed)
+				results[i].Count = result.Count + capacityConsumed
 				resultPresent = true
 			}
 		}
@@ -1000,7 +1559,7 @@ func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error
 				ClusterId:     kafka.ClusterID,
 				InstanceType:  kafka.InstanceType,
 				Region:        kafka.Region,
-				Count:         float64(instSize.CapacityConsumed),
+				Count:         capacityConsumed,
 			})
 		}
 	}
@@ -1009,7 +1568,7 @@ func (k *kafkaService) CountByRegionAndInstanceType() ([]KafkaRegionCount, error
 }
 
 func (k *kafkaService) CountByStatus(status []constants2.KafkaStatus) ([]KafkaStatusCount, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(context.Background())
 	var results []KafkaStatusCount
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).Select("status as Status, count(1) as Count").Where("status in (?)", status).Group("status").Scan(&results).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "Failed to count kafkas")
@@ -1047,7 +1606,7 @@ type KafkaComponentVersions struct {
 }
 
 func (k *kafkaService) ListComponentVersions() ([]KafkaComponentVersions, error) {
-	dbConn := k.connectionFactory.New()
+	dbConn := k.connectionFactory.NewReadOnly(context.Background())
 	var results []KafkaComponentVersions
 	if err := dbConn.Model(&dbapi.KafkaRequest{}).Select("id", "cluster_id", "desired_strimzi_version", "actual_strimzi_version", "strimzi_upgrading", "desired_kafka_version", "actual_kafka_version", "kafka_upgrading", "desired_kafka_ibp_version", "actual_kafka_ibp_version", "kafka_ibp_upgrading").Scan(&results).Error; err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list component versions")
@@ -1064,15 +1623,22 @@ func (k *kafkaService) ListKafkasWithRoutesNotCreated() ([]*dbapi.KafkaRequest,
 	return results, nil
 }
 
-func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.KafkaConfig, keycloakService sso.KeycloakService) (*managedkafka.ManagedKafka, *errors.ServiceError) {
+func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, certificate *dbapi.KafkaCertificate, kafkaConfig *config.KafkaConfig, keycloakService sso.KeycloakService) (*managedkafka.ManagedKafka, *errors.ServiceError) {
 	k, err := kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
 	if err != nil {
 		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list kafka request")
 	}
+	tunedConfig, err := kafkaRequest.GetKafkaConfig()
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to get kafka config of kafka request %s", kafkaRequest.ID)
+	}
 	labels := map[string]string{
 		"bf2.org/kafkaInstanceProfileQuotaConsumed": strconv.Itoa(k.QuotaConsumed),
 		"bf2.org/kafkaInstanceProfileType":          kafkaRequest.InstanceType,
 	}
+	if isSuspendedKafka(kafkaRequest) {
+		labels[ManagedKafkaSuspendedLabel] = "true"
+	}
 	managedKafkaCR := &managedkafka.ManagedKafka{
 		Id: kafkaRequest.ID,
 		TypeMeta: metav1.TypeMeta{
@@ -1100,6 +1666,7 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 			},
 			Endpoint: managedkafka.EndpointSpec{
 				BootstrapServerHost: kafkaRequest.BootstrapServerHost,
+				Internal:            kafkaRequest.Private,
 			},
 			Versions: managedkafka.VersionsSpec{
 				Kafka:    kafkaRequest.DesiredKafkaVersion,
@@ -1112,6 +1679,33 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 		Status: managedkafka.ManagedKafkaStatus{},
 	}
 
+	// the kafka settings tuned by the user lower the limits of the instance size
+	if tunedConfig != nil {
+		if tunedConfig.MaxDataRetentionPeriod != "" {
+			managedKafkaCR.Spec.Capacity.MaxDataRetentionPeriod = tunedConfig.MaxDataRetentionPeriod
+		}
+		if tunedConfig.MaxConnectionAttemptsPerSec != 0 {
+			managedKafkaCR.Spec.Capacity.MaxConnectionAttemptsPerSec = tunedConfig.MaxConnectionAttemptsPerSec
+		}
+		if tunedConfig.DefaultRetentionPeriod != "" || tunedConfig.MessageMaxBytes != 0 {
+			managedKafkaCR.Spec.KafkaConfig = &managedkafka.KafkaConfigSpec{
+				DefaultRetentionPeriod: tunedConfig.DefaultRetentionPeriod,
+				MessageMaxBytes:        tunedConfig.MessageMaxBytes,
+			}
+		}
+	}
+
+	// suspended kafkas are scaled down to no throughput, connections or partitions, releasing their broker resources
+	// on the data plane cluster. Their storage and retention are kept until they are resumed or deprovisioned
+	if isSuspendedKafka(kafkaRequest) {
+		managedKafkaCR.Spec.Capacity = managedkafka.Capacity{
+			IngressPerSec:          "0",
+			EgressPerSec:           "0",
+			MaxDataRetentionSize:   managedKafkaCR.Spec.Capacity.MaxDataRetentionSize,
+			MaxDataRetentionPeriod: managedKafkaCR.Spec.Capacity.MaxDataRetentionPeriod,
+		}
+	}
+
 	keycloakConfig := keycloakService.GetConfig()
 	keycloakRealmConfig := keycloakService.GetRealmConfig()
 
@@ -1144,9 +1738,17 @@ func buildManagedKafkaCR(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.K
 	}
 
 	if kafkaConfig.EnableKafkaExternalCertificate {
-		managedKafkaCR.Spec.Endpoint.Tls = &managedkafka.TlsSpec{
-			Cert: kafkaConfig.KafkaTLSCert,
-			Key:  kafkaConfig.KafkaTLSKey,
+		// use the certificate issued for this kafka, falling back to the shared certificate until it is issued
+		if certificate != nil {
+			managedKafkaCR.Spec.Endpoint.Tls = &managedkafka.TlsSpec{
+				Cert: certificate.Certificate,
+				Key:  certificate.Key,
+			}
+		} else {
+			managedKafkaCR.Spec.Endpoint.Tls = &managedkafka.TlsSpec{
+				Cert: kafkaConfig.KafkaTLSCert,
+				Key:  kafkaConfig.KafkaTLSKey,
+			}
 		}
 	}
 
@@ -1162,36 +1764,16 @@ func buildKafkaOwner(kafkaRequest *dbapi.KafkaRequest, kafkaConfig *config.Kafka
 	}
 }
 
-func buildKafkaClusterCNAMESRecordBatch(routes []dbapi.DataPlaneKafkaRoute, action string) *route53.ChangeBatch {
-	var changes []*route53.Change
+func buildKafkaClusterCNAMERecords(routes []dbapi.DataPlaneKafkaRoute) []dns.Record {
+	var records []dns.Record
 	for _, r := range routes {
-		c := buildResourceRecordChange(r.Domain, r.Router, action)
-		changes = append(changes, c)
-	}
-	recordChangeBatch := &route53.ChangeBatch{
-		Changes: changes,
-	}
-
-	return recordChangeBatch
-}
-
-func buildResourceRecordChange(recordName string, clusterIngress string, action string) *route53.Change {
-	recordType := "CNAME"
-	recordTTL := int64(300)
-
-	resourceRecordChange := &route53.Change{
-		Action: &action,
-		ResourceRecordSet: &route53.ResourceRecordSet{
-			Name: &recordName,
-			Type: &recordType,
-			TTL:  &recordTTL,
-			ResourceRecords: []*route53.ResourceRecord{
-				{
-					Value: &clusterIngress,
-				},
-			},
-		},
+		records = append(records, dns.Record{
+			Name:  r.Domain,
+			Type:  "CNAME",
+			TTL:   300,
+			Value: r.Router,
+		})
 	}
 
-	return resourceRecordChange
+	return records
 }
@@ -0,0 +1,215 @@
+package services
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/acme"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+)
+
+// kafkaCertificateStatuses are the statuses of the kafkas whose ManagedKafka CR is expected to carry their certificate
+var kafkaCertificateStatuses = []string{constants2.KafkaRequestStatusProvisioning.String(), constants2.KafkaRequestStatusReady.String()}
+
+//go:generate moq -out kafka_certificates_moq.go . KafkaCertificateService
+type KafkaCertificateService interface {
+	// ListKafkasRequiringCertificate returns the kafkas without a certificate valid for all of their hosts
+	// or whose certificate is due for renewal
+	ListKafkasRequiringCertificate() ([]*dbapi.KafkaRequest, *errors.ServiceError)
+	// Issue issues a certificate for the bootstrap and routes hosts of the kafka, replacing its current certificate
+	Issue(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError
+	// GetByKafkaIds returns the certificates of the given kafkas, with their decrypted key, indexed by kafka id
+	GetByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError)
+	// DeleteOrphaned deletes the certificates of deleted kafkas
+	DeleteOrphaned() *errors.ServiceError
+}
+
+var _ KafkaCertificateService = &kafkaCertificateService{}
+
+type kafkaCertificateService struct {
+	connectionFactory *db.ConnectionFactory
+	acmeConfig        *config.ACMEConfig
+	issuer            acme.Issuer
+}
+
+func NewKafkaCertificateService(connectionFactory *db.ConnectionFactory, acmeConfig *config.ACMEConfig, kafkaConfig *config.KafkaConfig, dnsProviderFactory dns.ProviderFactory) KafkaCertificateService {
+	service := &kafkaCertificateService{
+		connectionFactory: connectionFactory,
+		acmeConfig:        acmeConfig,
+	}
+	if acmeConfig.EnableKafkaACMECertificates {
+		var httpClient *http.Client
+		if acmeConfig.DirectoryCAs != nil {
+			httpClient = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: acmeConfig.DirectoryCAs}}}
+		}
+		service.issuer = acme.NewIssuer(acme.Config{
+			DirectoryURL: acmeConfig.DirectoryURL,
+			Email:        acmeConfig.Email,
+			AccountKey:   acmeConfig.AccountKey,
+			Zone:         kafkaConfig.KafkaDomainName,
+			HTTPClient:   httpClient,
+		}, dnsProviderFactory)
+	}
+	return service
+}
+
+func (s *kafkaCertificateService) ListKafkasRequiringCertificate() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+	if !s.acmeConfig.EnableKafkaACMECertificates {
+		return nil, nil
+	}
+
+	var kafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().
+		Where("status IN (?)", kafkaCertificateStatuses).
+		Where("bootstrap_server_host != ''").
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafka requests")
+	}
+	if len(kafkas) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(kafkas))
+	for _, kafka := range kafkas {
+		ids = append(ids, kafka.ID)
+	}
+	certificates, err := s.findByKafkaIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	renewAfter := time.Now().Add(s.acmeConfig.RenewBefore)
+	var res []*dbapi.KafkaRequest
+	for _, kafka := range kafkas {
+		hosts, err := kafkaCertificateHosts(kafka)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get hosts of kafka %s", kafka.ID)
+		}
+		if requiresCertificate(certificates[kafka.ID], hosts, renewAfter) {
+			res = append(res, kafka)
+		}
+	}
+	return res, nil
+}
+
+func (s *kafkaCertificateService) Issue(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+	if s.issuer == nil {
+		return errors.GeneralError("ACME certificates are not enabled")
+	}
+
+	hosts, err := kafkaCertificateHosts(kafkaRequest)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to get hosts of kafka %s", kafkaRequest.ID)
+	}
+	issued, err := s.issuer.Issue(ctx, kafkaRequest.Region, hosts)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to issue certificate for kafka %s", kafkaRequest.ID)
+	}
+	encryptedKey, err := secrets.Encrypt(s.acmeConfig.EncryptionKey, issued.Key)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to encrypt certificate key of kafka %s", kafkaRequest.ID)
+	}
+
+	certificate := &dbapi.KafkaCertificate{
+		KafkaID:      kafkaRequest.ID,
+		Certificate:  issued.Certificate,
+		EncryptedKey: encryptedKey,
+		NotAfter:     issued.NotAfter,
+	}
+	certificate.SetHosts(hosts)
+
+	dbConn := s.connectionFactory.New()
+	var existing []*dbapi.KafkaCertificate
+	if err := dbConn.Where("kafka_id = ?", kafkaRequest.ID).Find(&existing).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to find certificate of kafka %s", kafkaRequest.ID)
+	}
+	if len(existing) > 0 {
+		certificate.ID = existing[0].ID
+		certificate.CreatedAt = existing[0].CreatedAt
+		err = dbConn.Save(certificate).Error
+	} else {
+		certificate.ID = api.NewID()
+		err = dbConn.Create(certificate).Error
+	}
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to store certificate of kafka %s", kafkaRequest.ID)
+	}
+	return nil
+}
+
+func (s *kafkaCertificateService) GetByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+	if !s.acmeConfig.EnableKafkaACMECertificates || len(kafkaIds) == 0 {
+		return map[string]*dbapi.KafkaCertificate{}, nil
+	}
+
+	certificates, serviceErr := s.findByKafkaIds(kafkaIds)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	for _, certificate := range certificates {
+		key, err := secrets.Decrypt(s.acmeConfig.EncryptionKey, certificate.EncryptedKey)
+		if err != nil {
+			return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to decrypt certificate key of kafka %s", certificate.KafkaID)
+		}
+		certificate.Key = key
+	}
+	return certificates, nil
+}
+
+func (s *kafkaCertificateService) DeleteOrphaned() *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+	if err := dbConn.
+		Where("kafka_id NOT IN (?)", dbConn.Model(&dbapi.KafkaRequest{}).Select("id")).
+		Delete(&dbapi.KafkaCertificate{}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete certificates of deleted kafkas")
+	}
+	return nil
+}
+
+func (s *kafkaCertificateService) findByKafkaIds(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+	var certificates []*dbapi.KafkaCertificate
+	if err := s.connectionFactory.New().Where("kafka_id IN (?)", kafkaIds).Find(&certificates).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafka certificates")
+	}
+	res := make(map[string]*dbapi.KafkaCertificate, len(certificates))
+	for _, certificate := range certificates {
+		res[certificate.KafkaID] = certificate
+	}
+	return res, nil
+}
+
+// kafkaCertificateHosts returns the bootstrap host of the kafka followed by the sorted hosts of its routes
+func kafkaCertificateHosts(kafkaRequest *dbapi.KafkaRequest) ([]string, error) {
+	routes, err := kafkaRequest.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	var routeHosts []string
+	seen := map[string]bool{kafkaRequest.BootstrapServerHost: true}
+	for _, r := range routes {
+		if !seen[r.Domain] {
+			seen[r.Domain] = true
+			routeHosts = append(routeHosts, r.Domain)
+		}
+	}
+	sort.Strings(routeHosts)
+	return append([]string{kafkaRequest.BootstrapServerHost}, routeHosts...), nil
+}
+
+func requiresCertificate(certificate *dbapi.KafkaCertificate, hosts []string, renewAfter time.Time) bool {
+	return certificate == nil ||
+		certificate.Hosts != strings.Join(hosts, ",") ||
+		!certificate.NotAfter.After(renewAfter)
+}
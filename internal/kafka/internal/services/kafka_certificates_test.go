@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/acme"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	. "github.com/onsi/gomega"
+	goerrors "github.com/pkg/errors"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+var testCertificateEncryptionKey = []byte("0123456789abcdef0123456789abcdef")
+
+func Test_kafkaCertificateHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	hosts, err := kafkaCertificateHosts(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.BootstrapServerHost = "test.kafka.example.com"
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hosts).To(Equal([]string{"test.kafka.example.com"}))
+
+	hosts, err = kafkaCertificateHosts(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.BootstrapServerHost = "test.kafka.example.com"
+		kafkaRequest.Routes = []byte(`[{"domain": "test.kafka.example.com", "router": "elb.rhcloud.com"},
+			{"domain": "broker-0-test.kafka.example.com", "router": "elb.rhcloud.com"},
+			{"domain": "admin-server-test.kafka.example.com", "router": "elb.rhcloud.com"}]`)
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(hosts).To(Equal([]string{"test.kafka.example.com", "admin-server-test.kafka.example.com", "broker-0-test.kafka.example.com"}))
+
+	_, err = kafkaCertificateHosts(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.Routes = []byte(`{`)
+	}))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func Test_requiresCertificate(t *testing.T) {
+	renewAfter := time.Now().Add(30 * 24 * time.Hour)
+	hosts := []string{"test.kafka.example.com", "admin-server-test.kafka.example.com"}
+
+	tests := []struct {
+		name        string
+		certificate *dbapi.KafkaCertificate
+		want        bool
+	}{
+		{
+			name: "should require a certificate when there is none",
+			want: true,
+		},
+		{
+			name:        "should not require a certificate when it is valid for all hosts and not due for renewal",
+			certificate: &dbapi.KafkaCertificate{Hosts: "test.kafka.example.com,admin-server-test.kafka.example.com", NotAfter: renewAfter.Add(time.Hour)},
+			want:        false,
+		},
+		{
+			name:        "should require a certificate when hosts were added",
+			certificate: &dbapi.KafkaCertificate{Hosts: "test.kafka.example.com", NotAfter: renewAfter.Add(time.Hour)},
+			want:        true,
+		},
+		{
+			name:        "should require a certificate when it is due for renewal",
+			certificate: &dbapi.KafkaCertificate{Hosts: "test.kafka.example.com,admin-server-test.kafka.example.com", NotAfter: renewAfter.Add(-time.Hour)},
+			want:        true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(requiresCertificate(tt.certificate, hosts, renewAfter)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_kafkaCertificateService_Issue(t *testing.T) {
+	kafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.BootstrapServerHost = "test.kafka.example.com"
+	})
+
+	tests := []struct {
+		name    string
+		issuer  acme.Issuer
+		wantErr bool
+	}{
+		{
+			name: "should store the issued certificate",
+			issuer: &acme.IssuerMock{
+				IssueFunc: func(ctx context.Context, region string, hosts []string) (*acme.Certificate, error) {
+					if region != testKafkaRequestRegion || len(hosts) != 1 || hosts[0] != "test.kafka.example.com" {
+						return nil, goerrors.Errorf("unexpected issue request for %s in %s", hosts, region)
+					}
+					return &acme.Certificate{Certificate: "certificate", Key: "key", NotAfter: time.Now().Add(time.Hour)}, nil
+				},
+			},
+		},
+		{
+			name: "should return an error when the certificate cannot be issued",
+			issuer: &acme.IssuerMock{
+				IssueFunc: func(ctx context.Context, region string, hosts []string) (*acme.Certificate, error) {
+					return nil, goerrors.New("order failed")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "should return an error when ACME certificates are disabled",
+			wantErr: true,
+		},
+	}
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			s := &kafkaCertificateService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				acmeConfig:        &config.ACMEConfig{EnableKafkaACMECertificates: true, EncryptionKey: testCertificateEncryptionKey},
+				issuer:            tt.issuer,
+			}
+			err := s.Issue(context.Background(), kafkaRequest)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_kafkaCertificateService_GetByKafkaIds(t *testing.T) {
+	g := NewWithT(t)
+
+	encryptedKey, err := secrets.Encrypt(testCertificateEncryptionKey, "key")
+	g.Expect(err).ToNot(HaveOccurred())
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_certificates"`).WithReply([]map[string]interface{}{
+		{"id": "certificate-id", "kafka_id": testID, "certificate": "certificate", "encrypted_key": encryptedKey},
+	})
+
+	s := &kafkaCertificateService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		acmeConfig:        &config.ACMEConfig{EnableKafkaACMECertificates: true, EncryptionKey: testCertificateEncryptionKey},
+	}
+	certificates, serviceErr := s.GetByKafkaIds([]string{testID})
+	g.Expect(serviceErr).To(BeNil())
+	g.Expect(certificates).To(HaveKey(testID))
+	g.Expect(certificates[testID].Certificate).To(Equal("certificate"))
+	g.Expect(certificates[testID].Key).To(Equal("key"))
+
+	// no certificates are looked up when ACME certificates are disabled
+	s.acmeConfig.EnableKafkaACMECertificates = false
+	certificates, serviceErr = s.GetByKafkaIds([]string{testID})
+	g.Expect(serviceErr).To(BeNil())
+	g.Expect(certificates).To(BeEmpty())
+}
+
+func Test_buildManagedKafkaCR_certificate(t *testing.T) {
+	g := NewWithT(t)
+
+	kafkaConfig := &config.KafkaConfig{
+		EnableKafkaExternalCertificate: true,
+		KafkaTLSCert:                   "shared-certificate",
+		KafkaTLSKey:                    "shared-key",
+		SupportedInstanceTypes:         &kafkaSupportedInstanceTypesConfig,
+	}
+	keycloakService := &sso.KeycloakServiceMock{
+		GetConfigFunc: func() *keycloak.KeycloakConfig {
+			return &keycloak.KeycloakConfig{}
+		},
+		GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+			return &keycloak.KeycloakRealmConfig{}
+		},
+	}
+	kafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+		kafkaRequest.InstanceType = "developer"
+	})
+
+	mk, err := buildManagedKafkaCR(kafkaRequest, &dbapi.KafkaCertificate{Certificate: "certificate", Key: "key"}, kafkaConfig, keycloakService)
+	g.Expect(err).To(BeNil())
+	g.Expect(mk.Spec.Endpoint.Tls.Cert).To(Equal("certificate"))
+	g.Expect(mk.Spec.Endpoint.Tls.Key).To(Equal("key"))
+
+	mk, err = buildManagedKafkaCR(kafkaRequest, nil, kafkaConfig, keycloakService)
+	g.Expect(err).To(BeNil())
+	g.Expect(mk.Spec.Endpoint.Tls.Cert).To(Equal("shared-certificate"))
+	g.Expect(mk.Spec.Endpoint.Tls.Key).To(Equal("shared-key"))
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out kafka_events_moq.go . KafkaEventService
+type KafkaEventService interface {
+	// Record adds the event to the history of its kafka. The history is informative only: a failure to record an
+	// event is logged and never fails the operation that caused it.
+	Record(event *dbapi.KafkaEvent)
+	// ListByKafkaId returns the history of the given kafka, most recent event first
+	ListByKafkaId(kafkaId string, listArgs *services.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError)
+}
+
+var _ KafkaEventService = &kafkaEventService{}
+
+type kafkaEventService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewKafkaEventService(connectionFactory *db.ConnectionFactory) KafkaEventService {
+	return &kafkaEventService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *kafkaEventService) Record(event *dbapi.KafkaEvent) {
+	recordKafkaEvent(s.connectionFactory, event)
+}
+
+func (s *kafkaEventService) ListByKafkaId(kafkaId string, listArgs *services.ListArguments) (dbapi.KafkaEventList, *api.PagingMeta, *errors.ServiceError) {
+	var events dbapi.KafkaEventList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := s.connectionFactory.New().Where("kafka_id = ?", kafkaId)
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&events).Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the events of kafka %s", kafkaId)
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	// ids are sortable by creation time, ordering by id as well keeps the order of events recorded at the same time stable
+	if err := dbConn.Order("created_at desc, id desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&events).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the events of kafka %s", kafkaId)
+	}
+
+	return events, pagingMeta, nil
+}
+
+// recordKafkaEvent stores the event, logging the failure if it cannot be stored
+func recordKafkaEvent(connectionFactory *db.ConnectionFactory, event *dbapi.KafkaEvent) {
+	if err := createKafkaEvent(connectionFactory.New(), event); err != nil {
+		logger.Logger.Errorf("failed to record %s event for kafka %s: %v", event.Type, event.KafkaID, err)
+	}
+}
+
+// createKafkaEvent stores the event with the given dbConn, e.g. in the transaction of the change it records
+func createKafkaEvent(dbConn *gorm.DB, event *dbapi.KafkaEvent) error {
+	if event.ID == "" {
+		event.ID = api.NewID()
+	}
+	if event.Actor == "" {
+		event.Actor = dbapi.KafkaEventActorSystem
+	}
+	return dbConn.Create(event).Error
+}
+
+// kafkaEventActor returns the user of the given ctx, who is the actor of the events caused by their request
+func kafkaEventActor(ctx context.Context) string {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return dbapi.KafkaEventActorSystem
+	}
+	if username, _ := claims.GetUsername(); username != "" {
+		return username
+	}
+	return dbapi.KafkaEventActorSystem
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"testing"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_kafkaEventService_ListByKafkaId(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupFn   func()
+		wantErr   bool
+		wantTotal int
+		wantIds   []string
+	}{
+		{
+			name: "should return the events of the kafka",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "kafka_events" WHERE kafka_id = $1`).
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT * FROM "kafka_events" WHERE kafka_id = $1`).
+					WithReply([]map[string]interface{}{
+						{"id": "event-2", "kafka_id": testID, "type": dbapi.KafkaEventTypePlacement.String()},
+						{"id": "event-1", "kafka_id": testID, "type": dbapi.KafkaEventTypeStatusChange.String()},
+					})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			wantTotal: 2,
+			wantIds:   []string{"event-2", "event-1"},
+		},
+		{
+			name: "should fail when the events cannot be listed",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "kafka_events" WHERE kafka_id = $1`).
+					WithReply([]map[string]interface{}{{"count": 2}})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tt.setupFn()
+
+			s := NewKafkaEventService(db.NewMockConnectionFactory(nil))
+			events, paging, err := s.ListByKafkaId(testID, &services.ListArguments{Page: 1, Size: 100})
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			g.Expect(paging.Total).To(Equal(tt.wantTotal))
+			var ids []string
+			for _, event := range events {
+				ids = append(ids, event.ID)
+			}
+			g.Expect(ids).To(Equal(tt.wantIds))
+		})
+	}
+}
+
+func Test_kafkaEventService_Record(t *testing.T) {
+	g := NewWithT(t)
+	mocket.Catcher.Reset()
+	insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+	mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+	event := &dbapi.KafkaEvent{
+		KafkaID: testID,
+		Type:    dbapi.KafkaEventTypeRoutesCreated.String(),
+	}
+	NewKafkaEventService(db.NewMockConnectionFactory(nil)).Record(event)
+
+	g.Expect(insert.Triggered).To(BeTrue())
+	g.Expect(event.ID).ToNot(BeEmpty())
+	g.Expect(event.Actor).To(Equal(dbapi.KafkaEventActorSystem))
+}
+
+func Test_recordKafkaStatusChange(t *testing.T) {
+	tests := []struct {
+		name           string
+		previousStatus constants2.KafkaStatus
+		status         constants2.KafkaStatus
+		wantRecorded   bool
+	}{
+		{
+			name:           "should record a change of status",
+			previousStatus: constants2.KafkaRequestStatusProvisioning,
+			status:         constants2.KafkaRequestStatusFailed,
+			wantRecorded:   true,
+		},
+		{
+			name:           "should not record an unchanged status",
+			previousStatus: constants2.KafkaRequestStatusReady,
+			status:         constants2.KafkaRequestStatusReady,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			recorded, published := mockKafkaStatusChange()
+
+			err := recordKafkaStatusChange(db.NewMockConnectionFactory(nil).New(), testID, tt.previousStatus.String(), tt.status.String(), testUser, "")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(recorded.Triggered).To(Equal(tt.wantRecorded))
+			g.Expect(published.Triggered).To(Equal(tt.wantRecorded))
+		})
+	}
+}
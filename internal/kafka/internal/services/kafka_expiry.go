@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+)
+
+// KafkaExpiringEvent is the event of the notifications sent before a kafka expires
+const KafkaExpiringEvent = "kafka_expiring"
+
+// KafkaExpiryNotice is an expiry notification due to the owner of a kafka
+type KafkaExpiryNotice struct {
+	Kafka     *dbapi.KafkaRequest
+	ExpiresAt time.Time
+	// Threshold is the notification threshold the kafka expires within
+	Threshold time.Duration
+}
+
+//go:generate moq -out kafka_expiry_moq.go . KafkaExpiryService
+type KafkaExpiryService interface {
+	// ListExpiryNoticesDue returns a notice for each kafka expiring within a notification threshold whose owner
+	// has not been notified yet for that threshold. Only the smallest threshold a kafka expires within is notified.
+	ListExpiryNoticesDue() ([]KafkaExpiryNotice, *errors.ServiceError)
+	// Notify sends the notice to the owner of the kafka and records its threshold as notified
+	Notify(ctx context.Context, notice KafkaExpiryNotice) *errors.ServiceError
+	// ExtendLifespan sets the expiration time of the kafka, overriding the lifespan of its instance size.
+	// The expiry notifications are sent again for the new expiration time.
+	ExtendLifespan(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError
+	// GetExpirationTime returns the expiration time set by an admin or else the end of the lifespan of the kafka
+	// instance size, nil is returned when the instance size has no lifespan
+	GetExpirationTime(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError)
+}
+
+var _ KafkaExpiryService = &kafkaExpiryService{}
+
+type kafkaExpiryService struct {
+	connectionFactory *db.ConnectionFactory
+	kafkaConfig       *config.KafkaConfig
+	notifier          notification.Notifier
+}
+
+func NewKafkaExpiryService(connectionFactory *db.ConnectionFactory, kafkaConfig *config.KafkaConfig, notifier notification.Notifier) KafkaExpiryService {
+	return &kafkaExpiryService{
+		connectionFactory: connectionFactory,
+		kafkaConfig:       kafkaConfig,
+		notifier:          notifier,
+	}
+}
+
+func (s *kafkaExpiryService) ListExpiryNoticesDue() ([]KafkaExpiryNotice, *errors.ServiceError) {
+	typesWithLifespan := kafkaInstanceTypesWithLifespan(s.kafkaConfig)
+	thresholds := s.kafkaConfig.KafkaLifespan.ExpiryNotificationThresholds
+	if len(typesWithLifespan) == 0 || len(thresholds) == 0 {
+		return nil, nil
+	}
+	// the smallest threshold a kafka expires within is the first one found in ascending order
+	thresholds = append([]time.Duration{}, thresholds...)
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+
+	// kafkas scheduled for deletion have been deleted by their owner, who does not need to be notified about their expiry
+	var kafkas []*dbapi.KafkaRequest
+	if err := s.connectionFactory.New().
+		Where("instance_type IN (?)", typesWithLifespan).
+		Where("status NOT IN (?)", kafkaDeletionStatuses).
+		Where("deletion_scheduled_at IS NULL").
+		Find(&kafkas).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list kafka requests with a lifespan")
+	}
+
+	now := time.Now()
+	var notices []KafkaExpiryNotice
+	for _, kafka := range kafkas {
+		expiresAt, err := s.GetExpirationTime(kafka)
+		if err != nil {
+			return nil, err
+		}
+		if expiresAt == nil || !expiresAt.After(now) {
+			// expired kafkas are deprovisioned by the kafka manager
+			continue
+		}
+
+		remaining := expiresAt.Sub(now)
+		for _, threshold := range thresholds {
+			if remaining > threshold {
+				continue
+			}
+			if kafka.ExpiryNotificationThresholdSeconds == nil || *kafka.ExpiryNotificationThresholdSeconds > int64(threshold.Seconds()) {
+				notices = append(notices, KafkaExpiryNotice{Kafka: kafka, ExpiresAt: *expiresAt, Threshold: threshold})
+			}
+			break
+		}
+	}
+	return notices, nil
+}
+
+func (s *kafkaExpiryService) Notify(ctx context.Context, notice KafkaExpiryNotice) *errors.ServiceError {
+	kafka := notice.Kafka
+	remaining := time.Until(notice.ExpiresAt).Round(time.Minute)
+	if err := s.notifier.Notify(ctx, notification.Notification{
+		Event:   KafkaExpiringEvent,
+		Subject: fmt.Sprintf("Kafka instance %s expires in %s", kafka.Name, remaining),
+		Message: fmt.Sprintf("Your Kafka instance %s (%s) expires at %s and will be deleted afterwards, along with all of its data.",
+			kafka.Name, kafka.ID, notice.ExpiresAt.UTC().Format(time.RFC1123)),
+		Recipients: []string{kafka.Owner},
+		Data: map[string]string{
+			"kafka_id":        kafka.ID,
+			"kafka_name":      kafka.Name,
+			"owner":           kafka.Owner,
+			"organisation_id": kafka.OrganisationId,
+			"expires_at":      notice.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to notify owner of kafka %s of its expiration", kafka.ID)
+	}
+
+	thresholdSeconds := int64(notice.Threshold.Seconds())
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaRequest{}).
+		Where("id = ?", kafka.ID).
+		Update("expiry_notification_threshold_seconds", thresholdSeconds).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to record expiry notification of kafka %s", kafka.ID)
+	}
+	kafka.ExpiryNotificationThresholdSeconds = &thresholdSeconds
+	return nil
+}
+
+func (s *kafkaExpiryService) ExtendLifespan(kafkaRequest *dbapi.KafkaRequest, expiresAt time.Time) *errors.ServiceError {
+	if arrays.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return errors.BadRequest("unable to extend the lifespan of kafka %s in %s status", kafkaRequest.ID, kafkaRequest.Status)
+	}
+	currentExpiresAt, err := s.GetExpirationTime(kafkaRequest)
+	if err != nil {
+		return err
+	}
+	if currentExpiresAt == nil {
+		return errors.BadRequest("kafka %s has no lifespan", kafkaRequest.ID)
+	}
+	if !expiresAt.After(*currentExpiresAt) {
+		return errors.BadRequest("expiration time must be after the current expiration time %s", currentExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	if err := s.connectionFactory.New().Model(&dbapi.KafkaRequest{}).
+		Where("id = ?", kafkaRequest.ID).
+		Updates(map[string]interface{}{
+			"expires_at":                            expiresAt,
+			"expiry_notification_threshold_seconds": nil,
+		}).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to extend lifespan of kafka %s", kafkaRequest.ID)
+	}
+	kafkaRequest.ExpiresAt = &expiresAt
+	kafkaRequest.ExpiryNotificationThresholdSeconds = nil
+	return nil
+}
+
+func (s *kafkaExpiryService) GetExpirationTime(kafkaRequest *dbapi.KafkaRequest) (*time.Time, *errors.ServiceError) {
+	kafkaInstanceSize, err := s.kafkaConfig.GetKafkaInstanceSize(kafkaRequest.InstanceType, kafkaRequest.SizeId)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to get instance size of kafka %s", kafkaRequest.ID)
+	}
+	if kafkaInstanceSize.LifespanSeconds == nil {
+		return nil, nil
+	}
+	return kafkaRequest.GetExpirationTime(*kafkaInstanceSize.LifespanSeconds), nil
+}
+
+// kafkaInstanceTypesWithLifespan returns the ids of the instance types with at least one size having a lifespan
+func kafkaInstanceTypesWithLifespan(kafkaConfig *config.KafkaConfig) []string {
+	var typesWithLifespan []string
+	for _, kafkaInstanceType := range kafkaConfig.GetSupportedInstanceTypes().SupportedKafkaInstanceTypes {
+		if kafkaInstanceType.HasAnInstanceSizeWithLifespan() {
+			typesWithLifespan = append(typesWithLifespan, kafkaInstanceType.Id)
+		}
+	}
+	return typesWithLifespan
+}
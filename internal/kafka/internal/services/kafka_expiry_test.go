@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const (
+	testExpiryInstanceType = "developer"
+	testExpirySize         = "x1"
+	testExpiryLifespan     = 48 * 60 * 60
+)
+
+func buildExpiryKafkaConfig() *config.KafkaConfig {
+	kafkaConfig := config.NewKafkaConfig()
+	kafkaConfig.SupportedInstanceTypes.Configuration = config.SupportedKafkaInstanceTypesConfig{
+		SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+			{
+				Id: testExpiryInstanceType,
+				Sizes: []config.KafkaInstanceSize{
+					{Id: testExpirySize, LifespanSeconds: &[]int{testExpiryLifespan}[0]},
+				},
+			},
+			{
+				Id:    "standard",
+				Sizes: []config.KafkaInstanceSize{{Id: testExpirySize}},
+			},
+		},
+	}
+	return kafkaConfig
+}
+
+func Test_kafkaExpiryService_ListExpiryNoticesDue(t *testing.T) {
+	lifespan := time.Duration(testExpiryLifespan) * time.Second
+	// createdAt returns the creation time of a kafka expiring in the given duration
+	createdAt := func(expiresIn time.Duration) time.Time {
+		return time.Now().Add(expiresIn - lifespan)
+	}
+
+	tests := []struct {
+		name           string
+		kafkas         []map[string]interface{}
+		wantThresholds map[string]time.Duration
+		wantErr        bool
+	}{
+		{
+			name: "should return the smallest threshold each kafka expires within",
+			kafkas: []map[string]interface{}{
+				{"id": "not-due", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(30 * time.Hour)},
+				{"id": "due-24h", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(10 * time.Hour)},
+				{"id": "due-1h", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(30 * time.Minute)},
+				{"id": "expired", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(-time.Minute)},
+			},
+			wantThresholds: map[string]time.Duration{"due-24h": 24 * time.Hour, "due-1h": time.Hour},
+		},
+		{
+			name: "should skip the thresholds already notified",
+			kafkas: []map[string]interface{}{
+				{"id": "notified-24h", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(10 * time.Hour), "expiry_notification_threshold_seconds": 86400},
+				{"id": "due-1h", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(30 * time.Minute), "expiry_notification_threshold_seconds": 86400},
+				{"id": "notified-1h", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(30 * time.Minute), "expiry_notification_threshold_seconds": 3600},
+			},
+			wantThresholds: map[string]time.Duration{"due-1h": time.Hour},
+		},
+		{
+			name: "should use the extended expiration time",
+			kafkas: []map[string]interface{}{
+				{"id": "extended", "instance_type": testExpiryInstanceType, "size_id": testExpirySize, "created_at": createdAt(30 * time.Minute), "expires_at": time.Now().Add(5 * 24 * time.Hour)},
+			},
+			wantThresholds: map[string]time.Duration{},
+		},
+		{
+			name: "should return an error when the instance size is unknown",
+			kafkas: []map[string]interface{}{
+				{"id": "unknown", "instance_type": testExpiryInstanceType, "size_id": "unknown", "created_at": createdAt(time.Hour)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply(tt.kafkas)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			s := NewKafkaExpiryService(db.NewMockConnectionFactory(nil), buildExpiryKafkaConfig(), &notification.NotifierMock{})
+			notices, err := s.ListExpiryNoticesDue()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			got := map[string]time.Duration{}
+			for _, notice := range notices {
+				got[notice.Kafka.ID] = notice.Threshold
+			}
+			g.Expect(got).To(Equal(tt.wantThresholds))
+		})
+	}
+}
+
+func Test_kafkaExpiryService_Notify(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		notifyErr error
+		setupFn   func()
+		wantErr   bool
+	}{
+		{
+			name: "should notify the owner and record the threshold",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "expiry_notification_threshold_seconds"=$1,"updated_at"=$2 WHERE id = $3`).WithRowsNum(1)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name:      "should not record the threshold when the notification fails",
+			notifyErr: fmt.Errorf("webhook unavailable"),
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tt.setupFn()
+			notifier := &notification.NotifierMock{
+				NotifyFunc: func(ctx context.Context, n notification.Notification) error {
+					g.Expect(n.Event).To(Equal(KafkaExpiringEvent))
+					g.Expect(n.Recipients).To(Equal([]string{"owner"}))
+					g.Expect(n.Data["kafka_id"]).To(Equal("kafka-id"))
+					g.Expect(n.Data["expires_at"]).To(Equal(expiresAt.UTC().Format(time.RFC3339)))
+					return tt.notifyErr
+				},
+			}
+			kafka := &dbapi.KafkaRequest{Name: "test", Owner: "owner"}
+			kafka.ID = "kafka-id"
+
+			s := NewKafkaExpiryService(db.NewMockConnectionFactory(nil), buildExpiryKafkaConfig(), notifier)
+			err := s.Notify(context.Background(), KafkaExpiryNotice{Kafka: kafka, ExpiresAt: expiresAt, Threshold: time.Hour})
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(notifier.NotifyCalls()).To(HaveLen(1))
+			if !tt.wantErr {
+				g.Expect(kafka.ExpiryNotificationThresholdSeconds).To(Equal(&[]int64{3600}[0]))
+			}
+		})
+	}
+}
+
+func Test_kafkaExpiryService_ExtendLifespan(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour)
+	currentExpiresAt := createdAt.Add(time.Duration(testExpiryLifespan) * time.Second)
+
+	tests := []struct {
+		name      string
+		kafka     *dbapi.KafkaRequest
+		expiresAt time.Time
+		wantErr   bool
+	}{
+		{
+			name: "should extend the lifespan and reset the notifications",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.CreatedAt = createdAt
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = testExpirySize
+				kafkaRequest.ExpiryNotificationThresholdSeconds = &[]int64{3600}[0]
+			}),
+			expiresAt: currentExpiresAt.Add(24 * time.Hour),
+		},
+		{
+			name: "should reject an expiration time before the current one",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.CreatedAt = createdAt
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = testExpirySize
+			}),
+			expiresAt: currentExpiresAt.Add(-time.Minute),
+			wantErr:   true,
+		},
+		{
+			name: "should reject a kafka without lifespan",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.InstanceType = "standard"
+				kafkaRequest.SizeId = testExpirySize
+			}),
+			expiresAt: currentExpiresAt.Add(24 * time.Hour),
+			wantErr:   true,
+		},
+		{
+			name: "should reject a kafka being deleted",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.CreatedAt = createdAt
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = testExpirySize
+				kafkaRequest.Status = constants2.KafkaRequestStatusDeprovision.String()
+			}),
+			expiresAt: currentExpiresAt.Add(24 * time.Hour),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "expires_at"=$1,"expiry_notification_threshold_seconds"=$2,"updated_at"=$3 WHERE id = $4`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			s := NewKafkaExpiryService(db.NewMockConnectionFactory(nil), buildExpiryKafkaConfig(), &notification.NotifierMock{})
+			err := s.ExtendLifespan(tt.kafka, tt.expiresAt)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(tt.kafka.ExpiresAt).To(Equal(&tt.expiresAt))
+				g.Expect(tt.kafka.ExpiryNotificationThresholdSeconds).To(BeNil())
+				g.Expect(tt.kafka.GetExpirationTime(testExpiryLifespan)).To(Equal(&tt.expiresAt))
+			}
+		})
+	}
+}
+
+func Test_kafkaExpiryService_GetExpirationTime(t *testing.T) {
+	createdAt := time.Now().Add(-time.Hour)
+	lifespanEnd := createdAt.Add(time.Duration(testExpiryLifespan) * time.Second)
+	extendedExpiresAt := lifespanEnd.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		kafka   *dbapi.KafkaRequest
+		want    *time.Time
+		wantErr bool
+	}{
+		{
+			name: "should return the end of the instance size lifespan",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.CreatedAt = createdAt
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = testExpirySize
+			}),
+			want: &lifespanEnd,
+		},
+		{
+			name: "should return the expiration time set by an admin",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.CreatedAt = createdAt
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = testExpirySize
+				kafkaRequest.ExpiresAt = &extendedExpiresAt
+			}),
+			want: &extendedExpiresAt,
+		},
+		{
+			name: "should return nil for a kafka without lifespan",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.InstanceType = "standard"
+				kafkaRequest.SizeId = testExpirySize
+			}),
+		},
+		{
+			name: "should return an error for an unknown instance size",
+			kafka: buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.InstanceType = testExpiryInstanceType
+				kafkaRequest.SizeId = "x9"
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := NewKafkaExpiryService(db.NewMockConnectionFactory(nil), buildExpiryKafkaConfig(), &notification.NotifierMock{})
+			got, err := s.GetExpirationTime(tt.kafka)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
+	"gorm.io/gorm"
+)
+
+//go:generate moq -out kafka_ownership_transfer_moq.go . KafkaOwnershipTransferService
+type KafkaOwnershipTransferService interface {
+	// Create requests the transfer of the kafka to the given user. The kafka keeps its owner until the transfer is accepted.
+	// Transfers to a user of another organisation have to be approved by an admin before they can be accepted.
+	Create(kafkaRequest *dbapi.KafkaRequest, requestedBy string, toOwner string, toOrganisationId string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// Get retrieves a transfer the user of the given ctx is involved in, either as the new owner, as the current owner
+	// or requester of the transfer, or as an admin of the organisation of the kafka
+	Get(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// List returns the transfers the user of the given ctx is involved in, most recent first
+	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaOwnershipTransferList, *api.PagingMeta, *errors.ServiceError)
+	// Accept transfers the kafka to the user of the given ctx, who must be the new owner of the pending transfer.
+	// The quota of the kafka is reserved against the new owner before the kafka changes owner.
+	Accept(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// Decline rejects an open transfer on behalf of the new owner, the user of the given ctx
+	Decline(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// Cancel withdraws an open transfer on behalf of the current owner, the requester or an admin of the organisation of the kafka
+	Cancel(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+	// Approve allows a transfer to another organisation to be accepted by the new owner
+	Approve(id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError)
+}
+
+var _ KafkaOwnershipTransferService = &kafkaOwnershipTransferService{}
+
+type kafkaOwnershipTransferService struct {
+	connectionFactory *db.ConnectionFactory
+	kafkaService      KafkaService
+}
+
+func NewKafkaOwnershipTransferService(connectionFactory *db.ConnectionFactory, kafkaService KafkaService) KafkaOwnershipTransferService {
+	return &kafkaOwnershipTransferService{
+		connectionFactory: connectionFactory,
+		kafkaService:      kafkaService,
+	}
+}
+
+func (s *kafkaOwnershipTransferService) Create(kafkaRequest *dbapi.KafkaRequest, requestedBy string, toOwner string, toOrganisationId string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	if kafkaRequest.Owner == toOwner && kafkaRequest.OrganisationId == toOrganisationId {
+		return nil, errors.BadRequest("unable to transfer kafka %s: %s already owns it", kafkaRequest.ID, toOwner)
+	}
+	if arrays.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return nil, errors.BadRequest("unable to transfer kafka %s: it is being deleted", kafkaRequest.ID)
+	}
+
+	dbConn := s.connectionFactory.New()
+	var openTransfers int64
+	if err := dbConn.Model(&dbapi.KafkaOwnershipTransfer{}).
+		Where("kafka_id = ?", kafkaRequest.ID).
+		Where("status IN (?)", openKafkaOwnershipTransferStatuses).
+		Count(&openTransfers).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to count the open transfers of kafka %s", kafkaRequest.ID)
+	}
+	if openTransfers > 0 {
+		return nil, errors.Conflict("unable to transfer kafka %s: a transfer is already open for it", kafkaRequest.ID)
+	}
+
+	transfer := &dbapi.KafkaOwnershipTransfer{
+		Meta: api.Meta{
+			ID: api.NewID(),
+		},
+		KafkaID:            kafkaRequest.ID,
+		FromOwner:          kafkaRequest.Owner,
+		FromOrganisationId: kafkaRequest.OrganisationId,
+		ToOwner:            toOwner,
+		ToOrganisationId:   toOrganisationId,
+		RequestedBy:        requestedBy,
+		Status:             dbapi.KafkaOwnershipTransferStatusPending.String(),
+	}
+	if transfer.IsCrossOrganisation() {
+		transfer.Status = dbapi.KafkaOwnershipTransferStatusPendingApproval.String()
+	}
+
+	if err := dbConn.Create(transfer).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the transfer of kafka %s", kafkaRequest.ID)
+	}
+
+	logger.Logger.WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("transfer %s of kafka %s to %s has been requested by %s", transfer.ID, kafkaRequest.ID, toOwner, requestedBy)
+	return transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) Get(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	dbConn, svcErr := s.filterByInvolvedUser(ctx, s.connectionFactory.New())
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var transfer dbapi.KafkaOwnershipTransfer
+	if err := dbConn.Where("id = ?", id).First(&transfer).Error; err != nil {
+		return nil, services.HandleGetError("KafkaOwnershipTransfer", "id", id, err)
+	}
+	return &transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaOwnershipTransferList, *api.PagingMeta, *errors.ServiceError) {
+	var transfers dbapi.KafkaOwnershipTransferList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn, svcErr := s.filterByInvolvedUser(ctx, s.connectionFactory.NewReadOnly(ctx))
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&transfers).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&transfers).Error; err != nil {
+		return transfers, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list kafka ownership transfers")
+	}
+
+	return transfers, pagingMeta, nil
+}
+
+func (s *kafkaOwnershipTransferService) Accept(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	transfer, svcErr := s.getAddressedTransfer(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if transfer.Status == dbapi.KafkaOwnershipTransferStatusPendingApproval.String() {
+		return nil, errors.BadRequest("unable to accept transfer %s: transfers to another organisation have to be approved by an admin first", id)
+	}
+	if transfer.Status != dbapi.KafkaOwnershipTransferStatusPending.String() {
+		return nil, errors.BadRequest("unable to accept transfer %s: only %s transfers can be accepted", id, dbapi.KafkaOwnershipTransferStatusPending)
+	}
+
+	kafkaRequest, svcErr := s.kafkaService.GetById(transfer.KafkaID)
+	if svcErr != nil {
+		return nil, errors.NewWithCause(svcErr.Code, svcErr, "unable to accept transfer %s", id)
+	}
+	if kafkaRequest.Owner != transfer.FromOwner || kafkaRequest.OrganisationId != transfer.FromOrganisationId {
+		return nil, errors.Conflict("unable to accept transfer %s: the owner of kafka %s has changed since the transfer was requested", id, kafkaRequest.ID)
+	}
+	if arrays.Contains(kafkaDeletionStatuses, kafkaRequest.Status) {
+		return nil, errors.BadRequest("unable to accept transfer %s: kafka %s is being deleted", id, kafkaRequest.ID)
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	accountId, _ := claims.GetAccountId()
+
+	// the transfer is only accepted once, together with the change of owner
+	if svcErr := s.kafkaService.TransferOwnership(kafkaRequest, transfer.ToOwner, transfer.ToOrganisationId, accountId, func(tx *gorm.DB) *errors.ServiceError {
+		return s.updateStatus(tx, transfer, []string{dbapi.KafkaOwnershipTransferStatusPending.String()}, dbapi.KafkaOwnershipTransferStatusAccepted)
+	}); svcErr != nil {
+		return nil, errors.NewWithCause(svcErr.Code, svcErr, "unable to accept transfer %s", id)
+	}
+
+	logger.NewUHCLogger(ctx).WithFields(logger.KafkaIDField, kafkaRequest.ID).Infof("kafka %s has been transferred from %s to %s", kafkaRequest.ID, transfer.FromOwner, transfer.ToOwner)
+	return transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) Decline(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	transfer, svcErr := s.getAddressedTransfer(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	if !transfer.IsOpen() {
+		return nil, errors.BadRequest("unable to decline transfer %s: it is %s", id, transfer.Status)
+	}
+
+	if svcErr := s.updateStatus(s.connectionFactory.New(), transfer, openKafkaOwnershipTransferStatuses, dbapi.KafkaOwnershipTransferStatusDeclined); svcErr != nil {
+		return nil, svcErr
+	}
+	return transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) Cancel(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	transfer, svcErr := s.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	orgId, _ := claims.GetOrgId()
+	canCancel := transfer.FromOrganisationId == orgId && (claims.IsOrgAdmin() || transfer.FromOwner == user || transfer.RequestedBy == user)
+	if !canCancel {
+		return nil, errors.Forbidden("only the owner of the kafka, the requester of the transfer or an organisation admin can cancel transfer %s", id)
+	}
+
+	if !transfer.IsOpen() {
+		return nil, errors.BadRequest("unable to cancel transfer %s: it is %s", id, transfer.Status)
+	}
+
+	if svcErr := s.updateStatus(s.connectionFactory.New(), transfer, openKafkaOwnershipTransferStatuses, dbapi.KafkaOwnershipTransferStatusCancelled); svcErr != nil {
+		return nil, svcErr
+	}
+	return transfer, nil
+}
+
+func (s *kafkaOwnershipTransferService) Approve(id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var transfer dbapi.KafkaOwnershipTransfer
+	if err := s.connectionFactory.New().Where("id = ?", id).First(&transfer).Error; err != nil {
+		return nil, services.HandleGetError("KafkaOwnershipTransfer", "id", id, err)
+	}
+
+	if transfer.Status != dbapi.KafkaOwnershipTransferStatusPendingApproval.String() {
+		return nil, errors.BadRequest("unable to approve transfer %s: only %s transfers can be approved", id, dbapi.KafkaOwnershipTransferStatusPendingApproval)
+	}
+
+	if svcErr := s.updateStatus(s.connectionFactory.New(), &transfer, []string{dbapi.KafkaOwnershipTransferStatusPendingApproval.String()}, dbapi.KafkaOwnershipTransferStatusPending); svcErr != nil {
+		return nil, svcErr
+	}
+	return &transfer, nil
+}
+
+// getAddressedTransfer retrieves a transfer whose new owner is the user of the given ctx
+func (s *kafkaOwnershipTransferService) getAddressedTransfer(ctx context.Context, id string) (*dbapi.KafkaOwnershipTransfer, *errors.ServiceError) {
+	transfer, svcErr := s.Get(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	orgId, _ := claims.GetOrgId()
+	if transfer.ToOwner != user || transfer.ToOrganisationId != orgId {
+		return nil, errors.Forbidden("only the new owner of the kafka can accept or decline transfer %s", id)
+	}
+	return transfer, nil
+}
+
+// filterByInvolvedUser restricts the query to the transfers the user of the given ctx is involved in
+func (s *kafkaOwnershipTransferService) filterByInvolvedUser(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	if user == "" {
+		return nil, errors.Unauthenticated("user not authenticated")
+	}
+	orgId, _ := claims.GetOrgId()
+
+	if claims.IsOrgAdmin() {
+		return dbConn.Where("((to_owner = ? AND to_organisation_id = ?) OR from_organisation_id = ?)", user, orgId, orgId), nil
+	}
+	return dbConn.Where("((to_owner = ? AND to_organisation_id = ?) OR (from_organisation_id = ? AND (from_owner = ? OR requested_by = ?)))", user, orgId, orgId, user, user), nil
+}
+
+// updateStatus changes the status of the transfer if it is still in one of the given statuses, so that concurrent
+// changes of the transfer result in a conflict instead of overwriting each other
+func (s *kafkaOwnershipTransferService) updateStatus(dbConn *gorm.DB, transfer *dbapi.KafkaOwnershipTransfer, fromStatuses []string, status dbapi.KafkaOwnershipTransferStatus) *errors.ServiceError {
+	dbConn = dbConn.Model(&dbapi.KafkaOwnershipTransfer{}).
+		Where("id = ?", transfer.ID).
+		Where("status IN (?)", fromStatuses).
+		Update("status", status.String())
+	if err := dbConn.Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the status of transfer %s", transfer.ID)
+	}
+	if dbConn.RowsAffected == 0 {
+		return errors.Conflict("unable to update transfer %s: it has been changed concurrently", transfer.ID)
+	}
+	transfer.Status = status.String()
+	return nil
+}
+
+var openKafkaOwnershipTransferStatuses = []string{dbapi.KafkaOwnershipTransferStatusPending.String(), dbapi.KafkaOwnershipTransferStatusPendingApproval.String()}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+	"gorm.io/gorm"
+)
+
+const (
+	testTransferID       = "test-transfer"
+	testTransferOrgID    = "test-org"
+	testTransferNewOwner = "new-owner"
+)
+
+func buildKafkaOwnershipTransfer(modifyFn func(transfer *dbapi.KafkaOwnershipTransfer)) map[string]interface{} {
+	transfer := &dbapi.KafkaOwnershipTransfer{
+		KafkaID:            testID,
+		FromOwner:          testUser,
+		FromOrganisationId: testTransferOrgID,
+		ToOwner:            testTransferNewOwner,
+		ToOrganisationId:   testTransferOrgID,
+		RequestedBy:        testUser,
+		Status:             dbapi.KafkaOwnershipTransferStatusPending.String(),
+	}
+	transfer.ID = testTransferID
+	if modifyFn != nil {
+		modifyFn(transfer)
+	}
+	return map[string]interface{}{
+		"id":                   transfer.ID,
+		"kafka_id":             transfer.KafkaID,
+		"from_owner":           transfer.FromOwner,
+		"from_organisation_id": transfer.FromOrganisationId,
+		"to_owner":             transfer.ToOwner,
+		"to_organisation_id":   transfer.ToOrganisationId,
+		"requested_by":         transfer.RequestedBy,
+		"status":               transfer.Status,
+	}
+}
+
+func buildTransferAuthenticatedContext(t *testing.T, username string) context.Context {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(username, "", "", testTransferOrgID)
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	return auth.SetTokenInContext(context.TODO(), jwt)
+}
+
+func Test_kafkaOwnershipTransferService_Create(t *testing.T) {
+	tests := []struct {
+		name             string
+		toOwner          string
+		toOrganisationId string
+		kafkaStatus      constants2.KafkaStatus
+		openTransfers    int
+		wantErrCode      errors.ServiceErrorCode
+		wantStatus       dbapi.KafkaOwnershipTransferStatus
+	}{
+		{
+			name:             "should fail when the new owner already owns the kafka",
+			toOwner:          testUser,
+			toOrganisationId: testTransferOrgID,
+			kafkaStatus:      constants2.KafkaRequestStatusReady,
+			wantErrCode:      errors.ErrorBadRequest,
+		},
+		{
+			name:             "should fail when the kafka is being deleted",
+			toOwner:          testTransferNewOwner,
+			toOrganisationId: testTransferOrgID,
+			kafkaStatus:      constants2.KafkaRequestStatusDeprovision,
+			wantErrCode:      errors.ErrorBadRequest,
+		},
+		{
+			name:             "should fail when a transfer is already open for the kafka",
+			toOwner:          testTransferNewOwner,
+			toOrganisationId: testTransferOrgID,
+			kafkaStatus:      constants2.KafkaRequestStatusReady,
+			openTransfers:    1,
+			wantErrCode:      errors.ErrorConflict,
+		},
+		{
+			name:             "should create a pending transfer within the organisation",
+			toOwner:          testTransferNewOwner,
+			toOrganisationId: testTransferOrgID,
+			kafkaStatus:      constants2.KafkaRequestStatusReady,
+			wantStatus:       dbapi.KafkaOwnershipTransferStatusPending,
+		},
+		{
+			name:             "should create a transfer pending approval to another organisation",
+			toOwner:          testTransferNewOwner,
+			toOrganisationId: "another-org",
+			kafkaStatus:      constants2.KafkaRequestStatusReady,
+			wantStatus:       dbapi.KafkaOwnershipTransferStatusPendingApproval,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT count(1) FROM "kafka_ownership_transfers" WHERE kafka_id = $1 AND status IN ($2,$3)`).
+				WithReply([]map[string]interface{}{{"count": tt.openTransfers}})
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_ownership_transfers"`)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			kafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.OrganisationId = testTransferOrgID
+				kafkaRequest.Status = tt.kafkaStatus.String()
+			})
+
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), &KafkaServiceMock{})
+			transfer, err := s.Create(kafkaRequest, testUser, tt.toOwner, tt.toOrganisationId)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Code).To(Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(transfer.Status).To(Equal(tt.wantStatus.String()))
+			g.Expect(transfer.FromOwner).To(Equal(testUser))
+			g.Expect(transfer.ToOwner).To(Equal(tt.toOwner))
+		})
+	}
+}
+
+func Test_kafkaOwnershipTransferService_Accept(t *testing.T) {
+	newOwnerCtx := buildTransferAuthenticatedContext(t, testTransferNewOwner)
+	ownerCtx := buildTransferAuthenticatedContext(t, testUser)
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		transfer       map[string]interface{}
+		kafkaOwner     string
+		transferErr    *errors.ServiceError
+		transferRows   int
+		wantErrCode    errors.ServiceErrorCode
+		wantTransfered bool
+	}{
+		{
+			name:        "should fail when the user is not the new owner",
+			ctx:         ownerCtx,
+			transfer:    buildKafkaOwnershipTransfer(nil),
+			kafkaOwner:  testUser,
+			wantErrCode: errors.ErrorForbidden,
+		},
+		{
+			name: "should fail when the transfer to another organisation is not approved",
+			ctx:  newOwnerCtx,
+			transfer: buildKafkaOwnershipTransfer(func(transfer *dbapi.KafkaOwnershipTransfer) {
+				transfer.FromOrganisationId = "another-org"
+				transfer.Status = dbapi.KafkaOwnershipTransferStatusPendingApproval.String()
+			}),
+			kafkaOwner:  testUser,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name: "should fail when the transfer has been declined",
+			ctx:  newOwnerCtx,
+			transfer: buildKafkaOwnershipTransfer(func(transfer *dbapi.KafkaOwnershipTransfer) {
+				transfer.Status = dbapi.KafkaOwnershipTransferStatusDeclined.String()
+			}),
+			kafkaOwner:  testUser,
+			wantErrCode: errors.ErrorBadRequest,
+		},
+		{
+			name:        "should fail when the owner of the kafka has changed",
+			ctx:         newOwnerCtx,
+			transfer:    buildKafkaOwnershipTransfer(nil),
+			kafkaOwner:  "another-owner",
+			wantErrCode: errors.ErrorConflict,
+		},
+		{
+			name:           "should fail when the new owner has no quota for the kafka",
+			ctx:            newOwnerCtx,
+			transfer:       buildKafkaOwnershipTransfer(nil),
+			kafkaOwner:     testUser,
+			transferErr:    errors.InsufficientQuotaError("Insufficient Quota"),
+			wantErrCode:    errors.ErrorInsufficientQuota,
+			wantTransfered: true,
+		},
+		{
+			name:           "should fail when the transfer has been changed concurrently",
+			ctx:            newOwnerCtx,
+			transfer:       buildKafkaOwnershipTransfer(nil),
+			kafkaOwner:     testUser,
+			transferRows:   0,
+			wantErrCode:    errors.ErrorConflict,
+			wantTransfered: true,
+		},
+		{
+			name:           "should transfer the kafka to the new owner",
+			ctx:            newOwnerCtx,
+			transfer:       buildKafkaOwnershipTransfer(nil),
+			kafkaOwner:     testUser,
+			transferRows:   1,
+			wantTransfered: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_ownership_transfers"`).WithReply([]map[string]interface{}{tt.transfer})
+			var updatedTransfer []driver.NamedValue
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_ownership_transfers" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status IN ($4)`).
+				WithRowsNum(int64(tt.transferRows)).
+				WithCallback(func(_ string, args []driver.NamedValue) { updatedTransfer = args })
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			kafkaService := &KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.Owner = tt.kafkaOwner
+						kafkaRequest.OrganisationId = testTransferOrgID
+						kafkaRequest.Status = constants2.KafkaRequestStatusReady.String()
+					}), nil
+				},
+				TransferOwnershipFunc: func(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *errors.ServiceError) *errors.ServiceError {
+					if tt.transferErr != nil {
+						return tt.transferErr
+					}
+					return update(db.NewMockConnectionFactory(nil).New())
+				},
+			}
+
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), kafkaService)
+			transfer, err := s.Accept(tt.ctx, testTransferID)
+			g.Expect(len(kafkaService.TransferOwnershipCalls()) > 0).To(Equal(tt.wantTransfered))
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Code).To(Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(transfer.Status).To(Equal(dbapi.KafkaOwnershipTransferStatusAccepted.String()))
+			call := kafkaService.TransferOwnershipCalls()[0]
+			g.Expect(call.Owner).To(Equal(testTransferNewOwner))
+			g.Expect(call.OrganisationId).To(Equal(testTransferOrgID))
+			// the transfer is only accepted while it is still pending
+			g.Expect(updatedTransfer).To(HaveLen(4))
+			g.Expect(updatedTransfer[3].Value).To(Equal(dbapi.KafkaOwnershipTransferStatusPending.String()))
+		})
+	}
+}
+
+func Test_kafkaOwnershipTransferService_Decline(t *testing.T) {
+	tests := []struct {
+		name         string
+		transferRows int64
+		wantErrCode  errors.ServiceErrorCode
+	}{
+		{
+			name:         "should decline an open transfer",
+			transferRows: 1,
+		},
+		{
+			name:         "should fail when the transfer has been changed concurrently",
+			transferRows: 0,
+			wantErrCode:  errors.ErrorConflict,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_ownership_transfers"`).WithReply([]map[string]interface{}{buildKafkaOwnershipTransfer(nil)})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_ownership_transfers" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status IN ($4,$5)`).WithRowsNum(tt.transferRows)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), &KafkaServiceMock{})
+			transfer, err := s.Decline(buildTransferAuthenticatedContext(t, testTransferNewOwner), testTransferID)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Code).To(Equal(tt.wantErrCode))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(transfer.Status).To(Equal(dbapi.KafkaOwnershipTransferStatusDeclined.String()))
+		})
+	}
+}
+
+func Test_kafkaOwnershipTransferService_Approve(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   dbapi.KafkaOwnershipTransferStatus
+		wantErr  bool
+		wantStat dbapi.KafkaOwnershipTransferStatus
+	}{
+		{
+			name:     "should make a transfer pending approval acceptable",
+			status:   dbapi.KafkaOwnershipTransferStatusPendingApproval,
+			wantStat: dbapi.KafkaOwnershipTransferStatusPending,
+		},
+		{
+			name:    "should fail when the transfer does not need an approval",
+			status:  dbapi.KafkaOwnershipTransferStatusPending,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			transfer := buildKafkaOwnershipTransfer(func(transfer *dbapi.KafkaOwnershipTransfer) {
+				transfer.ToOrganisationId = "another-org"
+				transfer.Status = tt.status.String()
+			})
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_ownership_transfers" WHERE id = $1`).WithReply([]map[string]interface{}{transfer})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_ownership_transfers" SET "status"=$1`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			s := NewKafkaOwnershipTransferService(db.NewMockConnectionFactory(nil), &KafkaServiceMock{})
+			approved, err := s.Approve(testTransferID)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr {
+				g.Expect(approved.Status).To(Equal(tt.wantStat.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/golang/glog"
+	"gorm.io/gorm"
+)
+
+// DefaultMirroredTopics mirrors all the topics of the primary
+const DefaultMirroredTopics = ".*"
+
+//go:generate moq -out kafka_replicated_pairs_moq.go . KafkaReplicatedPairService
+type KafkaReplicatedPairService interface {
+	// Create registers the primary and then the secondary kafka of the pair. The kafkas of a pair are never placed on
+	// the same cluster. The primary is deprovisioned again if the secondary cannot be registered.
+	Create(pair *dbapi.KafkaReplicatedPair, primary *dbapi.KafkaRequest, secondary *dbapi.KafkaRequest) *errors.ServiceError
+	// Get retrieves a pair the user of the given ctx has access to
+	Get(ctx context.Context, id string) (*dbapi.KafkaReplicatedPair, *errors.ServiceError)
+	// List returns the pairs the user of the given ctx has access to, most recent first
+	List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaReplicatedPairList, *api.PagingMeta, *errors.ServiceError)
+	// Delete deprovisions both kafkas of a pair the user of the given ctx can manage, and removes its alias DNS record
+	Delete(ctx context.Context, id string) *errors.ServiceError
+	// Failover swaps the roles of the kafkas of a pair the user of the given ctx can manage. The secondary must be ready
+	// to become the primary. The alias DNS record is repointed to the new primary asynchronously.
+	Failover(ctx context.Context, id string) (*dbapi.KafkaReplicatedPair, *errors.ServiceError)
+	// ListWithAliasNotInSync returns the pairs whose alias DNS record does not point to their primary yet
+	ListWithAliasNotInSync() (dbapi.KafkaReplicatedPairList, *errors.ServiceError)
+	// UpdateAlias points the alias DNS record of the pair to the bootstrap server of its primary, once the routes of
+	// the primary have been created
+	UpdateAlias(pair *dbapi.KafkaReplicatedPair) *errors.ServiceError
+}
+
+var _ KafkaReplicatedPairService = &kafkaReplicatedPairService{}
+
+type kafkaReplicatedPairService struct {
+	connectionFactory  *db.ConnectionFactory
+	kafkaService       KafkaService
+	kafkaConfig        *config.KafkaConfig
+	dnsProviderFactory dns.ProviderFactory
+}
+
+func NewKafkaReplicatedPairService(connectionFactory *db.ConnectionFactory, kafkaService KafkaService, kafkaConfig *config.KafkaConfig, dnsProviderFactory dns.ProviderFactory) KafkaReplicatedPairService {
+	return &kafkaReplicatedPairService{
+		connectionFactory:  connectionFactory,
+		kafkaService:       kafkaService,
+		kafkaConfig:        kafkaConfig,
+		dnsProviderFactory: dnsProviderFactory,
+	}
+}
+
+func (s *kafkaReplicatedPairService) Create(pair *dbapi.KafkaReplicatedPair, primary *dbapi.KafkaRequest, secondary *dbapi.KafkaRequest) *errors.ServiceError {
+	// the id of the pair is needed to place the secondary away from the primary
+	pair.ID = api.NewID()
+	pair.Owner = primary.Owner
+	pair.OrganisationId = primary.OrganisationId
+	if pair.MirroredTopics == "" {
+		pair.MirroredTopics = DefaultMirroredTopics
+	}
+	// private kafkas get no public DNS records, neither does their pair
+	if !primary.Private {
+		pair.AliasHost = fmt.Sprintf("%s.%s", pair.ID, s.kafkaConfig.KafkaDomainName)
+	}
+
+	primary.ReplicatedPairId = pair.ID
+	primary.ReplicationRole = dbapi.KafkaReplicationRolePrimary.String()
+	if err := s.kafkaService.RegisterKafkaJob(primary); err != nil {
+		return errors.NewWithCause(err.Code, err, "unable to create the primary kafka of the replicated pair")
+	}
+
+	secondary.ReplicatedPairId = pair.ID
+	secondary.ReplicationRole = dbapi.KafkaReplicationRoleSecondary.String()
+	if err := s.kafkaService.RegisterKafkaJob(secondary); err != nil {
+		s.deprovisionKafka(primary.ID)
+		return errors.NewWithCause(err.Code, err, "unable to create the secondary kafka of the replicated pair")
+	}
+
+	pair.PrimaryKafkaID = primary.ID
+	pair.SecondaryKafkaID = secondary.ID
+	if err := s.connectionFactory.New().Create(pair).Error; err != nil {
+		s.deprovisionKafka(primary.ID)
+		s.deprovisionKafka(secondary.ID)
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the replicated pair")
+	}
+
+	glog.Infof("replicated pair %s of primary kafka %s in %s and secondary kafka %s in %s has been created", pair.ID, primary.ID, primary.Region, secondary.ID, secondary.Region)
+	return nil
+}
+
+func (s *kafkaReplicatedPairService) Get(ctx context.Context, id string) (*dbapi.KafkaReplicatedPair, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	dbConn, svcErr := s.filterByReadableOwner(ctx, s.connectionFactory.New())
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var pair dbapi.KafkaReplicatedPair
+	if err := dbConn.Where("id = ?", id).First(&pair).Error; err != nil {
+		return nil, services.HandleGetError("KafkaReplicatedPair", "id", id, err)
+	}
+	return &pair, nil
+}
+
+func (s *kafkaReplicatedPairService) List(ctx context.Context, listArgs *services.ListArguments) (dbapi.KafkaReplicatedPairList, *api.PagingMeta, *errors.ServiceError) {
+	var pairs dbapi.KafkaReplicatedPairList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn, svcErr := s.filterByReadableOwner(ctx, s.connectionFactory.NewReadOnly(ctx))
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	total := int64(pagingMeta.Total)
+	dbConn.Model(&pairs).Count(&total)
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&pairs).Error; err != nil {
+		return pairs, pagingMeta, errors.NewWithCause(errors.ErrorGeneral, err, "Unable to list kafka replicated pairs")
+	}
+
+	return pairs, pagingMeta, nil
+}
+
+func (s *kafkaReplicatedPairService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	pair, svcErr := s.getManageablePair(ctx, id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if pair.AliasKafkaID != "" {
+		if svcErr := s.changeAlias(pair, pair.AliasKafkaID, dns.ActionDelete); svcErr != nil {
+			return errors.NewWithCause(svcErr.Code, svcErr, "unable to delete replicated pair %s", id)
+		}
+	}
+
+	if err := s.connectionFactory.New().Delete(pair).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to delete replicated pair %s", id)
+	}
+
+	s.deprovisionKafka(pair.PrimaryKafkaID)
+	s.deprovisionKafka(pair.SecondaryKafkaID)
+
+	glog.Infof("replicated pair %s has been deleted", id)
+	return nil
+}
+
+func (s *kafkaReplicatedPairService) Failover(ctx context.Context, id string) (*dbapi.KafkaReplicatedPair, *errors.ServiceError) {
+	pair, svcErr := s.getManageablePair(ctx, id)
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	secondary, svcErr := s.kafkaService.GetById(pair.SecondaryKafkaID)
+	if svcErr != nil {
+		return nil, errors.NewWithCause(svcErr.Code, svcErr, "unable to fail over replicated pair %s", id)
+	}
+	if secondary.Status != constants2.KafkaRequestStatusReady.String() {
+		return nil, errors.BadRequest("unable to fail over replicated pair %s: secondary kafka %s is %s, only %s secondaries can take over", id, secondary.ID, secondary.Status, constants2.KafkaRequestStatusReady)
+	}
+
+	newPrimaryId, newSecondaryId := pair.SecondaryKafkaID, pair.PrimaryKafkaID
+	failedOverAt := time.Now()
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		// the pair only fails over from the primary it was read with, a concurrent fail over having swapped it already
+		result := tx.Model(&dbapi.KafkaReplicatedPair{}).
+			Where("id = ? AND primary_kafka_id = ?", pair.ID, pair.PrimaryKafkaID).
+			Updates(map[string]interface{}{
+				"primary_kafka_id":   newPrimaryId,
+				"secondary_kafka_id": newSecondaryId,
+				"failed_over_at":     failedOverAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.Conflict("unable to fail over replicated pair %s: it is failing over concurrently", id)
+		}
+		if err := tx.Model(&dbapi.KafkaRequest{}).Where("id = ?", newPrimaryId).
+			Update("replication_role", dbapi.KafkaReplicationRolePrimary.String()).Error; err != nil {
+			return err
+		}
+		return tx.Model(&dbapi.KafkaRequest{}).Where("id = ?", newSecondaryId).
+			Update("replication_role", dbapi.KafkaReplicationRoleSecondary.String()).Error
+	}); err != nil {
+		if svcErr, ok := err.(*errors.ServiceError); ok {
+			return nil, svcErr
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to fail over replicated pair %s", id)
+	}
+	pair.PrimaryKafkaID = newPrimaryId
+	pair.SecondaryKafkaID = newSecondaryId
+	pair.FailedOverAt = &failedOverAt
+
+	actor := kafkaEventActor(ctx)
+	recordKafkaEvent(s.connectionFactory, &dbapi.KafkaEvent{
+		KafkaID: newPrimaryId,
+		Type:    dbapi.KafkaEventTypeFailover.String(),
+		Actor:   actor,
+		Reason:  fmt.Sprintf("became the primary of replicated pair %s", id),
+	})
+	recordKafkaEvent(s.connectionFactory, &dbapi.KafkaEvent{
+		KafkaID: newSecondaryId,
+		Type:    dbapi.KafkaEventTypeFailover.String(),
+		Actor:   actor,
+		Reason:  fmt.Sprintf("became the secondary of replicated pair %s", id),
+	})
+
+	glog.Infof("replicated pair %s has failed over to kafka %s", id, newPrimaryId)
+	return pair, nil
+}
+
+func (s *kafkaReplicatedPairService) ListWithAliasNotInSync() (dbapi.KafkaReplicatedPairList, *errors.ServiceError) {
+	var pairs dbapi.KafkaReplicatedPairList
+	if err := s.connectionFactory.New().
+		Where("alias_host <> ''").
+		Where("alias_kafka_id <> primary_kafka_id").
+		Find(&pairs).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the replicated pairs whose alias is not in sync")
+	}
+	return pairs, nil
+}
+
+func (s *kafkaReplicatedPairService) UpdateAlias(pair *dbapi.KafkaReplicatedPair) *errors.ServiceError {
+	if pair.AliasHost == "" || pair.IsAliasInSync() {
+		return nil
+	}
+
+	primary, svcErr := s.kafkaService.GetById(pair.PrimaryKafkaID)
+	if svcErr != nil {
+		return errors.NewWithCause(svcErr.Code, svcErr, "unable to update the alias of replicated pair %s", pair.ID)
+	}
+	// the alias can only point to the bootstrap server of the primary once it resolves
+	if !primary.RoutesCreated || primary.BootstrapServerHost == "" {
+		return nil
+	}
+
+	// the upsert replaces the record pointing to the previous primary, if any
+	if svcErr := s.changeAliasRecord(pair, primary, dns.ActionUpsert); svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.connectionFactory.New().Model(pair).Update("alias_kafka_id", primary.ID).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the alias of replicated pair %s", pair.ID)
+	}
+	pair.AliasKafkaID = primary.ID
+
+	glog.Infof("alias %s of replicated pair %s now points to kafka %s", pair.AliasHost, pair.ID, primary.ID)
+	return nil
+}
+
+// changeAlias applies the action to the alias DNS record of the pair pointing to the given kafka
+func (s *kafkaReplicatedPairService) changeAlias(pair *dbapi.KafkaReplicatedPair, kafkaId string, action dns.Action) *errors.ServiceError {
+	kafkaRequest, svcErr := s.kafkaService.GetById(kafkaId)
+	if svcErr != nil {
+		return svcErr
+	}
+	return s.changeAliasRecord(pair, kafkaRequest, action)
+}
+
+func (s *kafkaReplicatedPairService) changeAliasRecord(pair *dbapi.KafkaReplicatedPair, kafkaRequest *dbapi.KafkaRequest, action dns.Action) *errors.ServiceError {
+	dnsProvider, err := s.dnsProviderFactory.NewProvider(kafkaRequest.Region)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to create dns provider")
+	}
+
+	records := []dns.Record{
+		{
+			Name:  pair.AliasHost,
+			Type:  "CNAME",
+			TTL:   300,
+			Value: kafkaRequest.BootstrapServerHost,
+		},
+	}
+	if _, err := dnsProvider.ChangeRecords(s.kafkaConfig.KafkaDomainName, action, records); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "Unable to change the alias record of replicated pair %s", pair.ID)
+	}
+	return nil
+}
+
+// deprovisionKafka deprovisions a kafka of a pair, logging the failure. Any kafka left behind can still be deleted by an admin.
+func (s *kafkaReplicatedPairService) deprovisionKafka(id string) {
+	if _, err := s.kafkaService.UpdateStatus(id, constants2.KafkaRequestStatusDeprovision); err != nil {
+		logger.Logger.Errorf("failed to deprovision kafka %s of a replicated pair: %v", id, err)
+	}
+}
+
+// getManageablePair retrieves a pair owned by the user of the given ctx, or of their organisation if they are an organisation admin
+func (s *kafkaReplicatedPairService) getManageablePair(ctx context.Context, id string) (*dbapi.KafkaReplicatedPair, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+
+	dbConn := s.connectionFactory.New().Where("id = ?", id)
+	if claims.IsOrgAdmin() {
+		orgId, _ := claims.GetOrgId()
+		dbConn = dbConn.Where("organisation_id = ?", orgId)
+	} else {
+		user, _ := claims.GetUsername()
+		dbConn = dbConn.Where("owner = ?", user)
+	}
+
+	var pair dbapi.KafkaReplicatedPair
+	if err := dbConn.First(&pair).Error; err != nil {
+		return nil, services.HandleGetError("KafkaReplicatedPair", "id", id, err)
+	}
+	return &pair, nil
+}
+
+// filterByReadableOwner restricts the query to the pairs of the organisation of the user of the given ctx, or to
+// their own pairs when the kafkas are not shared within organisations
+func (s *kafkaReplicatedPairService) filterByReadableOwner(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors.ServiceError) {
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	if user == "" {
+		return nil, errors.Unauthenticated("user not authenticated")
+	}
+
+	if auth.GetFilterByOrganisationFromContext(ctx) {
+		orgId, _ := claims.GetOrgId()
+		return dbConn.Where("organisation_id = ?", orgId), nil
+	}
+	return dbConn.Where("owner = ?", user), nil
+}
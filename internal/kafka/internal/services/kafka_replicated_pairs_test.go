@@ -0,0 +1,262 @@
+package services
+
+import (
+	"testing"
+
+	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+const (
+	testPairID           = "test-pair"
+	testPrimaryKafkaID   = "test-primary"
+	testSecondaryKafkaID = "test-secondary"
+)
+
+func Test_kafkaReplicatedPairService_Create(t *testing.T) {
+	tests := []struct {
+		name             string
+		secondaryErr     *errors.ServiceError
+		private          bool
+		wantErr          bool
+		wantDeprovisions []string
+		wantAliasHost    bool
+	}{
+		{
+			name:          "should register both kafkas of the pair",
+			wantAliasHost: true,
+		},
+		{
+			name:    "should not give an alias to a pair of private kafkas",
+			private: true,
+		},
+		{
+			name:             "should deprovision the primary when the secondary cannot be registered",
+			secondaryErr:     errors.TooManyKafkaInstancesReached("Region us-east-1 cannot accept instance type: standard at this moment"),
+			wantErr:          true,
+			wantDeprovisions: []string{testPrimaryKafkaID},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			insert := mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_replicated_pairs"`)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			var deprovisioned []string
+			kafkaService := &KafkaServiceMock{
+				RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+					if kafkaRequest.ReplicationRole == dbapi.KafkaReplicationRoleSecondary.String() {
+						kafkaRequest.ID = testSecondaryKafkaID
+						return tt.secondaryErr
+					}
+					kafkaRequest.ID = testPrimaryKafkaID
+					return nil
+				},
+				UpdateStatusFunc: func(id string, status constants2.KafkaStatus) (bool, *errors.ServiceError) {
+					deprovisioned = append(deprovisioned, id)
+					return true, nil
+				},
+			}
+
+			s := NewKafkaReplicatedPairService(db.NewMockConnectionFactory(nil), kafkaService, &config.KafkaConfig{KafkaDomainName: "kafka.bf2.dev"}, nil)
+			pair := &dbapi.KafkaReplicatedPair{}
+			primary := &dbapi.KafkaRequest{Owner: testUser, Region: "us-east-1", Private: tt.private}
+			secondary := &dbapi.KafkaRequest{Owner: testUser, Region: "eu-west-1", Private: tt.private}
+			err := s.Create(pair, primary, secondary)
+
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(deprovisioned).To(Equal(tt.wantDeprovisions))
+			g.Expect(primary.ReplicatedPairId).To(Equal(pair.ID))
+			g.Expect(secondary.ReplicatedPairId).To(Equal(pair.ID))
+			if tt.wantErr {
+				g.Expect(insert.Triggered).To(BeFalse())
+				return
+			}
+			g.Expect(insert.Triggered).To(BeTrue())
+			g.Expect(pair.PrimaryKafkaID).To(Equal(testPrimaryKafkaID))
+			g.Expect(pair.SecondaryKafkaID).To(Equal(testSecondaryKafkaID))
+			g.Expect(pair.MirroredTopics).To(Equal(DefaultMirroredTopics))
+			g.Expect(pair.AliasHost != "").To(Equal(tt.wantAliasHost))
+		})
+	}
+}
+
+func Test_kafkaReplicatedPairService_Failover(t *testing.T) {
+	ctx := buildTransferAuthenticatedContext(t, testUser)
+
+	tests := []struct {
+		name            string
+		secondaryStatus constants2.KafkaStatus
+		pairRowsUpdated int64
+		wantErrCode     errors.ServiceErrorCode
+	}{
+		{
+			name:            "should fail when the secondary is not ready",
+			secondaryStatus: constants2.KafkaRequestStatusProvisioning,
+			pairRowsUpdated: 1,
+			wantErrCode:     errors.ErrorBadRequest,
+		},
+		{
+			name:            "should conflict when the pair failed over concurrently",
+			secondaryStatus: constants2.KafkaRequestStatusReady,
+			wantErrCode:     errors.ErrorConflict,
+		},
+		{
+			name:            "should swap the roles of the kafkas",
+			secondaryStatus: constants2.KafkaRequestStatusReady,
+			pairRowsUpdated: 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_replicated_pairs"`).WithReply([]map[string]interface{}{{
+				"id":                 testPairID,
+				"owner":              testUser,
+				"primary_kafka_id":   testPrimaryKafkaID,
+				"secondary_kafka_id": testSecondaryKafkaID,
+				"alias_kafka_id":     testPrimaryKafkaID,
+			}})
+			updatePair := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_replicated_pairs" SET`).WithRowsNum(tt.pairRowsUpdated)
+			updateRoles := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "replication_role"=$1`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			kafkaService := &KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.ID = id
+						kafkaRequest.Status = tt.secondaryStatus.String()
+					}), nil
+				},
+			}
+
+			s := NewKafkaReplicatedPairService(db.NewMockConnectionFactory(nil), kafkaService, &config.KafkaConfig{}, nil)
+			pair, err := s.Failover(ctx, testPairID)
+			if tt.wantErrCode != 0 {
+				g.Expect(err).ToNot(BeNil())
+				g.Expect(err.Code).To(Equal(tt.wantErrCode))
+				g.Expect(updatePair.Triggered).To(Equal(tt.wantErrCode == errors.ErrorConflict))
+				g.Expect(updateRoles.Triggered).To(BeFalse())
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(updatePair.Triggered).To(BeTrue())
+			g.Expect(updateRoles.Triggered).To(BeTrue())
+			g.Expect(pair.PrimaryKafkaID).To(Equal(testSecondaryKafkaID))
+			g.Expect(pair.SecondaryKafkaID).To(Equal(testPrimaryKafkaID))
+			g.Expect(pair.FailedOverAt).ToNot(BeNil())
+			g.Expect(pair.IsAliasInSync()).To(BeFalse())
+		})
+	}
+}
+
+func Test_kafkaReplicatedPairService_UpdateAlias(t *testing.T) {
+	tests := []struct {
+		name          string
+		routesCreated bool
+		wantUpserted  bool
+	}{
+		{
+			name: "should wait for the routes of the primary",
+		},
+		{
+			name:          "should point the alias to the primary",
+			routesCreated: true,
+			wantUpserted:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			update := mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_replicated_pairs" SET "alias_kafka_id"=$1`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			kafkaService := &KafkaServiceMock{
+				GetByIdFunc: func(id string) (*dbapi.KafkaRequest, *errors.ServiceError) {
+					return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+						kafkaRequest.ID = id
+						kafkaRequest.RoutesCreated = tt.routesCreated
+						kafkaRequest.BootstrapServerHost = "primary.kafka.bf2.dev"
+					}), nil
+				},
+			}
+			dnsProvider := &dns.ProviderMock{
+				ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.ChangeInfo, error) {
+					return &dns.ChangeInfo{}, nil
+				},
+			}
+			dnsProviderFactory := &dns.ProviderFactoryMock{
+				NewProviderFunc: func(region string) (dns.Provider, error) {
+					return dnsProvider, nil
+				},
+			}
+
+			pair := &dbapi.KafkaReplicatedPair{
+				Meta:             api.Meta{ID: testPairID},
+				PrimaryKafkaID:   testSecondaryKafkaID,
+				SecondaryKafkaID: testPrimaryKafkaID,
+				AliasHost:        "test-pair.kafka.bf2.dev",
+				AliasKafkaID:     testPrimaryKafkaID,
+			}
+			s := NewKafkaReplicatedPairService(db.NewMockConnectionFactory(nil), kafkaService, &config.KafkaConfig{KafkaDomainName: "kafka.bf2.dev"}, dnsProviderFactory)
+			g.Expect(s.UpdateAlias(pair)).To(BeNil())
+
+			g.Expect(update.Triggered).To(Equal(tt.wantUpserted))
+			g.Expect(pair.IsAliasInSync()).To(Equal(tt.wantUpserted))
+			if !tt.wantUpserted {
+				g.Expect(dnsProvider.ChangeRecordsCalls()).To(BeEmpty())
+				return
+			}
+			g.Expect(dnsProvider.ChangeRecordsCalls()).To(HaveLen(1))
+			call := dnsProvider.ChangeRecordsCalls()[0]
+			g.Expect(call.Action).To(Equal(dns.ActionUpsert))
+			g.Expect(call.Records).To(Equal([]dns.Record{{Name: "test-pair.kafka.bf2.dev", Type: "CNAME", TTL: 300, Value: "primary.kafka.bf2.dev"}}))
+		})
+	}
+}
+
+func Test_kafkaService_getMirroringSpecs(t *testing.T) {
+	g := NewWithT(t)
+	mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_replicated_pairs"`).WithReply([]map[string]interface{}{{
+		"id":                          testPairID,
+		"primary_kafka_id":            testPrimaryKafkaID,
+		"secondary_kafka_id":          testSecondaryKafkaID,
+		"mirrored_topics":             "orders-.*",
+		"sync_consumer_group_offsets": true,
+	}})
+	mocket.Catcher.NewMock().WithQuery(`SELECT "id","bootstrap_server_host" FROM "kafka_requests"`).WithReply([]map[string]interface{}{{
+		"id":                    testPrimaryKafkaID,
+		"bootstrap_server_host": "primary.kafka.bf2.dev",
+	}})
+	mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+	k := &kafkaService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+	}
+	mirroring, err := k.getMirroringSpecs(dbapi.KafkaList{
+		buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.ID = testSecondaryKafkaID
+			kafkaRequest.ReplicationRole = dbapi.KafkaReplicationRoleSecondary.String()
+		}),
+		buildKafkaRequest(nil),
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(mirroring).To(HaveLen(1))
+	g.Expect(mirroring[testSecondaryKafkaID].SourceBootstrapServerHost).To(Equal("primary.kafka.bf2.dev"))
+	g.Expect(mirroring[testSecondaryKafkaID].Topics).To(Equal("orders-.*"))
+	g.Expect(mirroring[testSecondaryKafkaID].SyncConsumerGroupOffsets).To(BeTrue())
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,6 +21,7 @@ import (
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
@@ -436,7 +438,13 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				kafkaRequest: buildKafkaRequest(nil),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "status" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"status": constants2.KafkaRequestStatusPreparing.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			wantErr: false,
@@ -492,7 +500,13 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				}),
 			},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "status" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"status": constants2.KafkaRequestStatusPreparing.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests"`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			wantErr:                 false,
@@ -566,7 +580,6 @@ func Test_kafkaService_PrepareKafkaRequest(t *testing.T) {
 				clusterService:    tt.fields.clusterService,
 				keycloakService:   tt.fields.keycloakService,
 				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			if err := k.PrepareKafkaRequest(tt.args.kafkaRequest); (err != nil) != tt.wantErr {
@@ -659,7 +672,6 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.RegisterKafkaDeprovisionJob(context.TODO(), tt.args.kafkaRequest.ID)
 			if (err != nil) != tt.wantErr {
@@ -675,6 +687,389 @@ func Test_kafkaService_RegisterKafkaDeprovisionJob(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_RegisterKafkaDeprovisionJobWithDeletionGracePeriod(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	tests := []struct {
+		name              string
+		status            constants2.KafkaStatus
+		deletionScheduled bool
+		wantErr           bool
+		wantStatusChange  bool
+		setupFn           func()
+	}{
+		{
+			name:             "suspends a ready kafka",
+			wantStatusChange: true,
+			status:           constants2.KafkaRequestStatusReady,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"status"=$2,"updated_at"=$3 WHERE status = $4 AND deletion_scheduled_at IS NULL AND "id" = $5`).
+					WithRowsNum(1)
+			},
+		},
+		{
+			name:    "error when the status of the ready kafka changed concurrently",
+			status:  constants2.KafkaRequestStatusReady,
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"status"=$2,"updated_at"=$3 WHERE status = $4 AND deletion_scheduled_at IS NULL AND "id" = $5`).
+					WithRowsNum(0)
+			},
+		},
+		{
+			name:   "schedules the deletion of a kafka suspended on user request",
+			status: constants2.KafkaRequestStatusSuspended,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"updated_at"=$2 WHERE status = $3 AND deletion_scheduled_at IS NULL AND "id" = $4`).
+					WithRowsNum(1)
+			},
+		},
+		{
+			name:              "deprovisions a kafka already scheduled for deletion",
+			wantStatusChange:  true,
+			status:            constants2.KafkaRequestStatusSuspended,
+			deletionScheduled: true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE "id" = $3`).
+					WithRowsNum(1)
+			},
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kafkaRequest := converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = tt.status.String()
+				kafkaRequest.InstanceType = types.STANDARD.String()
+				if tt.deletionScheduled {
+					kafkaRequest.DeletionScheduledAt = &[]time.Time{time.Now().Add(time.Hour)}[0]
+				}
+			}))
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).WithReply(kafkaRequest)
+			tt.setupFn()
+			mocket.Catcher.NewMock().WithQuery(`SELECT "status" FROM "kafka_requests"`).WithReply([]map[string]interface{}{{"status": tt.status.String()}})
+			recorded, published := mockKafkaStatusChange()
+
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       config.NewKafkaConfig(),
+			}
+			k.kafkaConfig.SupportedInstanceTypes.Configuration = config.SupportedKafkaInstanceTypesConfig{
+				SupportedKafkaInstanceTypes: []config.KafkaInstanceType{
+					{
+						Id:                         types.STANDARD.String(),
+						DeletionGracePeriodSeconds: &[]int{3600}[0],
+						Sizes:                      []config.KafkaInstanceSize{{Id: "x1"}},
+					},
+				},
+			}
+			err := k.RegisterKafkaDeprovisionJob(authenticatedCtx, testID)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
+		})
+	}
+}
+
+func Test_kafkaService_SuspendKafka(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		status            constants2.KafkaStatus
+		deletionScheduled bool
+		wantErr           bool
+		wantStatusChange  bool
+		setupFn           func()
+	}{
+		{
+			name:    "error when user not authenticated",
+			ctx:     context.TODO(),
+			status:  constants2.KafkaRequestStatusReady,
+			wantErr: true,
+		},
+		{
+			name:    "error when the kafka is not ready",
+			ctx:     authenticatedCtx,
+			status:  constants2.KafkaRequestStatusProvisioning,
+			wantErr: true,
+		},
+		{
+			name:    "error when the status of the kafka changed concurrently",
+			ctx:     authenticatedCtx,
+			status:  constants2.KafkaRequestStatusReady,
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status = $3 AND deletion_scheduled_at IS NULL AND "id" = $4`).
+					WithRowsNum(0)
+			},
+		},
+		{
+			name:             "suspends a ready kafka",
+			wantStatusChange: true,
+			ctx:              authenticatedCtx,
+			status:           constants2.KafkaRequestStatusReady,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status = $3 AND deletion_scheduled_at IS NULL AND "id" = $4`).
+					WithRowsNum(1)
+			},
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kafkaRequest := converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = tt.status.String()
+				if tt.deletionScheduled {
+					kafkaRequest.DeletionScheduledAt = &[]time.Time{time.Now().Add(time.Hour)}[0]
+				}
+			}))
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND owner = $2`).WithReply(kafkaRequest)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			recorded, published := mockKafkaStatusChange()
+
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			got, err := k.SuspendKafka(tt.ctx, testID)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
+			if !tt.wantErr {
+				g.Expect(got.Status).To(Equal(constants2.KafkaRequestStatusSuspending.String()))
+			}
+		})
+	}
+}
+
+func Test_kafkaService_ResumeKafka(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		status            constants2.KafkaStatus
+		deletionScheduled bool
+		wantErr           bool
+		wantStatusChange  bool
+		setupFn           func()
+	}{
+		{
+			name:    "error when user not authenticated",
+			ctx:     context.TODO(),
+			status:  constants2.KafkaRequestStatusSuspended,
+			wantErr: true,
+		},
+		{
+			name:    "error when the kafka is not suspended",
+			ctx:     authenticatedCtx,
+			status:  constants2.KafkaRequestStatusReady,
+			wantErr: true,
+		},
+		{
+			name:              "error when the kafka is scheduled for deletion",
+			ctx:               authenticatedCtx,
+			status:            constants2.KafkaRequestStatusSuspended,
+			deletionScheduled: true,
+			wantErr:           true,
+		},
+		{
+			name:             "resumes a suspended kafka",
+			wantStatusChange: true,
+			ctx:              authenticatedCtx,
+			status:           constants2.KafkaRequestStatusSuspended,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status = $3 AND deletion_scheduled_at IS NULL AND "id" = $4`).
+					WithRowsNum(1)
+			},
+		},
+		{
+			name:             "resumes a kafka which is still being suspended",
+			wantStatusChange: true,
+			ctx:              authenticatedCtx,
+			status:           constants2.KafkaRequestStatusSuspending,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE status = $3 AND deletion_scheduled_at IS NULL AND "id" = $4`).
+					WithRowsNum(1)
+			},
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kafkaRequest := converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = tt.status.String()
+				if tt.deletionScheduled {
+					kafkaRequest.DeletionScheduledAt = &[]time.Time{time.Now().Add(time.Hour)}[0]
+				}
+			}))
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND owner = $2`).WithReply(kafkaRequest)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			recorded, published := mockKafkaStatusChange()
+
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			got, err := k.ResumeKafka(tt.ctx, testID)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
+			if !tt.wantErr {
+				g.Expect(got.Status).To(Equal(constants2.KafkaRequestStatusResuming.String()))
+			}
+		})
+	}
+}
+
+func Test_kafkaService_RestoreKafka(t *testing.T) {
+	authHelper, err := auth.NewAuthHelper(JwtKeyFile, JwtCAFile, "")
+	if err != nil {
+		t.Fatalf("failed to create auth helper: %s", err.Error())
+	}
+	account, err := authHelper.NewAccount(testUser, "", "", "")
+	if err != nil {
+		t.Fatal("failed to build a new account")
+	}
+	jwt, err := authHelper.CreateJWTWithClaims(account, nil)
+	if err != nil {
+		t.Fatalf("failed to create jwt: %s", err.Error())
+	}
+	authenticatedCtx := auth.SetTokenInContext(context.TODO(), jwt)
+
+	tests := []struct {
+		name              string
+		ctx               context.Context
+		status            constants2.KafkaStatus
+		deletionScheduled bool
+		wantErr           bool
+		wantStatusChange  bool
+		setupFn           func()
+	}{
+		{
+			name:              "error when user not authenticated",
+			ctx:               context.TODO(),
+			status:            constants2.KafkaRequestStatusSuspended,
+			deletionScheduled: true,
+			wantErr:           true,
+		},
+		{
+			name:              "error when the kafka is not suspended",
+			ctx:               authenticatedCtx,
+			status:            constants2.KafkaRequestStatusDeprovision,
+			deletionScheduled: true,
+			wantErr:           true,
+		},
+		{
+			name:    "error when the kafka is not scheduled for deletion",
+			ctx:     authenticatedCtx,
+			status:  constants2.KafkaRequestStatusSuspended,
+			wantErr: true,
+		},
+		{
+			name:              "error when the kafka is deprovisioned concurrently",
+			ctx:               authenticatedCtx,
+			status:            constants2.KafkaRequestStatusSuspended,
+			deletionScheduled: true,
+			wantErr:           true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"status"=$2,"updated_at"=$3 WHERE status = $4 AND deletion_scheduled_at IS NOT NULL AND "id" = $5`).
+					WithRowsNum(0)
+			},
+		},
+		{
+			name:              "restores a suspended kafka",
+			wantStatusChange:  true,
+			ctx:               authenticatedCtx,
+			status:            constants2.KafkaRequestStatusSuspended,
+			deletionScheduled: true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"status"=$2,"updated_at"=$3 WHERE status = $4 AND deletion_scheduled_at IS NOT NULL AND "id" = $5`).
+					WithRowsNum(1)
+			},
+		},
+		{
+			name:              "restores a kafka which is still being suspended",
+			wantStatusChange:  true,
+			ctx:               authenticatedCtx,
+			status:            constants2.KafkaRequestStatusSuspending,
+			deletionScheduled: true,
+			setupFn: func() {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "deletion_scheduled_at"=$1,"status"=$2,"updated_at"=$3 WHERE status = $4 AND deletion_scheduled_at IS NOT NULL AND "id" = $5`).
+					WithRowsNum(1)
+			},
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kafkaRequest := converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.Status = tt.status.String()
+				if tt.deletionScheduled {
+					kafkaRequest.DeletionScheduledAt = &[]time.Time{time.Now().Add(time.Hour)}[0]
+				}
+			}))
+			mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1 AND owner = $2`).WithReply(kafkaRequest)
+			if tt.setupFn != nil {
+				tt.setupFn()
+			}
+			recorded, published := mockKafkaStatusChange()
+
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			got, err := k.RestoreKafka(tt.ctx, testID)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
+			if !tt.wantErr {
+				g.Expect(got.Status).To(Equal(constants2.KafkaRequestStatusResuming.String()))
+				g.Expect(got.DeletionScheduledAt).To(BeNil())
+			}
+		})
+	}
+}
+
 func Test_kafkaService_Delete(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -803,7 +1198,6 @@ func Test_kafkaService_Delete(t *testing.T) {
 				clusterService:    tt.fields.clusterService,
 				keycloakService:   tt.fields.keycloakService,
 				kafkaConfig:       tt.fields.kafkaConfig,
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Delete(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -922,7 +1316,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
-				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+				// the request is recorded in the transaction of its creation
+				mockKafkaStatusChange()
 			},
 			error: errorCheck{
 				wantErr: false,
@@ -1017,7 +1412,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
-				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+				// the request is recorded in the transaction of its creation
+				mockKafkaStatusChange()
 			},
 			error: errorCheck{
 				wantErr: false,
@@ -1060,7 +1456,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
-				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+				// the request is recorded in the transaction of its creation
+				mockKafkaStatusChange()
 			},
 			error: errorCheck{
 				wantErr:  true,
@@ -1187,7 +1584,8 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 						kafkaRequest.InstanceType = types.STANDARD.String()
 					})))
 				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_requests"`)
-				mocket.Catcher.NewMock().WithQueryException().WithExecException()
+				// the request is recorded in the transaction of its creation
+				mockKafkaStatusChange()
 			},
 			error: errorCheck{
 				wantErr:  true,
@@ -1241,7 +1639,6 @@ func Test_kafkaService_RegisterKafkaJob(t *testing.T) {
 				connectionFactory:        tt.fields.connectionFactory,
 				clusterService:           tt.fields.clusterService,
 				kafkaConfig:              &tt.fields.kafkaConfig,
-				awsConfig:                config.NewAWSConfig(),
 				providerConfig:           tt.fields.providerConfig,
 				clusterPlacementStrategy: tt.fields.clusterPlmtStrategy,
 				dataplaneClusterConfig:   tt.fields.dataplaneClusterConfig,
@@ -1671,7 +2068,6 @@ func Test_kafkaService_List(t *testing.T) {
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 
 			result, pagingMeta, err := k.List(tt.args.ctx, tt.args.listArgs)
@@ -1749,7 +2145,6 @@ func Test_kafkaService_ListByStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			got, err := k.ListByStatus(tt.args.status)
 			if (err != nil) != tt.wantErr {
@@ -1797,12 +2192,10 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 			wantErr:      true,
 			wantExecuted: false,
 			setupFn: func() {
-				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).
+				mocket.Catcher.Reset().NewMock().
+					WithQuery(`SELECT "status" FROM "kafka_requests" WHERE id = $1 AND "kafka_requests"."deleted_at" IS NULL FOR UPDATE`).
 					WithArgs(testID).
-					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
-						kafkaRequest.Status = constants2.KafkaRequestStatusDeprovision.String()
-					})))
+					WithReply([]map[string]interface{}{{"status": constants2.KafkaRequestStatusDeprovision.String()}})
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			args: args{
@@ -1820,11 +2213,16 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`)
 				mocket.Catcher.NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).
+					WithQuery(`SELECT "status" FROM "kafka_requests" WHERE id = $1 AND "kafka_requests"."deleted_at" IS NULL FOR UPDATE`).
 					WithArgs(testID).
-					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
-						kafkaRequest.Status = constants2.KafkaRequestStatusDeprovision.String()
-					})))
+					WithReply([]map[string]interface{}{{"status": constants2.KafkaRequestStatusDeprovision.String()}})
+				// the status change is recorded and written to the webhooks outbox in the same transaction
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+					WithArgs(testID).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			args: args{
@@ -1840,16 +2238,21 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 			},
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().
-					WithQuery(`SELECT * FROM "kafka_requests" WHERE id = $1`).
+					WithQuery(`SELECT "status" FROM "kafka_requests" WHERE id = $1 AND "kafka_requests"."deleted_at" IS NULL FOR UPDATE`).
 					WithArgs(testID).
-					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
-						kafkaRequest.Status = constants2.KafkaRequestStatusPreparing.String()
-					})))
+					WithReply([]map[string]interface{}{{"status": constants2.KafkaRequestStatusPreparing.String()}})
 				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`)
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+				mocket.Catcher.NewMock().
+					WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+					WithArgs(testID).
+					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 			args: args{
-				id: testID,
+				id:     testID,
+				status: constants2.KafkaRequestStatusProvisioning,
 			},
 		},
 	}
@@ -1860,7 +2263,6 @@ func Test_kafkaService_UpdateStatus(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			executed, err := k.UpdateStatus(tt.args.id, tt.args.status)
 			if executed != tt.wantExecuted {
@@ -1925,7 +2327,6 @@ func Test_kafkaService_Update(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Update(tt.args.kafkaRequest)
 			if (err != nil) != tt.wantErr {
@@ -1986,7 +2387,6 @@ func Test_kafkaService_Updates(t *testing.T) {
 				connectionFactory: tt.fields.connectionFactory,
 				clusterService:    tt.fields.clusterService,
 				kafkaConfig:       config.NewKafkaConfig(),
-				awsConfig:         config.NewAWSConfig(),
 			}
 			err := k.Updates(tt.args.kafkaRequest, map[string]interface{}{
 				"id":    "idsds",
@@ -2000,6 +2400,61 @@ func Test_kafkaService_Updates(t *testing.T) {
 	}
 }
 
+func Test_kafkaService_Updates_StatusChange(t *testing.T) {
+	tests := []struct {
+		name           string
+		storedStatus   constants2.KafkaStatus
+		status         constants2.KafkaStatus
+		wantRecorded   bool
+		wantPrevStatus string
+	}{
+		{
+			name:           "should record the change of status in the transaction of the update",
+			storedStatus:   constants2.KafkaRequestStatusProvisioning,
+			status:         constants2.KafkaRequestStatusReady,
+			wantRecorded:   true,
+			wantPrevStatus: constants2.KafkaRequestStatusProvisioning.String(),
+		},
+		{
+			name:         "should not record an unchanged status",
+			storedStatus: constants2.KafkaRequestStatusReady,
+			status:       constants2.KafkaRequestStatusReady,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			var recordedEvent []driver.NamedValue
+			mocket.Catcher.Reset().NewMock().
+				WithQuery(`SELECT "status" FROM "kafka_requests" WHERE id = $1 AND "kafka_requests"."deleted_at" IS NULL FOR UPDATE`).
+				WithArgs(testID).
+				WithReply([]map[string]interface{}{{"status": tt.storedStatus.String()}})
+			mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1`).WithRowsNum(1)
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`).
+				WithCallback(func(_ string, args []driver.NamedValue) { recordedEvent = args })
+			mocket.Catcher.NewMock().WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+				WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+			mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+			k := kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			err := k.Updates(buildKafkaRequest(nil), map[string]interface{}{
+				"status": tt.status.String(),
+			})
+			g.Expect(err).To(BeNil())
+			g.Expect(recordedEvent != nil).To(Equal(tt.wantRecorded))
+			if tt.wantRecorded {
+				// the previous status is the one locked in the transaction of the update
+				g.Expect(recordedEvent[8].Value).To(Equal(tt.wantPrevStatus))
+				g.Expect(recordedEvent[9].Value).To(Equal(tt.status.String()))
+			}
+		})
+	}
+}
+
 func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2009,11 +2464,12 @@ func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	}
 
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-		setupFn func()
+		name             string
+		fields           fields
+		args             args
+		wantErr          bool
+		wantStatusChange bool
+		setupFn          func()
 	}{
 		{
 			name: "should receive error when update fails",
@@ -2022,8 +2478,9 @@ func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 			},
 			wantErr: true,
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery("UPDATE").WithError(fmt.Errorf("some update error"))
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE owner IN ($1) AND status NOT IN ($2,$3)`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants2.KafkaRequestStatusReady.String()}})
+				mocket.Catcher.NewMock().WithQuery("UPDATE").WithError(fmt.Errorf("some update error"))
 			},
 			args: args{users: []string{"user"}},
 		},
@@ -2032,11 +2489,13 @@ func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 			fields: fields{
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
-			wantErr: false,
-			args:    args{users: []string{"user"}},
+			wantErr:          false,
+			wantStatusChange: true,
+			args:             args{users: []string{"user"}},
 			setupFn: func() {
-				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"`)
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE owner IN ($1) AND status NOT IN ($2,$3)`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants2.KafkaRequestStatusReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithRowsNum(1)
 			},
 		},
 	}
@@ -2044,11 +2503,14 @@ func Test_kafkaService_DeprovisionKafkaForUsers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupFn()
+			recorded, published := mockKafkaStatusChange()
 			k := kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 			}
 			err := k.DeprovisionKafkaForUsers(tt.args.users)
 			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
 		})
 	}
 }
@@ -2062,10 +2524,11 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 	const instanceSize = "size4"
 
 	tests := []struct {
-		name    string
-		fields  fields
-		wantErr bool
-		setupFn func()
+		name             string
+		fields           fields
+		wantErr          bool
+		wantStatusChange bool
+		setupFn          func()
 	}{
 		{
 			name: "fail when database update throws an error",
@@ -2075,8 +2538,9 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 			wantErr: true,
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize}})
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE id IN ($1) AND status NOT IN ($2,$3)`).
+					WithReply([]map[string]interface{}{{"id": "kafkainstance1", "status": constants2.KafkaRequestStatusReady.String()}})
 				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithError(fmt.Errorf("an update error"))
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
 		{
@@ -2084,11 +2548,13 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 			fields: fields{
 				connectionFactory: db.NewMockConnectionFactory(nil),
 			},
-			wantErr: false,
+			wantErr:          false,
+			wantStatusChange: true,
 			setupFn: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "kafka_requests" WHERE instance_type IN ($1) AND status NOT IN ($2,$3)`).WithReply([]map[string]interface{}{{"id": "kafkainstance1", "instance_type": instanceType, "size_id": instanceSize}})
-				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`)
-				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+				mocket.Catcher.NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE id IN ($1) AND status NOT IN ($2,$3)`).
+					WithReply([]map[string]interface{}{{"id": "kafkainstance1", "status": constants2.KafkaRequestStatusReady.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithRowsNum(1)
 			},
 		},
 	}
@@ -2098,6 +2564,7 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 			if tt.setupFn != nil {
 				tt.setupFn()
 			}
+			recorded, published := mockKafkaStatusChange()
 			k := &kafkaService{
 				connectionFactory: tt.fields.connectionFactory,
 				kafkaConfig:       config.NewKafkaConfig(),
@@ -2115,6 +2582,60 @@ func Test_kafkaService_DeprovisionExpiredKafkas(t *testing.T) {
 			}
 			err := k.DeprovisionExpiredKafkas()
 			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
+		})
+	}
+}
+
+func Test_kafkaService_DeprovisionSuspendedKafkas(t *testing.T) {
+	tests := []struct {
+		name             string
+		wantErr          bool
+		wantStatusChange bool
+		setupFn          func()
+	}{
+		{
+			name:    "fail when database update throws an error",
+			wantErr: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE status IN ($1,$2) AND deletion_scheduled_at <= $3`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants2.KafkaRequestStatusSuspended.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).WithError(fmt.Errorf("an update error"))
+			},
+		},
+		{
+			name:    "does nothing when no kafka is over its deletion grace period",
+			wantErr: false,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE status IN ($1,$2) AND deletion_scheduled_at <= $3`).
+					WithReply([]map[string]interface{}{})
+			},
+		},
+		{
+			name:             "success when database does not throw an error",
+			wantErr:          false,
+			wantStatusChange: true,
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT "id","status" FROM "kafka_requests" WHERE status IN ($1,$2) AND deletion_scheduled_at <= $3`).
+					WithReply([]map[string]interface{}{{"id": testID, "status": constants2.KafkaRequestStatusSuspended.String()}})
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "status"=$1,"updated_at"=$2 WHERE id IN ($3)`).
+					WithRowsNum(1)
+			},
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupFn()
+			recorded, published := mockKafkaStatusChange()
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+			}
+			err := k.DeprovisionSuspendedKafkas()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(recorded.Triggered).To(Equal(tt.wantStatusChange))
+			g.Expect(published.Triggered).To(Equal(tt.wantStatusChange))
 		})
 	}
 }
@@ -2273,6 +2794,7 @@ func Test_KafkaService_CountByRegionAndInstanceType(t *testing.T) {
 				kafkaConfig: &config.KafkaConfig{
 					SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
 				},
+				dataplaneClusterConfig: buildDataplaneClusterConfig(nil),
 			}
 			_, err := k.CountByRegionAndInstanceType()
 			if !tt.wantErr && err != nil {
@@ -2282,6 +2804,109 @@ func Test_KafkaService_CountByRegionAndInstanceType(t *testing.T) {
 	}
 }
 
+func Test_KafkaService_CountByRegionAndInstanceType_SuspendedKafkas(t *testing.T) {
+	g := NewWithT(t)
+	developerKafka := func(id string, status constants2.KafkaStatus) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.ID = id
+			kafkaRequest.InstanceType = "developer"
+			kafkaRequest.Status = status.String()
+		})
+	}
+	mocket.Catcher.Reset().
+		NewMock().
+		WithQuery(`SELECT * FROM "kafka_requests"`).
+		WithReply(converters.ConvertKafkaRequestList(dbapi.KafkaList{
+			developerKafka("ready", constants2.KafkaRequestStatusReady),
+			developerKafka("suspending", constants2.KafkaRequestStatusSuspending),
+			developerKafka("suspended", constants2.KafkaRequestStatusSuspended),
+			developerKafka("resuming", constants2.KafkaRequestStatusResuming),
+		}))
+	mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+	dataplaneClusterConfig := buildDataplaneClusterConfig(nil)
+	dataplaneClusterConfig.SuspendedKafkaCapacityWeight = 0.25
+	k := &kafkaService{
+		connectionFactory: db.NewMockConnectionFactory(nil),
+		kafkaConfig: &config.KafkaConfig{
+			SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+		},
+		dataplaneClusterConfig: dataplaneClusterConfig,
+	}
+	counts, err := k.CountByRegionAndInstanceType()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(counts).To(HaveLen(1))
+	// the ready and resuming kafkas count with their full capacity of 2, suspending and suspended ones with a quarter
+	g.Expect(counts[0].Count).To(Equal(float64(5)))
+}
+
+func Test_kafkaService_capacityAvailableForRegionAndInstanceType(t *testing.T) {
+	developerKafka := func(id string, status constants2.KafkaStatus) *dbapi.KafkaRequest {
+		return buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+			kafkaRequest.ID = id
+			kafkaRequest.InstanceType = "developer"
+			kafkaRequest.Status = status.String()
+		})
+	}
+	tests := []struct {
+		name                         string
+		existingKafkas               dbapi.KafkaList
+		suspendedKafkaCapacityWeight float64
+		want                         bool
+	}{
+		{
+			name:                         "should not have capacity if suspended kafkas count with their full capacity",
+			existingKafkas:               dbapi.KafkaList{developerKafka("ready", constants2.KafkaRequestStatusReady), developerKafka("suspended", constants2.KafkaRequestStatusSuspended)},
+			suspendedKafkaCapacityWeight: 1,
+			want:                         false,
+		},
+		{
+			name:                         "should have capacity released by suspended kafkas",
+			existingKafkas:               dbapi.KafkaList{developerKafka("ready", constants2.KafkaRequestStatusReady), developerKafka("suspended", constants2.KafkaRequestStatusSuspended)},
+			suspendedKafkaCapacityWeight: 0,
+			want:                         true,
+		},
+		{
+			name:                         "should have capacity released by suspending kafkas",
+			existingKafkas:               dbapi.KafkaList{developerKafka("ready", constants2.KafkaRequestStatusReady), developerKafka("suspending", constants2.KafkaRequestStatusSuspending)},
+			suspendedKafkaCapacityWeight: 0,
+			want:                         true,
+		},
+		{
+			name:                         "should not have capacity released by resuming kafkas",
+			existingKafkas:               dbapi.KafkaList{developerKafka("ready", constants2.KafkaRequestStatusReady), developerKafka("resuming", constants2.KafkaRequestStatusResuming)},
+			suspendedKafkaCapacityWeight: 0,
+			want:                         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset().
+				NewMock().
+				WithQuery(`SELECT * FROM "kafka_requests"`).
+				WithReply(converters.ConvertKafkaRequestList(tt.existingKafkas))
+			mocket.Catcher.NewMock().WithQueryException().WithExecException()
+
+			dataplaneClusterConfig := buildDataplaneClusterConfig(nil)
+			dataplaneClusterConfig.SuspendedKafkaCapacityWeight = tt.suspendedKafkaCapacityWeight
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig: &config.KafkaConfig{
+					SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+				},
+				dataplaneClusterConfig: dataplaneClusterConfig,
+			}
+			// each developer kafka consumes a capacity of 2
+			limit := 4
+			got, err := k.capacityAvailableForRegionAndInstanceType(&limit, developerKafka("new", constants2.KafkaRequestStatusAccepted))
+			g.Expect(err).To(BeNil())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
 func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 	type fields struct {
 		awsClient aws.AWSClient
@@ -2393,11 +3018,10 @@ func Test_KafkaService_ChangeKafkaCNAMErecords(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			kafkaService := &kafkaService{
-				awsClientFactory: aws.NewMockClientFactory(tt.fields.awsClient),
-				awsConfig: &config.AWSConfig{
-					Route53AccessKey:       "test-route-53-key",
-					Route53SecretAccessKey: "test-route-53-secret-key",
-				},
+				dnsProviderFactory: dns.NewRoute53ProviderFactory(aws.NewMockClientFactory(tt.fields.awsClient), aws.Config{
+					AccessKeyID:     "test-route-53-key",
+					SecretAccessKey: "test-route-53-secret-key",
+				}),
 				kafkaConfig: &config.KafkaConfig{
 					KafkaDomainName: "rhcloud.com",
 				},
@@ -2727,9 +3351,10 @@ func Test_kafkaService_GetAvailableSizesInRegion(t *testing.T) {
 }
 func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 	type fields struct {
-		connectionFactory *db.ConnectionFactory
-		keycloakService   sso.KeycloakService
-		kafkaConfig       *config.KafkaConfig
+		connectionFactory  *db.ConnectionFactory
+		keycloakService    sso.KeycloakService
+		kafkaConfig        *config.KafkaConfig
+		certificateService KafkaCertificateService
 	}
 	type args struct {
 		clusterID string
@@ -2747,6 +3372,7 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 			InstanceType: "developer",
 			SizeId:       "x1",
 		},
+		nil,
 		&config.KafkaConfig{
 			EnableKafkaExternalCertificate: true,
 			SupportedInstanceTypes:         &kafkaSupportedInstanceTypesConfig,
@@ -2788,6 +3414,11 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 					EnableKafkaExternalCertificate: true,
 					SupportedInstanceTypes:         &kafkaSupportedInstanceTypesConfig,
 				},
+				certificateService: &KafkaCertificateServiceMock{
+					GetByKafkaIdsFunc: func(kafkaIds []string) (map[string]*dbapi.KafkaCertificate, *errors.ServiceError) {
+						return map[string]*dbapi.KafkaCertificate{}, nil
+					},
+				},
 			},
 			args: args{
 				clusterID: testClusterID,
@@ -2808,9 +3439,10 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 		tt.setupFn()
 		t.Run(tt.name, func(t *testing.T) {
 			k := &kafkaService{
-				connectionFactory: tt.fields.connectionFactory,
-				keycloakService:   tt.fields.keycloakService,
-				kafkaConfig:       tt.fields.kafkaConfig,
+				connectionFactory:  tt.fields.connectionFactory,
+				keycloakService:    tt.fields.keycloakService,
+				kafkaConfig:        tt.fields.kafkaConfig,
+				certificateService: tt.fields.certificateService,
 			}
 			got, err := k.GetManagedKafkaByClusterID(tt.args.clusterID)
 			g.Expect(got).To(Equal(tt.want))
@@ -2819,6 +3451,246 @@ func Test_kafkaService_GetManagedKafkaByClusterID(t *testing.T) {
 	}
 }
 
+func Test_buildManagedKafkaCR_Suspended(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        constants2.KafkaStatus
+		wantSuspended bool
+	}{
+		{
+			name:   "should not label or scale down a ready kafka",
+			status: constants2.KafkaRequestStatusReady,
+		},
+		{
+			name:          "should label and scale down a suspending kafka",
+			status:        constants2.KafkaRequestStatusSuspending,
+			wantSuspended: true,
+		},
+		{
+			name:          "should label and scale down a suspended kafka",
+			status:        constants2.KafkaRequestStatusSuspended,
+			wantSuspended: true,
+		},
+		{
+			name:   "should not label or scale down a resuming kafka",
+			status: constants2.KafkaRequestStatusResuming,
+		},
+	}
+	g := NewWithT(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			managedKafkaCR, err := buildManagedKafkaCR(
+				&dbapi.KafkaRequest{
+					ClusterID:        testClusterID,
+					InstanceType:     "developer",
+					SizeId:           "x1",
+					KafkaStorageSize: "10Gi",
+					Status:           tt.status.String(),
+				},
+				nil,
+				&config.KafkaConfig{
+					SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+				},
+				&sso.KeycloakServiceMock{
+					GetConfigFunc: func() *keycloak.KeycloakConfig {
+						return &keycloak.KeycloakConfig{}
+					},
+					GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+						return &keycloak.KeycloakRealmConfig{}
+					},
+				})
+			g.Expect(err).To(BeNil())
+			_, suspended := managedKafkaCR.Labels[ManagedKafkaSuspendedLabel]
+			g.Expect(suspended).To(Equal(tt.wantSuspended))
+			g.Expect(managedKafkaCR.Spec.Deleted).To(BeFalse())
+
+			capacity := managedKafkaCR.Spec.Capacity
+			g.Expect(capacity.MaxDataRetentionSize).To(Equal("10Gi"))
+			g.Expect(capacity.MaxDataRetentionPeriod).ToNot(BeEmpty())
+			if tt.wantSuspended {
+				g.Expect(capacity.IngressPerSec).To(Equal("0"))
+				g.Expect(capacity.EgressPerSec).To(Equal("0"))
+				g.Expect(capacity.TotalMaxConnections).To(BeZero())
+				g.Expect(capacity.MaxPartitions).To(BeZero())
+				g.Expect(capacity.MaxConnectionAttemptsPerSec).To(BeZero())
+			} else {
+				g.Expect(capacity.IngressPerSec).ToNot(Equal("0"))
+				g.Expect(capacity.TotalMaxConnections).ToNot(BeZero())
+				g.Expect(capacity.MaxPartitions).ToNot(BeZero())
+			}
+		})
+	}
+}
+
+func Test_buildManagedKafkaCR_KafkaConfig(t *testing.T) {
+	g := NewWithT(t)
+	kafkaRequest := &dbapi.KafkaRequest{
+		ClusterID:    testClusterID,
+		InstanceType: "standard",
+		SizeId:       "x1",
+		Status:       constants2.KafkaRequestStatusReady.String(),
+	}
+	kafkaConfig := &config.KafkaConfig{
+		SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+	}
+	keycloakService := &sso.KeycloakServiceMock{
+		GetConfigFunc: func() *keycloak.KeycloakConfig {
+			return &keycloak.KeycloakConfig{}
+		},
+		GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+			return &keycloak.KeycloakRealmConfig{}
+		},
+	}
+
+	managedKafkaCR, err := buildManagedKafkaCR(kafkaRequest, nil, kafkaConfig, keycloakService)
+	g.Expect(err).To(BeNil())
+	g.Expect(managedKafkaCR.Spec.Capacity.MaxDataRetentionPeriod).To(Equal("P14D"))
+	g.Expect(managedKafkaCR.Spec.Capacity.MaxConnectionAttemptsPerSec).To(Equal(100))
+	g.Expect(managedKafkaCR.Spec.KafkaConfig).To(BeNil())
+
+	g.Expect(kafkaRequest.SetKafkaConfig(&dbapi.KafkaConfig{
+		MaxDataRetentionPeriod:      "P7D",
+		DefaultRetentionPeriod:      "P1D",
+		MessageMaxBytes:             1024,
+		MaxConnectionAttemptsPerSec: 50,
+	})).To(Succeed())
+	managedKafkaCR, err = buildManagedKafkaCR(kafkaRequest, nil, kafkaConfig, keycloakService)
+	g.Expect(err).To(BeNil())
+	g.Expect(managedKafkaCR.Spec.Capacity.MaxDataRetentionPeriod).To(Equal("P7D"))
+	g.Expect(managedKafkaCR.Spec.Capacity.MaxConnectionAttemptsPerSec).To(Equal(50))
+	g.Expect(managedKafkaCR.Spec.KafkaConfig).To(Equal(&managedkafka.KafkaConfigSpec{
+		DefaultRetentionPeriod: "P1D",
+		MessageMaxBytes:        1024,
+	}))
+}
+
+func Test_buildManagedKafkaCR_PrivateEndpoint(t *testing.T) {
+	g := NewWithT(t)
+	keycloakService := &sso.KeycloakServiceMock{
+		GetConfigFunc: func() *keycloak.KeycloakConfig {
+			return &keycloak.KeycloakConfig{}
+		},
+		GetRealmConfigFunc: func() *keycloak.KeycloakRealmConfig {
+			return &keycloak.KeycloakRealmConfig{}
+		},
+	}
+	for _, private := range []bool{false, true} {
+		managedKafkaCR, err := buildManagedKafkaCR(&dbapi.KafkaRequest{
+			ClusterID:    testClusterID,
+			InstanceType: "standard",
+			SizeId:       "x1",
+			Private:      private,
+		}, nil, &config.KafkaConfig{
+			SupportedInstanceTypes: &kafkaSupportedInstanceTypesConfig,
+		}, keycloakService)
+		g.Expect(err).To(BeNil())
+		g.Expect(managedKafkaCR.Spec.Endpoint.Internal).To(Equal(private))
+	}
+}
+
+func Test_kafkaService_TransferOwnership(t *testing.T) {
+	const previousSubscriptionId = "previous-subscription"
+	tests := []struct {
+		name                     string
+		reserveErr               *errors.ServiceError
+		reservedSubscriptionId   string
+		updateErr                bool
+		updatedRows              int64
+		transferErr              *errors.ServiceError
+		wantErr                  bool
+		wantDeletedSubscriptions []string
+	}{
+		{
+			name:       "should keep the owner when the new owner has no quota",
+			reserveErr: errors.InsufficientQuotaError("Insufficient Quota"),
+			wantErr:    true,
+		},
+		{
+			name:                     "should release the quota of the new owner when the kafka can not be updated",
+			reservedSubscriptionId:   "new-subscription",
+			updateErr:                true,
+			wantErr:                  true,
+			wantDeletedSubscriptions: []string{"new-subscription"},
+		},
+		{
+			name:                     "should release the quota of the new owner when the kafka has changed owner",
+			reservedSubscriptionId:   "new-subscription",
+			updatedRows:              0,
+			wantErr:                  true,
+			wantDeletedSubscriptions: []string{"new-subscription"},
+		},
+		{
+			name:                     "should release the quota of the new owner when the update of the transfer fails",
+			reservedSubscriptionId:   "new-subscription",
+			updatedRows:              1,
+			transferErr:              errors.Conflict("transfer changed concurrently"),
+			wantErr:                  true,
+			wantDeletedSubscriptions: []string{"new-subscription"},
+		},
+		{
+			name:                     "should transfer the kafka and release the quota of the previous owner",
+			reservedSubscriptionId:   "new-subscription",
+			updatedRows:              1,
+			wantDeletedSubscriptions: []string{previousSubscriptionId},
+		},
+		{
+			name:                   "should not release the quota when the subscription is unchanged",
+			reservedSubscriptionId: previousSubscriptionId,
+			updatedRows:            1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			if !tt.updateErr {
+				mocket.Catcher.NewMock().WithQuery(`UPDATE "kafka_requests" SET "organisation_id"=$1,"owner"=$2,"owner_account_id"=$3,"quota_type"=$4,"subscription_id"=$5,"updated_at"=$6 WHERE id = $7 AND (owner = $8 AND organisation_id = $9) AND status not IN`).WithRowsNum(tt.updatedRows)
+			}
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			var deletedSubscriptions []string
+			quotaService := &QuotaServiceMock{
+				ReserveQuotaFunc: func(kafka *dbapi.KafkaRequest, instanceType types.KafkaInstanceType) (string, *errors.ServiceError) {
+					g.Expect(kafka.Owner).To(Equal("new-owner"))
+					return tt.reservedSubscriptionId, tt.reserveErr
+				},
+				DeleteQuotaFunc: func(subscriptionId string) *errors.ServiceError {
+					deletedSubscriptions = append(deletedSubscriptions, subscriptionId)
+					return nil
+				},
+			}
+			k := &kafkaService{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				kafkaConfig:       config.NewKafkaConfig(),
+				quotaServiceFactory: &QuotaServiceFactoryMock{
+					GetQuotaServiceFunc: func(quotaType api.QuotaType) (QuotaService, *errors.ServiceError) {
+						return quotaService, nil
+					},
+				},
+			}
+
+			kafkaRequest := buildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+				kafkaRequest.InstanceType = types.STANDARD.String()
+				kafkaRequest.SubscriptionId = previousSubscriptionId
+			})
+			err := k.TransferOwnership(kafkaRequest, "new-owner", "new-org", "new-account", func(tx *gorm.DB) *errors.ServiceError {
+				return tt.transferErr
+			})
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(deletedSubscriptions).To(Equal(tt.wantDeletedSubscriptions))
+			if tt.wantErr {
+				g.Expect(kafkaRequest.Owner).To(Equal(testUser))
+				return
+			}
+			g.Expect(kafkaRequest.Owner).To(Equal("new-owner"))
+			g.Expect(kafkaRequest.OrganisationId).To(Equal("new-org"))
+			g.Expect(kafkaRequest.OwnerAccountId).To(Equal("new-account"))
+			g.Expect(kafkaRequest.SubscriptionId).To(Equal(tt.reservedSubscriptionId))
+		})
+	}
+}
+
 func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 	type fields struct {
 		connectionFactory *db.ConnectionFactory
@@ -2897,7 +3769,55 @@ func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 			want: nil,
 			setupFunc: func() {
 				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).
-					WithReply(converters.ConvertKafkaRequest(buildKafkaRequest(nil)))
+					WithQuery(`WHERE version = $`).
+					WithRowsNum(1)
+				mocket.Catcher.NewMock().WithQuery(`SELECT "version" FROM "kafka_requests"`).
+					WithReply([]map[string]interface{}{{"version": 2}})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+		},
+		{
+			name: "should return a conflict if the kafka has been changed since it was retrieved",
+			fields: fields{
+				connectionFactory: db.NewMockConnectionFactory(nil),
+				authService:       authorization.NewMockAuthorization(),
+				clusterService: &ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *errors.ServiceError) {
+						return &api.Cluster{
+							Meta: api.Meta{
+								ID: "id",
+							},
+							ClusterID:                "cluster-id",
+							AvailableStrimziVersions: availableStrimziVersions,
+						}, nil
+					},
+					IsStrimziKafkaVersionAvailableInClusterFunc: func(cluster *api.Cluster, strimziVersion, kafkaVersion, ibpVersion string) (bool, error) {
+						return true, nil
+					},
+					CheckStrimziVersionReadyFunc: func(cluster *api.Cluster, strimziVersion string) (bool, error) {
+						return true, nil
+					},
+				},
+			},
+			args: args{
+				ctx: auth.SetIsAdminContext(context.TODO(), true),
+				kafkaRequest: &dbapi.KafkaRequest{
+					Meta: api.Meta{
+						ID: "id",
+					},
+					ClusterID:              "cluster-id",
+					ActualKafkaIBPVersion:  "2.7",
+					DesiredKafkaIBPVersion: "2.7",
+					ActualKafkaVersion:     "2.7",
+					DesiredKafkaVersion:    "2.7",
+					DesiredStrimziVersion:  "2.7",
+					KafkaStorageSize:       "100",
+				},
+			},
+			want: errors.Conflict("unable to update kafka id: it has been changed concurrently or is being deleted"),
+			setupFunc: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`UPDATE "kafka_requests"`).
+					WithRowsNum(0)
 				mocket.Catcher.NewMock().WithExecException().WithQueryException()
 			},
 		},
@@ -3100,18 +4020,14 @@ func Test_kafkaService_VerifyAndUpdateKafkaAdmin(t *testing.T) {
 
 func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 	type fields struct {
-		awsConfig        *config.AWSConfig
-		awsClientFactory aws.ClientFactory
+		awsClient aws.AWSClient
 	}
 
 	CNAME_Id := "CNAME_Id"
 	CNAME_Status := "CNAME_Status"
-	awsConfig := &config.AWSConfig{
-		AccountID:              "AccountID",
-		AccessKey:              "AccessKey",
-		SecretAccessKey:        "SecretAccessKey",
-		Route53AccessKey:       "Route53AccessKey",
-		Route53SecretAccessKey: "Route53SecretAccessKey",
+	awsConfig := aws.Config{
+		AccessKeyID:     "Route53AccessKey",
+		SecretAccessKey: "Route53SecretAccessKey",
 	}
 
 	type args struct {
@@ -3127,8 +4043,7 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		{
 			name: "should get the CNAME record Status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
+				awsClient: &aws.AWSClientMock{
 					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
 						return &route53.GetChangeOutput{
 							ChangeInfo: &route53.ChangeInfo{
@@ -3137,7 +4052,7 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 							},
 						}, nil
 					},
-				}),
+				},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
@@ -3153,12 +4068,11 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 		{
 			name: "should return error when it fails to get CNAME status",
 			fields: fields{
-				awsConfig: awsConfig,
-				awsClientFactory: aws.NewMockClientFactory(&aws.AWSClientMock{
+				awsClient: &aws.AWSClientMock{
 					GetChangeFunc: func(changeId string) (*route53.GetChangeOutput, error) {
 						return nil, errors.GeneralError("Unable to CNAME record status")
 					},
-				}),
+				},
 			},
 			args: args{
 				kafkaRequest: &dbapi.KafkaRequest{
@@ -3172,8 +4086,7 @@ func Test_kafkaService_GetCNAMERecordStatus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			k := &kafkaService{
-				awsConfig:        tt.fields.awsConfig,
-				awsClientFactory: tt.fields.awsClientFactory,
+				dnsProviderFactory: dns.NewRoute53ProviderFactory(aws.NewMockClientFactory(tt.fields.awsClient), awsConfig),
 			}
 			got, err := k.GetCNAMERecordStatus(tt.args.kafkaRequest)
 			g.Expect(got).To(Equal(tt.want))
@@ -3189,9 +4102,9 @@ func Test_NewKafkaService(t *testing.T) {
 		keycloakService          sso.KafkaKeycloakService
 		kafkaConfig              *config.KafkaConfig
 		dataplaneClusterConfig   *config.DataplaneClusterConfig
-		awsConfig                *config.AWSConfig
 		quotaServiceFactory      QuotaServiceFactory
-		awsClientFactory         aws.ClientFactory
+		dnsProviderFactory       dns.ProviderFactory
+		certificateService       KafkaCertificateService
 		authorizationService     authorization.Authorization
 		providerConfig           *config.ProviderConfig
 		clusterPlacementStrategy ClusterPlacementStrategy
@@ -3209,9 +4122,9 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:          &sso.KeycloakServiceMock{},
 				kafkaConfig:              &config.KafkaConfig{},
 				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
 				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
+				dnsProviderFactory:       &dns.ProviderFactoryMock{},
+				certificateService:       &KafkaCertificateServiceMock{},
 				providerConfig:           &config.ProviderConfig{},
 				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
 			},
@@ -3221,9 +4134,9 @@ func Test_NewKafkaService(t *testing.T) {
 				keycloakService:          &sso.KeycloakServiceMock{},
 				kafkaConfig:              &config.KafkaConfig{},
 				dataplaneClusterConfig:   &config.DataplaneClusterConfig{},
-				awsConfig:                &config.AWSConfig{},
 				quotaServiceFactory:      &QuotaServiceFactoryMock{},
-				awsClientFactory:         &aws.MockClientFactory{},
+				dnsProviderFactory:       &dns.ProviderFactoryMock{},
+				certificateService:       &KafkaCertificateServiceMock{},
 				providerConfig:           &config.ProviderConfig{},
 				clusterPlacementStrategy: &ClusterPlacementStrategyMock{},
 			},
@@ -3231,7 +4144,7 @@ func Test_NewKafkaService(t *testing.T) {
 	}
 	g := NewWithT(t)
 	for _, tt := range tests {
-		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.awsConfig, tt.args.quotaServiceFactory, tt.args.awsClientFactory, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy)).To(Equal(tt.want))
+		g.Expect(NewKafkaService(tt.args.connectionFactory, tt.args.clusterService, tt.args.keycloakService, tt.args.kafkaConfig, tt.args.dataplaneClusterConfig, tt.args.quotaServiceFactory, tt.args.dnsProviderFactory, tt.args.certificateService, tt.args.authorizationService, tt.args.providerConfig, tt.args.clusterPlacementStrategy)).To(Equal(tt.want))
 	}
 }
 
@@ -3272,3 +4185,14 @@ func Test_kafkaService_ListKafkasWithRoutesNotCreated(t *testing.T) {
 		})
 	}
 }
+
+// mockKafkaStatusChange mocks the recording of a kafka status change in its history and in the webhooks outbox, which
+// are returned to check if they were triggered, followed by a failure of any other query
+func mockKafkaStatusChange() (recorded *mocket.FakeResponse, published *mocket.FakeResponse) {
+	recorded = mocket.Catcher.NewMock().WithQuery(`INSERT INTO "kafka_events"`)
+	mocket.Catcher.NewMock().WithQuery(`SELECT "id","name","organisation_id","failed_reason" FROM "kafka_requests" WHERE id = $1 LIMIT 1`).
+		WithReply([]map[string]interface{}{{"id": testID, "organisation_id": "org-id"}})
+	published = mocket.Catcher.NewMock().WithQuery(`INSERT INTO "outbox_events"`)
+	mocket.Catcher.NewMock().WithExecException().WithQueryException()
+	return recorded, published
+}
@@ -5,14 +5,15 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/service/route53"
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/kafkas/types"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	managedkafka "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api/managedkafkas.managedkafka.bf2.org/v1"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	serviceError "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
 	"sync"
 )
 
@@ -29,7 +30,7 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			AssignInstanceTypeFunc: func(owner string, organisationID string) (types.KafkaInstanceType, *serviceError.ServiceError) {
 // 				panic("mock out the AssignInstanceType method")
 // 			},
-// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+// 			ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.ChangeInfo, *serviceError.ServiceError) {
 // 				panic("mock out the ChangeKafkaCNAMErecords method")
 // 			},
 // 			CountByRegionAndInstanceTypeFunc: func() ([]KafkaRegionCount, error) {
@@ -47,6 +48,9 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			DeprovisionKafkaForUsersFunc: func(users []string) *serviceError.ServiceError {
 // 				panic("mock out the DeprovisionKafkaForUsers method")
 // 			},
+// 			DeprovisionSuspendedKafkasFunc: func() *serviceError.ServiceError {
+// 				panic("mock out the DeprovisionSuspendedKafkas method")
+// 			},
 // 			GetFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
 // 				panic("mock out the Get method")
 // 			},
@@ -86,6 +90,18 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			RegisterKafkaJobFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the RegisterKafkaJob method")
 // 			},
+// 			RestoreKafkaFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+// 				panic("mock out the RestoreKafka method")
+// 			},
+// 			ResumeKafkaFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+// 				panic("mock out the ResumeKafka method")
+// 			},
+// 			SuspendKafkaFunc: func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+// 				panic("mock out the SuspendKafka method")
+// 			},
+// 			TransferOwnershipFunc: func(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *serviceError.ServiceError) *serviceError.ServiceError {
+// 				panic("mock out the TransferOwnership method")
+// 			},
 // 			UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the Update method")
 // 			},
@@ -101,6 +117,9 @@ var _ KafkaService = &KafkaServiceMock{}
 // 			VerifyAndUpdateKafkaAdminFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 // 				panic("mock out the VerifyAndUpdateKafkaAdmin method")
 // 			},
+// 			VersionedUpdatesFunc: func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
+// 				panic("mock out the VersionedUpdates method")
+// 			},
 // 		}
 //
 // 		// use mockedKafkaService in code that requires KafkaService
@@ -112,7 +131,7 @@ type KafkaServiceMock struct {
 	AssignInstanceTypeFunc func(owner string, organisationID string) (types.KafkaInstanceType, *serviceError.ServiceError)
 
 	// ChangeKafkaCNAMErecordsFunc mocks the ChangeKafkaCNAMErecords method.
-	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError)
+	ChangeKafkaCNAMErecordsFunc func(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.ChangeInfo, *serviceError.ServiceError)
 
 	// CountByRegionAndInstanceTypeFunc mocks the CountByRegionAndInstanceType method.
 	CountByRegionAndInstanceTypeFunc func() ([]KafkaRegionCount, error)
@@ -129,6 +148,9 @@ type KafkaServiceMock struct {
 	// DeprovisionKafkaForUsersFunc mocks the DeprovisionKafkaForUsers method.
 	DeprovisionKafkaForUsersFunc func(users []string) *serviceError.ServiceError
 
+	// DeprovisionSuspendedKafkasFunc mocks the DeprovisionSuspendedKafkas method.
+	DeprovisionSuspendedKafkasFunc func() *serviceError.ServiceError
+
 	// GetFunc mocks the Get method.
 	GetFunc func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
 
@@ -168,6 +190,18 @@ type KafkaServiceMock struct {
 	// RegisterKafkaJobFunc mocks the RegisterKafkaJob method.
 	RegisterKafkaJobFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
+	// RestoreKafkaFunc mocks the RestoreKafka method.
+	RestoreKafkaFunc func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// ResumeKafkaFunc mocks the ResumeKafka method.
+	ResumeKafkaFunc func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// SuspendKafkaFunc mocks the SuspendKafka method.
+	SuspendKafkaFunc func(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError)
+
+	// TransferOwnershipFunc mocks the TransferOwnership method.
+	TransferOwnershipFunc func(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *serviceError.ServiceError) *serviceError.ServiceError
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
@@ -183,6 +217,9 @@ type KafkaServiceMock struct {
 	// VerifyAndUpdateKafkaAdminFunc mocks the VerifyAndUpdateKafkaAdmin method.
 	VerifyAndUpdateKafkaAdminFunc func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError
 
+	// VersionedUpdatesFunc mocks the VersionedUpdates method.
+	VersionedUpdatesFunc func(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AssignInstanceType holds details about calls to the AssignInstanceType method.
@@ -220,6 +257,9 @@ type KafkaServiceMock struct {
 			// Users is the users argument value.
 			Users []string
 		}
+		// DeprovisionSuspendedKafkas holds details about calls to the DeprovisionSuspendedKafkas method.
+		DeprovisionSuspendedKafkas []struct {
+		}
 		// Get holds details about calls to the Get method.
 		Get []struct {
 			// Ctx is the ctx argument value.
@@ -287,6 +327,40 @@ type KafkaServiceMock struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// RestoreKafka holds details about calls to the RestoreKafka method.
+		RestoreKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// ResumeKafka holds details about calls to the ResumeKafka method.
+		ResumeKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// SuspendKafka holds details about calls to the SuspendKafka method.
+		SuspendKafka []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// TransferOwnership holds details about calls to the TransferOwnership method.
+		TransferOwnership []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Owner is the owner argument value.
+			Owner string
+			// OrganisationId is the organisationId argument value.
+			OrganisationId string
+			// OwnerAccountId is the ownerAccountId argument value.
+			OwnerAccountId string
+			// Update is the update argument value.
+			Update func(tx *gorm.DB) *serviceError.ServiceError
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// KafkaRequest is the kafkaRequest argument value.
@@ -324,6 +398,13 @@ type KafkaServiceMock struct {
 			// KafkaRequest is the kafkaRequest argument value.
 			KafkaRequest *dbapi.KafkaRequest
 		}
+		// VersionedUpdates holds details about calls to the VersionedUpdates method.
+		VersionedUpdates []struct {
+			// KafkaRequest is the kafkaRequest argument value.
+			KafkaRequest *dbapi.KafkaRequest
+			// Values is the values argument value.
+			Values map[string]interface{}
+		}
 	}
 	lockAssignInstanceType             sync.RWMutex
 	lockChangeKafkaCNAMErecords        sync.RWMutex
@@ -332,6 +413,7 @@ type KafkaServiceMock struct {
 	lockDelete                         sync.RWMutex
 	lockDeprovisionExpiredKafkas       sync.RWMutex
 	lockDeprovisionKafkaForUsers       sync.RWMutex
+	lockDeprovisionSuspendedKafkas     sync.RWMutex
 	lockGet                            sync.RWMutex
 	lockGetAvailableSizesInRegion      sync.RWMutex
 	lockGetById                        sync.RWMutex
@@ -345,11 +427,16 @@ type KafkaServiceMock struct {
 	lockPrepareKafkaRequest            sync.RWMutex
 	lockRegisterKafkaDeprovisionJob    sync.RWMutex
 	lockRegisterKafkaJob               sync.RWMutex
+	lockRestoreKafka                   sync.RWMutex
+	lockResumeKafka                    sync.RWMutex
+	lockSuspendKafka                   sync.RWMutex
+	lockTransferOwnership              sync.RWMutex
 	lockUpdate                         sync.RWMutex
 	lockUpdateStatus                   sync.RWMutex
 	lockUpdates                        sync.RWMutex
 	lockValidateBillingAccount         sync.RWMutex
 	lockVerifyAndUpdateKafkaAdmin      sync.RWMutex
+	lockVersionedUpdates               sync.RWMutex
 }
 
 // AssignInstanceType calls AssignInstanceTypeFunc.
@@ -388,7 +475,7 @@ func (mock *KafkaServiceMock) AssignInstanceTypeCalls() []struct {
 }
 
 // ChangeKafkaCNAMErecords calls ChangeKafkaCNAMErecordsFunc.
-func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *serviceError.ServiceError) {
+func (mock *KafkaServiceMock) ChangeKafkaCNAMErecords(kafkaRequest *dbapi.KafkaRequest, action KafkaRoutesAction) (*dns.ChangeInfo, *serviceError.ServiceError) {
 	if mock.ChangeKafkaCNAMErecordsFunc == nil {
 		panic("KafkaServiceMock.ChangeKafkaCNAMErecordsFunc: method is nil but KafkaService.ChangeKafkaCNAMErecords was just called")
 	}
@@ -567,6 +654,32 @@ func (mock *KafkaServiceMock) DeprovisionKafkaForUsersCalls() []struct {
 	return calls
 }
 
+// DeprovisionSuspendedKafkas calls DeprovisionSuspendedKafkasFunc.
+func (mock *KafkaServiceMock) DeprovisionSuspendedKafkas() *serviceError.ServiceError {
+	if mock.DeprovisionSuspendedKafkasFunc == nil {
+		panic("KafkaServiceMock.DeprovisionSuspendedKafkasFunc: method is nil but KafkaService.DeprovisionSuspendedKafkas was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockDeprovisionSuspendedKafkas.Lock()
+	mock.calls.DeprovisionSuspendedKafkas = append(mock.calls.DeprovisionSuspendedKafkas, callInfo)
+	mock.lockDeprovisionSuspendedKafkas.Unlock()
+	return mock.DeprovisionSuspendedKafkasFunc()
+}
+
+// DeprovisionSuspendedKafkasCalls gets all the calls that were made to DeprovisionSuspendedKafkas.
+// Check the length with:
+//     len(mockedKafkaService.DeprovisionSuspendedKafkasCalls())
+func (mock *KafkaServiceMock) DeprovisionSuspendedKafkasCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockDeprovisionSuspendedKafkas.RLock()
+	calls = mock.calls.DeprovisionSuspendedKafkas
+	mock.lockDeprovisionSuspendedKafkas.RUnlock()
+	return calls
+}
+
 // Get calls GetFunc.
 func (mock *KafkaServiceMock) Get(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
 	if mock.GetFunc == nil {
@@ -972,6 +1085,158 @@ func (mock *KafkaServiceMock) RegisterKafkaJobCalls() []struct {
 	return calls
 }
 
+// RestoreKafka calls RestoreKafkaFunc.
+func (mock *KafkaServiceMock) RestoreKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.RestoreKafkaFunc == nil {
+		panic("KafkaServiceMock.RestoreKafkaFunc: method is nil but KafkaService.RestoreKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestoreKafka.Lock()
+	mock.calls.RestoreKafka = append(mock.calls.RestoreKafka, callInfo)
+	mock.lockRestoreKafka.Unlock()
+	return mock.RestoreKafkaFunc(ctx, id)
+}
+
+// RestoreKafkaCalls gets all the calls that were made to RestoreKafka.
+// Check the length with:
+//     len(mockedKafkaService.RestoreKafkaCalls())
+func (mock *KafkaServiceMock) RestoreKafkaCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRestoreKafka.RLock()
+	calls = mock.calls.RestoreKafka
+	mock.lockRestoreKafka.RUnlock()
+	return calls
+}
+
+// ResumeKafka calls ResumeKafkaFunc.
+func (mock *KafkaServiceMock) ResumeKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.ResumeKafkaFunc == nil {
+		panic("KafkaServiceMock.ResumeKafkaFunc: method is nil but KafkaService.ResumeKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockResumeKafka.Lock()
+	mock.calls.ResumeKafka = append(mock.calls.ResumeKafka, callInfo)
+	mock.lockResumeKafka.Unlock()
+	return mock.ResumeKafkaFunc(ctx, id)
+}
+
+// ResumeKafkaCalls gets all the calls that were made to ResumeKafka.
+// Check the length with:
+//     len(mockedKafkaService.ResumeKafkaCalls())
+func (mock *KafkaServiceMock) ResumeKafkaCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockResumeKafka.RLock()
+	calls = mock.calls.ResumeKafka
+	mock.lockResumeKafka.RUnlock()
+	return calls
+}
+
+// SuspendKafka calls SuspendKafkaFunc.
+func (mock *KafkaServiceMock) SuspendKafka(ctx context.Context, id string) (*dbapi.KafkaRequest, *serviceError.ServiceError) {
+	if mock.SuspendKafkaFunc == nil {
+		panic("KafkaServiceMock.SuspendKafkaFunc: method is nil but KafkaService.SuspendKafka was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockSuspendKafka.Lock()
+	mock.calls.SuspendKafka = append(mock.calls.SuspendKafka, callInfo)
+	mock.lockSuspendKafka.Unlock()
+	return mock.SuspendKafkaFunc(ctx, id)
+}
+
+// SuspendKafkaCalls gets all the calls that were made to SuspendKafka.
+// Check the length with:
+//     len(mockedKafkaService.SuspendKafkaCalls())
+func (mock *KafkaServiceMock) SuspendKafkaCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockSuspendKafka.RLock()
+	calls = mock.calls.SuspendKafka
+	mock.lockSuspendKafka.RUnlock()
+	return calls
+}
+
+// TransferOwnership calls TransferOwnershipFunc.
+func (mock *KafkaServiceMock) TransferOwnership(kafkaRequest *dbapi.KafkaRequest, owner string, organisationId string, ownerAccountId string, update func(tx *gorm.DB) *serviceError.ServiceError) *serviceError.ServiceError {
+	if mock.TransferOwnershipFunc == nil {
+		panic("KafkaServiceMock.TransferOwnershipFunc: method is nil but KafkaService.TransferOwnership was just called")
+	}
+	callInfo := struct {
+		KafkaRequest   *dbapi.KafkaRequest
+		Owner          string
+		OrganisationId string
+		OwnerAccountId string
+		Update         func(tx *gorm.DB) *serviceError.ServiceError
+	}{
+		KafkaRequest:   kafkaRequest,
+		Owner:          owner,
+		OrganisationId: organisationId,
+		OwnerAccountId: ownerAccountId,
+		Update:         update,
+	}
+	mock.lockTransferOwnership.Lock()
+	mock.calls.TransferOwnership = append(mock.calls.TransferOwnership, callInfo)
+	mock.lockTransferOwnership.Unlock()
+	return mock.TransferOwnershipFunc(kafkaRequest, owner, organisationId, ownerAccountId, update)
+}
+
+// TransferOwnershipCalls gets all the calls that were made to TransferOwnership.
+// Check the length with:
+//     len(mockedKafkaService.TransferOwnershipCalls())
+func (mock *KafkaServiceMock) TransferOwnershipCalls() []struct {
+	KafkaRequest   *dbapi.KafkaRequest
+	Owner          string
+	OrganisationId string
+	OwnerAccountId string
+	Update         func(tx *gorm.DB) *serviceError.ServiceError
+} {
+	var calls []struct {
+		KafkaRequest   *dbapi.KafkaRequest
+		Owner          string
+		OrganisationId string
+		OwnerAccountId string
+		Update         func(tx *gorm.DB) *serviceError.ServiceError
+	}
+	mock.lockTransferOwnership.RLock()
+	calls = mock.calls.TransferOwnership
+	mock.lockTransferOwnership.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *KafkaServiceMock) Update(kafkaRequest *dbapi.KafkaRequest) *serviceError.ServiceError {
 	if mock.UpdateFunc == nil {
@@ -1150,3 +1415,38 @@ func (mock *KafkaServiceMock) VerifyAndUpdateKafkaAdminCalls() []struct {
 	mock.lockVerifyAndUpdateKafkaAdmin.RUnlock()
 	return calls
 }
+
+// VersionedUpdates calls VersionedUpdatesFunc.
+func (mock *KafkaServiceMock) VersionedUpdates(kafkaRequest *dbapi.KafkaRequest, values map[string]interface{}) *serviceError.ServiceError {
+	if mock.VersionedUpdatesFunc == nil {
+		panic("KafkaServiceMock.VersionedUpdatesFunc: method is nil but KafkaService.VersionedUpdates was just called")
+	}
+	callInfo := struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Values       map[string]interface{}
+	}{
+		KafkaRequest: kafkaRequest,
+		Values:       values,
+	}
+	mock.lockVersionedUpdates.Lock()
+	mock.calls.VersionedUpdates = append(mock.calls.VersionedUpdates, callInfo)
+	mock.lockVersionedUpdates.Unlock()
+	return mock.VersionedUpdatesFunc(kafkaRequest, values)
+}
+
+// VersionedUpdatesCalls gets all the calls that were made to VersionedUpdates.
+// Check the length with:
+//     len(mockedKafkaService.VersionedUpdatesCalls())
+func (mock *KafkaServiceMock) VersionedUpdatesCalls() []struct {
+	KafkaRequest *dbapi.KafkaRequest
+	Values       map[string]interface{}
+} {
+	var calls []struct {
+		KafkaRequest *dbapi.KafkaRequest
+		Values       map[string]interface{}
+	}
+	mock.lockVersionedUpdates.RLock()
+	calls = mock.calls.VersionedUpdates
+	mock.lockVersionedUpdates.RUnlock()
+	return calls
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/notification"
+)
+
+// NewNotifier returns the notifier selected in the NotificationConfig
+func NewNotifier(notificationConfig *config.NotificationConfig) notification.Notifier {
+	if notificationConfig.Notifier == config.NotifierSMTP {
+		return notification.NewSMTPNotifier(notificationConfig.SMTPConfig())
+	}
+	return notification.NewWebhookNotifier(notificationConfig.WebhookURL, notificationConfig.WebhookToken, nil)
+}
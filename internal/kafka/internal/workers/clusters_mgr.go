@@ -1,9 +1,11 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"math"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 
 	kafkaConstants "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -114,6 +116,7 @@ type ClusterManagerOptions struct {
 	CloudProvidersService      services.CloudProvidersService
 	KasFleetshardOperatorAddon services.KasFleetshardOperatorAddon
 	OsdIdpKeycloakService      sso.OsdKeycloakService
+	OperationService           operations.OperationService
 }
 
 type processor func() []error
@@ -165,7 +168,7 @@ func (c *ClusterManager) SetIsRunning(val bool) {
 	c.isRunning = val
 }
 
-func (c *ClusterManager) Reconcile() []error {
+func (c *ClusterManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling clusters")
 	var encounteredErrors []error
 
@@ -180,6 +183,7 @@ func (c *ClusterManager) Reconcile() []error {
 		c.processProvisionedClusters,
 		c.processWaitingForKasFleetshardOperatorClusters,
 		c.processReadyClusters,
+		c.processScaleUpOperations,
 	}
 
 	for _, p := range processors {
@@ -206,6 +210,54 @@ func (c *ClusterManager) processMetrics() []error {
 	return []error{}
 }
 
+// processScaleUpOperations completes the scale up operations of the clusters which either have capacity again or
+// can't be scaled up any further
+func (c *ClusterManager) processScaleUpOperations() []error {
+	var errs []error
+	scaleUpOperations, serviceErr := c.OperationService.ListActive(api.OperationKindClusterScaleUp)
+	if serviceErr != nil {
+		errs = append(errs, errors.Wrap(serviceErr, "failed to list active cluster scale up operations"))
+		return errs
+	} else {
+		glog.Infof("active cluster scale up operations count = %d", len(scaleUpOperations))
+	}
+
+	for _, operation := range scaleUpOperations {
+		if err := c.reconcileScaleUpOperation(operation); err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to reconcile scale up operation %s of cluster %s", operation.ID, operation.TargetId))
+		}
+	}
+	return errs
+}
+
+func (c *ClusterManager) reconcileScaleUpOperation(operation *api.Operation) error {
+	cluster, serviceErr := c.ClusterService.FindClusterByID(operation.TargetId)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	// the scale up succeeded once the cluster has capacity again
+	var cause error
+	if cluster == nil {
+		cause = errors.Errorf("cluster %s no longer exists", operation.TargetId)
+	} else {
+		switch cluster.Status {
+		case api.ClusterComputeNodeScalingUp:
+			return nil
+		case api.ClusterReady:
+		case api.ClusterFull:
+			cause = errors.Errorf("cluster %s has reached its maximum number of compute nodes", cluster.ClusterID)
+		default:
+			cause = errors.Errorf("cluster %s is %s", cluster.ClusterID, cluster.Status)
+		}
+	}
+
+	if serviceErr := c.OperationService.Complete(api.OperationKindClusterScaleUp, operation.TargetId, cause); serviceErr != nil {
+		return serviceErr
+	}
+	return nil
+}
+
 func (c *ClusterManager) processDeprovisioningClusters() []error {
 	var errs []error
 	deprovisioningClusters, serviceErr := c.ClusterService.ListByStatus(api.ClusterDeprovisioning)
@@ -441,6 +493,11 @@ func (c *ClusterManager) reconcileReadyCluster(cluster api.Cluster) error {
 		return errors.WithMessagef(err, "failed to reconcile instance type ready cluster %s: %s", cluster.ClusterID, err.Error())
 	}
 
+	err = c.reconcileClusterPrivateKafkaSupport(cluster)
+	if err != nil {
+		return errors.WithMessagef(err, "failed to reconcile private kafka support of ready cluster %s: %s", cluster.ClusterID, err.Error())
+	}
+
 	// resources update if needed
 	if err := c.reconcileClusterResources(cluster); err != nil {
 		return errors.WithMessagef(err, "failed to reconcile ready cluster resources %s ", cluster.ClusterID)
@@ -467,13 +524,14 @@ func (c *ClusterManager) reconcileReadyCluster(cluster api.Cluster) error {
 // reconcileClusterInstanceType checks wether a cluster has an instance type, if not, set to the instance type provided in the manual cluster configuration.
 // If the cluster does not exists, assume the cluster supports both instance types.
 func (c *ClusterManager) reconcileClusterInstanceType(cluster api.Cluster) error {
-	logger.Logger.Infof("reconciling cluster = %s instance type", cluster.ClusterID)
+	log := workers.NewWorkerLogger(c).WithFields(logger.ClusterIDField, cluster.ClusterID)
+	log.Infof("reconciling cluster = %s instance type", cluster.ClusterID)
 	supportedInstanceType := api.AllInstanceTypeSupport.String()
 	manualScalingEnabled := c.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled()
 	if manualScalingEnabled {
-		supportedType, found := c.DataplaneClusterConfig.ClusterConfig.GetClusterSupportedInstanceType(cluster.ClusterID)
+		supportedType, found := c.DataplaneClusterConfig.GetClusterConfig().GetClusterSupportedInstanceType(cluster.ClusterID)
 		if !found && cluster.SupportedInstanceType != "" {
-			logger.Logger.Infof("cluster instance type already set for cluster = %s", cluster.ClusterID)
+			log.Infof("cluster instance type already set for cluster = %s", cluster.ClusterID)
 			return nil
 		} else if found {
 			supportedInstanceType = supportedType
@@ -481,7 +539,7 @@ func (c *ClusterManager) reconcileClusterInstanceType(cluster api.Cluster) error
 	}
 
 	if cluster.SupportedInstanceType != "" && !manualScalingEnabled {
-		logger.Logger.Infof("cluster instance type already set for cluster = %s and scaling type is not manual", cluster.ClusterID)
+		log.Infof("cluster instance type already set for cluster = %s and scaling type is not manual", cluster.ClusterID)
 		return nil
 	}
 
@@ -493,7 +551,27 @@ func (c *ClusterManager) reconcileClusterInstanceType(cluster api.Cluster) error
 		}
 	}
 
-	logger.Logger.Infof("supported instance type for cluster = %s successful updated", cluster.ClusterID)
+	log.Infof("supported instance type for cluster = %s successful updated", cluster.ClusterID)
+	return nil
+}
+
+// reconcileClusterPrivateKafkaSupport sets whether private kafkas can be placed on a cluster as provided in the manual cluster configuration.
+// Clusters missing from the configuration, or registered while auto scaling is enabled, are left unchanged.
+func (c *ClusterManager) reconcileClusterPrivateKafkaSupport(cluster api.Cluster) error {
+	if !c.DataplaneClusterConfig.IsDataPlaneManualScalingEnabled() {
+		return nil
+	}
+
+	supportsPrivateKafka, found := c.DataplaneClusterConfig.GetClusterConfig().GetClusterSupportsPrivateKafka(cluster.ClusterID)
+	if !found || cluster.SupportsPrivateKafka == supportsPrivateKafka {
+		return nil
+	}
+
+	if err := c.ClusterService.UpdateSupportsPrivateKafka(cluster, supportsPrivateKafka); err != nil {
+		return errors.Wrapf(err, "failed to update private kafka support in database for cluster %s", cluster.ClusterID)
+	}
+
+	workers.NewWorkerLogger(c).WithFields(logger.ClusterIDField, cluster.ClusterID).Infof("private kafka support for cluster = %s successfully updated to %t", cluster.ClusterID, supportsPrivateKafka)
 	return nil
 }
 
@@ -715,7 +793,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	//Create all missing clusters
-	for _, p := range c.DataplaneClusterConfig.ClusterConfig.MissingClusters(clusterIdsMap) {
+	for _, p := range c.DataplaneClusterConfig.GetClusterConfig().MissingClusters(clusterIdsMap) {
 		clusterRequest := api.Cluster{
 			CloudProvider:         p.CloudProvider,
 			Region:                p.Region,
@@ -725,6 +803,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 			ProviderType:          p.ProviderType,
 			ClusterDNS:            p.ClusterDNS,
 			SupportedInstanceType: p.SupportedInstanceType,
+			SupportsPrivateKafka:  p.SupportsPrivateKafka,
 		}
 		if err := c.ClusterService.RegisterClusterJob(&clusterRequest); err != nil {
 			return []error{errors.Wrapf(err, "Failed to register new cluster %s with config file", p.ClusterId)}
@@ -734,7 +813,7 @@ func (c *ClusterManager) reconcileClusterWithManualConfig() []error {
 	}
 
 	// Remove all clusters that are not in the config file.
-	excessClusterIds := c.DataplaneClusterConfig.ClusterConfig.ExcessClusters(clusterIdsMap)
+	excessClusterIds := c.DataplaneClusterConfig.GetClusterConfig().ExcessClusters(clusterIdsMap)
 	if len(excessClusterIds) == 0 {
 		return nil
 	}
@@ -1139,7 +1218,7 @@ func (c *ClusterManager) reconcileClusterIdentityProvider(cluster api.Cluster) e
 }
 
 func (c *ClusterManager) setClusterStatusMaxCapacityMetrics() error {
-	for _, cluster := range c.DataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+	for _, cluster := range c.DataplaneClusterConfig.GetClusterConfig().GetManualClusters() {
 		if !cluster.Schedulable {
 			continue
 		}
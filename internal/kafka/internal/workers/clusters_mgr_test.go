@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/observatorium"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/ocm"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
@@ -199,10 +201,15 @@ func TestClusterManager_reconcile(t *testing.T) {
 					ClusterService:         tt.fields.clusterService,
 					DataplaneClusterConfig: tt.fields.dataplaneClusterConfig,
 					SupportedProviders:     tt.fields.supportedProviders,
+					OperationService: &operations.OperationServiceMock{
+						ListActiveFunc: func(kind api.OperationKind) (api.OperationList, *apiErrors.ServiceError) {
+							return api.OperationList{}, nil
+						},
+					},
 				},
 			}
 
-			Expect(len(c.Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(c.Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
@@ -305,6 +312,103 @@ func TestClusterManager_processMetrics(t *testing.T) {
 	}
 }
 
+func TestClusterManager_reconcileScaleUpOperation(t *testing.T) {
+	type fields struct {
+		clusterService services.ClusterService
+	}
+	tests := []struct {
+		name          string
+		fields        fields
+		wantErr       bool
+		wantCompleted bool
+		wantFailed    bool
+	}{
+		{
+			name: "should return an error if FindClusterByID fails in ClusterService",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, apiErrors.GeneralError("failed to find cluster")
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "should not complete the operation while the cluster is scaling up",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID, Status: api.ClusterComputeNodeScalingUp}, nil
+					},
+				},
+			},
+		},
+		{
+			name: "should complete the operation once the cluster is ready",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID, Status: api.ClusterReady}, nil
+					},
+				},
+			},
+			wantCompleted: true,
+		},
+		{
+			name: "should fail the operation if the cluster is full",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return &api.Cluster{ClusterID: clusterID, Status: api.ClusterFull}, nil
+					},
+				},
+			},
+			wantCompleted: true,
+			wantFailed:    true,
+		},
+		{
+			name: "should fail the operation if the cluster no longer exists",
+			fields: fields{
+				clusterService: &services.ClusterServiceMock{
+					FindClusterByIDFunc: func(clusterID string) (*api.Cluster, *apiErrors.ServiceError) {
+						return nil, nil
+					},
+				},
+			},
+			wantCompleted: true,
+			wantFailed:    true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operationService := &operations.OperationServiceMock{
+				CompleteFunc: func(kind api.OperationKind, targetId string, cause error) *apiErrors.ServiceError {
+					return nil
+				},
+			}
+			c := &ClusterManager{
+				ClusterManagerOptions: ClusterManagerOptions{
+					ClusterService:   tt.fields.clusterService,
+					OperationService: operationService,
+				},
+			}
+
+			err := c.reconcileScaleUpOperation(&api.Operation{Kind: api.OperationKindClusterScaleUp.String(), TargetId: "cluster-id"})
+			Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantCompleted {
+				Expect(operationService.CompleteCalls()).To(BeEmpty())
+				return
+			}
+			Expect(operationService.CompleteCalls()).To(HaveLen(1))
+			Expect(operationService.CompleteCalls()[0].Cause != nil).To(Equal(tt.wantFailed))
+		})
+	}
+}
+
 func TestClusterManager_processDeprovisioningClusters(t *testing.T) {
 	type fields struct {
 		clusterService         services.ClusterService
@@ -3369,6 +3473,90 @@ func TestClusterManager_reconcileClusterInstanceType(t *testing.T) {
 	}
 }
 
+func TestClusterManager_reconcileClusterPrivateKafkaSupport(t *testing.T) {
+	manualCluster := dpMock.BuildManualCluster(supportedInstanceType)
+	manualCluster.SupportsPrivateKafka = true
+	testOsdConfig := config.NewDataplaneClusterConfig()
+	testOsdConfig.ClusterConfig = config.NewClusterConfig(config.ClusterList{manualCluster})
+	noScalingDataplaneClusterConfig := config.DataplaneClusterConfig{
+		DataPlaneClusterScalingType: config.NoScaling,
+	}
+	tests := []struct {
+		name                   string
+		clusterService         services.ClusterService
+		dataplaneClusterConfig *config.DataplaneClusterConfig
+		cluster                api.Cluster
+		wantErr                bool
+	}{
+		{
+			name: "Do not update the cluster when scaling type is not manual",
+			clusterService: &services.ClusterServiceMock{
+				UpdateSupportsPrivateKafkaFunc: nil, // should not be called
+			},
+			dataplaneClusterConfig: &noScalingDataplaneClusterConfig,
+			cluster:                api.Cluster{ClusterID: manualCluster.ClusterId},
+			wantErr:                false,
+		},
+		{
+			name: "Do not update the cluster when it is not found in manual configuration",
+			clusterService: &services.ClusterServiceMock{
+				UpdateSupportsPrivateKafkaFunc: nil, // should not be called
+			},
+			dataplaneClusterConfig: testOsdConfig,
+			cluster:                api.Cluster{ClusterID: "another-cluster-id"},
+			wantErr:                false,
+		},
+		{
+			name: "Do not update the cluster when private kafka support has not changed",
+			clusterService: &services.ClusterServiceMock{
+				UpdateSupportsPrivateKafkaFunc: nil, // should not be called
+			},
+			dataplaneClusterConfig: testOsdConfig,
+			cluster:                api.Cluster{ClusterID: manualCluster.ClusterId, SupportsPrivateKafka: true},
+			wantErr:                false,
+		},
+		{
+			name: "Update private kafka support of the cluster to the one set in manual cluster configuration",
+			clusterService: &services.ClusterServiceMock{
+				UpdateSupportsPrivateKafkaFunc: func(cluster api.Cluster, supportsPrivateKafka bool) *apiErrors.ServiceError {
+					if !supportsPrivateKafka {
+						return &apiErrors.ServiceError{}
+					}
+					return nil
+				},
+			},
+			dataplaneClusterConfig: testOsdConfig,
+			cluster:                api.Cluster{ClusterID: manualCluster.ClusterId},
+			wantErr:                false,
+		},
+		{
+			name: "Throw an error when update in database fails",
+			clusterService: &services.ClusterServiceMock{
+				UpdateSupportsPrivateKafkaFunc: func(cluster api.Cluster, supportsPrivateKafka bool) *apiErrors.ServiceError {
+					return &apiErrors.ServiceError{}
+				},
+			},
+			dataplaneClusterConfig: testOsdConfig,
+			cluster:                api.Cluster{ClusterID: manualCluster.ClusterId},
+			wantErr:                true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ClusterManager{
+				ClusterManagerOptions: ClusterManagerOptions{
+					DataplaneClusterConfig: tt.dataplaneClusterConfig,
+					ClusterService:         tt.clusterService,
+				},
+			}
+			Expect(c.reconcileClusterPrivateKafkaSupport(tt.cluster) != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
 func TestClusterManager_setClusterStatusMaxCapacityMetrics(t *testing.T) {
 	type fields struct {
 		dataplaneClusterConfig *config.DataplaneClusterConfig
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/google/uuid"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/pkg/errors"
@@ -54,7 +56,7 @@ func (k *AcceptedKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *AcceptedKafkaManager) Reconcile() []error {
+func (k *AcceptedKafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling accepted kafkas")
 	var encounteredErrors []error
 
@@ -67,7 +69,7 @@ func (k *AcceptedKafkaManager) Reconcile() []error {
 	}
 
 	for _, kafka := range acceptedKafkas {
-		glog.V(10).Infof("accepted kafka id = %s", kafka.ID)
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("accepted kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusAccepted, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 		if err := k.reconcileAcceptedKafka(kafka); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile accepted kafka %s", kafka.ID))
@@ -92,7 +94,7 @@ func (k *AcceptedKafkaManager) reconcileAcceptedKafka(kafka *dbapi.KafkaRequest)
 		// until the max retry duration is reached before updating its status to 'failed'.
 		durationSinceCreation := time.Since(kafka.CreatedAt)
 		if durationSinceCreation < constants2.AcceptedKafkaMaxRetryDuration {
-			glog.V(10).Infof("No available strimzi version found for Kafka '%s' in Cluster ID '%s'", kafka.ID, kafka.ClusterID)
+			workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID, logger.ClusterIDField, kafka.ClusterID).V(10).Infof("No available strimzi version found for Kafka '%s' in Cluster ID '%s'", kafka.ID, kafka.ClusterID)
 			return nil
 		}
 		kafka.Status = constants2.KafkaRequestStatusFailed.String()
@@ -122,7 +124,7 @@ func (k *AcceptedKafkaManager) reconcileAcceptedKafka(kafka *dbapi.KafkaRequest)
 	}
 	kafka.DesiredKafkaIBPVersion = selectedStrimziVersion.KafkaIBPVersions[len(selectedStrimziVersion.KafkaIBPVersions)-1].Version
 
-	glog.Infof("Kafka instance with id %s is assigned to cluster with id %s", kafka.ID, kafka.ClusterID)
+	workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID, logger.ClusterIDField, kafka.ClusterID).Infof("Kafka instance with id %s is assigned to cluster with id %s", kafka.ID, kafka.ClusterID)
 	kafka.Status = constants2.KafkaRequestStatusPreparing.String()
 	if err2 := k.kafkaService.Update(kafka); err2 != nil {
 		return errors.Wrapf(err2, "failed to update kafka %s with cluster details", kafka.ID)
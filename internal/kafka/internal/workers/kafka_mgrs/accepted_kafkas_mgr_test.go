@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -120,7 +121,7 @@ func TestAcceptedKafkaManager_Reconcile(t *testing.T) {
 				config.NewDataplaneClusterConfig(),
 				tt.fields.clusterService,
 				w.Reconciler{})
-			Expect(len(k.Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(k.Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
@@ -1,10 +1,13 @@
 package kafka_mgrs
 
 import (
+	"context"
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -14,16 +17,21 @@ import (
 	"github.com/golang/glog"
 )
 
+// deletingKafkaProgress is the progress of the deletion of a kafka removed from its data plane cluster, whose
+// remaining dependencies are being cleaned up
+const deletingKafkaProgress = 50
+
 // DeletingKafkaManager represents a kafka manager that periodically reconciles deleting and deprovision kafka requests.
 type DeletingKafkaManager struct {
 	workers.BaseWorker
 	kafkaService        services.KafkaService
 	keycloakConfig      *keycloak.KeycloakConfig
 	quotaServiceFactory services.QuotaServiceFactory
+	operationService    operations.OperationService
 }
 
 // NewDeletingKafkaManager creates a new kafka manager to reconcile deleting and deprovision kafkas.
-func NewDeletingKafkaManager(kafkaService services.KafkaService, keycloakConfig *keycloak.KeycloakConfig, quotaServiceFactory services.QuotaServiceFactory, reconciler workers.Reconciler) *DeletingKafkaManager {
+func NewDeletingKafkaManager(kafkaService services.KafkaService, keycloakConfig *keycloak.KeycloakConfig, quotaServiceFactory services.QuotaServiceFactory, operationService operations.OperationService, reconciler workers.Reconciler) *DeletingKafkaManager {
 	return &DeletingKafkaManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
@@ -33,6 +41,7 @@ func NewDeletingKafkaManager(kafkaService services.KafkaService, keycloakConfig
 		kafkaService:        kafkaService,
 		keycloakConfig:      keycloakConfig,
 		quotaServiceFactory: quotaServiceFactory,
+		operationService:    operationService,
 	}
 }
 
@@ -46,10 +55,15 @@ func (k *DeletingKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *DeletingKafkaManager) Reconcile() []error {
+func (k *DeletingKafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling deleting kafkas")
 	var encounteredErrors []error
 
+	// suspended kafkas are only deprovisioned, and thus have their dependencies cleaned up, once their deletion grace period is over
+	if serviceErr := k.kafkaService.DeprovisionSuspendedKafkas(); serviceErr != nil {
+		encounteredErrors = append(encounteredErrors, errors.Wrap(serviceErr, "failed to deprovision suspended kafka requests"))
+	}
+
 	// handle deleting kafka requests.
 	// Kafkas in a "deleting" state have been removed, along with all their resources (i.e. ManagedKafka, Kafka CRs),
 	// from the data plane cluster by the KAS Fleetshard operator. This reconcile phase ensures that any other
@@ -82,11 +96,18 @@ func (k *DeletingKafkaManager) Reconcile() []error {
 	glog.Infof("An additional of kafkas count = %d which are marked for removal before being provisioned will also be deleted", len(deletingKafkas)-originalTotalKafkaInDeleting)
 
 	for _, kafka := range deletingKafkas {
-		glog.V(10).Infof("deleting kafka id = %s", kafka.ID)
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("deleting kafka id = %s", kafka.ID)
 		if err := k.reconcileDeletingKafkas(kafka); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile deleting kafka request %s", kafka.ID))
+			// the deletion is retried on the next reconcile, the operation tracking it reports the error meanwhile
+			if opErr := k.operationService.UpdateProgress(api.OperationKindKafkaDeletion, kafka.ID, deletingKafkaProgress, err); opErr != nil {
+				encounteredErrors = append(encounteredErrors, errors.Wrapf(opErr, "failed to update the deletion operation of kafka %s", kafka.ID))
+			}
 			continue
 		}
+		if opErr := k.operationService.Complete(api.OperationKindKafkaDeletion, kafka.ID, nil); opErr != nil {
+			encounteredErrors = append(encounteredErrors, errors.Wrapf(opErr, "failed to complete the deletion operation of kafka %s", kafka.ID))
+		}
 	}
 
 	return encounteredErrors
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -12,6 +13,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
 	. "github.com/onsi/gomega"
@@ -32,6 +34,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should fail if listing kafkas in the reconciler fails",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionSuspendedKafkasFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return nil, errors.GeneralError("fail to list kafka requests")
 					},
@@ -39,10 +44,27 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail if deprovisioning suspended kafkas fails",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					DeprovisionSuspendedKafkasFunc: func() *errors.ServiceError {
+						return errors.GeneralError("failed to deprovision suspended kafkas")
+					},
+					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{}, nil
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should not fail if listing kafkas returns an empty list",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionSuspendedKafkasFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{}, nil
 					},
@@ -54,6 +76,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and fail if an error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionSuspendedKafkasFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
@@ -81,6 +106,9 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 			name: "Should call reconcileDeletingKafkas and not fail if no error is returned",
 			fields: fields{
 				kafkaService: &services.KafkaServiceMock{
+					DeprovisionSuspendedKafkasFunc: func() *errors.ServiceError {
+						return nil
+					},
 					ListByStatusFunc: func(status ...constants2.KafkaStatus) ([]*dbapi.KafkaRequest, *errors.ServiceError) {
 						return []*dbapi.KafkaRequest{
 							mockKafkas.BuildKafkaRequest(
@@ -121,8 +149,16 @@ func TestDeletingKafkaManager_Reconcile(t *testing.T) {
 						return tt.fields.quotaService, nil
 					},
 				},
+				&operations.OperationServiceMock{
+					UpdateProgressFunc: func(kind api.OperationKind, targetId string, progress int32, cause error) *errors.ServiceError {
+						return nil
+					},
+					CompleteFunc: func(kind api.OperationKind, targetId string, cause error) *errors.ServiceError {
+						return nil
+					},
+				},
 				w.Reconciler{})
-			Expect(len(k.Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(k.Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
@@ -0,0 +1,79 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// certificateIssueTimeout bounds the issuance of a single certificate, including the DNS-01 challenges propagation
+const certificateIssueTimeout = 5 * time.Minute
+
+// KafkaCertificateManager issues the TLS certificate of each Kafka instance and renews it before it expires
+type KafkaCertificateManager struct {
+	workers.BaseWorker
+	certificateService services.KafkaCertificateService
+	acmeConfig         *config.ACMEConfig
+}
+
+var _ workers.Worker = &KafkaCertificateManager{}
+
+func NewKafkaCertificateManager(certificateService services.KafkaCertificateService, acmeConfig *config.ACMEConfig, reconciler workers.Reconciler) *KafkaCertificateManager {
+	return &KafkaCertificateManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_certificates",
+			Reconciler: reconciler,
+		},
+		certificateService: certificateService,
+		acmeConfig:         acmeConfig,
+	}
+}
+
+func (k *KafkaCertificateManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaCertificateManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaCertificateManager) Reconcile(ctx context.Context) []error {
+	if !k.acmeConfig.EnableKafkaACMECertificates {
+		glog.Infoln("ACME certificates are disabled, skip reconciling kafka certificates")
+		return nil
+	}
+
+	glog.Infoln("reconciling certificates for kafkas")
+	var errs []error
+
+	if err := k.certificateService.DeleteOrphaned(); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to delete certificates of deleted kafkas"))
+	}
+
+	kafkas, listErr := k.certificateService.ListKafkasRequiringCertificate()
+	if listErr != nil {
+		errs = append(errs, errors.Wrap(listErr, "failed to list kafkas requiring a certificate"))
+	} else {
+		glog.Infof("kafkas requiring a certificate count = %d", len(kafkas))
+	}
+
+	for _, kafka := range kafkas {
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).Infof("issuing certificate for kafka %s", kafka.ID)
+		ctx, cancel := context.WithTimeout(ctx, certificateIssueTimeout)
+		err := k.certificateService.Issue(ctx, kafka)
+		cancel()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
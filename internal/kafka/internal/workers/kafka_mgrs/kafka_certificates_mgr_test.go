@@ -0,0 +1,99 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	. "github.com/onsi/gomega"
+
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+)
+
+func TestKafkaCertificateManager_Reconcile(t *testing.T) {
+	type fields struct {
+		certificateService *services.KafkaCertificateServiceMock
+		acmeConfig         *config.ACMEConfig
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		wantErr    bool
+		wantIssued int
+	}{
+		{
+			name: "should do nothing when ACME certificates are disabled",
+			fields: fields{
+				certificateService: &services.KafkaCertificateServiceMock{},
+				acmeConfig:         &config.ACMEConfig{},
+			},
+		},
+		{
+			name: "should issue certificates of kafkas requiring one",
+			fields: fields{
+				certificateService: &services.KafkaCertificateServiceMock{
+					DeleteOrphanedFunc: func() *errors.ServiceError {
+						return nil
+					},
+					ListKafkasRequiringCertificateFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{mockKafkas.BuildKafkaRequest(), mockKafkas.BuildKafkaRequest()}, nil
+					},
+					IssueFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return nil
+					},
+				},
+				acmeConfig: &config.ACMEConfig{EnableKafkaACMECertificates: true},
+			},
+			wantIssued: 2,
+		},
+		{
+			name: "should keep issuing certificates when one fails",
+			fields: fields{
+				certificateService: &services.KafkaCertificateServiceMock{
+					DeleteOrphanedFunc: func() *errors.ServiceError {
+						return nil
+					},
+					ListKafkasRequiringCertificateFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{mockKafkas.BuildKafkaRequest(), mockKafkas.BuildKafkaRequest()}, nil
+					},
+					IssueFunc: func(ctx context.Context, kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						return errors.GeneralError("failed to issue certificate")
+					},
+				},
+				acmeConfig: &config.ACMEConfig{EnableKafkaACMECertificates: true},
+			},
+			wantErr:    true,
+			wantIssued: 2,
+		},
+		{
+			name: "should fail when kafkas requiring a certificate cannot be listed",
+			fields: fields{
+				certificateService: &services.KafkaCertificateServiceMock{
+					DeleteOrphanedFunc: func() *errors.ServiceError {
+						return errors.GeneralError("failed to delete certificates")
+					},
+					ListKafkasRequiringCertificateFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return nil, errors.GeneralError("failed to list kafkas")
+					},
+				},
+				acmeConfig: &config.ACMEConfig{EnableKafkaACMECertificates: true},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := NewKafkaCertificateManager(tt.fields.certificateService, tt.fields.acmeConfig, w.Reconciler{}).Reconcile(context.Background())
+			g.Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			g.Expect(tt.fields.certificateService.IssueCalls()).To(HaveLen(tt.wantIssued))
+		})
+	}
+}
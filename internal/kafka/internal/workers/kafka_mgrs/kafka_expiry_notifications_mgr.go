@@ -0,0 +1,73 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// expiryNotificationTimeout bounds the delivery of a single expiry notification
+const expiryNotificationTimeout = 30 * time.Second
+
+// KafkaExpiryNotificationManager notifies the owners of kafkas with a lifespan before their kafka expires
+type KafkaExpiryNotificationManager struct {
+	workers.BaseWorker
+	kafkaExpiryService services.KafkaExpiryService
+	kafkaConfig        *config.KafkaConfig
+}
+
+var _ workers.Worker = &KafkaExpiryNotificationManager{}
+
+func NewKafkaExpiryNotificationManager(kafkaExpiryService services.KafkaExpiryService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *KafkaExpiryNotificationManager {
+	return &KafkaExpiryNotificationManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "kafka_expiry_notifications",
+			Reconciler: reconciler,
+		},
+		kafkaExpiryService: kafkaExpiryService,
+		kafkaConfig:        kafkaConfig,
+	}
+}
+
+func (k *KafkaExpiryNotificationManager) Start() {
+	k.StartWorker(k)
+}
+
+func (k *KafkaExpiryNotificationManager) Stop() {
+	k.StopWorker(k)
+}
+
+func (k *KafkaExpiryNotificationManager) Reconcile(ctx context.Context) []error {
+	if !k.kafkaConfig.KafkaLifespan.EnableExpiryNotifications {
+		glog.Infoln("kafka expiry notifications are disabled, skip reconciling kafka expiry notifications")
+		return nil
+	}
+
+	glog.Infoln("reconciling kafka expiry notifications")
+	notices, err := k.kafkaExpiryService.ListExpiryNoticesDue()
+	if err != nil {
+		return []error{errors.Wrap(err, "failed to list kafka expiry notifications due")}
+	}
+	glog.Infof("kafka expiry notifications due count = %d", len(notices))
+
+	var errs []error
+	for _, notice := range notices {
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, notice.Kafka.ID).Infof("notifying owner of kafka %s that it expires at %s", notice.Kafka.ID, notice.ExpiresAt)
+		ctx, cancel := context.WithTimeout(ctx, expiryNotificationTimeout)
+		err := k.kafkaExpiryService.Notify(ctx, notice)
+		cancel()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
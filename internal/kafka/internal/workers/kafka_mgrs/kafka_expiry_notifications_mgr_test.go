@@ -0,0 +1,89 @@
+package kafka_mgrs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+
+	. "github.com/onsi/gomega"
+
+	mockKafkas "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/test/mocks/kafkas"
+)
+
+func TestKafkaExpiryNotificationManager_Reconcile(t *testing.T) {
+	notices := []services.KafkaExpiryNotice{
+		{Kafka: mockKafkas.BuildKafkaRequest(), ExpiresAt: time.Now().Add(time.Hour), Threshold: time.Hour},
+		{Kafka: mockKafkas.BuildKafkaRequest(), ExpiresAt: time.Now().Add(10 * time.Hour), Threshold: 24 * time.Hour},
+	}
+	enabled := func() *config.KafkaConfig {
+		kafkaConfig := config.NewKafkaConfig()
+		kafkaConfig.KafkaLifespan.EnableExpiryNotifications = true
+		return kafkaConfig
+	}
+
+	tests := []struct {
+		name         string
+		expiry       *services.KafkaExpiryServiceMock
+		kafkaConfig  *config.KafkaConfig
+		wantErr      bool
+		wantNotified int
+	}{
+		{
+			name:        "should do nothing when expiry notifications are disabled",
+			expiry:      &services.KafkaExpiryServiceMock{},
+			kafkaConfig: config.NewKafkaConfig(),
+		},
+		{
+			name: "should notify each notice due",
+			expiry: &services.KafkaExpiryServiceMock{
+				ListExpiryNoticesDueFunc: func() ([]services.KafkaExpiryNotice, *errors.ServiceError) {
+					return notices, nil
+				},
+				NotifyFunc: func(ctx context.Context, notice services.KafkaExpiryNotice) *errors.ServiceError {
+					return nil
+				},
+			},
+			kafkaConfig:  enabled(),
+			wantNotified: 2,
+		},
+		{
+			name: "should keep notifying when a notification fails",
+			expiry: &services.KafkaExpiryServiceMock{
+				ListExpiryNoticesDueFunc: func() ([]services.KafkaExpiryNotice, *errors.ServiceError) {
+					return notices, nil
+				},
+				NotifyFunc: func(ctx context.Context, notice services.KafkaExpiryNotice) *errors.ServiceError {
+					return errors.GeneralError("failed to notify")
+				},
+			},
+			kafkaConfig:  enabled(),
+			wantErr:      true,
+			wantNotified: 2,
+		},
+		{
+			name: "should fail when the notices cannot be listed",
+			expiry: &services.KafkaExpiryServiceMock{
+				ListExpiryNoticesDueFunc: func() ([]services.KafkaExpiryNotice, *errors.ServiceError) {
+					return nil, errors.GeneralError("failed to list kafkas")
+				},
+			},
+			kafkaConfig: enabled(),
+			wantErr:     true,
+		},
+	}
+
+	for _, testcase := range tests {
+		tt := testcase
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := NewKafkaExpiryNotificationManager(tt.expiry, tt.kafkaConfig, w.Reconciler{}).Reconcile(context.Background())
+			g.Expect(len(errs) > 0).To(Equal(tt.wantErr))
+			g.Expect(tt.expiry.NotifyCalls()).To(HaveLen(tt.wantNotified))
+		})
+	}
+}
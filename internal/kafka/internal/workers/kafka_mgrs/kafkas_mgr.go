@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"math"
 	"strings"
 
@@ -22,6 +23,9 @@ var kafkaMetricsStatuses = []constants2.KafkaStatus{
 	constants2.KafkaRequestStatusPreparing,
 	constants2.KafkaRequestStatusProvisioning,
 	constants2.KafkaRequestStatusReady,
+	constants2.KafkaRequestStatusSuspending,
+	constants2.KafkaRequestStatusSuspended,
+	constants2.KafkaRequestStatusResuming,
 	constants2.KafkaRequestStatusDeprovision,
 	constants2.KafkaRequestStatusDeleting,
 	constants2.KafkaRequestStatusFailed,
@@ -63,7 +67,7 @@ func (k *KafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *KafkaManager) Reconcile() []error {
+func (k *KafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling kafkas")
 	var encounteredErrors []error
 
@@ -83,9 +87,10 @@ func (k *KafkaManager) Reconcile() []error {
 	accessControlListConfig := k.accessControlListConfig
 	if accessControlListConfig.EnableDenyList {
 		glog.Infoln("reconciling denied kafka owners")
-		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(accessControlListConfig.DenyList)
+		denyList := accessControlListConfig.GetDenyList()
+		kafkaDeprovisioningForDeniedOwnersErr := k.reconcileDeniedKafkaOwners(denyList)
 		if kafkaDeprovisioningForDeniedOwnersErr != nil {
-			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "Failed to deprovision kafka for denied owners %s", accessControlListConfig.DenyList)
+			wrappedError := errors.Wrapf(kafkaDeprovisioningForDeniedOwnersErr, "Failed to deprovision kafka for denied owners %s", denyList)
 			encounteredErrors = append(encounteredErrors, wrappedError)
 		}
 	}
@@ -168,7 +173,7 @@ func (k *KafkaManager) calculateAvailableCapacityByRegionAndInstanceType(kafkasB
 		return totalUsed, instanceTypeUsed
 	}
 
-	for _, cluster := range k.dataplaneClusterConfig.ClusterConfig.GetManualClusters() {
+	for _, cluster := range k.dataplaneClusterConfig.GetClusterConfig().GetManualClusters() {
 		if !cluster.Schedulable {
 			continue
 		}
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -105,7 +106,7 @@ func TestKafkaManager_Reconcile(t *testing.T) {
 				kafkaConfig:             &tt.fields.kafkaConfig,
 			}
 
-			Expect(len(k.Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(k.Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
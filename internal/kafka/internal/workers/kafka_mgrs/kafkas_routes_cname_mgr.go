@@ -1,8 +1,12 @@
 package kafka_mgrs
 
 import (
+	"context"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/golang/glog"
 	"github.com/google/uuid"
@@ -11,21 +15,25 @@ import (
 
 type KafkaRoutesCNAMEManager struct {
 	workers.BaseWorker
-	kafkaService services.KafkaService
-	kafkaConfig  *config.KafkaConfig
+	kafkaService      services.KafkaService
+	kafkaEventService services.KafkaEventService
+	pairService       services.KafkaReplicatedPairService
+	kafkaConfig       *config.KafkaConfig
 }
 
 var _ workers.Worker = &KafkaRoutesCNAMEManager{}
 
-func NewKafkaCNAMEManager(kafkaService services.KafkaService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *KafkaRoutesCNAMEManager {
+func NewKafkaCNAMEManager(kafkaService services.KafkaService, kafkaEventService services.KafkaEventService, pairService services.KafkaReplicatedPairService, kafkaConfig *config.KafkaConfig, reconciler workers.Reconciler) *KafkaRoutesCNAMEManager {
 	return &KafkaRoutesCNAMEManager{
 		BaseWorker: workers.BaseWorker{
 			Id:         uuid.New().String(),
 			WorkerType: "kafka_dns",
 			Reconciler: reconciler,
 		},
-		kafkaService: kafkaService,
-		kafkaConfig:  kafkaConfig,
+		kafkaService:      kafkaService,
+		kafkaEventService: kafkaEventService,
+		pairService:       pairService,
+		kafkaConfig:       kafkaConfig,
 	}
 }
 
@@ -37,7 +45,7 @@ func (k *KafkaRoutesCNAMEManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
+func (k *KafkaRoutesCNAMEManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling DNS for kafkas")
 	var errs []error
 
@@ -49,9 +57,12 @@ func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
 	}
 
 	for _, kafka := range kafkas {
-		if k.kafkaConfig.EnableKafkaExternalCertificate {
+		if kafka.Private {
+			workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).Infof("kafka %s is private, skip CNAME creation", kafka.ID)
+			kafka.RoutesCreated = true
+		} else if k.kafkaConfig.EnableKafkaExternalCertificate {
 			if kafka.RoutesCreationId == "" {
-				glog.Infof("creating CNAME records for kafka %s", kafka.ID)
+				workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).Infof("creating CNAME records for kafka %s", kafka.ID)
 
 				changeOutput, err := k.kafkaService.ChangeKafkaCNAMErecords(kafka, services.KafkaRoutesActionCreate)
 
@@ -60,18 +71,18 @@ func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
 					continue
 				}
 
-				kafka.RoutesCreationId = *changeOutput.ChangeInfo.Id
-				kafka.RoutesCreated = *changeOutput.ChangeInfo.Status == "INSYNC"
+				kafka.RoutesCreationId = changeOutput.Id
+				kafka.RoutesCreated = changeOutput.Status == dns.ChangeStatusInSync
 			} else {
 				recordStatus, err := k.kafkaService.GetCNAMERecordStatus(kafka)
 				if err != nil {
 					errs = append(errs, err)
 					continue
 				}
-				kafka.RoutesCreated = *recordStatus.Status == "INSYNC"
+				kafka.RoutesCreated = *recordStatus.Status == string(dns.ChangeStatusInSync)
 			}
 		} else {
-			glog.Infof("external certificate is disabled, skip CNAME creation for Kafka %s", kafka.ID)
+			workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).Infof("external certificate is disabled, skip CNAME creation for Kafka %s", kafka.ID)
 			kafka.RoutesCreated = true
 		}
 
@@ -79,6 +90,37 @@ func (k *KafkaRoutesCNAMEManager) Reconcile() []error {
 			errs = append(errs, err)
 			continue
 		}
+
+		if kafka.RoutesCreated {
+			k.kafkaEventService.Record(&dbapi.KafkaEvent{
+				KafkaID: kafka.ID,
+				Type:    dbapi.KafkaEventTypeRoutesCreated.String(),
+				Status:  kafka.Status,
+			})
+		}
+	}
+
+	if k.kafkaConfig.EnableKafkaExternalCertificate {
+		errs = append(errs, k.reconcileReplicatedPairAliases()...)
+	}
+
+	return errs
+}
+
+// reconcileReplicatedPairAliases points the alias DNS record of the replicated pairs to their primary, which changes on failover
+func (k *KafkaRoutesCNAMEManager) reconcileReplicatedPairAliases() []error {
+	var errs []error
+
+	pairs, listErr := k.pairService.ListWithAliasNotInSync()
+	if listErr != nil {
+		return append(errs, errors.Wrap(listErr, "failed to list replicated pairs whose alias is not in sync"))
+	}
+	glog.Infof("replicated pairs need alias updated count = %d", len(pairs))
+
+	for _, pair := range pairs {
+		if err := k.pairService.UpdateAlias(pair); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	return errs
@@ -1,12 +1,13 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/config"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	w "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 
@@ -17,10 +18,11 @@ import (
 
 func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 	testChangeID := "1234"
-	testChangeINSYNC := route53.ChangeStatusInsync
+	testChangeINSYNC := string(dns.ChangeStatusInSync)
 
 	type fields struct {
 		kafkaService services.KafkaService
+		pairService  services.KafkaReplicatedPairService
 		kafkaConfig  *config.KafkaConfig
 	}
 	tests := []struct {
@@ -39,12 +41,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
+						return &dns.ChangeInfo{
+							Id:     testChangeID,
+							Status: dns.ChangeStatusInSync,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -67,12 +67,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
+						return &dns.ChangeInfo{
+							Id:     testChangeID,
+							Status: dns.ChangeStatusInSync,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -100,12 +98,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
+						return &dns.ChangeInfo{
+							Id:     testChangeID,
+							Status: dns.ChangeStatusInSync,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -138,6 +134,29 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "should skip route creation for private kafkas",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					ListKafkasWithRoutesNotCreatedFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{
+							mockKafkas.BuildKafkaRequest(func(kafkaRequest *dbapi.KafkaRequest) {
+								kafkaRequest.RoutesCreated = false
+								kafkaRequest.Private = true
+							}),
+						}, nil
+					},
+					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
+						if !kafkaRequest.RoutesCreated {
+							return errors.GeneralError("routes of private kafka should be marked as created")
+						}
+						return nil
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{EnableKafkaExternalCertificate: true},
+			},
+			wantErr: false,
+		},
 		{
 			name: "should fail if kafka update fails",
 			fields: fields{
@@ -149,12 +168,10 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
-						return &route53.ChangeResourceRecordSetsOutput{
-							ChangeInfo: &route53.ChangeInfo{
-								Id:     &testChangeID,
-								Status: &testChangeINSYNC,
-							},
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
+						return &dns.ChangeInfo{
+							Id:     testChangeID,
+							Status: dns.ChangeStatusInSync,
 						}, nil
 					},
 					UpdateFunc: func(kafkaRequest *dbapi.KafkaRequest) *errors.ServiceError {
@@ -188,7 +205,7 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 							}),
 						}, nil
 					},
-					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*route53.ChangeResourceRecordSetsOutput, *errors.ServiceError) {
+					ChangeKafkaCNAMErecordsFunc: func(kafkaRequest *dbapi.KafkaRequest, action services.KafkaRoutesAction) (*dns.ChangeInfo, *errors.ServiceError) {
 						return nil, errors.GeneralError("failed to create CNAME")
 					},
 				},
@@ -196,14 +213,45 @@ func TestKafkaRoutesCNAMEManager_Reconcile(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "should return error when updating the alias of a replicated pair failed",
+			fields: fields{
+				kafkaService: &services.KafkaServiceMock{
+					ListKafkasWithRoutesNotCreatedFunc: func() ([]*dbapi.KafkaRequest, *errors.ServiceError) {
+						return []*dbapi.KafkaRequest{}, nil
+					},
+				},
+				pairService: &services.KafkaReplicatedPairServiceMock{
+					ListWithAliasNotInSyncFunc: func() (dbapi.KafkaReplicatedPairList, *errors.ServiceError) {
+						return dbapi.KafkaReplicatedPairList{{PrimaryKafkaID: "primary", AliasKafkaID: "secondary"}}, nil
+					},
+					UpdateAliasFunc: func(pair *dbapi.KafkaReplicatedPair) *errors.ServiceError {
+						return errors.GeneralError("failed to update alias")
+					},
+				},
+				kafkaConfig: &config.KafkaConfig{EnableKafkaExternalCertificate: true},
+			},
+			wantErr: true,
+		},
 	}
 
 	RegisterTestingT(t)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			Expect(len(NewKafkaCNAMEManager(test.fields.kafkaService,
-				test.fields.kafkaConfig, w.Reconciler{}).Reconcile()) > 0).To(Equal(test.wantErr))
+			kafkaEventService := &services.KafkaEventServiceMock{
+				RecordFunc: func(event *dbapi.KafkaEvent) {},
+			}
+			pairService := test.fields.pairService
+			if pairService == nil {
+				pairService = &services.KafkaReplicatedPairServiceMock{
+					ListWithAliasNotInSyncFunc: func() (dbapi.KafkaReplicatedPairList, *errors.ServiceError) {
+						return dbapi.KafkaReplicatedPairList{}, nil
+					},
+				}
+			}
+			Expect(len(NewKafkaCNAMEManager(test.fields.kafkaService, kafkaEventService, pairService,
+				test.fields.kafkaConfig, w.Reconciler{}).Reconcile(context.Background())) > 0).To(Equal(test.wantErr))
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"time"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -8,6 +9,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/google/uuid"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/pkg/errors"
@@ -45,7 +47,7 @@ func (k *PreparingKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *PreparingKafkaManager) Reconcile() []error {
+func (k *PreparingKafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling preparing kafkas")
 	var encounteredErrors []error
 
@@ -58,7 +60,7 @@ func (k *PreparingKafkaManager) Reconcile() []error {
 	}
 
 	for _, kafka := range preparingKafkas {
-		glog.V(10).Infof("preparing kafka id = %s", kafka.ID)
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("preparing kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusPreparing, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 		if err := k.reconcilePreparingKafka(kafka); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to reconcile preparing kafka %s", kafka.ID))
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -94,7 +95,7 @@ func TestPreparingKafkaManager_Reconcile(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Expect(len(NewPreparingKafkaManager(tt.fields.kafkaService, w.Reconciler{}).Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(NewPreparingKafkaManager(tt.fields.kafkaService, w.Reconciler{}).Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
@@ -1,12 +1,14 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"time"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/google/uuid"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/pkg/errors"
@@ -42,7 +44,7 @@ func (k *ProvisioningKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *ProvisioningKafkaManager) Reconcile() []error {
+func (k *ProvisioningKafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling kafkas")
 	var encounteredErrors []error
 
@@ -57,7 +59,7 @@ func (k *ProvisioningKafkaManager) Reconcile() []error {
 		glog.Infof("provisioning kafkas count = %d", len(provisioningKafkas))
 	}
 	for _, kafka := range provisioningKafkas {
-		glog.V(10).Infof("provisioning kafka id = %s", kafka.ID)
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("provisioning kafka id = %s", kafka.ID)
 		metrics.UpdateKafkaRequestsStatusSinceCreatedMetric(constants2.KafkaRequestStatusProvisioning, kafka.ID, kafka.ClusterID, time.Since(kafka.CreatedAt))
 	}
 
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 
 	constants2 "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/constants"
@@ -65,7 +66,7 @@ func TestProvisioningKafkaManager_Reconcile(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Expect(len(NewProvisioningKafkaManager(tt.fields.kafkaService, w.Reconciler{}).Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(NewProvisioningKafkaManager(tt.fields.kafkaService, w.Reconciler{}).Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
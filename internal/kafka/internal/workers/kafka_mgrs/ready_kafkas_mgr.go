@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/api/dbapi"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/internal/kafka/internal/services"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/golang/glog"
 	"github.com/google/uuid"
@@ -48,7 +50,7 @@ func (k *ReadyKafkaManager) Stop() {
 	k.StopWorker(k)
 }
 
-func (k *ReadyKafkaManager) Reconcile() []error {
+func (k *ReadyKafkaManager) Reconcile(ctx context.Context) []error {
 	glog.Infoln("reconciling ready kafkas")
 	if !k.keycloakConfig.EnableAuthenticationOnKafka {
 		return nil
@@ -64,7 +66,7 @@ func (k *ReadyKafkaManager) Reconcile() []error {
 	}
 
 	for _, kafka := range readyKafkas {
-		glog.V(10).Infof("ready kafka id = %s", kafka.ID)
+		workers.NewWorkerLogger(k).WithFields(logger.KafkaIDField, kafka.ID).V(10).Infof("ready kafka id = %s", kafka.ID)
 		if err := k.reconcileCanaryServiceAccount(kafka); err != nil {
 			encounteredErrors = append(encounteredErrors, errors.Wrapf(err, "failed to create ready kafka canary service account: %s", kafka.ID))
 		}
@@ -1,6 +1,7 @@
 package kafka_mgrs
 
 import (
+	"context"
 	"testing"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
@@ -101,7 +102,7 @@ func TestReadyKafkaManager_Reconcile(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			k := NewReadyKafkaManager(tt.fields.kafkaService, tt.fields.keycloakService, tt.fields.keycloakConfig, w.Reconciler{})
 
-			Expect(len(k.Reconcile()) > 0).To(Equal(tt.wantErr))
+			Expect(len(k.Reconcile(context.Background())) > 0).To(Equal(tt.wantErr))
 		})
 	}
 }
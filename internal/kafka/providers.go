@@ -43,10 +43,13 @@ func ConfigProviders() di.Option {
 
 		// Configuration for the Kafka service...
 		di.Provide(config.NewAWSConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewDNSConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewACMEConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
+		di.Provide(config.NewNotificationConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewSupportedProvidersConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(observatoriumClient.NewObservabilityConfigurationConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(config.NewKafkaConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
-		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule))),
+		di.Provide(config.NewDataplaneClusterConfig, di.As(new(environments2.ConfigModule)), di.As(new(environments2.ServiceValidator))),
 		di.Provide(config.NewKasFleetshardConfig, di.As(new(environments2.ConfigModule))),
 		di.Provide(quota_management.NewQuotaManagementListConfig, di.As(new(environments2.ConfigModule))),
 
@@ -68,6 +71,13 @@ func ServiceProviders() di.Option {
 	return di.Options(
 		di.Provide(services.NewClusterService),
 		di.Provide(services.NewKafkaService, di.As(new(services.KafkaService))),
+		di.Provide(services.NewDNSProviderFactory),
+		di.Provide(services.NewKafkaCertificateService),
+		di.Provide(services.NewNotifier),
+		di.Provide(services.NewKafkaExpiryService),
+		di.Provide(services.NewKafkaOwnershipTransferService),
+		di.Provide(services.NewKafkaEventService),
+		di.Provide(services.NewKafkaReplicatedPairService),
 		di.Provide(services.NewCloudProvidersService),
 		di.Provide(services.NewSupportedKafkaInstanceTypesService),
 		di.Provide(services.NewObservatoriumService),
@@ -87,5 +97,9 @@ func ServiceProviders() di.Option {
 		di.Provide(kafka_mgrs.NewProvisioningKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewReadyKafkaManager, di.As(new(workers.Worker))),
 		di.Provide(kafka_mgrs.NewKafkaCNAMEManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaCertificateManager, di.As(new(workers.Worker))),
+		di.Provide(kafka_mgrs.NewKafkaExpiryNotificationManager, di.As(new(workers.Worker))),
+		di.Provide(migrations.NewHealthCheck, di.As(new(environments2.HealthCheck))),
+		di.Provide(observatoriumClient.NewHealthCheck, di.As(new(environments2.HealthCheck))),
 	)
 }
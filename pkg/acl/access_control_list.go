@@ -1,6 +1,8 @@
 package acl
 
 import (
+	"sync/atomic"
+
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/utils/arrays"
 	"github.com/spf13/pflag"
@@ -19,6 +21,9 @@ type AccessControlListConfig struct {
 	DenyList           DeniedUsers
 	DenyListConfigFile string
 	EnableDenyList     bool
+
+	// reloadedDenyList holds the DeniedUsers read by Reload
+	reloadedDenyList atomic.Value
 }
 
 func NewAccessControlListConfig() *AccessControlListConfig {
@@ -41,6 +46,34 @@ func (c *AccessControlListConfig) ReadFiles() (err error) {
 	return nil
 }
 
+func (c *AccessControlListConfig) ConfigFiles() []string {
+	if c.EnableDenyList {
+		return []string{c.DenyListConfigFile}
+	}
+	return nil
+}
+
+func (c *AccessControlListConfig) Reload() error {
+	if !c.EnableDenyList {
+		return nil
+	}
+
+	var denyList DeniedUsers
+	if err := readDenyListConfigFile(c.DenyListConfigFile, &denyList); err != nil {
+		return err
+	}
+	c.reloadedDenyList.Store(denyList)
+	return nil
+}
+
+// GetDenyList returns the denied users. It is safe to call while the deny list is reloaded.
+func (c *AccessControlListConfig) GetDenyList() DeniedUsers {
+	if denyList, ok := c.reloadedDenyList.Load().(DeniedUsers); ok {
+		return denyList
+	}
+	return c.DenyList
+}
+
 // Read the contents of file into the deny list config
 func readDenyListConfigFile(file string, val *DeniedUsers) error {
 	fileContents, err := shared.ReadFile(file)
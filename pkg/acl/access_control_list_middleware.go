@@ -32,7 +32,7 @@ func (middleware *AccessControlListMiddleware) Authorize(next http.Handler) http
 		username, _ := claims.GetUsername()
 
 		if middleware.accessControlListConfig.EnableDenyList {
-			userIsDenied := middleware.accessControlListConfig.DenyList.IsUserDenied(username)
+			userIsDenied := middleware.accessControlListConfig.GetDenyList().IsUserDenied(username)
 			if userIsDenied {
 				shared.HandleError(r, w, errors.New(errors.ErrorForbidden, "User '%s' is not authorized to access the service.", username))
 				return
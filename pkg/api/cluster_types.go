@@ -160,6 +160,9 @@ type Cluster struct {
 	// SupportedInstanceType holds information on what kind of instances types can be provisioned on this cluster.
 	// A cluster can support two kinds of instance types: 'developer', 'standard' or both in this case it will be a comma separated list of instance types e.g 'standard,developer'.
 	SupportedInstanceType string `json:"supported_instance_type"`
+	// SupportsPrivateKafka indicates whether Kafka instances on this cluster can be exposed through an internal ingress,
+	// reachable over cloud private links or VPC peering only
+	SupportsPrivateKafka bool `json:"supports_private_kafka"`
 }
 
 type ClusterList []*Cluster
@@ -30,6 +30,11 @@ type Capacity struct {
 	MaxConnectionAttemptsPerSec int    `json:"maxConnectionAttemptsPerSec"`
 }
 
+type KafkaConfigSpec struct {
+	DefaultRetentionPeriod string `json:"defaultRetentionPeriod,omitempty"`
+	MessageMaxBytes        int64  `json:"messageMaxBytes,omitempty"`
+}
+
 type VersionsSpec struct {
 	Kafka    string `json:"kafka"`
 	Strimzi  string `json:"strimzi"`
@@ -64,6 +69,16 @@ type TlsSpec struct {
 type EndpointSpec struct {
 	BootstrapServerHost string   `json:"bootstrapServerHost"`
 	Tls                 *TlsSpec `json:"tls,omitempty"`
+	// Internal requests an ingress only reachable over cloud private links or VPC peering
+	Internal bool `json:"internal,omitempty"`
+}
+
+// MirroringSpec configures the mirroring of the topics of another Kafka, the source, into this one
+type MirroringSpec struct {
+	SourceBootstrapServerHost string `json:"sourceBootstrapServerHost"`
+	// Topics is the regular expression of the mirrored topics
+	Topics                   string `json:"topics"`
+	SyncConsumerGroupOffsets bool   `json:"syncConsumerGroupOffsets,omitempty"`
 }
 
 type ServiceAccount struct {
@@ -74,12 +89,15 @@ type ServiceAccount struct {
 
 type ManagedKafkaSpec struct {
 	Capacity        Capacity         `json:"capacity"`
+	KafkaConfig     *KafkaConfigSpec `json:"kafkaConfig,omitempty"`
 	OAuth           OAuthSpec        `json:"oauth"`
 	Endpoint        EndpointSpec     `json:"endpoint"`
 	Versions        VersionsSpec     `json:"versions"`
 	Deleted         bool             `json:"deleted"`
 	Owners          []string         `json:"owners"`
 	ServiceAccounts []ServiceAccount `json:"service_accounts"`
+	// Mirroring is only set for the secondary of a replicated pair
+	Mirroring *MirroringSpec `json:"mirroring,omitempty"`
 }
 
 type ManagedKafka struct {
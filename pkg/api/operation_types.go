@@ -0,0 +1,90 @@
+package api
+
+import (
+	"time"
+)
+
+type OperationKind string
+
+const (
+	// OperationKindKafkaDeletion tracks the deprovisioning of a kafka, until its record is deleted
+	OperationKindKafkaDeletion OperationKind = "kafka_deletion"
+	// OperationKindClusterScaleUp tracks the scale up of the compute nodes of a data plane cluster out of capacity
+	OperationKindClusterScaleUp OperationKind = "cluster_scale_up"
+	// OperationKindConnectorUpgrade tracks the upgrade of the type or the operator of a connector, until its
+	// deployment reports the upgraded version
+	OperationKindConnectorUpgrade OperationKind = "connector_upgrade"
+	// OperationKindConnectorDeletion tracks the removal of the deployment of a connector, until its record is deleted
+	OperationKindConnectorDeletion OperationKind = "connector_deletion"
+)
+
+func (k OperationKind) String() string {
+	return string(k)
+}
+
+// TargetKind returns the kind of the resources the operations of this kind act on
+func (k OperationKind) TargetKind() string {
+	switch k {
+	case OperationKindKafkaDeletion:
+		return OperationTargetKafka
+	case OperationKindClusterScaleUp:
+		return OperationTargetCluster
+	case OperationKindConnectorUpgrade, OperationKindConnectorDeletion:
+		return OperationTargetConnector
+	default:
+		return ""
+	}
+}
+
+type OperationState string
+
+const (
+	// OperationStatePending operations have been accepted but not picked up by a worker yet
+	OperationStatePending OperationState = "pending"
+	// OperationStateRunning operations are being carried out by a worker
+	OperationStateRunning OperationState = "running"
+	// OperationStateSucceeded operations are finished
+	OperationStateSucceeded OperationState = "succeeded"
+	// OperationStateFailed operations can't be carried out, Error tells why
+	OperationStateFailed OperationState = "failed"
+)
+
+func (s OperationState) String() string {
+	return string(s)
+}
+
+// ActiveOperationStates are the states of the operations which are not finished yet
+var ActiveOperationStates = []string{OperationStatePending.String(), OperationStateRunning.String()}
+
+const (
+	OperationTargetKafka     = "Kafka"
+	OperationTargetCluster   = "Cluster"
+	OperationTargetConnector = "Connector"
+)
+
+// Operation tracks an asynchronous action on a resource, from the request which accepted it until the worker
+// carrying it out finishes it
+type Operation struct {
+	Meta
+	Kind string `gorm:"index"`
+	// TargetKind and TargetId identify the resource the operation acts on
+	TargetKind string
+	TargetId   string `gorm:"index"`
+	// OrganisationId and Owner are the ones of the target, the operations of the resources of the data plane have none
+	OrganisationId string `gorm:"index"`
+	Owner          string
+	State          string `gorm:"index"`
+	// Progress is the percentage of the operation done
+	Progress int32
+	// Error is the reason of the failure of a failed operation, or the last error encountered by a running operation
+	Error       string
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+type OperationList []*Operation
+
+// IsFinished returns true if the operation succeeded or failed
+func (o *Operation) IsFinished() bool {
+	return o.State == OperationStateSucceeded.String() || o.State == OperationStateFailed.String()
+}
@@ -0,0 +1,305 @@
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	dns01ChallengeType        = "dns-01"
+	dns01RecordPrefix         = "_acme-challenge."
+	dns01RecordTTL            = 60
+	defaultPollInterval       = 5 * time.Second
+	defaultPropagationTimeout = 2 * time.Minute
+)
+
+// Certificate is a certificate issued by the ACME server along with its private key, both PEM encoded
+type Certificate struct {
+	// Certificate is the leaf certificate followed by the issuer chain
+	Certificate string
+	Key         string
+	NotAfter    time.Time
+}
+
+//go:generate moq -out issuer_moq.go . Issuer
+type Issuer interface {
+	// Issue orders a certificate valid for the given hosts, proving their ownership with DNS-01 challenges
+	// whose records are changed through the dns provider of the given region
+	Issue(ctx context.Context, region string, hosts []string) (*Certificate, error)
+}
+
+type Config struct {
+	// DirectoryURL is the ACME directory endpoint, e.g. https://acme-v02.api.letsencrypt.org/directory
+	DirectoryURL string
+	// Email is the contact registered with the ACME account
+	Email string
+	// AccountKey identifies the ACME account
+	AccountKey crypto.Signer
+	// Zone is the DNS zone in which the challenge records are created
+	Zone string
+	// HTTPClient is used to talk to the ACME server, the default client is used when nil
+	HTTPClient *http.Client
+	// PropagationTimeout bounds the wait for challenge records to be in sync on the dns provider
+	PropagationTimeout time.Duration
+	// PollInterval is the interval between checks of the challenge records status
+	PollInterval time.Duration
+}
+
+var _ Issuer = &issuer{}
+
+type issuer struct {
+	config             Config
+	client             *acme.Client
+	dnsProviderFactory dns.ProviderFactory
+	mu                 sync.Mutex
+	registered         bool
+}
+
+func NewIssuer(config Config, dnsProviderFactory dns.ProviderFactory) *issuer {
+	if config.PropagationTimeout == 0 {
+		config.PropagationTimeout = defaultPropagationTimeout
+	}
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	return &issuer{
+		config: config,
+		client: &acme.Client{
+			Key:          config.AccountKey,
+			DirectoryURL: config.DirectoryURL,
+			HTTPClient:   config.HTTPClient,
+			UserAgent:    "kas-fleet-manager",
+		},
+		dnsProviderFactory: dnsProviderFactory,
+	}
+}
+
+func (i *issuer) Issue(ctx context.Context, region string, hosts []string) (*Certificate, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("at least one host is required to issue a certificate")
+	}
+	if err := i.register(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := i.client.AuthorizeOrder(ctx, acme.DomainIDs(hosts...))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create certificate order for %s", strings.Join(hosts, ", "))
+	}
+
+	if order.Status != acme.StatusReady {
+		if err := i.authorize(ctx, region, order.AuthzURLs); err != nil {
+			return nil, err
+		}
+		orderURL := order.URI
+		if order, err = i.client.WaitOrder(ctx, orderURL); err != nil {
+			return nil, errors.Wrapf(err, "certificate order %s failed", orderURL)
+		}
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate certificate key")
+	}
+	csr, err := newCertificateRequest(key, hosts)
+	if err != nil {
+		return nil, err
+	}
+	der, _, err := i.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to finalize certificate order %s", order.URI)
+	}
+
+	return newCertificate(der, key)
+}
+
+// register creates the ACME account on first use, reusing the existing one bound to the account key
+func (i *issuer) register(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.registered {
+		return nil
+	}
+
+	account := &acme.Account{}
+	if i.config.Email != "" {
+		account.Contact = []string{"mailto:" + i.config.Email}
+	}
+	if _, err := i.client.Register(ctx, account, acme.AcceptTOS); err != nil && err != acme.ErrAccountAlreadyExists {
+		return errors.Wrapf(err, "failed to register acme account with %s", i.config.DirectoryURL)
+	}
+	i.registered = true
+	return nil
+}
+
+// authorize fulfills the DNS-01 challenges of all pending authorizations of an order
+func (i *issuer) authorize(ctx context.Context, region string, authzURLs []string) error {
+	var pending []*acme.Challenge
+	var pendingURLs []string
+	var records []dns.Record
+	for _, url := range authzURLs {
+		authz, err := i.client.GetAuthorization(ctx, url)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get authorization %s", url)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		challenge := findChallenge(authz, dns01ChallengeType)
+		if challenge == nil {
+			return errors.Errorf("no %s challenge offered to authorize %s", dns01ChallengeType, authz.Identifier.Value)
+		}
+		value, err := i.client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compute %s challenge record of %s", dns01ChallengeType, authz.Identifier.Value)
+		}
+		records = append(records, dns.Record{
+			Name:  dns01RecordPrefix + authz.Identifier.Value,
+			Type:  "TXT",
+			TTL:   dns01RecordTTL,
+			Value: value,
+		})
+		pending = append(pending, challenge)
+		pendingURLs = append(pendingURLs, url)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	provider, err := i.dnsProviderFactory.NewProvider(region)
+	if err != nil {
+		return errors.Wrap(err, "failed to create dns provider")
+	}
+	if err := i.changeRecords(ctx, provider, dns.ActionUpsert, records); err != nil {
+		return errors.Wrap(err, "failed to create challenge records")
+	}
+	defer func() {
+		if _, err := provider.ChangeRecords(i.config.Zone, dns.ActionDelete, records); err != nil {
+			glog.Errorf("failed to delete %s challenge records: %v", dns01ChallengeType, err)
+		}
+	}()
+
+	for _, challenge := range pending {
+		if _, err := i.client.Accept(ctx, challenge); err != nil {
+			return errors.Wrapf(err, "failed to accept challenge %s", challenge.URI)
+		}
+	}
+	for _, url := range pendingURLs {
+		if _, err := i.client.WaitAuthorization(ctx, url); err != nil {
+			return errors.Wrapf(err, "authorization %s failed", url)
+		}
+	}
+	return nil
+}
+
+// changeRecords applies a change and waits for it to be in sync on all the name servers of the provider
+func (i *issuer) changeRecords(ctx context.Context, provider dns.Provider, action dns.Action, records []dns.Record) error {
+	change, err := provider.ChangeRecords(i.config.Zone, action, records)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, i.config.PropagationTimeout)
+	defer cancel()
+	for change.Status != dns.ChangeStatusInSync {
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("dns change %s still %s after %s", change.Id, change.Status, i.config.PropagationTimeout)
+		case <-time.After(i.config.PollInterval):
+		}
+		if change, err = provider.GetChange(change.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findChallenge(authz *acme.Authorization, challengeType string) *acme.Challenge {
+	for _, c := range authz.Challenges {
+		if c.Type == challengeType {
+			return c
+		}
+	}
+	return nil
+}
+
+func newCertificateRequest(key crypto.Signer, hosts []string) ([]byte, error) {
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: hosts[0]},
+		DNSNames: hosts,
+	}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate request")
+	}
+	return csr, nil
+}
+
+func newCertificate(der [][]byte, key *ecdsa.PrivateKey) (*Certificate, error) {
+	if len(der) == 0 {
+		return nil, errors.New("acme server returned an empty certificate chain")
+	}
+	leaf, err := x509.ParseCertificate(der[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse issued certificate")
+	}
+
+	var chain strings.Builder
+	for _, c := range der {
+		if err := pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: c}); err != nil {
+			return nil, err
+		}
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode certificate key")
+	}
+
+	return &Certificate{
+		Certificate: chain.String(),
+		Key:         string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes})),
+		NotAfter:    leaf.NotAfter,
+	}, nil
+}
+
+// ParseAccountKey parses a PEM encoded ECDSA, RSA or PKCS#8 private key of an ACME account
+func ParseAccountKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("acme account key is not PEM encoded")
+	}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported acme account key type %T", key)
+		}
+		return signer, nil
+	default:
+		return nil, fmt.Errorf("unsupported acme account key block %s", block.Type)
+	}
+}
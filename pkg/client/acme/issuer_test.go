@@ -0,0 +1,184 @@
+package acme
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/dns"
+	. "github.com/onsi/gomega"
+)
+
+func TestParseAccountKey(t *testing.T) {
+	g := NewWithT(t)
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	ecBytes, _ := x509.MarshalECPrivateKey(ecKey)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkcs8Bytes, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+
+	key, err := ParseAccountKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecBytes}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(Equal(ecKey))
+
+	key, err = ParseAccountKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key.Public()).To(Equal(rsaKey.Public()))
+
+	key, err = ParseAccountKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8Bytes}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key.Public()).To(Equal(rsaKey.Public()))
+
+	_, err = ParseAccountKey([]byte("not a key"))
+	g.Expect(err).To(MatchError("acme account key is not PEM encoded"))
+
+	_, err = ParseAccountKey(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")}))
+	g.Expect(err).To(MatchError("unsupported acme account key block CERTIFICATE"))
+}
+
+func TestNewCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	hosts := []string{"kafka.example.com", "admin-server-kafka.example.com"}
+
+	csrDer, err := newCertificateRequest(key, hosts)
+	g.Expect(err).ToNot(HaveOccurred())
+	csr, err := x509.ParseCertificateRequest(csrDer)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(csr.Subject.CommonName).To(Equal(hosts[0]))
+	g.Expect(csr.DNSNames).To(Equal(hosts))
+
+	notAfter := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+	leaf, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		DNSNames:     hosts,
+		NotBefore:    time.Now(),
+		NotAfter:     notAfter,
+	}, &x509.Certificate{SerialNumber: big.NewInt(2), Subject: pkix.Name{CommonName: "issuer"}}, &key.PublicKey, key)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	certificate, err := newCertificate([][]byte{leaf, []byte("issuer")}, key)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(certificate.NotAfter).To(Equal(notAfter))
+	g.Expect(strings.Count(certificate.Certificate, "BEGIN CERTIFICATE")).To(Equal(2))
+	parsedKey, err := ParseAccountKey([]byte(certificate.Key))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(parsedKey).To(Equal(key))
+
+	_, err = newCertificate(nil, key)
+	g.Expect(err).To(MatchError("acme server returned an empty certificate chain"))
+}
+
+func TestIssuer_changeRecords(t *testing.T) {
+	g := NewWithT(t)
+
+	records := []dns.Record{{Name: "_acme-challenge.kafka.example.com", Type: "TXT", TTL: 60, Value: "token"}}
+	polls := 0
+	provider := &dns.ProviderMock{
+		ChangeRecordsFunc: func(domain string, action dns.Action, r []dns.Record) (*dns.ChangeInfo, error) {
+			g.Expect(domain).To(Equal("example.com"))
+			g.Expect(r).To(Equal(records))
+			return &dns.ChangeInfo{Id: "change", Status: dns.ChangeStatusPending}, nil
+		},
+		GetChangeFunc: func(changeId string) (*dns.ChangeInfo, error) {
+			polls++
+			if polls < 3 {
+				return &dns.ChangeInfo{Id: changeId, Status: dns.ChangeStatusPending}, nil
+			}
+			return &dns.ChangeInfo{Id: changeId, Status: dns.ChangeStatusInSync}, nil
+		},
+	}
+
+	i := NewIssuer(Config{Zone: "example.com", PollInterval: time.Millisecond}, nil)
+	g.Expect(i.changeRecords(context.Background(), provider, dns.ActionUpsert, records)).To(Succeed())
+	g.Expect(polls).To(Equal(3))
+
+	// changes that never get in sync time out
+	i = NewIssuer(Config{Zone: "example.com", PollInterval: time.Millisecond, PropagationTimeout: 10 * time.Millisecond}, nil)
+	provider.GetChangeFunc = func(changeId string) (*dns.ChangeInfo, error) {
+		return &dns.ChangeInfo{Id: changeId, Status: dns.ChangeStatusPending}, nil
+	}
+	g.Expect(i.changeRecords(context.Background(), provider, dns.ActionUpsert, records)).To(MatchError("dns change change still PENDING after 10ms"))
+}
+
+// TestIssuer_IssuePebble issues a certificate from a local Pebble ACME test server, see docs/automated-testing.md.
+// It runs only when ACME_TEST_DIRECTORY_URL (e.g. https://localhost:14000/dir) and ACME_TEST_CHALLTESTSRV_URL
+// (e.g. http://localhost:8055) are set, Pebble must resolve the challenge records with pebble-challtestsrv.
+func TestIssuer_IssuePebble(t *testing.T) {
+	directoryURL := os.Getenv("ACME_TEST_DIRECTORY_URL")
+	challtestsrvURL := os.Getenv("ACME_TEST_CHALLTESTSRV_URL")
+	if directoryURL == "" || challtestsrvURL == "" {
+		t.Skip("ACME_TEST_DIRECTORY_URL and ACME_TEST_CHALLTESTSRV_URL are not set")
+	}
+	g := NewWithT(t)
+
+	// pebble-challtestsrv serves the TXT records set through its management API
+	manageTXT := func(action dns.Action, records []dns.Record) error {
+		path := "/set-txt"
+		if action == dns.ActionDelete {
+			path = "/clear-txt"
+		}
+		for _, r := range records {
+			body, _ := json.Marshal(map[string]string{"host": r.Name + ".", "value": r.Value})
+			resp, err := http.Post(challtestsrvURL+path, "application/json", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("challtestsrv %s returned %s", path, resp.Status)
+			}
+		}
+		return nil
+	}
+	dnsProviderFactory := &dns.ProviderFactoryMock{
+		NewProviderFunc: func(region string) (dns.Provider, error) {
+			return &dns.ProviderMock{
+				ChangeRecordsFunc: func(domain string, action dns.Action, records []dns.Record) (*dns.ChangeInfo, error) {
+					return &dns.ChangeInfo{Id: "change", Status: dns.ChangeStatusInSync}, manageTXT(action, records)
+				},
+			}, nil
+		},
+	}
+
+	accountKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	i := NewIssuer(Config{
+		DirectoryURL: directoryURL,
+		Email:        "kafka-admin@example.com",
+		AccountKey:   accountKey,
+		Zone:         "example.com",
+		// Pebble serves its directory with a self signed certificate
+		HTTPClient: &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}, //nolint:gosec
+	}, dnsProviderFactory)
+
+	hosts := []string{"test-kafka.example.com", "admin-server-test-kafka.example.com"}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	certificate, err := i.Issue(ctx, "us-east-1", hosts)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	block, _ := pem.Decode([]byte(certificate.Certificate))
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(leaf.DNSNames).To(ConsistOf(hosts))
+	g.Expect(certificate.NotAfter).To(Equal(leaf.NotAfter))
+	_, err = tls.X509KeyPair([]byte(certificate.Certificate), []byte(certificate.Key))
+	g.Expect(err).ToNot(HaveOccurred())
+}
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	errors "github.com/zgalor/weberr"
 )
 
@@ -79,6 +81,10 @@ func newClient(credentials Config, region string) (AWSClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the transport is wrapped once the session is created as the session may need to configure a custom CA bundle in it
+	sess = sess.Copy(&aws.Config{
+		HTTPClient: &http.Client{Transport: tracing.Transport(sess.Config.HTTPClient.Transport)},
+	})
 	return &awsCl{
 		route53Client: route53.New(sess),
 	}, nil
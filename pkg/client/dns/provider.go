@@ -0,0 +1,51 @@
+package dns
+
+// Action is the change applied to a batch of DNS records
+type Action string
+
+const (
+	// ActionCreate adds the records to the zone
+	ActionCreate Action = "CREATE"
+	// ActionUpsert replaces any records of the same name and type with the records
+	ActionUpsert Action = "UPSERT"
+	// ActionDelete removes the records from the zone
+	ActionDelete Action = "DELETE"
+)
+
+// ChangeStatus is the propagation status of a change
+type ChangeStatus string
+
+const (
+	// ChangeStatusPending means the change has not yet been applied to all authoritative name servers
+	ChangeStatusPending ChangeStatus = "PENDING"
+	// ChangeStatusInSync means the change has been applied to all authoritative name servers
+	ChangeStatusInSync ChangeStatus = "INSYNC"
+)
+
+// Record is a DNS resource record
+type Record struct {
+	Name  string
+	Type  string
+	TTL   int64
+	Value string
+}
+
+// ChangeInfo identifies a change and its propagation status
+type ChangeInfo struct {
+	Id     string
+	Status ChangeStatus
+}
+
+//go:generate moq -out provider_moq.go . Provider
+type Provider interface {
+	// ChangeRecords applies the action to a batch of records in the zone of the domain
+	ChangeRecords(domain string, action Action, records []Record) (*ChangeInfo, error)
+	// GetChange returns the propagation status of a change returned by ChangeRecords
+	GetChange(changeId string) (*ChangeInfo, error)
+}
+
+//go:generate moq -out provider_factory_moq.go . ProviderFactory
+type ProviderFactory interface {
+	// NewProvider returns a provider for changing DNS records of resources in the region
+	NewProvider(region string) (Provider, error)
+}
@@ -0,0 +1,281 @@
+package dns
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/xid"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	opCodeUpdate = dnsmessage.OpCode(5)
+	classNONE    = dnsmessage.Class(254)
+
+	defaultRFC2136Timeout = 10 * time.Second
+)
+
+// RFC2136Config contains the settings for sending RFC 2136 dynamic updates to an authoritative name server
+type RFC2136Config struct {
+	// Server is the host and optional port of the name server accepting dynamic updates
+	Server string
+	// Zone is the zone updated, defaults to the domain of the changed records
+	Zone string
+	// TSIGKeyName is the name of the TSIG key used to sign updates, updates are not signed if empty
+	TSIGKeyName string
+	// TSIGSecret is the base64 encoded TSIG secret
+	TSIGSecret string
+	// TSIGAlgorithm is the TSIG algorithm, defaults to hmac-sha256
+	TSIGAlgorithm string
+	// Timeout is the timeout for sending an update and reading the response
+	Timeout time.Duration
+}
+
+var _ ProviderFactory = &RFC2136ProviderFactory{}
+
+// RFC2136ProviderFactory creates providers sending RFC 2136 dynamic updates, e.g. to BIND
+type RFC2136ProviderFactory struct {
+	config RFC2136Config
+}
+
+func NewRFC2136ProviderFactory(config RFC2136Config) *RFC2136ProviderFactory {
+	return &RFC2136ProviderFactory{config: config}
+}
+
+// NewProvider returns a provider for the configured name server, which is the same for all regions
+func (f *RFC2136ProviderFactory) NewProvider(_ string) (Provider, error) {
+	if f.config.Server == "" {
+		return nil, errors.New("rfc2136 name server is not configured")
+	}
+	server := f.config.Server
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+	timeout := f.config.Timeout
+	if timeout <= 0 {
+		timeout = defaultRFC2136Timeout
+	}
+
+	provider := &rfc2136Provider{
+		server:  server,
+		zone:    f.config.Zone,
+		timeout: timeout,
+	}
+	if f.config.TSIGKeyName != "" {
+		key, err := newTSIGKey(f.config.TSIGKeyName, f.config.TSIGAlgorithm, f.config.TSIGSecret)
+		if err != nil {
+			return nil, err
+		}
+		provider.key = key
+	}
+	return provider, nil
+}
+
+var _ Provider = &rfc2136Provider{}
+
+type rfc2136Provider struct {
+	server  string
+	zone    string
+	timeout time.Duration
+	key     *tsigKey
+}
+
+// ChangeRecords sends the changes in a single dynamic update, which the name server applies atomically
+func (p *rfc2136Provider) ChangeRecords(domain string, action Action, records []Record) (*ChangeInfo, error) {
+	zone := p.zone
+	if zone == "" {
+		zone = domain
+	}
+
+	msg, err := buildUpdate(zone, action, records)
+	if err != nil {
+		return nil, err
+	}
+	var requestMAC []byte
+	if p.key != nil {
+		if msg, requestMAC, err = p.key.sign(msg, nil, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	response, err := p.exchange(msg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send dynamic update for zone %s to %s", zone, p.server)
+	}
+	var parser dnsmessage.Parser
+	header, err := parser.Start(response)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid dynamic update response from %s", p.server)
+	}
+	if header.ID != binary.BigEndian.Uint16(msg) {
+		return nil, errors.Errorf("dynamic update response id mismatch from %s", p.server)
+	}
+	// name servers don't sign the error response to a request failing verification, e.g. NOTAUTH for a bad key,
+	// so the rcode is checked before the signature to report why the update was rejected
+	if header.RCode != dnsmessage.RCodeSuccess {
+		return nil, errors.Errorf("dynamic update for zone %s rejected by %s: %s", zone, p.server, rcodeString(int(header.RCode)))
+	}
+	if p.key != nil {
+		if _, err := p.key.verify(response, requestMAC, time.Now()); err != nil {
+			return nil, errors.Wrapf(err, "invalid dynamic update response signature from %s", p.server)
+		}
+	}
+
+	// the name server has applied the update once it responds, so there is nothing to poll
+	return &ChangeInfo{
+		Id:     xid.New().String(),
+		Status: ChangeStatusInSync,
+	}, nil
+}
+
+// GetChange always reports changes as in sync, since dynamic updates are applied synchronously
+func (p *rfc2136Provider) GetChange(changeId string) (*ChangeInfo, error) {
+	return &ChangeInfo{
+		Id:     changeId,
+		Status: ChangeStatusInSync,
+	}, nil
+}
+
+// exchange sends the message over TCP, which isn't limited in size like UDP, and reads the response
+func (p *rfc2136Provider) exchange(msg []byte) ([]byte, error) {
+	conn, err := net.DialTimeout("tcp", p.server, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+	if err := conn.SetDeadline(time.Now().Add(p.timeout)); err != nil {
+		return nil, err
+	}
+	return exchangeTCP(conn, msg)
+}
+
+func exchangeTCP(conn io.ReadWriter, msg []byte) ([]byte, error) {
+	if err := writeTCPMessage(conn, msg); err != nil {
+		return nil, err
+	}
+	return readTCPMessage(conn)
+}
+
+func writeTCPMessage(w io.Writer, msg []byte) error {
+	_, err := w.Write(append(appendUint16(nil, uint16(len(msg))), msg...))
+	return err
+}
+
+func readTCPMessage(r io.Reader) ([]byte, error) {
+	var length [2]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// buildUpdate builds an unsigned dynamic update message for the records in the zone
+func buildUpdate(zone string, action Action, records []Record) ([]byte, error) {
+	zoneName, err := dnsmessage.NewName(fqdn(zone))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid zone %s", zone)
+	}
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: binary.BigEndian.Uint16(id[:]), OpCode: opCodeUpdate})
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(dnsmessage.Question{Name: zoneName, Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET}); err != nil {
+		return nil, err
+	}
+	// the update section is the authority section of an update message
+	if err := b.StartAuthorities(); err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		switch action {
+		case ActionCreate:
+			err = addRecord(&b, r, dnsmessage.ClassINET)
+		case ActionUpsert:
+			if err = deleteRRset(&b, r); err == nil {
+				err = addRecord(&b, r, dnsmessage.ClassINET)
+			}
+		case ActionDelete:
+			err = addRecord(&b, r, classNONE)
+		default:
+			err = errors.Errorf("unsupported dns record action %s", action)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b.Finish()
+}
+
+// addRecord adds a record to the update section, records with class NONE are deleted from the zone
+func addRecord(b *dnsmessage.Builder, r Record, class dnsmessage.Class) error {
+	name, err := dnsmessage.NewName(fqdn(r.Name))
+	if err != nil {
+		return errors.Wrapf(err, "invalid record name %s", r.Name)
+	}
+	rrType, err := recordType(r)
+	if err != nil {
+		return err
+	}
+	header := dnsmessage.ResourceHeader{Name: name, Class: class, TTL: uint32(r.TTL)}
+	if class == classNONE {
+		header.TTL = 0
+	}
+	switch rrType {
+	case dnsmessage.TypeCNAME:
+		target, err := dnsmessage.NewName(fqdn(r.Value))
+		if err != nil {
+			return errors.Wrapf(err, "invalid CNAME record value %s", r.Value)
+		}
+		return b.CNAMEResource(header, dnsmessage.CNAMEResource{CNAME: target})
+	case dnsmessage.TypeA:
+		ip := net.ParseIP(r.Value).To4()
+		if ip == nil {
+			return errors.Errorf("invalid A record value %s", r.Value)
+		}
+		a := dnsmessage.AResource{}
+		copy(a.A[:], ip)
+		return b.AResource(header, a)
+	default:
+		return b.TXTResource(header, dnsmessage.TXTResource{TXT: []string{r.Value}})
+	}
+}
+
+// deleteRRset adds a delete of all records with the name and type of the record to the update section
+func deleteRRset(b *dnsmessage.Builder, r Record) error {
+	name, err := dnsmessage.NewName(fqdn(r.Name))
+	if err != nil {
+		return errors.Wrapf(err, "invalid record name %s", r.Name)
+	}
+	rrType, err := recordType(r)
+	if err != nil {
+		return err
+	}
+	return b.UnknownResource(dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassANY}, dnsmessage.UnknownResource{Type: rrType})
+}
+
+func recordType(r Record) (dnsmessage.Type, error) {
+	switch strings.ToUpper(r.Type) {
+	case "CNAME":
+		return dnsmessage.TypeCNAME, nil
+	case "A":
+		return dnsmessage.TypeA, nil
+	case "TXT":
+		return dnsmessage.TypeTXT, nil
+	default:
+		return 0, errors.Errorf("unsupported dns record type %s", r.Type)
+	}
+}
@@ -0,0 +1,246 @@
+package dns
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	testZone       = "kafka.example.com."
+	testTSIGKey    = "fleet-manager"
+	testTSIGSecret = "c2VjcmV0LXRzaWcta2V5LWZvci10ZXN0aW5n"
+)
+
+// testNameServer is an in-process authoritative name server applying signed dynamic updates to an in-memory zone
+type testNameServer struct {
+	addr    string
+	key     *tsigKey
+	mu      sync.Mutex
+	records map[string]string
+}
+
+func startTestNameServer(t *testing.T) *testNameServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	key, err := newTSIGKey(testTSIGKey, "", testTSIGSecret)
+	if err != nil {
+		t.Fatalf("invalid tsig key: %v", err)
+	}
+	ns := &testNameServer{
+		addr:    listener.Addr().String(),
+		key:     key,
+		records: map[string]string{},
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go ns.serve(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	return ns
+}
+
+func (ns *testNameServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	request, err := readTCPMessage(conn)
+	if err != nil {
+		return
+	}
+
+	var parser dnsmessage.Parser
+	header, err := parser.Start(request)
+	if err != nil {
+		return
+	}
+	rcode := dnsmessage.RCodeSuccess
+	requestMAC, err := ns.key.verify(request, nil, time.Now())
+	if err != nil {
+		// responses to requests failing verification are not signed
+		rcode = dnsmessage.RCode(9) // NOTAUTH
+	} else {
+		rcode = ns.update(&parser, header)
+	}
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: header.ID, Response: true, OpCode: header.OpCode, RCode: rcode})
+	response, err := b.Finish()
+	if err != nil {
+		return
+	}
+	if requestMAC != nil {
+		if response, _, err = ns.key.sign(response, requestMAC, time.Now()); err != nil {
+			return
+		}
+	}
+	_ = writeTCPMessage(conn, response)
+}
+
+func (ns *testNameServer) update(parser *dnsmessage.Parser, header dnsmessage.Header) dnsmessage.RCode {
+	question, err := parser.Question()
+	if err != nil || header.OpCode != opCodeUpdate {
+		return dnsmessage.RCodeFormatError
+	}
+	if question.Name.String() != testZone {
+		return dnsmessage.RCode(10) // NOTZONE
+	}
+	if err := parser.SkipAllQuestions(); err != nil {
+		return dnsmessage.RCodeFormatError
+	}
+	if err := parser.SkipAllAnswers(); err != nil {
+		return dnsmessage.RCodeFormatError
+	}
+
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	for {
+		h, err := parser.AuthorityHeader()
+		if err == dnsmessage.ErrSectionDone {
+			return dnsmessage.RCodeSuccess
+		}
+		if err != nil {
+			return dnsmessage.RCodeFormatError
+		}
+		key := h.Name.String() + " " + h.Type.String()
+		switch {
+		case h.Class == dnsmessage.ClassINET && h.Type == dnsmessage.TypeCNAME:
+			r, err := parser.CNAMEResource()
+			if err != nil {
+				return dnsmessage.RCodeFormatError
+			}
+			ns.records[key] = r.CNAME.String()
+		case h.Class == dnsmessage.ClassANY || h.Class == classNONE:
+			if err := parser.SkipAuthority(); err != nil {
+				return dnsmessage.RCodeFormatError
+			}
+			delete(ns.records, key)
+		default:
+			return dnsmessage.RCodeNotImplemented
+		}
+	}
+}
+
+func (ns *testNameServer) record(name string) string {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	return ns.records[fqdn(name)+" "+dnsmessage.TypeCNAME.String()]
+}
+
+func TestRFC2136Provider_ChangeRecords(t *testing.T) {
+	g := NewWithT(t)
+	ns := startTestNameServer(t)
+
+	config := RFC2136Config{
+		Server:      ns.addr,
+		TSIGKeyName: testTSIGKey,
+		TSIGSecret:  testTSIGSecret,
+		Timeout:     5 * time.Second,
+	}
+	provider, err := NewRFC2136ProviderFactory(config).NewProvider("us-east-1")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	records := []Record{
+		{Name: "admin-server-test.kafka.example.com", Type: "CNAME", TTL: 300, Value: "elb.test.rhcloud.com"},
+		{Name: "broker-0-test.kafka.example.com", Type: "CNAME", TTL: 300, Value: "elb.test.rhcloud.com"},
+	}
+
+	change, err := provider.ChangeRecords("kafka.example.com", ActionCreate, records)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(change.Id).ToNot(BeEmpty())
+	g.Expect(change.Status).To(Equal(ChangeStatusInSync))
+	for _, r := range records {
+		g.Expect(ns.record(r.Name)).To(Equal("elb.test.rhcloud.com."))
+	}
+
+	status, err := provider.GetChange(change.Id)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(status).To(Equal(change))
+
+	records[0].Value = "elb-2.test.rhcloud.com"
+	_, err = provider.ChangeRecords("kafka.example.com", ActionUpsert, records[:1])
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(ns.record(records[0].Name)).To(Equal("elb-2.test.rhcloud.com."))
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionDelete, records)
+	g.Expect(err).ToNot(HaveOccurred())
+	for _, r := range records {
+		g.Expect(ns.record(r.Name)).To(BeEmpty())
+	}
+
+	// updates for a zone the server isn't authoritative for are rejected
+	_, err = provider.ChangeRecords("example.org", ActionCreate, records)
+	g.Expect(err).To(MatchError(ContainSubstring("rejected by " + ns.addr + ": NOTZONE")))
+
+	// updates signed with a different secret are refused
+	config.TSIGSecret = "b3RoZXItc2VjcmV0"
+	badKey, err := NewRFC2136ProviderFactory(config).NewProvider("us-east-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = badKey.ChangeRecords("kafka.example.com", ActionCreate, records)
+	g.Expect(err).To(MatchError(ContainSubstring("rejected by " + ns.addr + ": NOTAUTH")))
+
+	// unsigned updates are refused
+	config.TSIGKeyName = ""
+	unsigned, err := NewRFC2136ProviderFactory(config).NewProvider("us-east-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = unsigned.ChangeRecords("kafka.example.com", ActionCreate, records)
+	g.Expect(err).To(MatchError(ContainSubstring("rejected by " + ns.addr + ": NOTAUTH")))
+}
+
+func TestRFC2136ProviderFactory_NewProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := NewRFC2136ProviderFactory(RFC2136Config{}).NewProvider("us-east-1")
+	g.Expect(err).To(MatchError("rfc2136 name server is not configured"))
+
+	_, err = NewRFC2136ProviderFactory(RFC2136Config{Server: "ns1.example.com", TSIGKeyName: "key", TSIGAlgorithm: "hmac-md5"}).NewProvider("us-east-1")
+	g.Expect(err).To(MatchError("unsupported tsig algorithm hmac-md5."))
+
+	_, err = NewRFC2136ProviderFactory(RFC2136Config{Server: "ns1.example.com", TSIGKeyName: "key", TSIGSecret: "not base64!"}).NewProvider("us-east-1")
+	g.Expect(err).To(MatchError(ContainSubstring("tsig secret must be base64 encoded")))
+
+	provider, err := NewRFC2136ProviderFactory(RFC2136Config{Server: "ns1.example.com", TSIGKeyName: "Key", TSIGAlgorithm: "HMAC-SHA512", TSIGSecret: testTSIGSecret}).NewProvider("us-east-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	p := provider.(*rfc2136Provider)
+	g.Expect(p.server).To(Equal("ns1.example.com:53"))
+	g.Expect(p.timeout).To(Equal(defaultRFC2136Timeout))
+	g.Expect(p.key.name).To(Equal("key."))
+	g.Expect(p.key.algorithm).To(Equal("hmac-sha512."))
+}
+
+func TestBuildUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := buildUpdate("kafka.example.com", ActionCreate, []Record{{Name: "a.kafka.example.com", Type: "MX", Value: "mail.example.com"}})
+	g.Expect(err).To(MatchError("unsupported dns record type MX"))
+	_, err = buildUpdate("kafka.example.com", ActionCreate, []Record{{Name: "a.kafka.example.com", Type: "A", Value: "not-an-ip"}})
+	g.Expect(err).To(MatchError("invalid A record value not-an-ip"))
+	_, err = buildUpdate("kafka.example.com", Action("MOVE"), []Record{{Name: "a.kafka.example.com", Type: "TXT", Value: "token"}})
+	g.Expect(err).To(MatchError("unsupported dns record action MOVE"))
+
+	msg, err := buildUpdate("kafka.example.com", ActionUpsert, []Record{{Name: "_acme-challenge.kafka.example.com", Type: "TXT", TTL: 60, Value: "token"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	var parser dnsmessage.Parser
+	header, err := parser.Start(msg)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(header.OpCode).To(Equal(opCodeUpdate))
+	g.Expect(parser.SkipAllQuestions()).To(Succeed())
+	g.Expect(parser.SkipAllAnswers()).To(Succeed())
+	updates, err := parser.AllAuthorities()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(updates).To(HaveLen(2))
+	g.Expect(updates[0].Header.Class).To(Equal(dnsmessage.ClassANY))
+	g.Expect(updates[1].Header.Class).To(Equal(dnsmessage.ClassINET))
+	g.Expect(strings.Join(updates[1].Body.(*dnsmessage.TXTResource).TXT, "")).To(Equal("token"))
+}
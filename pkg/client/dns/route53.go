@@ -0,0 +1,91 @@
+package dns
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	awsclient "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	"github.com/pkg/errors"
+)
+
+var _ ProviderFactory = &Route53ProviderFactory{}
+
+// Route53ProviderFactory creates providers changing records in AWS Route53 hosted zones
+type Route53ProviderFactory struct {
+	awsClientFactory awsclient.ClientFactory
+	credentials      awsclient.Config
+}
+
+func NewRoute53ProviderFactory(awsClientFactory awsclient.ClientFactory, credentials awsclient.Config) *Route53ProviderFactory {
+	return &Route53ProviderFactory{
+		awsClientFactory: awsClientFactory,
+		credentials:      credentials,
+	}
+}
+
+func (f *Route53ProviderFactory) NewProvider(region string) (Provider, error) {
+	client, err := f.awsClientFactory.NewClient(f.credentials, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create aws client")
+	}
+	return &route53Provider{client: client}, nil
+}
+
+var _ Provider = &route53Provider{}
+
+type route53Provider struct {
+	client awsclient.AWSClient
+}
+
+func (p *route53Provider) ChangeRecords(domain string, action Action, records []Record) (*ChangeInfo, error) {
+	batch := &route53.ChangeBatch{}
+	for _, r := range records {
+		value := r.Value
+		if r.Type == "TXT" {
+			// route53 expects TXT values as quoted character strings
+			value = strconv.Quote(value)
+		}
+		batch.Changes = append(batch.Changes, &route53.Change{
+			Action: aws.String(string(action)),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: aws.String(r.Name),
+				Type: aws.String(r.Type),
+				TTL:  aws.Int64(r.TTL),
+				ResourceRecords: []*route53.ResourceRecord{
+					{
+						Value: aws.String(value),
+					},
+				},
+			},
+		})
+	}
+
+	output, err := p.client.ChangeResourceRecordSets(domain, batch)
+	if err != nil {
+		return nil, err
+	}
+	// the aws client ignores changes of records that already exist or have already been deleted
+	if output == nil || output.ChangeInfo == nil {
+		return &ChangeInfo{Status: ChangeStatusInSync}, nil
+	}
+	return presentChangeInfo(output.ChangeInfo), nil
+}
+
+func (p *route53Provider) GetChange(changeId string) (*ChangeInfo, error) {
+	output, err := p.client.GetChange(changeId)
+	if err != nil {
+		return nil, err
+	}
+	if output == nil || output.ChangeInfo == nil {
+		return nil, errors.Errorf("no change info returned for change %s", changeId)
+	}
+	return presentChangeInfo(output.ChangeInfo), nil
+}
+
+func presentChangeInfo(info *route53.ChangeInfo) *ChangeInfo {
+	return &ChangeInfo{
+		Id:     aws.StringValue(info.Id),
+		Status: ChangeStatus(aws.StringValue(info.Status)),
+	}
+}
@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	awsclient "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/aws"
+	. "github.com/onsi/gomega"
+)
+
+func TestRoute53Provider_ChangeRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  *route53.ChangeResourceRecordSetsOutput
+		want    *ChangeInfo
+		wantErr bool
+	}{
+		{
+			name: "should return the route53 change",
+			output: &route53.ChangeResourceRecordSetsOutput{
+				ChangeInfo: &route53.ChangeInfo{Id: aws.String("change-id"), Status: aws.String("PENDING")},
+			},
+			want: &ChangeInfo{Id: "change-id", Status: ChangeStatusPending},
+		},
+		{
+			name: "should return an in sync change for ignored changes",
+			want: &ChangeInfo{Status: ChangeStatusInSync},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			client := &awsclient.AWSClientMock{
+				ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+					g.Expect(dnsName).To(Equal("kafka.example.com"))
+					g.Expect(recordChangeBatch.Changes).To(HaveLen(1))
+					g.Expect(*recordChangeBatch.Changes[0].Action).To(Equal("UPSERT"))
+					g.Expect(*recordChangeBatch.Changes[0].ResourceRecordSet.Name).To(Equal("broker-0.kafka.example.com"))
+					g.Expect(*recordChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords[0].Value).To(Equal("elb.rhcloud.com"))
+					return tt.output, nil
+				},
+			}
+			provider, err := NewRoute53ProviderFactory(awsclient.NewMockClientFactory(client), awsclient.Config{}).NewProvider("us-east-1")
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := provider.ChangeRecords("kafka.example.com", ActionUpsert, []Record{
+				{Name: "broker-0.kafka.example.com", Type: "CNAME", TTL: 300, Value: "elb.rhcloud.com"},
+			})
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRoute53Provider_ChangeRecordsTXT(t *testing.T) {
+	g := NewWithT(t)
+	client := &awsclient.AWSClientMock{
+		ChangeResourceRecordSetsFunc: func(dnsName string, recordChangeBatch *route53.ChangeBatch) (*route53.ChangeResourceRecordSetsOutput, error) {
+			g.Expect(*recordChangeBatch.Changes[0].ResourceRecordSet.ResourceRecords[0].Value).To(Equal(`"challenge-token"`))
+			return nil, nil
+		},
+	}
+	provider, err := NewRoute53ProviderFactory(awsclient.NewMockClientFactory(client), awsclient.Config{}).NewProvider("us-east-1")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = provider.ChangeRecords("kafka.example.com", ActionUpsert, []Record{
+		{Name: "_acme-challenge.kafka.example.com", Type: "TXT", TTL: 60, Value: "challenge-token"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+}
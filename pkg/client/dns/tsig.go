@@ -0,0 +1,189 @@
+package dns
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TSIG (RFC 8945) signing and verification of DNS messages
+
+const (
+	typeTSIG  = 250
+	classANY  = 255
+	tsigFudge = 300
+
+	// DefaultTSIGAlgorithm is the TSIG algorithm used when none is configured
+	DefaultTSIGAlgorithm = "hmac-sha256."
+)
+
+var tsigAlgorithms = map[string]func() hash.Hash{
+	"hmac-sha1.":   sha1.New,
+	"hmac-sha224.": sha256.New224,
+	"hmac-sha256.": sha256.New,
+	"hmac-sha384.": sha512.New384,
+	"hmac-sha512.": sha512.New,
+}
+
+var tsigErrors = map[uint16]string{
+	16: "BADSIG",
+	17: "BADKEY",
+	18: "BADTIME",
+	22: "BADTRUNC",
+}
+
+type tsigKey struct {
+	name      string
+	algorithm string
+	secret    []byte
+}
+
+func newTSIGKey(name string, algorithm string, secret string) (*tsigKey, error) {
+	if algorithm == "" {
+		algorithm = DefaultTSIGAlgorithm
+	}
+	algorithm = strings.ToLower(fqdn(algorithm))
+	if _, ok := tsigAlgorithms[algorithm]; !ok {
+		return nil, errors.Errorf("unsupported tsig algorithm %s", algorithm)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "tsig secret must be base64 encoded")
+	}
+	return &tsigKey{
+		name:      strings.ToLower(fqdn(name)),
+		algorithm: algorithm,
+		secret:    decoded,
+	}, nil
+}
+
+// sign appends a TSIG record to the unsigned message, responses are signed including the MAC of the request
+func (k *tsigKey) sign(msg []byte, requestMAC []byte, now time.Time) ([]byte, []byte, error) {
+	if len(msg) < headerLen {
+		return nil, nil, errors.New("dns message too short")
+	}
+	timeSigned := uint64(now.Unix())
+	mac, err := k.mac(msg, requestMAC, timeSigned, tsigFudge, 0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rdata := appendName(nil, k.algorithm)
+	rdata = appendUint48(rdata, timeSigned)
+	rdata = appendUint16(rdata, tsigFudge)
+	rdata = appendUint16(rdata, uint16(len(mac)))
+	rdata = append(rdata, mac...)
+	rdata = append(rdata, msg[0:2]...) // original id
+	rdata = appendUint16(rdata, 0)
+	rdata = appendUint16(rdata, 0)
+
+	signed := append([]byte{}, msg...)
+	binary.BigEndian.PutUint16(signed[10:], binary.BigEndian.Uint16(msg[10:])+1)
+	signed = appendName(signed, k.name)
+	signed = appendUint16(signed, typeTSIG)
+	signed = appendUint16(signed, classANY)
+	signed = appendUint32(signed, 0)
+	signed = appendUint16(signed, uint16(len(rdata)))
+	signed = append(signed, rdata...)
+	return signed, mac, nil
+}
+
+// verify checks the TSIG record of a signed message and returns its MAC
+func (k *tsigKey) verify(msg []byte, requestMAC []byte, now time.Time) ([]byte, error) {
+	start, err := lastAdditionalOffset(msg)
+	if err != nil {
+		return nil, err
+	}
+	name, off, err := readName(msg, start)
+	if err != nil {
+		return nil, err
+	}
+	if off+10 > len(msg) || binary.BigEndian.Uint16(msg[off:]) != typeTSIG {
+		return nil, errors.New("dns message is not signed")
+	}
+	rdlength := int(binary.BigEndian.Uint16(msg[off+8:]))
+	rdata := off + 10
+	if rdata+rdlength != len(msg) {
+		return nil, errors.New("invalid tsig record length")
+	}
+	algorithm, off, err := readName(msg, rdata)
+	if err != nil {
+		return nil, err
+	}
+	if off+10 > len(msg) {
+		return nil, errors.New("invalid tsig record")
+	}
+	timeSigned := uint64(binary.BigEndian.Uint16(msg[off:]))<<32 | uint64(binary.BigEndian.Uint32(msg[off+2:]))
+	fudge := binary.BigEndian.Uint16(msg[off+6:])
+	macSize := int(binary.BigEndian.Uint16(msg[off+8:]))
+	off += 10
+	if off+macSize+6 > len(msg) {
+		return nil, errors.New("invalid tsig record")
+	}
+	mac := msg[off : off+macSize]
+	originalID := msg[off+macSize : off+macSize+2]
+	tsigError := binary.BigEndian.Uint16(msg[off+macSize+2:])
+	otherLen := int(binary.BigEndian.Uint16(msg[off+macSize+4:]))
+	if off+macSize+6+otherLen != len(msg) {
+		return nil, errors.New("invalid tsig record")
+	}
+	otherData := msg[off+macSize+6:]
+
+	if !strings.EqualFold(name, k.name) || !strings.EqualFold(algorithm, k.algorithm) {
+		return nil, errors.Errorf("dns message signed with unknown key %s", name)
+	}
+	if tsigError != 0 {
+		return nil, errors.Errorf("tsig error %s", tsigErrorString(tsigError))
+	}
+
+	unsigned := append([]byte{}, msg[:start]...)
+	copy(unsigned[0:2], originalID)
+	binary.BigEndian.PutUint16(unsigned[10:], binary.BigEndian.Uint16(msg[10:])-1)
+	expected, err := k.mac(unsigned, requestMAC, timeSigned, fudge, tsigError, otherData)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, expected) {
+		return nil, errors.Errorf("tsig error %s", tsigErrorString(16))
+	}
+	if delta := now.Unix() - int64(timeSigned); delta > int64(fudge) || -delta > int64(fudge) {
+		return nil, errors.Errorf("tsig error %s", tsigErrorString(18))
+	}
+	return mac, nil
+}
+
+// mac computes the MAC of the message and the TSIG variables, see RFC 8945 section 4.3
+func (k *tsigKey) mac(msg []byte, requestMAC []byte, timeSigned uint64, fudge uint16, tsigError uint16, otherData []byte) ([]byte, error) {
+	h := hmac.New(tsigAlgorithms[k.algorithm], k.secret)
+	if requestMAC != nil {
+		h.Write(appendUint16(nil, uint16(len(requestMAC))))
+		h.Write(requestMAC)
+	}
+	h.Write(msg)
+	variables := appendName(nil, k.name)
+	variables = appendUint16(variables, classANY)
+	variables = appendUint32(variables, 0)
+	variables = appendName(variables, k.algorithm)
+	variables = appendUint48(variables, timeSigned)
+	variables = appendUint16(variables, fudge)
+	variables = appendUint16(variables, tsigError)
+	variables = appendUint16(variables, uint16(len(otherData)))
+	variables = append(variables, otherData...)
+	h.Write(variables)
+	return h.Sum(nil), nil
+}
+
+func tsigErrorString(code uint16) string {
+	if s, ok := tsigErrors[code]; ok {
+		return s
+	}
+	return rcodeString(int(code))
+}
@@ -0,0 +1,129 @@
+package dns
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// test vectors published with the TSIG tests of github.com/miekg/dns, an update of example.com
+// signed with the key testkey. at 1594855491
+const (
+	tsigVectorKey        = "testkey."
+	tsigVectorTimeSigned = 1594855491
+)
+
+func tsigVectorMessage() []byte {
+	msg := []byte{0, 42, 0x28, 0, 0, 1, 0, 0, 0, 0, 0, 0} // id 42, opcode update, 1 question
+	msg = appendName(msg, "example.com.")
+	msg = appendUint16(msg, 6) // SOA
+	return appendUint16(msg, 1)
+}
+
+func TestTSIGKey_mac(t *testing.T) {
+	tests := []struct {
+		name        string
+		algorithm   string
+		secret      string
+		requestMAC  string
+		tsigError   uint16
+		otherData   string
+		expectedMAC string
+	}{
+		{
+			name:        "hmac-sha256 with request MAC",
+			algorithm:   "hmac-sha256",
+			secret:      "NoTCJU+DMqFWywaPyxSijrDEA/eC3nK0xi3AMEZuPVk=",
+			requestMAC:  "3684c225",
+			tsigError:   18,
+			expectedMAC: "c110e3f62694755c10761dc8717462431ee34340b7c9d1eee09449150757c5b1",
+		},
+		{
+			name:        "hmac-sha256 without request MAC",
+			algorithm:   "hmac-sha256",
+			secret:      "NoTCJU+DMqFWywaPyxSijrDEA/eC3nK0xi3AMEZuPVk=",
+			tsigError:   18,
+			expectedMAC: "385449a425c6d52b9bf2c65c0726eefa0ad8084cdaf488f24547e686605b9610",
+		},
+		{
+			name:        "hmac-sha256 with other data",
+			algorithm:   "hmac-sha256",
+			secret:      "NoTCJU+DMqFWywaPyxSijrDEA/eC3nK0xi3AMEZuPVk=",
+			requestMAC:  "3684c225",
+			tsigError:   18,
+			otherData:   "666f6f",
+			expectedMAC: "15b91571ca80b3b410a77e2b44f8cc4f35ace22b26020138439dd94803e23b5d",
+		},
+		{
+			name:        "hmac-sha224",
+			algorithm:   "hmac-sha224",
+			secret:      "hVEkQuAqnTmBuRrT9KF1Udr91gOMGWPw9LaTtw==",
+			expectedMAC: "d6daf9ea189e48bc38f9aed63d6cc4140cdfa38a7a333ee2eefdbd31",
+		},
+		{
+			name:        "hmac-sha384",
+			algorithm:   "hmac-sha384",
+			secret:      "Qjer2TL2lAdpq9w6Gjs98/ClCQx/L3vtgVHCmrZ8l/oKEPjqUUMFO18gMCRwd5H4",
+			expectedMAC: "89a48936d29187870c325cbdba5ad71609bd038d0459d6010c844d659c570e881d3650e4fe7310be53ebe5178d0d1001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			key, err := newTSIGKey(tsigVectorKey, tt.algorithm, tt.secret)
+			g.Expect(err).ToNot(HaveOccurred())
+			var requestMAC []byte
+			if tt.requestMAC != "" {
+				requestMAC, err = hex.DecodeString(tt.requestMAC)
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			otherData, err := hex.DecodeString(tt.otherData)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			mac, err := key.mac(tsigVectorMessage(), requestMAC, tsigVectorTimeSigned, 300, tt.tsigError, otherData)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(hex.EncodeToString(mac)).To(Equal(tt.expectedMAC))
+		})
+	}
+}
+
+func TestTSIGKey_verify(t *testing.T) {
+	g := NewWithT(t)
+	key, err := newTSIGKey(tsigVectorKey, "hmac-sha256", "NoTCJU+DMqFWywaPyxSijrDEA/eC3nK0xi3AMEZuPVk=")
+	g.Expect(err).ToNot(HaveOccurred())
+	signedAt := time.Unix(tsigVectorTimeSigned, 0)
+
+	signed, mac, err := key.sign(tsigVectorMessage(), nil, signedAt)
+	g.Expect(err).ToNot(HaveOccurred())
+	verified, err := key.verify(signed, nil, signedAt.Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(verified).To(Equal(mac))
+
+	// the message id may be rewritten in transit, the original id is signed
+	rewritten := append([]byte{}, signed...)
+	rewritten[1] = 7
+	_, err = key.verify(rewritten, nil, signedAt)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tampered := append([]byte{}, signed...)
+	tampered[3] = 1 // rcode
+	_, err = key.verify(tampered, nil, signedAt)
+	g.Expect(err).To(MatchError("tsig error BADSIG"))
+
+	_, err = key.verify(signed, nil, signedAt.Add(time.Hour))
+	g.Expect(err).To(MatchError("tsig error BADTIME"))
+
+	// a response is signed including the MAC of the request
+	response, _, err := key.sign(tsigVectorMessage(), mac, signedAt)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = key.verify(response, mac, signedAt)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = key.verify(response, nil, signedAt)
+	g.Expect(err).To(MatchError("tsig error BADSIG"))
+
+	_, err = key.verify(tsigVectorMessage(), nil, signedAt)
+	g.Expect(err).To(MatchError("dns message is not signed"))
+}
@@ -0,0 +1,135 @@
+package dns
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// minimal DNS wire format helpers for the parts of messages not handled by dnsmessage
+
+const headerLen = 12
+
+var rcodeNames = map[int]string{
+	0:  "NOERROR",
+	1:  "FORMERR",
+	2:  "SERVFAIL",
+	3:  "NXDOMAIN",
+	4:  "NOTIMP",
+	5:  "REFUSED",
+	6:  "YXDOMAIN",
+	7:  "YXRRSET",
+	8:  "NXRRSET",
+	9:  "NOTAUTH",
+	10: "NOTZONE",
+}
+
+func rcodeString(rcode int) string {
+	if s, ok := rcodeNames[rcode]; ok {
+		return s
+	}
+	return "RCODE" + strconv.Itoa(rcode)
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// appendName appends the uncompressed wire format of a fully qualified domain name
+func appendName(b []byte, name string) []byte {
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if label == "" {
+			continue
+		}
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return append(b, byte(v>>8), byte(v))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint48(b []byte, v uint64) []byte {
+	b = appendUint16(b, uint16(v>>32))
+	return appendUint32(b, uint32(v))
+}
+
+// readName reads a possibly compressed domain name at off, returning the name and the offset following it
+func readName(msg []byte, off int) (string, int, error) {
+	var labels []string
+	next := -1
+	for hops := 0; ; hops++ {
+		if off >= len(msg) || hops > 126 {
+			return "", 0, errors.New("invalid domain name")
+		}
+		c := int(msg[off])
+		switch c & 0xC0 {
+		case 0x00:
+			if c == 0 {
+				if next < 0 {
+					next = off + 1
+				}
+				return strings.Join(labels, ".") + ".", next, nil
+			}
+			if off+1+c > len(msg) {
+				return "", 0, errors.New("invalid domain name")
+			}
+			labels = append(labels, string(msg[off+1:off+1+c]))
+			off += 1 + c
+		case 0xC0:
+			if off+2 > len(msg) {
+				return "", 0, errors.New("invalid domain name")
+			}
+			if next < 0 {
+				next = off + 2
+			}
+			off = int(binary.BigEndian.Uint16(msg[off:]) & 0x3FFF)
+		default:
+			return "", 0, errors.New("invalid domain name label")
+		}
+	}
+}
+
+// lastAdditionalOffset returns the offset of the last resource record in the additional section
+func lastAdditionalOffset(msg []byte) (int, error) {
+	if len(msg) < headerLen {
+		return 0, errors.New("dns message too short")
+	}
+	questions := int(binary.BigEndian.Uint16(msg[4:]))
+	records := int(binary.BigEndian.Uint16(msg[6:])) + int(binary.BigEndian.Uint16(msg[8:])) + int(binary.BigEndian.Uint16(msg[10:]))
+	if records == 0 {
+		return 0, errors.New("dns message is not signed")
+	}
+	off := headerLen
+	var err error
+	for i := 0; i < questions; i++ {
+		if _, off, err = readName(msg, off); err != nil {
+			return 0, err
+		}
+		off += 4
+	}
+	for i := 0; i < records-1; i++ {
+		if _, off, err = readName(msg, off); err != nil {
+			return 0, err
+		}
+		if off+10 > len(msg) {
+			return 0, errors.New("invalid resource record")
+		}
+		off += 10 + int(binary.BigEndian.Uint16(msg[off+8:]))
+	}
+	if off >= len(msg) {
+		return 0, errors.New("invalid resource record")
+	}
+	return off, nil
+}
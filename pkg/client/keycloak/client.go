@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Nerzal/gocloak/v11"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
@@ -82,6 +83,8 @@ func NewClient(config *KeycloakConfig, realmConfig *KeycloakRealmConfig) *kcClie
 	client := gocloak.NewClient(realmConfig.BaseURL)
 	client.RestyClient().SetDebug(config.Debug)
 	client.RestyClient().SetTLSClientConfig(&tls.Config{InsecureSkipVerify: config.InsecureSkipVerify})
+	// wrapped last as resty can only set the TLS config of a *http.Transport
+	client.RestyClient().SetTransport(tracing.Transport(client.RestyClient().GetClient().Transport))
 	return &kcClient{
 		kcClient:    client,
 		ctx:         context.Background(),
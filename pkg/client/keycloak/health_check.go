@@ -0,0 +1,13 @@
+package keycloak
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+)
+
+// NewHealthCheck returns the health check of the reachability of the token endpoint of the selected sso provider
+func NewHealthCheck(config *KeycloakConfig) *environments.FuncHealthCheck {
+	return environments.NewHTTPHealthCheck(environments.HealthCheckOptions{
+		Name:        "sso",
+		Criticality: environments.HealthCheckNonCritical,
+	}, config.SSOProviderRealm().TokenEndpointURI)
+}
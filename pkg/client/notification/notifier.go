@@ -0,0 +1,23 @@
+package notification
+
+import "context"
+
+// Notification is a message sent to the owner of a resource about an event of the resource
+type Notification struct {
+	// Event identifies the kind of event, e.g. kafka_expiring
+	Event string `json:"event"`
+	// Subject is a short human readable summary of the event
+	Subject string `json:"subject"`
+	// Message is the human readable description of the event
+	Message string `json:"message"`
+	// Recipients are the users to notify, e.g. the owner of the resource
+	Recipients []string `json:"recipients"`
+	// Data holds the attributes of the event for machine consumption
+	Data map[string]string `json:"data,omitempty"`
+}
+
+//go:generate moq -out notifier_moq.go . Notifier
+type Notifier interface {
+	// Notify delivers the notification, an error is returned when it could not be delivered
+	Notify(ctx context.Context, notification Notification) error
+}
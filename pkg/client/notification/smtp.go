@@ -0,0 +1,109 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SMTPConfig configures the delivery of notifications as emails
+type SMTPConfig struct {
+	// Address is the host:port of the SMTP server
+	Address string
+	// From is the sender address of the emails
+	From string
+	// To are addresses receiving every notification in addition to the recipients of the notification
+	To []string
+	// Username and Password authenticate with the SMTP server using PLAIN auth when Username is not empty
+	Username string
+	Password string
+}
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+type smtpNotifier struct {
+	config   SMTPConfig
+	sendMail sendMailFunc
+}
+
+var _ Notifier = &smtpNotifier{}
+
+// NewSMTPNotifier returns a notifier sending each notification as an email. Only the recipients of the
+// notification that are valid email addresses receive it, along with the configured To addresses
+func NewSMTPNotifier(config SMTPConfig) *smtpNotifier {
+	return &smtpNotifier{
+		config:   config,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (s *smtpNotifier) Notify(ctx context.Context, notification Notification) error {
+	recipients := s.recipients(notification)
+	if len(recipients) == 0 {
+		return fmt.Errorf("no email address to send the %s notification to", notification.Event)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.config.Username != "" {
+		host, _, err := net.SplitHostPort(s.config.Address)
+		if err != nil {
+			return fmt.Errorf("invalid smtp address %q: %w", s.config.Address, err)
+		}
+		auth = smtp.PlainAuth("", s.config.Username, s.config.Password, host)
+	}
+
+	if err := s.sendMail(s.config.Address, auth, s.config.From, recipients, s.message(notification, recipients)); err != nil {
+		return fmt.Errorf("failed to send notification email: %w", err)
+	}
+	return nil
+}
+
+func (s *smtpNotifier) recipients(notification Notification) []string {
+	seen := map[string]bool{}
+	var recipients []string
+	for _, recipient := range append(append([]string{}, s.config.To...), notification.Recipients...) {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil || seen[address.Address] {
+			continue
+		}
+		seen[address.Address] = true
+		recipients = append(recipients, address.Address)
+	}
+	return recipients
+}
+
+func (s *smtpNotifier) message(notification Notification, recipients []string) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", notification.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(notification.Message, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+
+	if len(notification.Data) > 0 {
+		keys := make([]string, 0, len(notification.Data))
+		for key := range notification.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		msg.WriteString("\r\n")
+		for _, key := range keys {
+			fmt.Fprintf(&msg, "%s: %s\r\n", key, notification.Data[key])
+		}
+	}
+	return msg.Bytes()
+}
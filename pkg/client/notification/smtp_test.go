@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	notification := Notification{
+		Event:      "kafka_expiring",
+		Subject:    "Kafka instance expiring",
+		Message:    "Your Kafka instance expires soon",
+		Recipients: []string{"owner@example.com", "not an email"},
+		Data:       map[string]string{"kafka_id": "kafka-id"},
+	}
+
+	tests := []struct {
+		name           string
+		config         SMTPConfig
+		notification   Notification
+		sendErr        error
+		wantRecipients []string
+		wantAuth       bool
+		wantErr        bool
+	}{
+		{
+			name:           "should send the email to the recipients that are email addresses",
+			config:         SMTPConfig{Address: "smtp.example.com:25", From: "noreply@example.com"},
+			notification:   notification,
+			wantRecipients: []string{"owner@example.com"},
+		},
+		{
+			name:           "should send the email to the configured addresses",
+			config:         SMTPConfig{Address: "smtp.example.com:25", From: "noreply@example.com", To: []string{"ops@example.com", "owner@example.com"}},
+			notification:   notification,
+			wantRecipients: []string{"ops@example.com", "owner@example.com"},
+		},
+		{
+			name:           "should authenticate when a username is configured",
+			config:         SMTPConfig{Address: "smtp.example.com:587", From: "noreply@example.com", Username: "user", Password: "password"},
+			notification:   notification,
+			wantRecipients: []string{"owner@example.com"},
+			wantAuth:       true,
+		},
+		{
+			name:         "should return an error when there is no email address to send to",
+			config:       SMTPConfig{Address: "smtp.example.com:25", From: "noreply@example.com"},
+			notification: Notification{Event: "kafka_expiring", Recipients: []string{"owner"}},
+			wantErr:      true,
+		},
+		{
+			name:           "should return an error when the email could not be sent",
+			config:         SMTPConfig{Address: "smtp.example.com:25", From: "noreply@example.com"},
+			notification:   notification,
+			sendErr:        errors.New("connection refused"),
+			wantRecipients: []string{"owner@example.com"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			var sent bool
+			notifier := NewSMTPNotifier(tt.config)
+			notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				sent = true
+				g.Expect(addr).To(Equal(tt.config.Address))
+				g.Expect(a != nil).To(Equal(tt.wantAuth))
+				g.Expect(from).To(Equal(tt.config.From))
+				g.Expect(to).To(Equal(tt.wantRecipients))
+				g.Expect(string(msg)).To(ContainSubstring("Subject: Kafka instance expiring\r\n"))
+				g.Expect(string(msg)).To(ContainSubstring("\r\n\r\nYour Kafka instance expires soon\r\n"))
+				g.Expect(string(msg)).To(ContainSubstring("kafka_id: kafka-id\r\n"))
+				return tt.sendErr
+			}
+
+			err := notifier.Notify(context.Background(), tt.notification)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(sent).To(Equal(tt.wantRecipients != nil))
+		})
+	}
+}
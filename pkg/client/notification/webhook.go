@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// maxErrorBodySize limits how much of an error response is included in the returned error
+const maxErrorBodySize = 1024
+
+type webhookNotifier struct {
+	url        string
+	token      string
+	httpClient *http.Client
+}
+
+var _ Notifier = &webhookNotifier{}
+
+// NewWebhookNotifier returns a notifier POSTing each notification as JSON to the url. The token, when not empty,
+// is sent as a bearer token in the Authorization header
+func NewWebhookNotifier(url string, token string, httpClient *http.Client) *webhookNotifier {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &webhookNotifier{
+		url:        url,
+		token:      token,
+		httpClient: httpClient,
+	}
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		request.Header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to send notification to webhook: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		responseBody, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
+		return fmt.Errorf("webhook responded with status %d: %s", response.StatusCode, string(responseBody))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	notification := Notification{
+		Event:      "kafka_expiring",
+		Subject:    "Kafka instance expiring",
+		Message:    "Your Kafka instance expires soon",
+		Recipients: []string{"owner"},
+		Data:       map[string]string{"kafka_id": "kafka-id"},
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		status    int
+		wantToken string
+		wantErr   bool
+	}{
+		{
+			name:   "should post the notification",
+			status: http.StatusAccepted,
+		},
+		{
+			name:      "should authenticate with the token",
+			token:     "token",
+			status:    http.StatusOK,
+			wantToken: "Bearer token",
+		},
+		{
+			name:    "should return an error when the webhook fails",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+				g.Expect(r.Header.Get("Authorization")).To(Equal(tt.wantToken))
+				var got Notification
+				g.Expect(json.NewDecoder(r.Body).Decode(&got)).To(Succeed())
+				g.Expect(got).To(Equal(notification))
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhookNotifier(server.URL, tt.token, nil).Notify(context.Background(), notification)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/pkg/errors"
 	pAPI "github.com/prometheus/client_golang/api"
 	pV1 "github.com/prometheus/client_golang/api/prometheus/v1"
@@ -90,7 +91,7 @@ func NewClient(config *Configuration) (*Client, error) {
 		Address: client.Config.BaseURL,
 		RoundTripper: observatoriumRoundTripper{
 			config:  *client.Config,
-			wrapped: pAPI.DefaultRoundTripper,
+			wrapped: tracing.Transport(pAPI.DefaultRoundTripper),
 		},
 	})
 	if err != nil {
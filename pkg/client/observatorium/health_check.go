@@ -0,0 +1,26 @@
+package observatorium
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+)
+
+// NewHealthCheck returns the health check of the reachability of the observatorium API used by the Observatorium client
+func NewHealthCheck(c *ObservabilityConfiguration) *environments.FuncHealthCheck {
+	options := environments.HealthCheckOptions{
+		Name:        "observatorium",
+		Criticality: environments.HealthCheckNonCritical,
+	}
+	if c.EnableMock {
+		return environments.NewFuncHealthCheck(options, func(ctx context.Context) error {
+			return nil
+		})
+	}
+
+	url := c.ObservatoriumGateway
+	if c.AuthType == AuthTypeSso {
+		url = c.RedHatSsoTokenRefresherUrl
+	}
+	return environments.NewHTTPHealthCheck(options, url)
+}
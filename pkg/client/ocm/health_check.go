@@ -0,0 +1,30 @@
+package ocm
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+)
+
+// NewHealthCheck returns the health check of the reachability of the OCM API
+func NewHealthCheck(config *OCMConfig) *environments.FuncHealthCheck {
+	return newHealthCheck("ocm", config.BaseURL, config.EnableMock)
+}
+
+// NewAMSHealthCheck returns the health check of the reachability of the AMS API
+func NewAMSHealthCheck(config *OCMConfig) *environments.FuncHealthCheck {
+	return newHealthCheck("ams", config.AmsUrl, config.EnableMock)
+}
+
+func newHealthCheck(name string, url string, enableMock bool) *environments.FuncHealthCheck {
+	options := environments.HealthCheckOptions{
+		Name:        name,
+		Criticality: environments.HealthCheckNonCritical,
+	}
+	if enableMock {
+		return environments.NewFuncHealthCheck(options, func(ctx context.Context) error {
+			return nil
+		})
+	}
+	return environments.NewHTTPHealthCheck(options, url)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	serviceaccountsclient "github.com/redhat-developer/app-services-sdk-go/serviceaccounts/apiv1internal/client"
@@ -23,6 +24,9 @@ const (
 	cacheCleanupInterval = 299 * time.Second
 )
 
+// httpClient is shared by the requests made to the sso server so that they are traced
+var httpClient = &http.Client{Transport: tracing.Transport(http.DefaultTransport)}
+
 //go:generate moq -out client_moq.go . SSOClient
 type SSOClient interface {
 	GetToken() (string, error)
@@ -41,8 +45,9 @@ func NewSSOClient(config *keycloak.KeycloakConfig, realmConfig *keycloak.Keycloa
 		config:      config,
 		realmConfig: realmConfig,
 		configuration: &serviceaccountsclient.Configuration{
-			UserAgent: "OpenAPI-Generator/1.0.0/go",
-			Debug:     false,
+			UserAgent:  "OpenAPI-Generator/1.0.0/go",
+			Debug:      false,
+			HTTPClient: httpClient,
 			Servers: serviceaccountsclient.ServerConfigurations{
 				{
 					URL: realmConfig.BaseURL + realmConfig.APIEndpointURI,
@@ -77,8 +82,9 @@ func (c *rhSSOClient) getConfiguration(accessToken string) *serviceaccountsclien
 			"Authorization": fmt.Sprintf("Bearer %s", accessToken),
 			"Content-Type":  "application/json",
 		},
-		UserAgent: "OpenAPI-Generator/1.0.0/go",
-		Debug:     false,
+		UserAgent:  "OpenAPI-Generator/1.0.0/go",
+		Debug:      false,
+		HTTPClient: httpClient,
 		Servers: serviceaccountsclient.ServerConfigurations{
 			{
 				URL: c.realmConfig.BaseURL + c.realmConfig.APIEndpointURI,
@@ -105,7 +111,6 @@ func (c *rhSSOClient) GetToken() (string, error) {
 		return cachedToken, nil
 	}
 
-	client := &http.Client{}
 	parameters := url.Values{}
 	parameters.Set("grant_type", "client_credentials")
 	parameters.Set("client_id", c.realmConfig.ClientID)
@@ -117,7 +122,7 @@ func (c *rhSSOClient) GetToken() (string, error) {
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Content-Length", strconv.Itoa(len(parameters.Encode())))
 
-	resp, err := client.Do(req)
+	resp, err := httpClient.Do(req)
 
 	if err != nil {
 		return "", err
@@ -119,8 +119,9 @@ func Test_rhSSOClient_getConfiguration(t *testing.T) {
 					"Authorization": fmt.Sprintf("Bearer %s", "accessToken"),
 					"Content-Type":  "application/json",
 				},
-				UserAgent: "OpenAPI-Generator/1.0.0/go",
-				Debug:     false,
+				UserAgent:  "OpenAPI-Generator/1.0.0/go",
+				Debug:      false,
+				HTTPClient: httpClient,
 				Servers: serviceaccountsclient.ServerConfigurations{
 					{
 						URL: "",
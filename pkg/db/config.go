@@ -2,6 +2,8 @@ package db
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
 
@@ -26,6 +28,14 @@ type DatabaseConfig struct {
 	NameFile           string `json:"name_file"`
 	UsernameFile       string `json:"username_file"`
 	PasswordFile       string `json:"password_file"`
+
+	// ReadReplicas are the connection strings of the read replicas serving the sessions returned by
+	// ConnectionFactory.NewReadOnly. They are read from ReadReplicasFile, one per line.
+	ReadReplicas     []string `json:"read_replicas"`
+	ReadReplicasFile string   `json:"read_replicas_file"`
+	// ReadReplicaMaxLag is the replication lag above which the reads of a replica fall back to the primary
+	ReadReplicaMaxLag           time.Duration `json:"read_replica_max_lag"`
+	ReadReplicaLagCheckInterval time.Duration `json:"read_replica_lag_check_interval"`
 }
 
 func NewDatabaseConfig() *DatabaseConfig {
@@ -41,6 +51,9 @@ func NewDatabaseConfig() *DatabaseConfig {
 		PasswordFile:       "secrets/db.password",
 		NameFile:           "secrets/db.name",
 		DatabaseCaCertFile: "secrets/db.ca_cert",
+
+		ReadReplicaMaxLag:           10 * time.Second,
+		ReadReplicaLagCheckInterval: 5 * time.Second,
 	}
 }
 
@@ -54,6 +67,9 @@ func (c *DatabaseConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.SSLMode, "db-sslmode", c.SSLMode, "Database ssl mode (disable | require | verify-ca | verify-full)")
 	fs.BoolVar(&c.Debug, "enable-db-debug", c.Debug, " framework's debug mode")
 	fs.IntVar(&c.MaxOpenConnections, "db-max-open-connections", c.MaxOpenConnections, "Maximum open DB connections for this instance")
+	fs.StringVar(&c.ReadReplicasFile, "db-read-replicas-file", c.ReadReplicasFile, "Database read replica connection strings file, one per line. The list and count queries are served by the primary when empty")
+	fs.DurationVar(&c.ReadReplicaMaxLag, "db-read-replica-max-lag", c.ReadReplicaMaxLag, "Replication lag above which the reads of a database read replica fall back to the primary")
+	fs.DurationVar(&c.ReadReplicaLagCheckInterval, "db-read-replica-lag-check-interval", c.ReadReplicaLagCheckInterval, "Interval between two measures of the replication lag of the database read replicas")
 }
 
 func (c *DatabaseConfig) ReadFiles() error {
@@ -79,7 +95,21 @@ func (c *DatabaseConfig) ReadFiles() error {
 	}
 
 	err = shared.ReadFileValueString(c.NameFile, &c.Name)
-	return err
+	if err != nil {
+		return err
+	}
+
+	var readReplicas string
+	err = shared.ReadFileValueString(c.ReadReplicasFile, &readReplicas)
+	if err != nil {
+		return err
+	}
+	for _, readReplica := range strings.Split(readReplicas, "\n") {
+		if readReplica = strings.TrimSpace(readReplica); readReplica != "" {
+			c.ReadReplicas = append(c.ReadReplicas, readReplica)
+		}
+	}
+	return nil
 }
 
 func (c *DatabaseConfig) ConnectionString() string {
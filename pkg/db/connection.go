@@ -13,6 +13,9 @@ import (
 type ConnectionFactory struct {
 	Config *DatabaseConfig
 	DB     *gorm.DB
+
+	readReplicas    []*readReplica
+	nextReadReplica uint32
 }
 
 var gormConfig *gorm.Config = &gorm.Config{
@@ -51,8 +54,20 @@ func NewConnectionFactory(config *DatabaseConfig) (*ConnectionFactory, func()) {
 	}
 
 	sqlDB.SetMaxOpenConns(config.MaxOpenConnections)
-	dbFactory := &ConnectionFactory{Config: config, DB: db}
+
+	readReplicas, err := openReadReplicas(config)
+	if err != nil {
+		panic(err)
+	}
+
+	dbFactory := &ConnectionFactory{Config: config, DB: db, readReplicas: readReplicas}
+	stopReadReplicasMonitor := make(chan struct{})
+	if len(readReplicas) > 0 {
+		dbFactory.checkReadReplicas()
+		go dbFactory.monitorReadReplicas(config.ReadReplicaLagCheckInterval, stopReadReplicasMonitor)
+	}
 	cleanup := func() {
+		close(stopReadReplicasMonitor)
 		if err := dbFactory.close(); err != nil {
 			glog.Fatalf("Unable to close db connection: %s", err.Error())
 		}
@@ -79,7 +94,7 @@ func NewMockConnectionFactory(dbConfig *DatabaseConfig) *ConnectionFactory {
 	if err != nil {
 		panic(err)
 	}
-	connectionFactory := &ConnectionFactory{dbConfig, mocketDB}
+	connectionFactory := &ConnectionFactory{Config: dbConfig, DB: mocketDB}
 	return connectionFactory
 }
 
@@ -100,6 +115,16 @@ func (f *ConnectionFactory) CheckConnection() error {
 // THIS MUST **NOT** BE CALLED UNTIL THE SERVER/PROCESS IS EXITING!!
 // This should only ever be called once for the entire duration of the application and only at the end.
 func (f *ConnectionFactory) close() error {
+	for _, replica := range f.readReplicas {
+		sqlDB, sqlDBErr := replica.db.DB()
+		if sqlDBErr != nil {
+			return sqlDBErr
+		}
+		if err := sqlDB.Close(); err != nil {
+			return err
+		}
+	}
+
 	sqlDB, sqlDBErr := f.DB.DB()
 	if sqlDBErr != nil {
 		return sqlDBErr
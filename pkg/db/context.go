@@ -7,6 +7,7 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"gorm.io/gorm"
 )
 
 type contextKey int
@@ -98,6 +99,18 @@ func FromContext(ctx context.Context) (*sql.Tx, error) {
 	return transaction.tx, nil
 }
 
+// RequestTransaction returns a session running its queries in the transaction stored in the context, so that they are
+// committed or rolled back along with the request
+func (c *ConnectionFactory) RequestTransaction(ctx context.Context) (*gorm.DB, error) {
+	tx, err := FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session := c.New().Session(&gorm.Session{NewDB: true, Context: ctx})
+	session.Statement.ConnPool = tx
+	return session, nil
+}
+
 // MarkForRollback flags the transaction stored in the context for rollback and logs whatever error caused the rollback
 func MarkForRollback(ctx context.Context, err error) {
 	ulog := logger.NewUHCLogger(ctx)
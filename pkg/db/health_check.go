@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// NewHealthCheck returns the health check of the connection to the primary database
+func NewHealthCheck(connectionFactory *ConnectionFactory) *environments.FuncHealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        "database",
+		Criticality: environments.HealthCheckCritical,
+	}, func(ctx context.Context) error {
+		sqlDB, err := connectionFactory.DB.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	})
+}
+
+// NewMigrationHealthCheck returns a health check failing until the last of the given migrations is applied, so that an
+// instance does not serve requests against a schema older than the one it expects
+func NewMigrationHealthCheck(name string, connectionFactory *ConnectionFactory, gormOptions *gormigrate.Options, migrations []*gormigrate.Migration) *environments.FuncHealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        name,
+		Criticality: environments.HealthCheckCritical,
+	}, func(ctx context.Context) error {
+		if len(migrations) == 0 {
+			return nil
+		}
+		latest := migrations[len(migrations)-1].ID
+
+		var count int64
+		query := fmt.Sprintf("SELECT count(1) FROM %s WHERE %s = ?", gormOptions.TableName, gormOptions.IDColumnName)
+		if err := connectionFactory.New().WithContext(ctx).Raw(query, latest).Scan(&count).Error; err != nil {
+			return fmt.Errorf("failed to read the applied migrations: %v", err)
+		}
+		if count == 0 {
+			return fmt.Errorf("migration %s is not applied", latest)
+		}
+		return nil
+	})
+}
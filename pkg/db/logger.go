@@ -6,6 +6,10 @@ import (
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm/logger"
 )
 
@@ -41,9 +45,27 @@ func (l customLoggerWithMetricsCollector) Trace(ctx context.Context, begin time.
 		status = "failure"
 	}
 
-	sql, _ := fc()
+	sql, rows := fc()
 	sql = strings.TrimLeft(sql, " ")
 	tokens := strings.Split(sql, " ")
 	metrics.IncreaseDatabaseQueryCount(status, tokens[0])
 	metrics.UpdateDatabaseQueryDurationMetric(status, tokens[0], elapsed)
+
+	// only queries run on behalf of a traced request or reconcile are traced, so that untraced queries don't start
+	// root spans of their own
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	// the statement is not recorded as gorm interpolates the values of its parameters
+	_, span := tracing.Tracer().Start(ctx, "db "+tokens[0], trace.WithTimestamp(begin), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", tokens[0]),
+		attribute.Int64("db.rows_affected", rows),
+	))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End(trace.WithTimestamp(begin.Add(elapsed)))
 }
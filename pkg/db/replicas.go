@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/golang/glog"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// replicaLagQuery measures the replication lag of a standby. A standby which replayed all the WAL it received is not
+// lagging, however old the last transaction it replayed is, and a server which is not a standby reports no lag.
+const replicaLagQuery = `SELECT COALESCE(CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()) END, 0)`
+
+type readReplica struct {
+	// name is the index of the replica in the configuration, as its connection string holds its credentials
+	name string
+	db   *gorm.DB
+	// available is 1 when the last lag check succeeded and the lag did not exceed the max lag
+	available int32
+}
+
+// openReadReplicas connects to the read replicas of the given config. They are unavailable until their lag is checked.
+func openReadReplicas(config *DatabaseConfig) ([]*readReplica, error) {
+	var replicas []*readReplica
+	for i, dsn := range config.ReadReplicas {
+		// each gorm.DB needs its own config, and a replica unreachable on startup must not prevent the service from starting
+		replicaDB, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+			PrepareStmt:          true,
+			QueryFields:          true,
+			Logger:               customLoggerWithMetricsCollector{},
+			DisableAutomaticPing: true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to open read replica %d: %v", i, err)
+		}
+		sqlDB, err := replicaDB.DB()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open read replica %d: %v", i, err)
+		}
+		sqlDB.SetMaxOpenConns(config.MaxOpenConnections)
+		replicas = append(replicas, &readReplica{name: strconv.Itoa(i), db: replicaDB})
+	}
+	return replicas, nil
+}
+
+func (r *readReplica) isAvailable() bool {
+	return atomic.LoadInt32(&r.available) == 1
+}
+
+// checkLag measures the replication lag of the replica and makes it unavailable when it exceeds maxLag or can't be measured
+func (r *readReplica) checkLag(maxLag time.Duration) {
+	var lagSeconds float64
+	err := r.db.Raw(replicaLagQuery).Scan(&lagSeconds).Error
+	lag := time.Duration(lagSeconds * float64(time.Second))
+
+	var available int32
+	if err != nil {
+		glog.Warningf("failed to check the lag of database read replica %s: %v", r.name, err)
+	} else {
+		metrics.UpdateDatabaseReplicaLagMetric(r.name, lag)
+		if lag <= maxLag {
+			available = 1
+		}
+	}
+
+	if previous := atomic.SwapInt32(&r.available, available); previous != available {
+		if available == 1 {
+			glog.Infof("database read replica %s is available, lag = %s", r.name, lag)
+		} else {
+			glog.Warningf("database read replica %s is unavailable, its reads fall back to the primary, lag = %s", r.name, lag)
+		}
+	}
+}
+
+// monitorReadReplicas checks the lag of the read replicas every interval, until stop is closed
+func (f *ConnectionFactory) monitorReadReplicas(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.checkReadReplicas()
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (f *ConnectionFactory) checkReadReplicas() {
+	for _, replica := range f.readReplicas {
+		replica.checkLag(f.Config.ReadReplicaMaxLag)
+	}
+}
+
+// NewReadOnly returns a database connection bound to ctx, for the queries which tolerate reading data a little stale,
+// such as the list and count queries. The queries are spread over the available read replicas, and served by the
+// primary when there is none. The connection must not be used to write.
+func (f *ConnectionFactory) NewReadOnly(ctx context.Context) *gorm.DB {
+	dbConn := f.DB
+	if replica := f.nextAvailableReadReplica(); replica != nil {
+		dbConn = replica.db
+	}
+	if f.Config.Debug {
+		dbConn = dbConn.Debug()
+	}
+	return dbConn.WithContext(ctx)
+}
+
+func (f *ConnectionFactory) nextAvailableReadReplica() *readReplica {
+	count := len(f.readReplicas)
+	if count == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint32(&f.nextReadReplica, 1))
+	for i := 0; i < count; i++ {
+		if replica := f.readReplicas[(start+i)%count]; replica.isAvailable() {
+			return replica
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConnectionFactory_NewReadOnly(t *testing.T) {
+	newReplica := func(available bool) *readReplica {
+		replica := &readReplica{db: NewMockConnectionFactory(nil).DB}
+		if available {
+			replica.available = 1
+		}
+		return replica
+	}
+	availableReplica := newReplica(true)
+	anotherAvailableReplica := newReplica(true)
+
+	tests := []struct {
+		name         string
+		readReplicas []*readReplica
+		want         []*readReplica
+	}{
+		{
+			name: "should use the primary when there is no read replica",
+			want: []*readReplica{nil, nil},
+		},
+		{
+			name:         "should use the primary when no read replica is available",
+			readReplicas: []*readReplica{newReplica(false), newReplica(false)},
+			want:         []*readReplica{nil, nil},
+		},
+		{
+			name:         "should use the available read replicas in turn",
+			readReplicas: []*readReplica{availableReplica, newReplica(false), anotherAvailableReplica},
+			// the turn of the unavailable read replica goes to the next available one
+			want: []*readReplica{availableReplica, anotherAvailableReplica, anotherAvailableReplica, availableReplica},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			factory := NewMockConnectionFactory(nil)
+			factory.readReplicas = tt.readReplicas
+			// start from the first read replica
+			factory.nextReadReplica = uint32(len(tt.readReplicas) - 1)
+
+			for _, want := range tt.want {
+				dbConn := factory.NewReadOnly(context.Background())
+				if want == nil {
+					g.Expect(dbConn.ConnPool).To(BeIdenticalTo(factory.DB.ConnPool))
+				} else {
+					g.Expect(dbConn.ConnPool).To(BeIdenticalTo(want.db.ConnPool))
+				}
+			}
+		})
+	}
+}
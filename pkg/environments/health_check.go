@@ -0,0 +1,84 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HealthCheckCriticality tells whether a failing HealthCheck makes the service unhealthy
+type HealthCheckCriticality string
+
+const (
+	// HealthCheckCritical checks make the service not ready, or not live, when they fail
+	HealthCheckCritical HealthCheckCriticality = "critical"
+	// HealthCheckNonCritical checks are reported, but the service keeps serving the requests which don't need them
+	HealthCheckNonCritical HealthCheckCriticality = "non_critical"
+)
+
+// HealthCheck checks a dependency of the service. The types registered as a HealthCheck, with
+// di.As(new(environments.HealthCheck)), are run by the readiness endpoint of the health check server, and the liveness
+// endpoint runs the ones whose options have Liveness set.
+type HealthCheck interface {
+	HealthCheckOptions() HealthCheckOptions
+	// Check returns an error when the dependency is unhealthy. ctx is cancelled when the timeout of the check is reached.
+	Check(ctx context.Context) error
+}
+
+type HealthCheckOptions struct {
+	// Name identifies the check in the report of the health check endpoints, it must be unique
+	Name        string
+	Criticality HealthCheckCriticality
+	// Liveness is set for the checks of failures which only a restart of the service recovers from
+	Liveness bool
+	// Timeout of the check, the default timeout of the health check server is used when not set
+	Timeout time.Duration
+}
+
+var _ HealthCheck = &FuncHealthCheck{}
+
+// FuncHealthCheck is a HealthCheck calling a function
+type FuncHealthCheck struct {
+	options HealthCheckOptions
+	check   func(ctx context.Context) error
+}
+
+func NewFuncHealthCheck(options HealthCheckOptions, check func(ctx context.Context) error) *FuncHealthCheck {
+	return &FuncHealthCheck{
+		options: options,
+		check:   check,
+	}
+}
+
+func (c *FuncHealthCheck) HealthCheckOptions() HealthCheckOptions {
+	return c.options
+}
+
+func (c *FuncHealthCheck) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// NewHTTPHealthCheck returns a HealthCheck of the reachability of an HTTP endpoint. Any response which is not a server
+// error is healthy, as the endpoint is called without credentials.
+func NewHTTPHealthCheck(options HealthCheckOptions, url string) *FuncHealthCheck {
+	return NewFuncHealthCheck(options, func(ctx context.Context) error {
+		return checkHTTPEndpoint(ctx, url)
+	})
+}
+
+func checkHTTPEndpoint(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("%s responded with status code %d", url, resp.StatusCode)
+	}
+	return nil
+}
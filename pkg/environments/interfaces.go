@@ -12,6 +12,16 @@ type ConfigModule interface {
 	ReadFiles() error
 }
 
+// ReloadableConfigModule are ConfigModule values whose configuration files can be read again without a restart
+type ReloadableConfigModule interface {
+	ConfigModule
+	// ConfigFiles returns the files read by Reload, the checksum of their content identifies the loaded configuration
+	ConfigFiles() []string
+	// Reload reads the configuration files into a new configuration, validates it and swaps it in. The configuration
+	// in use is kept when the new one can't be read or is invalid.
+	Reload() error
+}
+
 type ServiceValidator interface {
 	Validate(env *Env) error
 }
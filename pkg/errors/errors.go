@@ -188,6 +188,14 @@ const (
 	ErrorBillingAccountInvalid       ServiceErrorCode = 43
 	ErrorBillingAccountInvalidReason string           = "Billing account id missing or invalid"
 
+	// Idempotency key reused with a different request
+	ErrorIdempotencyKeyReused       ServiceErrorCode = 44
+	ErrorIdempotencyKeyReusedReason string           = "Idempotency key already used for a different request"
+
+	// Precondition of a conditional request failed
+	ErrorPreconditionFailed       ServiceErrorCode = 45
+	ErrorPreconditionFailedReason string           = "Resource was modified since it was retrieved"
+
 	// Too Many requests error. Used by rate limiting
 	ErrorTooManyRequests       ServiceErrorCode = 429
 	ErrorTooManyRequestsReason string           = "Too Many requests"
@@ -262,6 +270,8 @@ func Errors() ServiceErrors {
 		ServiceError{ErrorMaxLimitForServiceAccountsReached, ErrorMaxLimitForServiceAccountsReachedReason, http.StatusForbidden, nil},
 		ServiceError{ErrorInstancePlanNotSupported, ErrorInstancePlanNotSupportedReason, http.StatusBadRequest, nil},
 		ServiceError{ErrorBillingAccountInvalid, ErrorBillingAccountInvalidReason, http.StatusBadRequest, nil},
+		ServiceError{ErrorIdempotencyKeyReused, ErrorIdempotencyKeyReusedReason, http.StatusUnprocessableEntity, nil},
+		ServiceError{ErrorPreconditionFailed, ErrorPreconditionFailedReason, http.StatusPreconditionFailed, nil},
 	}
 }
 
@@ -648,3 +658,13 @@ func InvalidBillingAccount(reason string, values ...interface{}) *ServiceError {
 	message := fmt.Sprintf("%s: %s", ErrorBillingAccountInvalidReason, reason)
 	return New(ErrorBillingAccountInvalid, message, values...)
 }
+
+func IdempotencyKeyReused(reason string, values ...interface{}) *ServiceError {
+	message := fmt.Sprintf("%s: %s", ErrorIdempotencyKeyReusedReason, reason)
+	return New(ErrorIdempotencyKeyReused, message, values...)
+}
+
+func PreconditionFailed(reason string, values ...interface{}) *ServiceError {
+	message := fmt.Sprintf("%s: %s", ErrorPreconditionFailedReason, reason)
+	return New(ErrorPreconditionFailed, message, values...)
+}
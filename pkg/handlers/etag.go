@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// ETagFunc returns the entity tag of the resource returned by the Action of a HandlerConfig
+type ETagFunc func(result interface{}) string
+
+// ETag returns the strong entity tag of the given version of a resource
+func ETag(version interface{}) string {
+	return fmt.Sprintf(`"%v"`, version)
+}
+
+// CheckIfMatch returns a 412 error when the value of an If-Match header does not match the entity tag of the current version of a resource.
+// An empty header or "*" matches any version.
+func CheckIfMatch(ifMatch string, currentETag string) *errors.ServiceError {
+	if ifMatch == "" {
+		return nil
+	}
+	for _, etag := range strings.Split(ifMatch, ",") {
+		etag = strings.TrimSpace(etag)
+		if etag == "*" || etag == currentETag {
+			return nil
+		}
+	}
+	return errors.PreconditionFailed("%s header %s does not match the current version %s", HeaderIfMatch, ifMatch, currentETag)
+}
+
+// ValidateIfMatch checks the If-Match header of the request against the entity tag of the current version of the resource.
+// The current entity tag is only retrieved when the request has an If-Match header.
+func ValidateIfMatch(r *http.Request, currentETag func() (string, *errors.ServiceError)) Validate {
+	return func() *errors.ServiceError {
+		ifMatch := r.Header.Get(HeaderIfMatch)
+		if ifMatch == "" {
+			return nil
+		}
+		etag, err := currentETag()
+		if err != nil {
+			return err
+		}
+		return CheckIfMatch(ifMatch, etag)
+	}
+}
+
+// ConditionalWriteError returns a 412 error in place of the conflict of a write conditioned on the version of a resource,
+// when the request has an If-Match header: the resource has changed since its version was checked against the header.
+func ConditionalWriteError(ifMatch string, err *errors.ServiceError) *errors.ServiceError {
+	if err != nil && err.IsConflict() && ifMatch != "" {
+		return errors.PreconditionFailed("%s header %s does not match the current version: %s", HeaderIfMatch, ifMatch, err.Reason)
+	}
+	return err
+}
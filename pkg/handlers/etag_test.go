@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	. "github.com/onsi/gomega"
+)
+
+func Test_CheckIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		wantErr bool
+	}{
+		{
+			name:    "should match any version when the header is not set",
+			ifMatch: "",
+		},
+		{
+			name:    "should match any version with a wildcard",
+			ifMatch: "*",
+		},
+		{
+			name:    "should match the current version",
+			ifMatch: `"2"`,
+		},
+		{
+			name:    "should match the current version in a list",
+			ifMatch: `"1", "2"`,
+		},
+		{
+			name:    "should not match a weak entity tag",
+			ifMatch: `W/"2"`,
+			wantErr: true,
+		},
+		{
+			name:    "should not match another version",
+			ifMatch: `"1"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := CheckIfMatch(tt.ifMatch, ETag(2))
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				g.Expect(err.HttpCode).To(Equal(http.StatusPreconditionFailed))
+			}
+		})
+	}
+}
+
+func Test_ValidateIfMatch(t *testing.T) {
+	g := NewWithT(t)
+	retrieved := false
+	currentETag := func() (string, *errors.ServiceError) {
+		retrieved = true
+		return ETag(2), nil
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/", nil)
+	g.Expect(ValidateIfMatch(req, currentETag)()).To(BeNil())
+	// the resource is only retrieved for conditional requests
+	g.Expect(retrieved).To(BeFalse())
+
+	req.Header.Set(HeaderIfMatch, `"1"`)
+	g.Expect(ValidateIfMatch(req, currentETag)().HttpCode).To(Equal(http.StatusPreconditionFailed))
+	g.Expect(retrieved).To(BeTrue())
+
+	notFound := func() (string, *errors.ServiceError) {
+		return "", errors.NotFound("not found")
+	}
+	g.Expect(ValidateIfMatch(req, notFound)().HttpCode).To(Equal(http.StatusNotFound))
+}
+
+func Test_HandleGet_ETag(t *testing.T) {
+	g := NewWithT(t)
+	req, rw := httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder()
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return map[string]int{"version": 3}, nil
+		},
+		ETag: func(result interface{}) string {
+			return ETag(result.(map[string]int)["version"])
+		},
+	}
+	HandleGet(rw, req, cfg)
+	g.Expect(rw.Code).To(Equal(http.StatusOK))
+	g.Expect(rw.Header().Get(HeaderETag)).To(Equal(`"3"`))
+}
+
+func Test_ConditionalWriteError(t *testing.T) {
+	g := NewWithT(t)
+	conflict := errors.Conflict("resource version changed")
+
+	g.Expect(ConditionalWriteError("", nil)).To(BeNil())
+	// the conflict of an unconditional write is kept
+	g.Expect(ConditionalWriteError("", conflict)).To(Equal(conflict))
+
+	g.Expect(ConditionalWriteError(ETag(2), nil)).To(BeNil())
+	g.Expect(ConditionalWriteError(ETag(2), conflict).HttpCode).To(Equal(http.StatusPreconditionFailed))
+	badRequest := errors.BadRequest("invalid")
+	g.Expect(ConditionalWriteError(ETag(2), badRequest)).To(Equal(badRequest))
+}
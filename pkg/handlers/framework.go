@@ -20,11 +20,15 @@ import (
 //   Validate is a list of Validation function that run in order, returning fast on the first error.
 //   Action is the specific logic a handler must take (e.g, find an object, save an object)
 //   ErrorHandler is the way errors are returned to the client
+//   ETag returns the entity tag of the result of Action, set as the ETag header of the response
+//   Location returns the path of the result of Action, set as the Location header of the response
 type HandlerConfig struct {
 	MarshalInto  interface{}
 	Validate     []Validate
 	Action       HttpAction
 	ErrorHandler ErrorHandlerFunc
+	ETag         ETagFunc
+	Location     LocationFunc
 }
 
 type EventStream struct {
@@ -38,6 +42,12 @@ type Validate func() *errors.ServiceError
 type ErrorHandlerFunc func(r *http.Request, w http.ResponseWriter, err *errors.ServiceError)
 type HttpAction func() (interface{}, *errors.ServiceError)
 
+const HeaderLocation = "Location"
+
+// LocationFunc returns the path of the resource returned by the Action of a HandlerConfig, such as the operation
+// tracking an asynchronous action
+type LocationFunc func(result interface{}) string
+
 func success(r *http.Request) {
 	ctx := context.WithValue(r.Context(), logger.ActionResultKey, logger.ActionSuccess)
 	ulog := logger.NewUHCLogger(ctx)
@@ -53,6 +63,24 @@ func errorHandler(r *http.Request, w http.ResponseWriter, cfg *HandlerConfig, er
 	cfg.ErrorHandler(r, w, err)
 }
 
+func setETag(w http.ResponseWriter, cfg *HandlerConfig, result interface{}) {
+	if cfg.ETag == nil || result == nil {
+		return
+	}
+	if etag := cfg.ETag(result); etag != "" {
+		w.Header().Set(HeaderETag, etag)
+	}
+}
+
+func setLocation(w http.ResponseWriter, cfg *HandlerConfig, result interface{}) {
+	if cfg.Location == nil || result == nil {
+		return
+	}
+	if location := cfg.Location(result); location != "" {
+		w.Header().Set(HeaderLocation, location)
+	}
+}
+
 func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStatus int) {
 	if cfg.ErrorHandler == nil {
 		cfg.ErrorHandler = shared.HandleError
@@ -91,6 +119,8 @@ func Handle(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, httpStat
 	case serviceErr != nil:
 		errorHandler(r, w, cfg, serviceErr)
 	default:
+		setETag(w, cfg, result)
+		setLocation(w, cfg, result)
 		shared.WriteJSONResponse(w, httpStatus, result)
 		success(r)
 	}
@@ -115,6 +145,7 @@ func HandleDelete(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig, ht
 	case serviceErr != nil:
 		errorHandler(r, w, cfg, serviceErr)
 	default:
+		setLocation(w, cfg, result)
 		shared.WriteJSONResponse(w, httpStatus, result)
 		success(r)
 	}
@@ -137,6 +168,7 @@ func HandleGet(w http.ResponseWriter, r *http.Request, cfg *HandlerConfig) {
 	result, serviceErr := cfg.Action()
 	switch {
 	case serviceErr == nil:
+		setETag(w, cfg, result)
 		shared.WriteJSONResponse(w, http.StatusOK, result)
 		success(r)
 	default:
@@ -228,6 +228,32 @@ func Test_HandleDelete(t *testing.T) {
 	}
 }
 
+func Test_HandleDelete_Location(t *testing.T) {
+	g := NewWithT(t)
+	cfg := &HandlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			return "result", nil
+		},
+		Location: func(result interface{}) string {
+			return "/operations/" + result.(string)
+		},
+	}
+
+	req, rw := GetHandlerParams("DELETE", "/", nil)
+	HandleDelete(rw, req, cfg, http.StatusAccepted)
+	g.Expect(rw.Code).To(Equal(http.StatusAccepted))
+	g.Expect(rw.Header().Get("Location")).To(Equal("/operations/result"))
+
+	// no location is set when the action fails
+	cfg.Action = func() (interface{}, *errors.ServiceError) {
+		return nil, errors.NotFound("not found")
+	}
+	req, rw = GetHandlerParams("DELETE", "/", nil)
+	HandleDelete(rw, req, cfg, http.StatusAccepted)
+	g.Expect(rw.Code).To(Equal(http.StatusNotFound))
+	g.Expect(rw.Header().Get("Location")).To(BeEmpty())
+}
+
 func Test_HandleGet(t *testing.T) {
 	RegisterTestingT(t)
 	req, rw := GetHandlerParams("GET", "/{id}", nil)
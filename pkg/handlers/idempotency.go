@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"gorm.io/gorm"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// recordIdempotencyKeyQuery records a new key, or a key whose response has expired. No row is returned when the key
+// is already recorded: the statement waits for the transaction of a request still processing the key to complete.
+const recordIdempotencyKeyQuery = `INSERT INTO idempotency_keys (owner, key, request_hash, created_at) VALUES (@owner, @key, @hash, now())
+ON CONFLICT (owner, key) DO UPDATE SET
+	request_hash = EXCLUDED.request_hash,
+	response_code = NULL,
+	response_body = NULL,
+	content_type = NULL,
+	created_at = now()
+WHERE idempotency_keys.created_at < now() - @ttl * interval '1 second'
+RETURNING key`
+
+type IdempotencyMiddleware struct {
+	idempotencyConfig *IdempotencyConfig
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewIdempotencyMiddleware(idempotencyConfig *IdempotencyConfig, connectionFactory *db.ConnectionFactory) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		idempotencyConfig: idempotencyConfig,
+		connectionFactory: connectionFactory,
+	}
+}
+
+// IdempotencyKey replays the response of a POST request to the retries of the request sent with the same Idempotency-Key header
+// by the same user, and rejects the reuse of a key for a different request with a 422 error.
+// It must run inside db.TransactionMiddleware: the key and the response are recorded in the request transaction, so that a retry
+// sent while the request is processed waits for its response, and the key of a request failing with a server error is forgotten.
+func (m *IdempotencyMiddleware) IdempotencyKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderIdempotencyKey)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			shared.HandleError(r, w, errors.BadRequest("%s header must not be longer than %d characters", HeaderIdempotencyKey, maxIdempotencyKeyLength))
+			return
+		}
+
+		ctx := r.Context()
+		ulog := logger.NewUHCLogger(ctx)
+
+		// keys are scoped to their user so that a response is never replayed to somebody else
+		claims, err := auth.GetClaimsFromContext(ctx)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		owner, _ := claims.GetUsername()
+		if owner == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			shared.HandleError(r, w, errors.BadRequest("unable to read request body: %v", err))
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		requestHash := hashRequest(r, body)
+
+		tx, err := m.connectionFactory.RequestTransaction(ctx)
+		if err != nil {
+			ulog.Errorf("unable to record idempotency key %q: %v", key, err)
+			shared.HandleError(r, w, errors.GeneralError("unable to record idempotency key"))
+			return
+		}
+
+		var recordedKeys []string
+		result := tx.Raw(recordIdempotencyKeyQuery,
+			sql.Named("owner", owner),
+			sql.Named("key", key),
+			sql.Named("hash", requestHash),
+			sql.Named("ttl", m.idempotencyConfig.IdempotencyKeyTTL.Seconds()),
+		).Scan(&recordedKeys)
+		if result.Error != nil {
+			ulog.Errorf("unable to record idempotency key %q: %v", key, result.Error)
+			shared.HandleError(r, w, errors.GeneralError("unable to record idempotency key"))
+			return
+		}
+		if len(recordedKeys) == 0 {
+			m.replay(w, r, tx, owner, key, requestHash)
+			return
+		}
+
+		recorder := &idempotentResponseRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		if err := m.recordResponse(tx, owner, key, recorder); err != nil {
+			ulog.Errorf("unable to record the response of idempotency key %q: %v", key, err)
+		}
+
+		// the expired keys of the user are removed once the request is committed, outside of its transaction
+		ttl := m.idempotencyConfig.IdempotencyKeyTTL.Seconds()
+		_ = db.AddPostCommitAction(ctx, func() {
+			if err := m.connectionFactory.New().
+				Exec("DELETE FROM idempotency_keys WHERE owner = ? AND created_at < now() - ? * interval '1 second'", owner, ttl).
+				Error; err != nil {
+				ulog.Errorf("unable to delete the expired idempotency keys: %v", err)
+			}
+		})
+	})
+}
+
+// recordResponse stores the response of a request, or forgets its key when the request failed with a server error so that it can be retried
+func (m *IdempotencyMiddleware) recordResponse(tx *gorm.DB, owner string, key string, recorder *idempotentResponseRecorder) error {
+	if recorder.code >= http.StatusInternalServerError {
+		return tx.Exec("DELETE FROM idempotency_keys WHERE owner = ? AND key = ?", owner, key).Error
+	}
+	return tx.Exec("UPDATE idempotency_keys SET response_code = ?, response_body = ?, content_type = ? WHERE owner = ? AND key = ?",
+		recorder.code, recorder.body.Bytes(), recorder.Header().Get("Content-Type"), owner, key).Error
+}
+
+// replay writes the recorded response of a key to a retry of its request
+func (m *IdempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, tx *gorm.DB, owner string, key string, requestHash string) {
+	var recorded []struct {
+		RequestHash  string
+		ResponseCode *int
+		ResponseBody []byte
+		ContentType  *string
+	}
+	if err := tx.Raw("SELECT request_hash, response_code, response_body, content_type FROM idempotency_keys WHERE owner = ? AND key = ?", owner, key).
+		Scan(&recorded).Error; err != nil {
+		logger.NewUHCLogger(r.Context()).Errorf("unable to get the response of idempotency key %q: %v", key, err)
+		shared.HandleError(r, w, errors.GeneralError("unable to get the response of idempotency key"))
+		return
+	}
+
+	if len(recorded) > 0 && recorded[0].RequestHash != requestHash {
+		shared.HandleError(r, w, errors.IdempotencyKeyReused("key %q was used for a request with a different method, path or body", key))
+		return
+	}
+	// the key may also have expired and been removed after the request was received
+	if len(recorded) == 0 || recorded[0].ResponseCode == nil {
+		shared.HandleError(r, w, errors.Conflict("the request with idempotency key %q is being processed, retry the request", key))
+		return
+	}
+
+	if recorded[0].ContentType != nil && *recorded[0].ContentType != "" {
+		w.Header().Set("Content-Type", *recorded[0].ContentType)
+	}
+	w.Header().Set(HeaderIdempotentReplayed, "true")
+	w.WriteHeader(*recorded[0].ResponseCode)
+	_, _ = w.Write(recorded[0].ResponseBody)
+}
+
+// hashRequest returns the hash identifying a request: a key can only be reused for the same method, path and body
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.URL.Path))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// idempotentResponseRecorder keeps a copy of the status code and the body written to the response
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *idempotentResponseRecorder) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.code = code
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *idempotentResponseRecorder) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
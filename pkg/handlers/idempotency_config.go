@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type IdempotencyConfig struct {
+	// IdempotencyKeyTTL is how long the response of a request with an Idempotency-Key header is kept for replays
+	IdempotencyKeyTTL time.Duration
+}
+
+func NewIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		IdempotencyKeyTTL: 24 * time.Hour,
+	}
+}
+
+func (c *IdempotencyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.IdempotencyKeyTTL, "idempotency-key-ttl", c.IdempotencyKeyTTL, "How long the response of a create request with an Idempotency-Key header is replayed to the retries of the request")
+}
+
+func (c *IdempotencyConfig) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"context"
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_IdempotencyMiddleware_IdempotencyKey(t *testing.T) {
+	const body = `{"name":"test"}`
+	requestHash := hashRequest(httptest.NewRequest(http.MethodPost, "/kafkas", nil), []byte(body))
+
+	tests := []struct {
+		name           string
+		method         string
+		key            string
+		setupDB        func()
+		wantCalled     bool
+		wantCode       int
+		wantBody       string
+		wantReplayed   bool
+		wantRecorded   bool
+		wantKeyRemoved bool
+	}{
+		{
+			name:       "should not handle requests without an idempotency key",
+			method:     http.MethodPost,
+			wantCalled: true,
+			wantCode:   http.StatusAccepted,
+			wantBody:   `{"id":"new"}`,
+		},
+		{
+			name:       "should not handle requests other than POST",
+			method:     http.MethodGet,
+			key:        "key",
+			wantCalled: true,
+			wantCode:   http.StatusAccepted,
+			wantBody:   `{"id":"new"}`,
+		},
+		{
+			name:     "should reject keys that are too long",
+			method:   http.MethodPost,
+			key:      strings.Repeat("k", maxIdempotencyKeyLength+1),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "should record the response of a new key",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO idempotency_keys`).WithReply([]map[string]interface{}{{"key": "key"}})
+			},
+			wantCalled:   true,
+			wantCode:     http.StatusAccepted,
+			wantBody:     `{"id":"new"}`,
+			wantRecorded: true,
+		},
+		{
+			name:   "should replay the recorded response of a key",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT request_hash, response_code, response_body, content_type FROM idempotency_keys`).
+					WithReply([]map[string]interface{}{{"request_hash": requestHash, "response_code": 202, "response_body": `{"id":"recorded"}`, "content_type": "application/json"}})
+			},
+			wantCode:     http.StatusAccepted,
+			wantBody:     `{"id":"recorded"}`,
+			wantReplayed: true,
+		},
+		{
+			name:   "should reject the reuse of a key for a different request",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT request_hash, response_code, response_body, content_type FROM idempotency_keys`).
+					WithReply([]map[string]interface{}{{"request_hash": "other-hash", "response_code": 202, "response_body": `{"id":"recorded"}`, "content_type": "application/json"}})
+			},
+			wantCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "should return a conflict when the request of a key is being processed",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`SELECT request_hash, response_code, response_body, content_type FROM idempotency_keys`).
+					WithReply([]map[string]interface{}{{"request_hash": requestHash, "response_code": nil, "response_body": nil, "content_type": nil}})
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:   "should forget the key of a request failing with a server error",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO idempotency_keys`).WithReply([]map[string]interface{}{{"key": "key"}})
+			},
+			wantCalled:     true,
+			wantCode:       http.StatusInternalServerError,
+			wantBody:       `{"id":"new"}`,
+			wantKeyRemoved: true,
+		},
+		{
+			name:   "should return an error when the key cannot be recorded",
+			method: http.MethodPost,
+			key:    "key",
+			setupDB: func() {
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO idempotency_keys`).WithQueryException()
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			mocket.Catcher.NewMock().WithQuery(`select txid_current()`).WithReply([]map[string]interface{}{{"txid_current": 1}})
+			if tt.setupDB != nil {
+				tt.setupDB()
+			}
+			recorded := false
+			mocket.Catcher.NewMock().WithQuery(`UPDATE idempotency_keys SET response_code`).WithCallback(func(_ string, _ []driver.NamedValue) {
+				recorded = true
+			})
+			keyRemoved := false
+			mocket.Catcher.NewMock().WithQuery(`DELETE FROM idempotency_keys WHERE owner = $1 AND key = $2`).WithCallback(func(_ string, _ []driver.NamedValue) {
+				keyRemoved = true
+			})
+
+			connectionFactory := db.NewMockConnectionFactory(nil)
+			ctx, err := connectionFactory.NewContext(auth.SetTokenInContext(context.Background(), &jwt.Token{Claims: jwt.MapClaims{"username": "test-user"}}))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			called := false
+			responseCode := http.StatusAccepted
+			if tt.wantKeyRemoved {
+				responseCode = http.StatusInternalServerError
+			}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(responseCode)
+				_, _ = w.Write([]byte(`{"id":"new"}`))
+			})
+
+			req := httptest.NewRequest(tt.method, "/kafkas", strings.NewReader(body)).WithContext(ctx)
+			if tt.key != "" {
+				req.Header.Set(HeaderIdempotencyKey, tt.key)
+			}
+			rw := httptest.NewRecorder()
+			m := NewIdempotencyMiddleware(&IdempotencyConfig{IdempotencyKeyTTL: time.Hour}, connectionFactory)
+			m.IdempotencyKey(next).ServeHTTP(rw, req)
+
+			g.Expect(called).To(Equal(tt.wantCalled))
+			g.Expect(rw.Code).To(Equal(tt.wantCode))
+			if tt.wantBody != "" {
+				g.Expect(rw.Body.String()).To(Equal(tt.wantBody))
+			}
+			g.Expect(rw.Header().Get(HeaderIdempotentReplayed) == "true").To(Equal(tt.wantReplayed))
+			g.Expect(recorded).To(Equal(tt.wantRecorded))
+			g.Expect(keyRemoved).To(Equal(tt.wantKeyRemoved))
+		})
+	}
+}
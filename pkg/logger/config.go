@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+type LoggingConfig struct {
+	// Format is the format of the records, text or json
+	Format string
+	// Levels are the verbosities of the components logging at a different verbosity than the one set by -v, as a
+	// comma separated list of component=level
+	Levels string
+}
+
+func NewLoggingConfig() *LoggingConfig {
+	return &LoggingConfig{
+		Format: string(TextFormat),
+	}
+}
+
+func (c *LoggingConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.Format, "log-format", c.Format, "Format of the logs: text, written by glog, or json, a JSON object per line written to the standard error, the glog records included")
+	fs.StringVar(&c.Levels, "log-levels", c.Levels, "Comma separated component=level verbosities of the components logging at a different verbosity than the one set by -v, e.g. cluster=5,http=1")
+}
+
+// ReadFiles applies the format and the component levels to all the loggers, there are no files to read
+func (c *LoggingConfig) ReadFiles() error {
+	format := Format(c.Format)
+	if format != TextFormat && format != JSONFormat {
+		return fmt.Errorf("invalid log format %q, must be %q or %q", c.Format, TextFormat, JSONFormat)
+	}
+
+	levels, err := ParseLevels(c.Levels)
+	if err != nil {
+		return err
+	}
+
+	SetFormat(format)
+	if format == JSONFormat {
+		if err := startGlogCapture(); err != nil {
+			return fmt.Errorf("unable to write the glog records as JSON: %v", err)
+		}
+	}
+	for _, level := range levels {
+		SetLevel(level.Component, level.Level)
+	}
+	return nil
+}
+
+// ParseLevels parses a comma separated list of component=level verbosities
+func ParseLevels(s string) ([]ComponentLevel, error) {
+	var levels []ComponentLevel
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid log level %q, must be component=level", entry)
+		}
+		level, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 32)
+		if err != nil || level < 0 {
+			return nil, fmt.Errorf("invalid log level %q, the level must be a number greater than or equal to 0", entry)
+		}
+		levels = append(levels, ComponentLevel{Component: strings.TrimSpace(parts[0]), Level: int32(level)})
+	}
+	return levels, nil
+}
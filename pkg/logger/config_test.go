@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_ParseLevels(t *testing.T) {
+	tests := []struct {
+		name    string
+		levels  string
+		want    []ComponentLevel
+		wantErr bool
+	}{
+		{
+			name:   "should parse no level",
+			levels: "",
+		},
+		{
+			name:   "should parse the levels of the components",
+			levels: "cluster=5, http=0",
+			want: []ComponentLevel{
+				{Component: "cluster", Level: 5},
+				{Component: "http", Level: 0},
+			},
+		},
+		{
+			name:    "should fail if the level is missing",
+			levels:  "cluster",
+			wantErr: true,
+		},
+		{
+			name:    "should fail if the level is negative",
+			levels:  "cluster=-1",
+			wantErr: true,
+		},
+		{
+			name:    "should fail if the component is missing",
+			levels:  "=1",
+			wantErr: true,
+		},
+	}
+
+	RegisterTestingT(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels, err := ParseLevels(tt.levels)
+			Expect(err != nil).To(Equal(tt.wantErr))
+			Expect(levels).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_LoggingConfig_ReadFiles(t *testing.T) {
+	RegisterTestingT(t)
+	defer SetFormat(TextFormat)
+	defer ResetLevel("cluster")
+
+	glogCaptured := false
+	defer func(start func() error) { startGlogCapture = start }(startGlogCapture)
+	startGlogCapture = func() error {
+		glogCaptured = true
+		return nil
+	}
+
+	c := NewLoggingConfig()
+	c.Format = "yaml"
+	Expect(c.ReadFiles()).ToNot(Succeed())
+	Expect(glogCaptured).To(BeFalse())
+
+	c.Format = string(JSONFormat)
+	c.Levels = "cluster=5"
+	Expect(c.ReadFiles()).To(Succeed())
+	Expect(currentFormat()).To(Equal(JSONFormat))
+	Expect(Level("cluster")).To(Equal(int32(5)))
+	Expect(glogCaptured).To(BeTrue())
+}
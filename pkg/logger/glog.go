@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// GlogComponent is the component of the records written by glog and klog, by the code and the libraries not using
+// the UHCLogger
+const GlogComponent = "glog"
+
+// glogHeader matches the header of the lines written by glog and klog: severity, date, time, thread id, file and line
+var glogHeader = regexp.MustCompile(`^([IWEF])\d{4} \d{2}:\d{2}:\d{2}\.\d{6}\s+\d+ ([^\s\]]+:\d+)\] ?(.*)$`)
+
+var glogSeverities = map[string]severity{
+	"I": severityInfo,
+	"W": severityWarning,
+	"E": severityError,
+	"F": severityFatal,
+}
+
+// maxGlogLineSize is the size of the lines read from the captured standard error beyond which a line is rewritten as
+// several records
+const maxGlogLineSize = 1024 * 1024
+
+var captureGlog sync.Once
+
+// glogCapture is the standard error written by glog and klog once captured, and the signal the rewriting of its records
+// is done
+var glogCapture = struct {
+	sync.Mutex
+	stderr *os.File
+	pipe   *os.File
+	done   chan struct{}
+}{}
+
+// startGlogCapture starts writing the glog records as JSON records, overridden by the tests
+var startGlogCapture = captureGlogRecords
+
+// captureGlogRecords makes the records glog and klog write to the standard error JSON records as well. The standard
+// error is replaced by a pipe whose lines are rewritten to the original standard error, the records of the loggers
+// being written to the original one directly.
+func captureGlogRecords() error {
+	var err error
+	captureGlog.Do(func() {
+		var r, w *os.File
+		r, w, err = os.Pipe()
+		if err != nil {
+			return
+		}
+		stderr, done := os.Stderr, make(chan struct{})
+		glogCapture.Lock()
+		glogCapture.stderr, glogCapture.pipe, glogCapture.done = stderr, w, done
+		glogCapture.Unlock()
+
+		os.Stderr = w
+		go func() {
+			defer close(done)
+			rewriteGlogRecords(r, stderr)
+		}()
+	})
+	return err
+}
+
+// flushGlogRecords rewrites the records written to the captured standard error so far, waiting at most the given
+// timeout, so that the last records are not lost when the process exits. The original standard error is restored.
+func flushGlogRecords(timeout time.Duration) {
+	glogCapture.Lock()
+	defer glogCapture.Unlock()
+	if glogCapture.pipe == nil {
+		return
+	}
+	os.Stderr = glogCapture.stderr
+	_ = glogCapture.pipe.Close()
+	glogCapture.pipe = nil
+
+	select {
+	case <-glogCapture.done:
+	case <-time.After(timeout):
+	}
+}
+
+// rewriteGlogRecords writes a JSON record per line read until the reader is closed. A line without a glog header,
+// such as a line of a stack trace, is attributed the severity of the record it follows. A line longer than
+// maxGlogLineSize is rewritten as several records. When the reader fails, the rest of it is copied as is to the
+// fallback writer, so that the writers of the captured standard error are never blocked.
+func rewriteGlogRecords(r io.Reader, fallback io.Writer) {
+	reader := bufio.NewReaderSize(r, 64*1024)
+	sev := severityInfo
+	for {
+		line, err := readGlogLine(reader)
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			_, _ = io.Copy(fallback, reader)
+			return
+		}
+
+		var caller, msg string
+		sev, caller, msg = parseGlogLine(line, sev)
+		header := []interface{}{"ts", time.Now().UTC().Format(time.RFC3339Nano), "level", string(sev), "component", GlogComponent}
+		if caller != "" {
+			header = append(header, "caller", caller)
+		}
+		writeJSONRecord(header, msg, nil, nil)
+	}
+}
+
+// readGlogLine reads a line without its end of line, a line longer than maxGlogLineSize being read in several parts
+func readGlogLine(reader *bufio.Reader) (string, error) {
+	var line []byte
+	for {
+		part, isPrefix, err := reader.ReadLine()
+		if err != nil {
+			return "", err
+		}
+		line = append(line, part...)
+		if !isPrefix || len(line) >= maxGlogLineSize {
+			return string(line), nil
+		}
+	}
+}
+
+// parseGlogLine returns the severity, the caller and the message of a line written by glog, the severity of a line
+// without a header being the given one
+func parseGlogLine(line string, sev severity) (severity, string, string) {
+	match := glogHeader.FindStringSubmatch(line)
+	if match == nil {
+		return sev, "", line
+	}
+	return glogSeverities[match[1]], match[2], match[3]
+}
@@ -0,0 +1,122 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_parseGlogLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		sev        severity
+		wantSev    severity
+		wantCaller string
+		wantMsg    string
+	}{
+		{
+			name:       "should parse the header of an info record",
+			line:       "I1019 11:57:05.229450    5472 api.go:261] kafka test-kafka is ready",
+			sev:        severityError,
+			wantSev:    severityInfo,
+			wantCaller: "api.go:261",
+			wantMsg:    "kafka test-kafka is ready",
+		},
+		{
+			name:       "should parse the severity of an error record",
+			line:       "E1019 11:57:05.229450      12 client.go:64] Unable to create client",
+			sev:        severityInfo,
+			wantSev:    severityError,
+			wantCaller: "client.go:64",
+			wantMsg:    "Unable to create client",
+		},
+		{
+			name:    "should attribute a line without header the severity of the previous record",
+			line:    "goroutine 1 [running]:",
+			sev:     severityFatal,
+			wantSev: severityFatal,
+			wantMsg: "goroutine 1 [running]:",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			sev, caller, msg := parseGlogLine(tt.line, tt.sev)
+			g.Expect(sev).To(Equal(tt.wantSev))
+			g.Expect(caller).To(Equal(tt.wantCaller))
+			g.Expect(msg).To(Equal(tt.wantMsg))
+		})
+	}
+}
+
+func Test_rewriteGlogRecords(t *testing.T) {
+	RegisterTestingT(t)
+	buf, restore := captureJSON()
+	defer restore()
+
+	rewriteGlogRecords(strings.NewReader("W1019 11:57:05.229450    5472 api.go:261] kafka test-kafka is slow\n"+
+		"  retrying\n"), io.Discard)
+
+	result := records(buf)
+	Expect(result).To(HaveLen(2))
+	Expect(result[0]).To(HaveKeyWithValue("level", "warning"))
+	Expect(result[0]).To(HaveKeyWithValue("component", GlogComponent))
+	Expect(result[0]).To(HaveKeyWithValue("caller", "api.go:261"))
+	Expect(result[0]).To(HaveKeyWithValue("msg", "kafka test-kafka is slow"))
+	Expect(result[1]).To(HaveKeyWithValue("level", "warning"))
+	Expect(result[1]).To(HaveKeyWithValue("msg", "  retrying"))
+	Expect(result[1]).ToNot(HaveKey("caller"))
+}
+
+func Test_rewriteGlogRecords_LongLine(t *testing.T) {
+	RegisterTestingT(t)
+	buf, restore := captureJSON()
+	defer restore()
+
+	long := strings.Repeat("x", maxGlogLineSize+10)
+	rewriteGlogRecords(strings.NewReader("E1019 11:57:05.229450    5472 api.go:261] "+long+"\n"+
+		"I1019 11:57:05.229450    5472 api.go:262] kafka test-kafka is ready\n"), io.Discard)
+
+	result := records(buf)
+	Expect(result).To(HaveLen(3))
+	Expect(result[0]).To(HaveKeyWithValue("level", "error"))
+	Expect(result[0]).To(HaveKeyWithValue("caller", "api.go:261"))
+	Expect(result[1]).To(HaveKeyWithValue("level", "error"))
+	Expect(result[1]).ToNot(HaveKey("caller"))
+	Expect(result[0]["msg"].(string) + result[1]["msg"].(string)).To(Equal(long))
+	Expect(result[2]).To(HaveKeyWithValue("level", "info"))
+	Expect(result[2]).To(HaveKeyWithValue("msg", "kafka test-kafka is ready"))
+}
+
+// failingReader fails its first read, the following ones reading the given reader
+type failingReader struct {
+	io.Reader
+	failed bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if !r.failed {
+		r.failed = true
+		return 0, errors.New("read failed")
+	}
+	return r.Reader.Read(p)
+}
+
+func Test_rewriteGlogRecords_Fallback(t *testing.T) {
+	RegisterTestingT(t)
+	buf, restore := captureJSON()
+	defer restore()
+
+	fallback := &bytes.Buffer{}
+	line := "I1019 11:57:05.229450    5472 api.go:261] kafka test-kafka is ready\n"
+	rewriteGlogRecords(&failingReader{Reader: strings.NewReader(line)}, fallback)
+
+	Expect(buf.String()).To(BeEmpty())
+	Expect(fallback.String()).To(Equal(line))
+}
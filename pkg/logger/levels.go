@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"flag"
+	"sort"
+	"sync"
+
+	"github.com/golang/glog"
+)
+
+// componentLevels holds the verbosity of the components whose level has been adjusted, the other components log at
+// the verbosity set by the glog -v flag
+var componentLevels = struct {
+	sync.RWMutex
+	levels map[string]int32
+}{
+	levels: map[string]int32{},
+}
+
+var verbosityFlag = struct {
+	sync.Once
+	getter flag.Getter
+}{}
+
+// ComponentLevel is the verbosity of a component
+type ComponentLevel struct {
+	Component string
+	Level     int32
+}
+
+// SetLevel sets the verbosity of a component, an info record of the component is logged if its level is lower or equal
+func SetLevel(component string, level int32) {
+	componentLevels.Lock()
+	defer componentLevels.Unlock()
+	componentLevels.levels[component] = level
+}
+
+// ResetLevel makes a component log at the default verbosity again
+func ResetLevel(component string) {
+	componentLevels.Lock()
+	defer componentLevels.Unlock()
+	delete(componentLevels.levels, component)
+}
+
+// Level returns the verbosity of a component
+func Level(component string) int32 {
+	componentLevels.RLock()
+	level, ok := componentLevels.levels[component]
+	componentLevels.RUnlock()
+	if ok {
+		return level
+	}
+	return DefaultLevel()
+}
+
+// DefaultLevel returns the verbosity of the components whose level has not been adjusted, set by the glog -v flag
+func DefaultLevel() int32 {
+	verbosityFlag.Do(func() {
+		if f := flag.Lookup("v"); f != nil {
+			verbosityFlag.getter, _ = f.Value.(flag.Getter)
+		}
+	})
+	if verbosityFlag.getter == nil {
+		return 0
+	}
+	if level, ok := verbosityFlag.getter.Get().(glog.Level); ok {
+		return int32(level)
+	}
+	return 0
+}
+
+// ComponentLevels returns the components whose level has been adjusted, sorted by name
+func ComponentLevels() []ComponentLevel {
+	componentLevels.RLock()
+	defer componentLevels.RUnlock()
+
+	levels := make([]ComponentLevel, 0, len(componentLevels.levels))
+	for component, level := range componentLevels.levels {
+		levels = append(levels, ComponentLevel{Component: component, Level: level})
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		return levels[i].Component < levels[j].Component
+	})
+	return levels
+}
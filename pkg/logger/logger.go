@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/go-logr/logr"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/glog"
 	"github.com/openshift-online/ocm-sdk-go/authentication"
@@ -67,6 +68,11 @@ func (l LogEvent) ToString() string {
 
 type UHCLogger interface {
 	V(level int32) UHCLogger
+	// WithComponent returns a logger whose records are attributed to the component, whose verbosity can be adjusted
+	// at runtime with SetLevel
+	WithComponent(component string) UHCLogger
+	// WithFields returns a logger adding the key/value pairs to its records, the keys being one of the *Field constants
+	WithFields(keysAndValues ...interface{}) UHCLogger
 	Infof(format string, args ...interface{})
 	Warningf(format string, args ...interface{})
 	Errorf(format string, args ...interface{})
@@ -86,6 +92,8 @@ type logger struct {
 	username  string
 	session   string
 	sentryHub *sentry.Hub
+	component string
+	fields    []interface{}
 }
 
 // NewUHCLogger creates a new logger instance with a default verbosity of 1
@@ -100,42 +108,49 @@ func NewUHCLogger(ctx context.Context) UHCLogger {
 	return logger
 }
 
-func (l *logger) prepareLogPrefix(format string, args ...interface{}) string {
-	orig := fmt.Sprintf(format, args...)
-	prefix := ""
+// contextFields returns the fields of the records taken from the context of the logger, followed by the fields
+// added with WithFields
+func (l *logger) contextFields() []interface{} {
+	var fields []interface{}
 
 	if l.username != "" {
-		prefix = strings.Join([]string{prefix, "user='", l.username, "' "}, "")
+		fields = append(fields, UserField, l.username)
 	}
 
 	if event, ok := l.context.Value(ActionKey).(string); ok {
-		prefix = strings.Join([]string{prefix, "action='", event, "' "}, "")
+		fields = append(fields, EventField, event)
 		if eventStatus, ok := l.context.Value(ActionResultKey).(string); ok {
-			prefix = strings.Join([]string{prefix, "result='", eventStatus, "' "}, "")
+			fields = append(fields, ResultField, eventStatus)
 		}
 	}
 
 	if remoteAddr, ok := l.context.Value(RemoteAddrKey).(string); ok {
-		prefix = strings.Join([]string{prefix, "src_ip='", remoteAddr, "' "}, "")
+		fields = append(fields, RemoteAddrField, remoteAddr)
 	}
 
 	if l.session != "" {
-		prefix = strings.Join([]string{prefix, "session='", l.session, "' "}, "")
+		fields = append(fields, SessionField, l.session)
 	}
 
 	if txid, ok := l.context.Value(TxIdKey).(int64); ok {
-		prefix = strings.Join([]string{prefix, "tx_id='", fmt.Sprintf("%v", txid), "' "}, "")
+		fields = append(fields, TxIdField, txid)
 	}
 
 	if l.accountID != "" {
-		prefix = strings.Join([]string{prefix, "accountID='", l.accountID, "' "}, "")
+		fields = append(fields, AccountIDField, l.accountID)
 	}
 
 	if opid, ok := l.context.Value(OpIDKey).(string); ok {
-		prefix = strings.Join([]string{prefix, "opid='", opid, "' "}, "")
+		fields = append(fields, OperationIDField, opid)
 	}
 
-	return strings.Trim(prefix+orig, " ")
+	return append(fields, l.fields...)
+}
+
+// log returns the logr logger writing the records of the logger
+func (l *logger) log() logr.Logger {
+	// skip the frame of the method of the logger, so that the records point to its caller
+	return logr.New(&sink{component: l.component}).WithCallDepth(1)
 }
 
 func (l *logger) V(level int32) UHCLogger {
@@ -145,9 +160,25 @@ func (l *logger) V(level int32) UHCLogger {
 		username:  l.username,
 		session:   l.session,
 		level:     level,
+		component: l.component,
+		fields:    l.fields,
 	}
 }
 
+func (l *logger) WithComponent(component string) UHCLogger {
+	c := *l
+	c.component = component
+	return &c
+}
+
+func (l *logger) WithFields(keysAndValues ...interface{}) UHCLogger {
+	c := *l
+	c.fields = make([]interface{}, 0, len(l.fields)+len(keysAndValues))
+	c.fields = append(c.fields, l.fields...)
+	c.fields = append(c.fields, keysAndValues...)
+	return &c
+}
+
 func getSessionFromClaims(ctx context.Context) string {
 	var claims jwt.MapClaims
 	token, err := authentication.TokenFromContext(ctx)
@@ -190,24 +221,22 @@ func getUsernameFromClaims(ctx context.Context) string {
 }
 
 func (l *logger) Infof(format string, args ...interface{}) {
-	prefixed := l.prepareLogPrefix(format, args...)
-	glog.V(glog.Level(l.level)).Infof(prefixed)
+	l.log().V(int(l.level)).Info(fmt.Sprintf(format, args...), l.contextFields()...)
 }
 
 func (l *logger) Warningf(format string, args ...interface{}) {
-	prefixed := l.prepareLogPrefix(format, args...)
-	glog.Warningln(prefixed)
+	// warnings are logged whatever the verbosity, as errors
+	l.log().Info(fmt.Sprintf(format, args...), append(l.contextFields(), severityKey, severityWarning)...)
 	l.captureSentryEvent(sentry.LevelWarning, format, args...)
 }
 
 func (l *logger) Errorf(format string, args ...interface{}) {
-	prefixed := l.prepareLogPrefix(format, args...)
-	glog.Errorln(prefixed)
+	l.log().Error(nil, fmt.Sprintf(format, args...), l.contextFields()...)
 	l.captureSentryEvent(sentry.LevelError, format, args...)
 }
 
 func (l *logger) Error(err error) {
-	glog.Error(err)
+	l.log().Error(err, "", l.contextFields()...)
 	if l.sentryHub == nil {
 		sentry.CaptureException(err)
 		return
@@ -216,9 +245,8 @@ func (l *logger) Error(err error) {
 }
 
 func (l *logger) Fatalf(format string, args ...interface{}) {
-	prefixed := l.prepareLogPrefix(format, args...)
-	glog.Fatalln(prefixed)
 	l.captureSentryEvent(sentry.LevelFatal, format, args...)
+	l.log().Error(nil, fmt.Sprintf(format, args...), append(l.contextFields(), severityKey, severityFatal)...)
 }
 
 func (l *logger) captureSentryEvent(level sentry.Level, format string, args ...interface{}) {
@@ -19,6 +19,7 @@ var (
 	testAddr     = "test-addr"
 	testId       = int64(9999)
 	testOpId     = "1111"
+	testKafkaId  = "test-kafka"
 )
 
 func Test_NewLogEventFromString(t *testing.T) {
@@ -136,7 +137,7 @@ func Test_ToString(t *testing.T) {
 	}
 }
 
-func Test_prepareLogPrefix(t *testing.T) {
+func Test_contextFields(t *testing.T) {
 	ctx := context.Background()
 	ctx = context.WithValue(ctx, ActionKey, testAction)
 	ctx = context.WithValue(ctx, RemoteAddrKey, testAddr)
@@ -157,7 +158,7 @@ func Test_prepareLogPrefix(t *testing.T) {
 		want   string
 	}{
 		{
-			name: "should prepare Log Prefix for specific logger and arguments",
+			name: "should prepare the fields of the records for specific logger and arguments",
 			fields: fields{
 				l: &logger{
 					level:     1,
@@ -165,14 +166,15 @@ func Test_prepareLogPrefix(t *testing.T) {
 					username:  testUsername,
 					session:   testSession,
 					context:   ctx,
+					fields:    []interface{}{KafkaIDField, testKafkaId},
 				},
 			},
 			args: args{
 				format:    "%s",
 				arguments: "",
 			},
-			want: fmt.Sprintf("user='%s' action='%s' result='%s' src_ip='%s' session='%s' tx_id='%d' accountID='%s' opid='%s'",
-				testUsername, testAction, testResult, testAddr, testSession, testId, testAccId, testOpId),
+			want: fmt.Sprintf("user='%s' action='%s' result='%s' src_ip='%s' session='%s' tx_id='%d' accountID='%s' opid='%s' kafka_id='%s'",
+				testUsername, testAction, testResult, testAddr, testSession, testId, testAccId, testOpId, testKafkaId),
 		},
 	}
 
@@ -180,7 +182,7 @@ func Test_prepareLogPrefix(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Expect(tt.fields.l.prepareLogPrefix(tt.args.format, tt.args.arguments)).To(Equal(tt.want))
+			Expect(formatText(fmt.Sprintf(tt.args.format, tt.args.arguments), nil, tt.fields.l.contextFields())).To(Equal(tt.want))
 		})
 	}
 }
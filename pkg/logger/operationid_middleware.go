@@ -6,6 +6,8 @@ import (
 
 	"github.com/getsentry/sentry-go"
 	"github.com/rs/xid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type OperationIDKey string
@@ -30,6 +32,9 @@ func OperationIDMiddleware(handler http.Handler) http.Handler {
 			})
 		}
 
+		// Add operation ID to the span of the request
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("operation_id", opID))
+
 		handler.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
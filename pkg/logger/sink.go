@@ -0,0 +1,263 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/glog"
+)
+
+// Format is the format of the records written by the loggers
+type Format string
+
+const (
+	// TextFormat records are written by glog, prefixed by their fields as key='value'
+	TextFormat Format = "text"
+	// JSONFormat records are written to the standard error as a JSON object per line
+	JSONFormat Format = "json"
+)
+
+// The keys of the fields of the records, so that the records of the different components can be correlated
+const (
+	OperationIDField = "operation_id"
+	KafkaIDField     = "kafka_id"
+	ClusterIDField   = "cluster_id"
+	ConnectorIDField = "connector_id"
+	WorkerTypeField  = "worker_type"
+	EventField       = "event"
+	ResultField      = "result"
+	UserField        = "user"
+	SessionField     = "session"
+	AccountIDField   = "account_id"
+	RemoteAddrField  = "src_ip"
+	TxIdField        = "tx_id"
+)
+
+// textFieldKeys are the keys the fields whose key changed with the JSON records keep in the text records, so that
+// the existing searches of the text records keep matching
+var textFieldKeys = map[string]string{
+	EventField:       "action",
+	AccountIDField:   "accountID",
+	OperationIDField: "opid",
+}
+
+type severity string
+
+const (
+	severityInfo    severity = "info"
+	severityWarning severity = "warning"
+	severityError   severity = "error"
+	severityFatal   severity = "fatal"
+
+	// severityKey overrides the severity of a record, as logr only distinguishes info and error records
+	severityKey = "severity"
+)
+
+var output = struct {
+	sync.Mutex
+	format Format
+	writer io.Writer
+}{
+	format: TextFormat,
+	writer: os.Stderr,
+}
+
+// SetFormat sets the format of the records written by all the loggers
+func SetFormat(format Format) {
+	output.Lock()
+	defer output.Unlock()
+	output.format = format
+}
+
+func currentFormat() Format {
+	output.Lock()
+	defer output.Unlock()
+	return output.format
+}
+
+// exit terminates the process after a fatal record, overridden by the tests
+var exit = func() {
+	glog.Flush()
+	flushGlogRecords(time.Second)
+	os.Exit(255)
+}
+
+var _ logr.LogSink = &sink{}
+var _ logr.CallDepthLogSink = &sink{}
+
+// sink is the logr backend of the UHCLogger. The name of the logger is the component of its records, whose verbosity
+// can be adjusted at runtime.
+type sink struct {
+	component string
+	values    []interface{}
+	depth     int
+}
+
+func (s *sink) Init(info logr.RuntimeInfo) {
+	s.depth += info.CallDepth
+}
+
+func (s *sink) Enabled(level int) bool {
+	return int32(level) <= Level(s.component)
+}
+
+func (s *sink) Info(level int, msg string, keysAndValues ...interface{}) {
+	sev, keysAndValues := splitSeverity(severityInfo, keysAndValues)
+	s.write(sev, level, msg, nil, keysAndValues)
+}
+
+func (s *sink) Error(err error, msg string, keysAndValues ...interface{}) {
+	sev, keysAndValues := splitSeverity(severityError, keysAndValues)
+	s.write(sev, 0, msg, err, keysAndValues)
+}
+
+func (s *sink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	values := make([]interface{}, 0, len(s.values)+len(keysAndValues))
+	values = append(values, s.values...)
+	values = append(values, keysAndValues...)
+	return &sink{component: s.component, values: values, depth: s.depth}
+}
+
+func (s *sink) WithName(name string) logr.LogSink {
+	component := name
+	if s.component != "" {
+		component = s.component + "." + name
+	}
+	return &sink{component: component, values: s.values, depth: s.depth}
+}
+
+func (s *sink) WithCallDepth(depth int) logr.LogSink {
+	return &sink{component: s.component, values: s.values, depth: s.depth + depth}
+}
+
+func (s *sink) write(sev severity, level int, msg string, err error, keysAndValues []interface{}) {
+	fields := make([]interface{}, 0, len(s.values)+len(keysAndValues))
+	fields = append(fields, s.values...)
+	fields = append(fields, keysAndValues...)
+
+	// the depth of the caller of the logger relative to write, past the method of the sink calling it and logr
+	depth := s.depth + 2
+	if currentFormat() == JSONFormat {
+		s.writeJSON(depth, sev, level, msg, err, fields)
+		return
+	}
+
+	line := formatText(msg, err, fields)
+	switch sev {
+	case severityWarning:
+		glog.WarningDepth(depth, line)
+	case severityError:
+		glog.ErrorDepth(depth, line)
+	case severityFatal:
+		glog.FatalDepth(depth, line)
+	default:
+		glog.InfoDepth(depth, line)
+	}
+}
+
+func (s *sink) writeJSON(depth int, sev severity, level int, msg string, err error, fields []interface{}) {
+	header := []interface{}{"ts", time.Now().UTC().Format(time.RFC3339Nano), "level", string(sev)}
+	if sev == severityInfo {
+		header = append(header, "v", level)
+	}
+	if s.component != "" {
+		header = append(header, "component", s.component)
+	}
+	if _, file, line, ok := runtime.Caller(depth + 1); ok {
+		header = append(header, "caller", fmt.Sprintf("%s:%d", filepath.Base(file), line))
+	}
+	writeJSONRecord(header, msg, err, fields)
+
+	if sev == severityFatal {
+		exit()
+	}
+}
+
+// writeJSONRecord writes a record as a JSON object on its own line, the key/value pairs of its header come first
+func writeJSONRecord(header []interface{}, msg string, err error, fields []interface{}) {
+	buf := &bytes.Buffer{}
+	buf.WriteString("{")
+	for i := 0; i < len(header); i += 2 {
+		writeJSONField(buf, fmt.Sprint(header[i]), header[i+1], i == 0)
+	}
+	writeJSONField(buf, "msg", msg, len(header) == 0)
+	if err != nil {
+		writeJSONField(buf, "error", err.Error(), false)
+	}
+	for i := 0; i < len(fields); i += 2 {
+		writeJSONField(buf, fmt.Sprint(fields[i]), fieldValue(fields, i+1), false)
+	}
+	buf.WriteString("}\n")
+
+	output.Lock()
+	_, _ = output.writer.Write(buf.Bytes())
+	output.Unlock()
+}
+
+func writeJSONField(buf *bytes.Buffer, key string, value interface{}, first bool) {
+	if !first {
+		buf.WriteString(",")
+	}
+	k, _ := json.Marshal(key)
+	buf.Write(k)
+	buf.WriteString(":")
+	if e, ok := value.(error); ok {
+		value = e.Error()
+	}
+	v, err := json.Marshal(value)
+	if err != nil {
+		v, _ = json.Marshal(fmt.Sprintf("%+v", value))
+	}
+	buf.Write(v)
+}
+
+// formatText prefixes the message with the fields of the record as key='value'
+func formatText(msg string, err error, fields []interface{}) string {
+	var b strings.Builder
+	for i := 0; i < len(fields); i += 2 {
+		key := fmt.Sprint(fields[i])
+		if textKey, ok := textFieldKeys[key]; ok {
+			key = textKey
+		}
+		fmt.Fprintf(&b, "%s='%v' ", key, fieldValue(fields, i+1))
+	}
+	b.WriteString(msg)
+	if err != nil {
+		if msg != "" {
+			b.WriteString(": ")
+		}
+		b.WriteString(err.Error())
+	}
+	return strings.Trim(b.String(), " ")
+}
+
+func fieldValue(fields []interface{}, i int) interface{} {
+	if i < len(fields) {
+		return fields[i]
+	}
+	return "<missing>"
+}
+
+// splitSeverity removes the severity overriding the default one of a record from its key/value pairs
+func splitSeverity(def severity, keysAndValues []interface{}) (severity, []interface{}) {
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if keysAndValues[i] == severityKey {
+			if sev, ok := keysAndValues[i+1].(severity); ok {
+				rest := make([]interface{}, 0, len(keysAndValues)-2)
+				rest = append(rest, keysAndValues[:i]...)
+				rest = append(rest, keysAndValues[i+2:]...)
+				return sev, rest
+			}
+		}
+	}
+	return def, keysAndValues
+}
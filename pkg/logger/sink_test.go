@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// captureJSON makes the loggers write JSON records to a buffer until the returned func is called
+func captureJSON() (*bytes.Buffer, func()) {
+	buf := &bytes.Buffer{}
+	output.Lock()
+	format, writer := output.format, output.writer
+	output.format, output.writer = JSONFormat, buf
+	output.Unlock()
+	return buf, func() {
+		output.Lock()
+		output.format, output.writer = format, writer
+		output.Unlock()
+	}
+}
+
+func records(buf *bytes.Buffer) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+		result = append(result, record)
+	}
+	return result
+}
+
+func Test_JSONFormat(t *testing.T) {
+	RegisterTestingT(t)
+	buf, restore := captureJSON()
+	defer restore()
+
+	ctx := context.WithValue(context.Background(), OpIDKey, testOpId)
+	log := NewUHCLogger(ctx).V(0).WithComponent("test").WithFields(KafkaIDField, testKafkaId)
+	log.Infof("kafka %s is ready", testKafkaId)
+	log.Warningf("kafka %s is slow", testKafkaId)
+	log.Error(errors.New("kafka failed"))
+
+	result := records(buf)
+	Expect(result).To(HaveLen(3))
+
+	Expect(result[0]).To(HaveKeyWithValue("level", "info"))
+	Expect(result[0]).To(HaveKeyWithValue("v", float64(0)))
+	Expect(result[0]).To(HaveKeyWithValue("component", "test"))
+	Expect(result[0]).To(HaveKeyWithValue("msg", "kafka test-kafka is ready"))
+	Expect(result[0]).To(HaveKeyWithValue(OperationIDField, testOpId))
+	Expect(result[0]).To(HaveKeyWithValue(KafkaIDField, testKafkaId))
+	Expect(result[0]["caller"]).To(HavePrefix("sink_test.go:"))
+
+	Expect(result[1]).To(HaveKeyWithValue("level", "warning"))
+	Expect(result[1]).ToNot(HaveKey(severityKey))
+
+	Expect(result[2]).To(HaveKeyWithValue("level", "error"))
+	Expect(result[2]).To(HaveKeyWithValue("error", "kafka failed"))
+}
+
+func Test_ComponentLevels(t *testing.T) {
+	RegisterTestingT(t)
+	buf, restore := captureJSON()
+	defer restore()
+	defer ResetLevel("test")
+
+	log := NewUHCLogger(context.Background()).WithComponent("test")
+
+	SetLevel("test", 0)
+	log.V(1).Infof("filtered")
+	Expect(records(buf)).To(BeEmpty())
+	Expect(ComponentLevels()).To(Equal([]ComponentLevel{{Component: "test", Level: 0}}))
+
+	SetLevel("test", 5)
+	log.V(5).Infof("logged")
+	log.V(6).Infof("filtered")
+	result := records(buf)
+	Expect(result).To(HaveLen(1))
+	Expect(result[0]).To(HaveKeyWithValue("msg", "logged"))
+
+	ResetLevel("test")
+	Expect(Level("test")).To(Equal(DefaultLevel()))
+	Expect(ComponentLevels()).To(BeEmpty())
+}
@@ -63,6 +63,13 @@ const (
 	DatabaseQueryCount = "database_query_count"
 	// DatabaseQueryDuration - metric name for database query duration in milliseconds
 	DatabaseQueryDuration = "database_query_duration"
+	// DatabaseReplicaLag - metric name for the replication lag of the database read replicas in seconds
+	DatabaseReplicaLag = "database_replica_lag"
+
+	// ConfigChecksum - metric name for the checksum of the configuration files loaded by the reloadable config modules
+	ConfigChecksum = "config_checksum_info"
+	// ConfigReloadCount - metric name for the number of reloads of the reloadable config modules
+	ConfigReloadCount = "config_reload_count"
 
 	// ClusterStatusMaxCapacity - metric name for the maximum kafka instance capacity
 	ClusterStatusCapacityMax = "cluster_status_capacity_max"
@@ -79,6 +86,10 @@ const (
 
 	LabelDatabaseQueryStatus = "status"
 	LabelDatabaseQueryType   = "query"
+	LabelDatabaseReplica     = "replica"
+	LabelConfig              = "config"
+	LabelChecksum            = "checksum"
+	LabelResult              = "result"
 	LabelRegion              = "region"
 	LabelInstanceType        = "instance_type"
 	LabelCloudProvider       = "cloud_provider"
@@ -674,8 +685,76 @@ func UpdateDatabaseQueryDurationMetric(status string, queryType string, elapsed
 	databaseQueryDurationMetric.With(labels).Observe(float64(elapsed.Milliseconds()))
 }
 
+// register database read replica lag metric
+//	 database_replica_lag - Replication lag of each read replica in seconds, as last measured
+var databaseReplicaLagMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      DatabaseReplicaLag,
+	Help:      "replication lag of the database read replicas in seconds.",
+}, []string{LabelDatabaseReplica})
+
+// Update the database read replica lag metric with the following labels:
+// 	- replica: the index of the read replica in the configuration (i.e. "0")
+func UpdateDatabaseReplicaLagMetric(replica string, lag time.Duration) {
+	labels := prometheus.Labels{
+		LabelDatabaseReplica: replica,
+	}
+	databaseReplicaLagMetric.With(labels).Set(lag.Seconds())
+}
+
 // #### Metrics for Database - End ####
 
+// #### Metrics for Configuration - Start ####
+// register config checksum metric
+//	 config_checksum_info - Always 1, labelled with the checksum of the configuration files loaded by a config module
+var configChecksumMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: KasFleetManager,
+	Name:      ConfigChecksum,
+	Help:      "checksum of the configuration files loaded by the reloadable config modules.",
+}, []string{LabelConfig, LabelChecksum})
+
+// Update the config checksum metric with the following labels:
+// 	- config: the name of the config module (i.e. "KafkaConfig")
+// 	- checksum: the checksum of the loaded configuration files, which replaces the previous one
+func UpdateConfigChecksumMetric(config string, previousChecksum string, checksum string) {
+	if previousChecksum != "" {
+		configChecksumMetric.Delete(prometheus.Labels{
+			LabelConfig:   config,
+			LabelChecksum: previousChecksum,
+		})
+	}
+	labels := prometheus.Labels{
+		LabelConfig:   config,
+		LabelChecksum: checksum,
+	}
+	configChecksumMetric.With(labels).Set(1)
+}
+
+// register config reload count metric
+//	 config_reload_count - Number of reloads of a config module, by result
+var configReloadCountMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: KasFleetManager,
+	Name:      ConfigReloadCount,
+	Help:      "number of reloads of the reloadable config modules.",
+}, []string{LabelConfig, LabelResult})
+
+// Increase the config reload count metric with the following labels:
+// 	- config: the name of the config module (i.e. "KafkaConfig")
+// 	- result: "success" or "failure", a failed reload keeps the previous configuration
+func IncreaseConfigReloadCountMetric(config string, success bool) {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	labels := prometheus.Labels{
+		LabelConfig: config,
+		LabelResult: result,
+	}
+	configReloadCountMetric.With(labels).Inc()
+}
+
+// #### Metrics for Configuration - End ####
+
 // register the metric(s)
 func init() {
 	// metrics for data plane clusters
@@ -710,6 +789,11 @@ func init() {
 	// metrics for database
 	prometheus.MustRegister(databaseRequestCountMetric)
 	prometheus.MustRegister(databaseQueryDurationMetric)
+	prometheus.MustRegister(databaseReplicaLagMetric)
+
+	// metrics for configuration
+	prometheus.MustRegister(configChecksumMetric)
+	prometheus.MustRegister(configReloadCountMetric)
 }
 
 // ResetMetricsForKafkaManagers will reset the metrics for the KafkaManager background reconciler
@@ -774,4 +858,5 @@ func Reset() {
 
 	databaseRequestCountMetric.Reset()
 	databaseQueryDurationMetric.Reset()
+	databaseReplicaLagMetric.Reset()
 }
@@ -0,0 +1,30 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addIdempotencyKeys(migrationId string) *gormigrate.Migration {
+
+	type IdempotencyKey struct {
+		Owner        string `gorm:"primaryKey"`
+		Key          string `gorm:"primaryKey"`
+		RequestHash  string `gorm:"not null"`
+		ResponseCode *int
+		ResponseBody []byte
+		ContentType  *string
+		CreatedAt    time.Time `gorm:"not null;index"`
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&IdempotencyKey{}),
+	)
+}
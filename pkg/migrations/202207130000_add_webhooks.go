@@ -0,0 +1,56 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addWebhooks(migrationId string) *gormigrate.Migration {
+
+	type OutboxEvent struct {
+		api.Meta
+		OrganisationId string `gorm:"index"`
+		Type           string
+		Source         string
+		Subject        string
+		Data           api.JSON   `gorm:"type:jsonb"`
+		DispatchedAt   *time.Time `gorm:"index"`
+	}
+
+	type WebhookSubscription struct {
+		api.Meta
+		OrganisationId  string `gorm:"index"`
+		Owner           string
+		Url             string
+		EncryptedSecret string
+		EventTypes      string
+	}
+
+	type WebhookDelivery struct {
+		api.Meta
+		SubscriptionId string `gorm:"index"`
+		EventId        string `gorm:"index"`
+		EventType      string
+		Status         string `gorm:"index"`
+		Attempts       int
+		NextAttemptAt  time.Time `gorm:"index"`
+		LastAttemptAt  *time.Time
+		ResponseCode   int
+		Error          string
+		DeliveredAt    *time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&OutboxEvent{}),
+		db.CreateTableAction(&WebhookSubscription{}),
+		db.CreateTableAction(&WebhookDelivery{}),
+	)
+}
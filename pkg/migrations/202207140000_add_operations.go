@@ -0,0 +1,35 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addOperations(migrationId string) *gormigrate.Migration {
+
+	type Operation struct {
+		api.Meta
+		Kind           string `gorm:"index"`
+		TargetKind     string
+		TargetId       string `gorm:"index"`
+		OrganisationId string `gorm:"index"`
+		Owner          string
+		State          string `gorm:"index"`
+		Progress       int32
+		Error          string
+		StartedAt      *time.Time
+		CompletedAt    *time.Time
+	}
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.CreateTableAction(&Operation{}),
+	)
+}
@@ -0,0 +1,22 @@
+package migrations
+
+// Migrations should NEVER use types from other packages. Types can change
+// and then migrations run on a _new_ database will fail or behave unexpectedly.
+// Instead of importing types, always re-create the type in the migration, as
+// is done here, even though the same type is defined in pkg/api
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+func addOperationsActiveTargetIndex(migrationId string) *gormigrate.Migration {
+
+	return db.CreateMigrationFromActions(migrationId,
+		db.ExecAction(`
+			CREATE UNIQUE INDEX idx_operations_active_kind_target_id ON operations (kind, target_id) WHERE state IN ('pending', 'running') AND deleted_at IS NULL
+		`, `
+			DROP INDEX idx_operations_active_kind_target_id
+		`),
+	)
+}
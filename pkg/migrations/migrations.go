@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/go-gormigrate/gormigrate/v2"
+)
+
+// The migrations of the tables shared by the services, such as the rate limit buckets, the idempotency keys, the
+// webhooks and the operations. They are only applied by this set, whichever services are enabled, so that each table
+// is owned by exactly one set of migrations.
+
+// Migration rules:
+//
+// 1. IDs are numerical timestamps that must sort ascending.
+//    Use YYYYMMDDHHMM w/ 24 hour time for format
+//    Example: August 21 2018 at 2:54pm would be 201808211454.
+//
+// 2. Include models inline with migrations to see the evolution of the object over time.
+//    Using our internal type models directly in the first migration would fail in future clean installs.
+//
+// 3. Migrations must be backwards compatible. There are no new required fields allowed.
+//    See $project_home/db/README.md
+//
+// 4. Create one function in a separate file that returns your Migration. Add that single function call to this list.
+var migrations = []*gormigrate.Migration{
+	addRateLimitBuckets("202207110000"),
+	addIdempotencyKeys("202207120000"),
+	addWebhooks("202207130000"),
+	addOperations("202207140000"),
+	addOperationsActiveTargetIndex("202207150000"),
+}
+
+var gormOptions = &gormigrate.Options{
+	TableName:      "shared_migrations",
+	IDColumnName:   "id",
+	IDColumnSize:   255,
+	UseTransaction: false,
+}
+
+func New(dbConfig *db.DatabaseConfig) (*db.Migration, func(), error) {
+	return db.NewMigration(dbConfig, gormOptions, migrations)
+}
+
+// NewHealthCheck returns the health check of the migrations of the shared tables being applied
+func NewHealthCheck(connectionFactory *db.ConnectionFactory) *environments.FuncHealthCheck {
+	return db.NewMigrationHealthCheck("shared_migrations", connectionFactory, gormOptions, migrations)
+}
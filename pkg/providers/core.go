@@ -13,12 +13,18 @@ import (
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/migrations"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/account"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/authorization"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/configreload"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/operations"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sso"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/webhooks"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
 	"github.com/goava/di"
 )
@@ -36,19 +42,28 @@ func CoreConfigProviders() di.Option {
 		di.Provide(ocm.NewOCMConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(keycloak.NewKeycloakConfig, di.As(new(environments.ConfigModule)), di.As(new(environments.ServiceValidator))),
 		di.Provide(acl.NewAccessControlListConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(ratelimit.NewRateLimitConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(handlers.NewIdempotencyConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(server.NewMetricsConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(workers.NewReconcilerConfig, di.As(new(environments.ConfigModule))),
 		di.Provide(auth.NewContextConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(logger.NewLoggingConfig, di.As(new(environments.ConfigModule))),
 
 		// Add common CLI sub commands
 		di.Provide(serve.NewServeCommand),
 		di.Provide(migrate.NewMigrateCommand),
 
+		// Add the migrations of the tables shared by the services
+		di.Provide(migrations.New),
+
 		// Add other core config providers..
 		sentry.ConfigProviders(),
+		tracing.ConfigProviders(),
 		signalbus.ConfigProviders(),
 		authorization.ConfigProviders(),
 		account.ConfigProviders(),
+		webhooks.ConfigProviders(),
+		configreload.ConfigProviders(),
 
 		di.Provide(environments.Func(ServiceProviders)),
 	)
@@ -78,8 +93,11 @@ func ServiceProviders() di.Option {
 		}),
 
 		di.Provide(aws.NewDefaultClientFactory, di.As(new(aws.ClientFactory))),
+		di.Provide(operations.NewOperationService),
 
 		di.Provide(acl.NewAccessControlListMiddleware),
+		di.Provide(ratelimit.NewRateLimitMiddleware),
+		di.Provide(handlers.NewIdempotencyMiddleware),
 		di.Provide(handlers.NewErrorsHandler),
 		di.Provide(func(c *keycloak.KeycloakConfig) sso.KafkaKeycloakService {
 			return sso.NewKeycloakServiceBuilder().
@@ -96,9 +114,19 @@ func ServiceProviders() di.Option {
 		}),
 
 		// Types registered as a BootService are started when the env is started
+		di.Provide(tracing.NewTracerProvider, di.As(new(environments.BootService))),
 		di.Provide(server.NewAPIServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewMetricsServer, di.As(new(environments.BootService))),
 		di.Provide(server.NewHealthCheckServer, di.As(new(environments.BootService))),
 		di.Provide(workers.NewLeaderElectionManager, di.As(new(environments.BootService))),
+
+		// Types registered as a HealthCheck are run by the readiness and liveness endpoints of the health check server
+		di.Provide(db.NewHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(migrations.NewHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(keycloak.NewHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(ocm.NewHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(ocm.NewAMSHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(workers.NewLeaderElectionHealthCheck, di.As(new(environments.HealthCheck))),
+		di.Provide(workers.NewLeaderLeaseHealthCheck, di.As(new(environments.HealthCheck))),
 	)
 }
@@ -6,12 +6,16 @@ import (
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
 	"os"
+	"sync/atomic"
 )
 
 type QuotaManagementListConfig struct {
 	QuotaList                  RegisteredUsersListConfiguration
 	QuotaListConfigFile        string
 	EnableInstanceLimitControl bool
+
+	// reloadedQuotaList holds the RegisteredUsersListConfiguration read by Reload
+	reloadedQuotaList atomic.Value
 }
 
 func NewQuotaManagementListConfig() *QuotaManagementListConfig {
@@ -41,15 +45,38 @@ func (c *QuotaManagementListConfig) ReadFiles() error {
 	return err
 }
 
+func (c *QuotaManagementListConfig) ConfigFiles() []string {
+	return []string{c.QuotaListConfigFile}
+}
+
+// Reload reads the quota list again. Unlike on startup, a missing file is an error, so that the quota list in use is kept.
+func (c *QuotaManagementListConfig) Reload() error {
+	var quotaList RegisteredUsersListConfiguration
+	if err := readQuotaManagementListConfigFile(c.QuotaListConfigFile, &quotaList); err != nil {
+		return err
+	}
+	c.reloadedQuotaList.Store(quotaList)
+	return nil
+}
+
+// GetQuotaList returns the quota list in use
+func (c *QuotaManagementListConfig) GetQuotaList() RegisteredUsersListConfiguration {
+	if quotaList, ok := c.reloadedQuotaList.Load().(RegisteredUsersListConfiguration); ok {
+		return quotaList
+	}
+	return c.QuotaList
+}
+
 func (c *QuotaManagementListConfig) GetAllowedAccountByUsernameAndOrgId(username string, orgId string) (Account, bool) {
 	var user Account
 	var found bool
-	org, _ := c.QuotaList.Organisations.GetById(orgId)
+	quotaList := c.GetQuotaList()
+	org, _ := quotaList.Organisations.GetById(orgId)
 	user, found = org.RegisteredUsers.GetByUsername(username)
 	if found {
 		return user, found
 	}
-	return c.QuotaList.ServiceAccounts.GetByUsername(username)
+	return quotaList.ServiceAccounts.GetByUsername(username)
 }
 
 // Read the contents of file into the quota list config
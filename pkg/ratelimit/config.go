@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// KeyType identifies the claim the requests of a route are counted against
+type KeyType string
+
+const (
+	// KeyTypeOrganisation counts the requests of all the users of an organisation together.
+	// Users without an organisation are counted on their own
+	KeyTypeOrganisation KeyType = "organisation"
+	// KeyTypeUser counts the requests of each user on their own
+	KeyTypeUser KeyType = "user"
+	// KeyTypeAgent counts the requests of each agent cluster, identified by the client ID of its service account
+	KeyTypeAgent KeyType = "agent"
+)
+
+const (
+	StoreTypeMemory   = "memory"
+	StoreTypePostgres = "postgres"
+)
+
+// Limit is a token bucket limit: the bucket holds up to Burst tokens and is refilled with RequestsPerMinute tokens per minute.
+// A limit of 0 requests per minute disables the rate limiting.
+type Limit struct {
+	RequestsPerMinute int     `yaml:"requests_per_minute"`
+	Burst             int     `yaml:"burst"`
+	Key               KeyType `yaml:"key"`
+}
+
+func (l Limit) IsUnlimited() bool {
+	return l.RequestsPerMinute <= 0
+}
+
+// refillRate is the number of tokens added to the bucket each second
+func (l Limit) refillRate() float64 {
+	return float64(l.RequestsPerMinute) / 60
+}
+
+func (l Limit) keyType() KeyType {
+	if l.Key == "" {
+		return KeyTypeOrganisation
+	}
+	return l.Key
+}
+
+func (l Limit) validate() error {
+	if l.IsUnlimited() {
+		return nil
+	}
+	if l.Burst < 1 {
+		return fmt.Errorf("burst must be at least 1, got %d", l.Burst)
+	}
+	switch l.keyType() {
+	case KeyTypeOrganisation, KeyTypeUser, KeyTypeAgent:
+		return nil
+	default:
+		return fmt.Errorf("unsupported key %q, must be one of %q, %q or %q", l.Key, KeyTypeOrganisation, KeyTypeUser, KeyTypeAgent)
+	}
+}
+
+// RateLimits are the limits of the API routes, keyed by the type of their log event (e.g. "list-kafka")
+type RateLimits struct {
+	Default Limit            `yaml:"default"`
+	Routes  map[string]Limit `yaml:"routes"`
+}
+
+// GetLimit returns the limit of the given route, or the default limit if the route does not have one
+func (r RateLimits) GetLimit(routeName string) Limit {
+	if limit, ok := r.Routes[routeName]; ok {
+		return limit
+	}
+	return r.Default
+}
+
+type RateLimitConfig struct {
+	EnableRateLimiting  bool
+	RateLimitConfigFile string
+	StoreType           string
+	RateLimits          RateLimits
+}
+
+func NewRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		EnableRateLimiting:  false,
+		RateLimitConfigFile: "config/rate-limit-configuration.yaml",
+		StoreType:           StoreTypeMemory,
+	}
+}
+
+func (c *RateLimitConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.EnableRateLimiting, "enable-rate-limiting", c.EnableRateLimiting, "Enable the rate limiting of the requests of each organisation, user or agent cluster")
+	fs.StringVar(&c.RateLimitConfigFile, "rate-limit-config-file", c.RateLimitConfigFile, "Rate limits configuration file")
+	fs.StringVar(&c.StoreType, "rate-limit-store", c.StoreType, fmt.Sprintf("Where the rate limit counters are kept: %q for each replica on its own, %q for the limits to hold across replicas", StoreTypeMemory, StoreTypePostgres))
+}
+
+func (c *RateLimitConfig) ReadFiles() error {
+	if !c.EnableRateLimiting {
+		return nil
+	}
+	if c.StoreType != StoreTypeMemory && c.StoreType != StoreTypePostgres {
+		return fmt.Errorf("unsupported rate limit store %q, must be either %q or %q", c.StoreType, StoreTypeMemory, StoreTypePostgres)
+	}
+	return readRateLimitConfigFile(c.RateLimitConfigFile, &c.RateLimits)
+}
+
+// Read the contents of file into the rate limits config
+func readRateLimitConfigFile(file string, val *RateLimits) error {
+	fileContents, err := shared.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	if err := yaml.UnmarshalStrict([]byte(fileContents), val); err != nil {
+		return err
+	}
+
+	if err := val.Default.validate(); err != nil {
+		return fmt.Errorf("invalid default rate limit: %w", err)
+	}
+	for route, limit := range val.Routes {
+		if err := limit.validate(); err != nil {
+			return fmt.Errorf("invalid rate limit of route %q: %w", route, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_RateLimitConfig_ReadFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		modifyFn func(config *RateLimitConfig)
+		wantErr  bool
+	}{
+		{
+			name: "should read the rate limits configuration file",
+		},
+		{
+			name: "should not read the file when rate limiting is disabled",
+			modifyFn: func(config *RateLimitConfig) {
+				config.EnableRateLimiting = false
+				config.RateLimitConfigFile = "invalid"
+			},
+		},
+		{
+			name: "should fail when the file does not exist",
+			modifyFn: func(config *RateLimitConfig) {
+				config.RateLimitConfigFile = "invalid"
+			},
+			wantErr: true,
+		},
+		{
+			name: "should fail when the store is not supported",
+			modifyFn: func(config *RateLimitConfig) {
+				config.StoreType = "redis"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewRateLimitConfig()
+			config.EnableRateLimiting = true
+			if tt.modifyFn != nil {
+				tt.modifyFn(config)
+			}
+			g.Expect(config.ReadFiles() != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr && config.EnableRateLimiting {
+				g.Expect(config.RateLimits.Default.IsUnlimited()).To(BeFalse())
+				g.Expect(config.RateLimits.GetLimit("create-kafka")).ToNot(Equal(config.RateLimits.Default))
+			}
+		})
+	}
+}
+
+func Test_Limit_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   Limit
+		wantErr bool
+	}{
+		{
+			name:  "should accept a limit keyed on organisations by default",
+			limit: Limit{RequestsPerMinute: 60, Burst: 10},
+		},
+		{
+			name:  "should accept an unlimited limit",
+			limit: Limit{},
+		},
+		{
+			name:    "should reject a limit without burst",
+			limit:   Limit{RequestsPerMinute: 60},
+			wantErr: true,
+		},
+		{
+			name:    "should reject an unsupported key",
+			limit:   Limit{RequestsPerMinute: 60, Burst: 10, Key: "ip"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.limit.validate() != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/gorilla/mux"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+type RateLimitMiddleware struct {
+	rateLimitConfig *RateLimitConfig
+	store           Store
+}
+
+func NewRateLimitMiddleware(rateLimitConfig *RateLimitConfig, connectionFactory *db.ConnectionFactory) *RateLimitMiddleware {
+	var store Store
+	if rateLimitConfig.StoreType == StoreTypePostgres {
+		store = NewPostgresStore(connectionFactory)
+	} else {
+		store = NewMemoryStore()
+	}
+	return &RateLimitMiddleware{
+		rateLimitConfig: rateLimitConfig,
+		store:           store,
+	}
+}
+
+// RateLimit rejects the requests of an organisation, user or agent cluster exceeding the limit of the matched route.
+// Requests without claims are not limited: they are either to public endpoints or rejected by the authentication.
+func (m *RateLimitMiddleware) RateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !m.rateLimitConfig.EnableRateLimiting {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := auth.GetClaimsFromContext(r.Context())
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		routeName := getRouteName(r)
+		limit := m.rateLimitConfig.RateLimits.GetLimit(routeName)
+		if limit.IsUnlimited() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		keyType, keyValue := getKey(claims, limit.keyType())
+		if keyValue == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		result, err := m.store.Take(fmt.Sprintf("%s:%s:%s", routeName, keyType, keyValue), limit)
+		if err != nil {
+			// requests are let through rather than failing the whole API when the counters are unavailable
+			logger.NewUHCLogger(r.Context()).Errorf("unable to rate limit the request to route %q: %v", routeName, err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(HeaderRateLimitLimit, strconv.Itoa(limit.Burst))
+		w.Header().Set(HeaderRateLimitRemaining, strconv.Itoa(int(math.Floor(result.Tokens))))
+		w.Header().Set(HeaderRateLimitReset, strconv.Itoa(secondsUntil(float64(limit.Burst)-result.Tokens, limit)))
+		if !result.Allowed {
+			retryAfter := secondsUntil(1-result.Tokens, limit)
+			w.Header().Set(HeaderRetryAfter, strconv.Itoa(retryAfter))
+			shared.HandleError(r, w, errors.New(errors.ErrorTooManyRequests, "Too many requests for %q, retry in %d seconds", routeName, retryAfter))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// getRouteName returns the type of the log event naming the matched route, or its path template if it has no name
+func getRouteName(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return r.URL.Path
+	}
+	if name := logger.NewLogEventFromString(route.GetName()).Type; name != "" {
+		return name
+	}
+	if path, err := route.GetPathTemplate(); err == nil {
+		return path
+	}
+	return r.URL.Path
+}
+
+// getKey returns the value of the claim identifying the bucket of the request.
+// The requests are counted against the user when the claim of the requested key type is missing.
+func getKey(claims auth.KFMClaims, keyType KeyType) (KeyType, string) {
+	switch keyType {
+	case KeyTypeOrganisation:
+		if orgId, _ := claims.GetOrgId(); orgId != "" {
+			return KeyTypeOrganisation, orgId
+		}
+	case KeyTypeAgent:
+		if clientId, ok := claims["clientId"].(string); ok && clientId != "" {
+			return KeyTypeAgent, clientId
+		}
+	}
+	username, _ := claims.GetUsername()
+	return KeyTypeUser, username
+}
+
+// secondsUntil returns the number of seconds, rounded up, until the given number of tokens are added to a bucket
+func secondsUntil(tokens float64, limit Limit) int {
+	if tokens <= 0 {
+		return 0
+	}
+	return int(math.Ceil(tokens / limit.refillRate()))
+}
@@ -0,0 +1,173 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"github.com/openshift-online/ocm-sdk-go/authentication"
+)
+
+func Test_RateLimitMiddleware_RateLimit(t *testing.T) {
+	limits := RateLimits{
+		Default: Limit{RequestsPerMinute: 600, Burst: 100},
+		Routes: map[string]Limit{
+			"list-kafka":                     {RequestsPerMinute: 30, Burst: 5},
+			"get-kafka":                      {RequestsPerMinute: 0},
+			"list-dataplane-kafkas":          {RequestsPerMinute: 60, Burst: 10, Key: KeyTypeAgent},
+			"list-kafka-ownership-transfers": {RequestsPerMinute: 60, Burst: 10, Key: KeyTypeUser},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		disabled      bool
+		routeName     string
+		claims        jwt.MapClaims
+		result        Result
+		takeErr       error
+		wantKey       string
+		wantCode      int
+		wantHeaders   map[string]string
+		wantNoHeaders bool
+	}{
+		{
+			name:      "should let the request through and set the rate limit headers",
+			routeName: "list-kafka",
+			claims:    jwt.MapClaims{"org_id": "org-id", "username": "user"},
+			result:    Result{Allowed: true, Tokens: 3.5},
+			wantKey:   "list-kafka:organisation:org-id",
+			wantCode:  http.StatusOK,
+			wantHeaders: map[string]string{
+				HeaderRateLimitLimit:     "5",
+				HeaderRateLimitRemaining: "3",
+				HeaderRateLimitReset:     "3",
+			},
+		},
+		{
+			name:      "should reject the request with a 429 when the bucket is empty",
+			routeName: "list-kafka",
+			claims:    jwt.MapClaims{"org_id": "org-id", "username": "user"},
+			result:    Result{Allowed: false, Tokens: 0.5},
+			wantKey:   "list-kafka:organisation:org-id",
+			wantCode:  http.StatusTooManyRequests,
+			wantHeaders: map[string]string{
+				HeaderRateLimitRemaining: "0",
+				HeaderRateLimitReset:     "9",
+				HeaderRetryAfter:         "1",
+			},
+		},
+		{
+			name:      "should count the requests of users without organisation on their own",
+			routeName: "list-kafka",
+			claims:    jwt.MapClaims{"username": "user"},
+			result:    Result{Allowed: true},
+			wantKey:   "list-kafka:user:user",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "should count the requests against the user when the route is keyed on users",
+			routeName: "list-kafka-ownership-transfers",
+			claims:    jwt.MapClaims{"org_id": "org-id", "username": "user"},
+			result:    Result{Allowed: true},
+			wantKey:   "list-kafka-ownership-transfers:user:user",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "should count the requests against the agent cluster when the route is keyed on agents",
+			routeName: "list-dataplane-kafkas",
+			claims:    jwt.MapClaims{"clientId": "kas-fleetshard-agent-cluster-id"},
+			result:    Result{Allowed: true},
+			wantKey:   "list-dataplane-kafkas:agent:kas-fleetshard-agent-cluster-id",
+			wantCode:  http.StatusOK,
+		},
+		{
+			name:      "should use the default limit for routes without a limit",
+			routeName: "list-cloud-providers",
+			claims:    jwt.MapClaims{"org_id": "org-id"},
+			result:    Result{Allowed: true, Tokens: 99},
+			wantKey:   "list-cloud-providers:organisation:org-id",
+			wantCode:  http.StatusOK,
+			wantHeaders: map[string]string{
+				HeaderRateLimitLimit: "100",
+			},
+		},
+		{
+			name:          "should not limit the routes with a limit of 0 requests per minute",
+			routeName:     "get-kafka",
+			claims:        jwt.MapClaims{"org_id": "org-id"},
+			wantCode:      http.StatusOK,
+			wantNoHeaders: true,
+		},
+		{
+			name:          "should not limit the requests without claims",
+			routeName:     "list-kafka",
+			wantCode:      http.StatusOK,
+			wantNoHeaders: true,
+		},
+		{
+			name:          "should not limit the requests when rate limiting is disabled",
+			disabled:      true,
+			routeName:     "list-kafka",
+			claims:        jwt.MapClaims{"org_id": "org-id"},
+			wantCode:      http.StatusOK,
+			wantNoHeaders: true,
+		},
+		{
+			name:          "should let the request through when the store fails",
+			routeName:     "list-kafka",
+			claims:        jwt.MapClaims{"org_id": "org-id"},
+			takeErr:       fmt.Errorf("connection refused"),
+			wantKey:       "list-kafka:organisation:org-id",
+			wantCode:      http.StatusOK,
+			wantNoHeaders: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			store := &StoreMock{
+				TakeFunc: func(key string, limit Limit) (Result, error) {
+					return tt.result, tt.takeErr
+				},
+			}
+			m := &RateLimitMiddleware{
+				rateLimitConfig: &RateLimitConfig{EnableRateLimiting: !tt.disabled, RateLimits: limits},
+				store:           store,
+			}
+
+			router := mux.NewRouter()
+			router.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}).Name(logger.NewLogEvent(tt.routeName, "test route").ToString())
+			router.Use(m.RateLimit)
+
+			req := httptest.NewRequest(http.MethodGet, "/route", nil)
+			if tt.claims != nil {
+				req = req.WithContext(authentication.ContextWithToken(req.Context(), &jwt.Token{Claims: tt.claims}))
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(tt.wantCode))
+			if tt.wantKey == "" {
+				g.Expect(store.TakeCalls()).To(BeEmpty())
+			} else {
+				g.Expect(store.TakeCalls()).To(HaveLen(1))
+				g.Expect(store.TakeCalls()[0].Key).To(Equal(tt.wantKey))
+			}
+			for header, value := range tt.wantHeaders {
+				g.Expect(rec.Header().Get(header)).To(Equal(value), header)
+			}
+			if tt.wantNoHeaders {
+				g.Expect(rec.Header().Get(HeaderRateLimitLimit)).To(BeEmpty())
+			}
+		})
+	}
+}
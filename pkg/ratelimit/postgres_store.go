@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"database/sql"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+)
+
+// postgresStore keeps the buckets in the rate_limit_buckets table so that the limits hold across replicas.
+// The clock of the database is used to refill the buckets for the replicas to agree on the elapsed time.
+type postgresStore struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+var _ Store = &postgresStore{}
+
+func NewPostgresStore(connectionFactory *db.ConnectionFactory) Store {
+	return &postgresStore{
+		connectionFactory: connectionFactory,
+	}
+}
+
+// takeTokenQuery refills and takes a token from a bucket in a single statement, so that concurrent requests of the
+// same bucket are serialised by the lock of its row. A token is only taken when the bucket is not empty.
+const takeTokenQuery = `
+INSERT INTO rate_limit_buckets (key, tokens, allowed, updated_at) VALUES (@key, @burst - 1, true, now())
+ON CONFLICT (key) DO UPDATE SET
+	tokens = CASE
+		WHEN LEAST(@burst, rate_limit_buckets.tokens + EXTRACT(EPOCH FROM (now() - rate_limit_buckets.updated_at)) * @rate) >= 1
+		THEN LEAST(@burst, rate_limit_buckets.tokens + EXTRACT(EPOCH FROM (now() - rate_limit_buckets.updated_at)) * @rate) - 1
+		ELSE LEAST(@burst, rate_limit_buckets.tokens + EXTRACT(EPOCH FROM (now() - rate_limit_buckets.updated_at)) * @rate)
+	END,
+	allowed = LEAST(@burst, rate_limit_buckets.tokens + EXTRACT(EPOCH FROM (now() - rate_limit_buckets.updated_at)) * @rate) >= 1,
+	updated_at = now()
+RETURNING tokens, allowed`
+
+func (s *postgresStore) Take(key string, limit Limit) (Result, error) {
+	var result struct {
+		Tokens  float64
+		Allowed bool
+	}
+	// the request transaction is not used so that the token stays taken when the request fails
+	err := s.connectionFactory.New().Raw(takeTokenQuery,
+		sql.Named("key", key),
+		sql.Named("burst", float64(limit.Burst)),
+		sql.Named("rate", limit.refillRate()),
+	).Scan(&result).Error
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Allowed: result.Allowed, Tokens: result.Tokens}, nil
+}
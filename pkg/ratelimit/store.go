@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Result is the state of a bucket once a token was taken from it
+type Result struct {
+	// Allowed is false when the bucket was empty, in which case no token was taken
+	Allowed bool
+	// Tokens is the number of tokens left in the bucket
+	Tokens float64
+}
+
+//go:generate moq -out store_moq.go . Store
+type Store interface {
+	// Take refills the bucket identified by key according to the given limit and takes a token from it.
+	// Buckets that do not exist yet are created full.
+	Take(key string, limit Limit) (Result, error)
+}
+
+// refill returns the tokens of a bucket once it has been refilled for the given elapsed time
+func refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.refillRate())
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	limit     Limit
+}
+
+// memoryStore keeps the buckets in the memory of the replica, each replica enforcing the limits on its own
+type memoryStore struct {
+	mutex       sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+var _ Store = &memoryStore{}
+
+// cleanupInterval is how often the buckets that are full again are removed from the memory store
+const cleanupInterval = time.Minute
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (s *memoryStore) Take(key string, limit Limit) (Result, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	s.cleanup(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+	b.tokens = refill(b.tokens, now.Sub(b.updatedAt), limit)
+	b.updatedAt = now
+	b.limit = limit
+
+	if b.tokens < 1 {
+		return Result{Allowed: false, Tokens: b.tokens}, nil
+	}
+	b.tokens--
+	return Result{Allowed: true, Tokens: b.tokens}, nil
+}
+
+// cleanup removes the buckets that would be full by now, as they are the same as the new buckets
+func (s *memoryStore) cleanup(now time.Time) {
+	if now.Sub(s.lastCleanup) < cleanupInterval {
+		return
+	}
+	s.lastCleanup = now
+	for key, b := range s.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), b.limit) >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
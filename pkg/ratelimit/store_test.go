@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_memoryStore_Take(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	s := &memoryStore{
+		buckets: map[string]*bucket{},
+		now: func() time.Time {
+			return now
+		},
+	}
+	limit := Limit{RequestsPerMinute: 60, Burst: 2}
+
+	// a new bucket is full
+	g.Expect(s.Take("key", limit)).To(Equal(Result{Allowed: true, Tokens: 1}))
+	g.Expect(s.Take("key", limit)).To(Equal(Result{Allowed: true, Tokens: 0}))
+	// an empty bucket does not lose tokens when it is rejected
+	g.Expect(s.Take("key", limit)).To(Equal(Result{Allowed: false, Tokens: 0}))
+	// other buckets are not affected
+	g.Expect(s.Take("other-key", limit)).To(Equal(Result{Allowed: true, Tokens: 1}))
+
+	// 1 token is added each second
+	now = now.Add(time.Second)
+	g.Expect(s.Take("key", limit)).To(Equal(Result{Allowed: true, Tokens: 0}))
+
+	// the bucket is never refilled above its burst, and the full buckets are removed from memory
+	now = now.Add(time.Hour)
+	g.Expect(s.Take("key", limit)).To(Equal(Result{Allowed: true, Tokens: 1}))
+	g.Expect(s.buckets).To(HaveLen(1))
+}
+
+func Test_postgresStore_Take(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   []map[string]interface{}
+		want    Result
+		wantErr bool
+	}{
+		{
+			name:  "should return the tokens left in the bucket",
+			reply: []map[string]interface{}{{"tokens": 4.5, "allowed": true}},
+			want:  Result{Allowed: true, Tokens: 4.5},
+		},
+		{
+			name:  "should reject the request when the bucket is empty",
+			reply: []map[string]interface{}{{"tokens": 0.25, "allowed": false}},
+			want:  Result{Allowed: false, Tokens: 0.25},
+		},
+		{
+			name:    "should return the database errors",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mocket.Catcher.Reset()
+			if tt.reply != nil {
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO rate_limit_buckets`).WithReply(tt.reply)
+			}
+			mocket.Catcher.NewMock().WithExecException().WithQueryException()
+
+			s := NewPostgresStore(db.NewMockConnectionFactory(nil))
+			got, err := s.Take("list-kafka:organisation:org-id", Limit{RequestsPerMinute: 60, Burst: 5})
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
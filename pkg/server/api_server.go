@@ -10,9 +10,12 @@ import (
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/client/keycloak"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/handlers"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/ratelimit"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/server/logging"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/goava/di"
 
 	"github.com/openshift-online/ocm-sdk-go/authentication"
@@ -46,6 +49,7 @@ type ServerOptions struct {
 	ServerConfig    *ServerConfig
 	KeycloakConfig  *keycloak.KeycloakConfig
 	SentryConfig    *sentry.Config
+	RateLimit       *ratelimit.RateLimitMiddleware
 	RouteLoaders    []environments.RouteLoader
 	Env             *environments.Env
 	ReadyConditions []ApiServerReadyCondition `di:"optional"`
@@ -78,12 +82,18 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 	sentryMW := sentryhttp.New(sentryhttpOptions)
 	mainRouter.Use(sentryMW.Handle)
 
+	// Tracing middleware starts a span for each request, continuing the trace of the caller if any
+	mainRouter.Use(tracing.Middleware)
+
 	// Operation ID middleware sets a relatively unique operation ID in the context of each request for debugging purposes
 	mainRouter.Use(logger.OperationIDMiddleware)
 
 	// Request logging middleware logs pertinent information about the request and response
 	mainRouter.Use(logging.RequestLoggingMiddleware)
 
+	// Rate limiting middleware rejects the requests exceeding the limit of the route for their organisation, user or agent cluster
+	mainRouter.Use(options.RateLimit.RateLimit)
+
 	for _, loader := range options.RouteLoaders {
 		check(loader.AddRoutes(mainRouter), "error adding routes", options.SentryConfig.Timeout)
 	}
@@ -107,6 +117,17 @@ func NewAPIServer(options ServerOptions) *ApiServer {
 		gorillahandlers.AllowedHeaders([]string{
 			"Authorization",
 			"Content-Type",
+			handlers.HeaderIfMatch,
+			handlers.HeaderIdempotencyKey,
+		}),
+		gorillahandlers.ExposedHeaders([]string{
+			handlers.HeaderETag,
+			handlers.HeaderLocation,
+			handlers.HeaderIdempotentReplayed,
+			ratelimit.HeaderRateLimitLimit,
+			ratelimit.HeaderRateLimitRemaining,
+			ratelimit.HeaderRateLimitReset,
+			ratelimit.HeaderRetryAfter,
 		}),
 		gorillahandlers.MaxAge(int((10 * time.Minute).Seconds())),
 	)(mainHandler)
@@ -2,6 +2,7 @@ package server
 
 import (
 	"crypto/tls"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -14,6 +15,8 @@ type HealthCheckConfig struct {
 	// tls package accepts the versions in uint16 format, whose values
 	// are available as constants in that same package
 	MinTLSVersion uint16
+	// CheckTimeout is the timeout of the registered health checks which don't set their own
+	CheckTimeout time.Duration `json:"check_timeout"`
 }
 
 func NewHealthCheckConfig() *HealthCheckConfig {
@@ -21,12 +24,14 @@ func NewHealthCheckConfig() *HealthCheckConfig {
 		BindAddress:   "localhost:8083",
 		EnableHTTPS:   false,
 		MinTLSVersion: tls.VersionTLS12,
+		CheckTimeout:  5 * time.Second,
 	}
 }
 
 func (c *HealthCheckConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.BindAddress, "health-check-server-bindaddress", c.BindAddress, "Health check server bind address")
 	fs.BoolVar(&c.EnableHTTPS, "enable-health-check-https", c.EnableHTTPS, "Enable HTTPS for health check server")
+	fs.DurationVar(&c.CheckTimeout, "health-check-timeout", c.CheckTimeout, "Default timeout of the checks run by the readiness and liveness endpoints of the health check server")
 }
 
 func (c *HealthCheckConfig) ReadFiles() error {
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+)
+
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+	HealthStatusFailed   = "failed"
+)
+
+// HealthReport is the body of the readiness and liveness endpoints. Its status is failed when a critical check failed,
+// and degraded when only non critical checks failed.
+type HealthReport struct {
+	Status string              `json:"status"`
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+type HealthCheckResult struct {
+	Name        string                              `json:"name"`
+	Status      string                              `json:"status"`
+	Criticality environments.HealthCheckCriticality `json:"criticality"`
+	Duration    string                              `json:"duration"`
+	Error       string                              `json:"error,omitempty"`
+}
+
+// readyHandler reports whether the service can serve requests: all the registered checks are run, and the service is
+// not ready when one of the critical ones fails or when it is in maintenance mode
+func (s HealthCheckServer) readyHandler(w http.ResponseWriter, r *http.Request) {
+	report := s.runHealthChecks(r.Context(), s.healthChecks)
+
+	maintenance := HealthCheckResult{
+		Name:        "maintenance_status",
+		Status:      HealthStatusOK,
+		Criticality: environments.HealthCheckCritical,
+		Duration:    time.Duration(0).String(),
+	}
+	if err := updater.Check(); err != nil {
+		maintenance.Status = HealthStatusFailed
+		maintenance.Error = err.Error()
+	}
+	report.add(maintenance)
+
+	writeHealthReport(w, report)
+}
+
+// liveHandler reports whether the service must be restarted: only the checks registered as liveness checks are run
+func (s HealthCheckServer) liveHandler(w http.ResponseWriter, r *http.Request) {
+	var livenessChecks []environments.HealthCheck
+	for _, check := range s.healthChecks {
+		if check.HealthCheckOptions().Liveness {
+			livenessChecks = append(livenessChecks, check)
+		}
+	}
+	writeHealthReport(w, s.runHealthChecks(r.Context(), livenessChecks))
+}
+
+// runHealthChecks runs the given checks concurrently, each one bounded by its timeout
+func (s HealthCheckServer) runHealthChecks(ctx context.Context, checks []environments.HealthCheck) *HealthReport {
+	results := make([]HealthCheckResult, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check environments.HealthCheck) {
+			defer wg.Done()
+			results[i] = s.runHealthCheck(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := &HealthReport{
+		Status: HealthStatusOK,
+		Checks: []HealthCheckResult{},
+	}
+	for _, result := range results {
+		report.add(result)
+	}
+	return report
+}
+
+func (s HealthCheckServer) runHealthCheck(ctx context.Context, check environments.HealthCheck) HealthCheckResult {
+	options := check.HealthCheckOptions()
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = s.healthCheckConfig.CheckTimeout
+	}
+	criticality := options.Criticality
+	if criticality == "" {
+		criticality = environments.HealthCheckCritical
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- check.Check(ctx)
+	}()
+	// a check which ignores the cancellation of ctx must not block the endpoint past its timeout
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", timeout)
+	}
+
+	result := HealthCheckResult{
+		Name:        options.Name,
+		Status:      HealthStatusOK,
+		Criticality: criticality,
+		Duration:    time.Since(start).String(),
+	}
+	if err != nil {
+		glog.Warningf("health check %s failed: %v", options.Name, err)
+		result.Status = HealthStatusFailed
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (r *HealthReport) add(result HealthCheckResult) {
+	r.Checks = append(r.Checks, result)
+	sort.SliceStable(r.Checks, func(i, j int) bool {
+		return r.Checks[i].Name < r.Checks[j].Name
+	})
+
+	if result.Status != HealthStatusFailed || r.Status == HealthStatusFailed {
+		return
+	}
+	if result.Criticality == environments.HealthCheckCritical {
+		r.Status = HealthStatusFailed
+	} else {
+		r.Status = HealthStatusDegraded
+	}
+}
+
+func writeHealthReport(w http.ResponseWriter, report *HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	if report.Status == HealthStatusFailed {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		glog.Errorf("failed to write the health report: %v", err)
+	}
+}
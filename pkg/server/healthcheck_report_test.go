@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	. "github.com/onsi/gomega"
+)
+
+func newTestHealthCheck(name string, criticality environments.HealthCheckCriticality, liveness bool, err error) environments.HealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        name,
+		Criticality: criticality,
+		Liveness:    liveness,
+	}, func(ctx context.Context) error {
+		return err
+	})
+}
+
+func TestHealthCheckServer_readyHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		healthChecks []environments.HealthCheck
+		maintenance  bool
+		wantCode     int
+		wantStatus   string
+		wantFailed   []string
+	}{
+		{
+			name: "should be ready when all the checks succeed",
+			healthChecks: []environments.HealthCheck{
+				newTestHealthCheck("database", environments.HealthCheckCritical, false, nil),
+				newTestHealthCheck("sso", environments.HealthCheckNonCritical, false, nil),
+			},
+			wantCode:   http.StatusOK,
+			wantStatus: HealthStatusOK,
+		},
+		{
+			name: "should be degraded but ready when a non critical check fails",
+			healthChecks: []environments.HealthCheck{
+				newTestHealthCheck("database", environments.HealthCheckCritical, false, nil),
+				newTestHealthCheck("sso", environments.HealthCheckNonCritical, false, errors.New("unreachable")),
+			},
+			wantCode:   http.StatusOK,
+			wantStatus: HealthStatusDegraded,
+			wantFailed: []string{"sso"},
+		},
+		{
+			name: "should not be ready when a critical check fails",
+			healthChecks: []environments.HealthCheck{
+				newTestHealthCheck("database", environments.HealthCheckCritical, false, errors.New("connection refused")),
+				newTestHealthCheck("sso", environments.HealthCheckNonCritical, false, errors.New("unreachable")),
+			},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: HealthStatusFailed,
+			wantFailed: []string{"database", "sso"},
+		},
+		{
+			name:        "should not be ready in maintenance mode",
+			maintenance: true,
+			wantCode:    http.StatusServiceUnavailable,
+			wantStatus:  HealthStatusFailed,
+			wantFailed:  []string{"maintenance_status"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			if tt.maintenance {
+				downHandler(nil, nil)
+				defer upHandler(nil, nil)
+			}
+
+			s := NewHealthCheckServer(HealthCheckServerOptions{
+				HealthCheckConfig: NewHealthCheckConfig(),
+				ServerConfig:      NewServerConfig(),
+				SentryConfig:      sentry.NewConfig(),
+				HealthChecks:      tt.healthChecks,
+			})
+			recorder := httptest.NewRecorder()
+			s.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthcheck/ready", nil))
+
+			g.Expect(recorder.Code).To(Equal(tt.wantCode))
+			var report HealthReport
+			g.Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+			g.Expect(report.Status).To(Equal(tt.wantStatus))
+			g.Expect(report.Checks).To(HaveLen(len(tt.healthChecks) + 1))
+			var failed []string
+			for _, check := range report.Checks {
+				if check.Status == HealthStatusFailed {
+					g.Expect(check.Error).ToNot(BeEmpty())
+					failed = append(failed, check.Name)
+				}
+			}
+			g.Expect(failed).To(Equal(tt.wantFailed))
+		})
+	}
+}
+
+func TestHealthCheckServer_liveHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	s := NewHealthCheckServer(HealthCheckServerOptions{
+		HealthCheckConfig: NewHealthCheckConfig(),
+		ServerConfig:      NewServerConfig(),
+		SentryConfig:      sentry.NewConfig(),
+		HealthChecks: []environments.HealthCheck{
+			newTestHealthCheck("database", environments.HealthCheckCritical, false, errors.New("connection refused")),
+			newTestHealthCheck("leader_election", environments.HealthCheckCritical, true, nil),
+		},
+	})
+	recorder := httptest.NewRecorder()
+	s.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthcheck/live", nil))
+
+	// only the liveness checks are run
+	g.Expect(recorder.Code).To(Equal(http.StatusOK))
+	var report HealthReport
+	g.Expect(json.Unmarshal(recorder.Body.Bytes(), &report)).To(Succeed())
+	g.Expect(report.Status).To(Equal(HealthStatusOK))
+	g.Expect(report.Checks).To(HaveLen(1))
+	g.Expect(report.Checks[0].Name).To(Equal("leader_election"))
+}
+
+func TestHealthCheckServer_runHealthCheck(t *testing.T) {
+	g := NewWithT(t)
+
+	s := HealthCheckServer{healthCheckConfig: NewHealthCheckConfig()}
+	blocking := environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:    "observatorium",
+		Timeout: 10 * time.Millisecond,
+	}, func(ctx context.Context) error {
+		// ignores the cancellation of ctx
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	result := s.runHealthCheck(context.Background(), blocking)
+	g.Expect(result.Status).To(Equal(HealthStatusFailed))
+	g.Expect(result.Criticality).To(Equal(environments.HealthCheckCritical))
+	g.Expect(result.Error).To(ContainSubstring("timed out"))
+}
@@ -8,7 +8,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/sentry"
+	"github.com/goava/di"
 
 	health "github.com/docker/go-healthcheck"
 	"github.com/golang/glog"
@@ -26,30 +28,43 @@ type HealthCheckServer struct {
 	serverConfig      *ServerConfig
 	sentryTimeout     time.Duration
 	healthCheckConfig *HealthCheckConfig
+	healthChecks      []environments.HealthCheck
 }
 
-func NewHealthCheckServer(healthCheckConfig *HealthCheckConfig, serverConfig *ServerConfig, sentryConfig *sentry.Config) *HealthCheckServer {
+type HealthCheckServerOptions struct {
+	di.Inject
+	HealthCheckConfig *HealthCheckConfig
+	ServerConfig      *ServerConfig
+	SentryConfig      *sentry.Config
+	HealthChecks      []environments.HealthCheck `di:"optional"`
+}
+
+func NewHealthCheckServer(options HealthCheckServerOptions) *HealthCheckServer {
+	s := &HealthCheckServer{
+		serverConfig:      options.ServerConfig,
+		healthCheckConfig: options.HealthCheckConfig,
+		sentryTimeout:     options.SentryConfig.Timeout,
+		healthChecks:      options.HealthChecks,
+	}
+
 	router := mux.NewRouter()
 	health.DefaultRegistry = health.NewRegistry()
 	health.Register("maintenance_status", updater)
 	router.HandleFunc("/healthcheck", health.StatusHandler).Methods(http.MethodGet)
+	router.HandleFunc("/healthcheck/ready", s.readyHandler).Methods(http.MethodGet)
+	router.HandleFunc("/healthcheck/live", s.liveHandler).Methods(http.MethodGet)
 	router.HandleFunc("/healthcheck/down", downHandler).Methods(http.MethodPost)
 	router.HandleFunc("/healthcheck/up", upHandler).Methods(http.MethodPost)
 
-	srv := &http.Server{
+	s.httpServer = &http.Server{
 		Handler: router,
-		Addr:    healthCheckConfig.BindAddress,
+		Addr:    options.HealthCheckConfig.BindAddress,
 		TLSConfig: &tls.Config{
-			MinVersion: healthCheckConfig.MinTLSVersion,
+			MinVersion: options.HealthCheckConfig.MinTLSVersion,
 		},
 	}
 
-	return &HealthCheckServer{
-		httpServer:        srv,
-		serverConfig:      serverConfig,
-		healthCheckConfig: healthCheckConfig,
-		sentryTimeout:     sentryConfig.Timeout,
-	}
+	return s
 }
 
 func (s HealthCheckServer) Start() {
@@ -1,3 +1,6 @@
 package logging
 
 const LoggingThreshold int32 = 1
+
+// LoggingComponent is the logging component of the requests and responses, whose verbosity can be adjusted on its own
+const LoggingComponent = "http"
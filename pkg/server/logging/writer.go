@@ -34,7 +34,7 @@ func (writer *loggingWriter) WriteHeader(status int) {
 }
 
 func (writer *loggingWriter) Log(log string, err error) {
-	ulog := logger.NewUHCLogger(writer.request.Context())
+	ulog := logger.NewUHCLogger(writer.request.Context()).WithComponent(LoggingComponent)
 	switch err {
 	case nil:
 		ulog.V(LoggingThreshold).Infof(log)
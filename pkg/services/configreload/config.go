@@ -0,0 +1,27 @@
+package configreload
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	// WatchInterval is the interval between two checks of the configuration files of the reloadable config modules,
+	// a module is reloaded when the checksum of its files changed. Watching is disabled when it is 0.
+	WatchInterval time.Duration
+}
+
+func NewConfig() *Config {
+	return &Config{
+		WatchInterval: 30 * time.Second,
+	}
+}
+
+func (c *Config) AddFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&c.WatchInterval, "config-reload-watch-interval", c.WatchInterval, "Interval between two checks for changes of the reloadable configuration files, 0 disables the watch")
+}
+
+func (c *Config) ReadFiles() error {
+	return nil
+}
@@ -0,0 +1,201 @@
+package configreload
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/goava/di"
+	"github.com/golang/glog"
+)
+
+// ConfigStatus describes the configuration loaded by a reloadable config module
+type ConfigStatus struct {
+	// Name is the name of the type of the config module
+	Name  string
+	Files []string
+	// Checksum is the checksum of the content of the files of the loaded configuration
+	Checksum string
+	LoadedAt time.Time
+	// LastReloadError is the error of the last reload if it failed, in which case the configuration loaded before is
+	// still in use
+	LastReloadError string
+}
+
+type reloadableModule struct {
+	module environments.ReloadableConfigModule
+	status ConfigStatus
+	// failedChecksum is the checksum of the files whose reload failed, so that the watch doesn't retry them
+	failedChecksum string
+}
+
+// ConfigReloader reloads the config modules which implement environments.ReloadableConfigModule without a restart. A
+// reload is triggered by a SIGHUP, by a change of their configuration files, or by calling Reload.
+type ConfigReloader struct {
+	config  *Config
+	modules []*reloadableModule
+	// mutex serialises the reloads and guards the statuses of the modules
+	mutex   sync.Mutex
+	signals chan os.Signal
+	stop    chan struct{}
+}
+
+type ConfigReloaderOptions struct {
+	di.Inject
+	Config        *Config
+	ConfigModules []environments.ConfigModule `di:"optional"`
+}
+
+func NewConfigReloader(options ConfigReloaderOptions) *ConfigReloader {
+	r := &ConfigReloader{
+		config: options.Config,
+	}
+	for _, configModule := range options.ConfigModules {
+		module, ok := configModule.(environments.ReloadableConfigModule)
+		if !ok {
+			continue
+		}
+		// the configuration was loaded when the environment was created
+		status := ConfigStatus{
+			Name:     moduleName(module),
+			Files:    module.ConfigFiles(),
+			Checksum: checksum(module.ConfigFiles()),
+			LoadedAt: time.Now(),
+		}
+		metrics.UpdateConfigChecksumMetric(status.Name, "", status.Checksum)
+		r.modules = append(r.modules, &reloadableModule{module: module, status: status})
+	}
+	sort.Slice(r.modules, func(i, j int) bool {
+		return r.modules[i].status.Name < r.modules[j].status.Name
+	})
+	return r
+}
+
+func (r *ConfigReloader) Start() {
+	r.stop = make(chan struct{})
+	r.signals = make(chan os.Signal, 1)
+	signal.Notify(r.signals, syscall.SIGHUP)
+
+	go func() {
+		var watch <-chan time.Time
+		if r.config.WatchInterval > 0 {
+			ticker := time.NewTicker(r.config.WatchInterval)
+			defer ticker.Stop()
+			watch = ticker.C
+		}
+		for {
+			select {
+			case <-r.signals:
+				glog.Infof("received SIGHUP, reloading the configuration")
+				r.Reload()
+			case <-watch:
+				r.reloadChanged()
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+func (r *ConfigReloader) Stop() {
+	if r.stop == nil {
+		return
+	}
+	signal.Stop(r.signals)
+	close(r.stop)
+}
+
+// Reload reloads all the reloadable config modules, and returns their status
+func (r *ConfigReloader) Reload() []ConfigStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, module := range r.modules {
+		r.reload(module, checksum(module.status.Files))
+	}
+	return r.statuses()
+}
+
+// Status returns the status of the reloadable config modules
+func (r *ConfigReloader) Status() []ConfigStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.statuses()
+}
+
+// reloadChanged reloads the config modules whose files changed since they were loaded
+func (r *ConfigReloader) reloadChanged() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, module := range r.modules {
+		sum := checksum(module.status.Files)
+		if sum != module.status.Checksum && sum != module.failedChecksum {
+			glog.Infof("configuration files of %s changed, reloading them", module.status.Name)
+			r.reload(module, sum)
+		}
+	}
+}
+
+// reload reloads the module, sum being the checksum of its files before the reload. A change of the files during the
+// reload is caught by the next watch.
+func (r *ConfigReloader) reload(module *reloadableModule, sum string) {
+	if err := module.module.Reload(); err != nil {
+		glog.Errorf("failed to reload the configuration of %s, the previous configuration is kept: %v", module.status.Name, err)
+		metrics.IncreaseConfigReloadCountMetric(module.status.Name, false)
+		module.status.LastReloadError = err.Error()
+		module.failedChecksum = sum
+		return
+	}
+
+	metrics.IncreaseConfigReloadCountMetric(module.status.Name, true)
+	metrics.UpdateConfigChecksumMetric(module.status.Name, module.status.Checksum, sum)
+	if sum != module.status.Checksum {
+		glog.Infof("reloaded the configuration of %s, checksum = %s", module.status.Name, sum)
+	}
+	module.status.Checksum = sum
+	module.status.LoadedAt = time.Now()
+	module.status.LastReloadError = ""
+	module.failedChecksum = ""
+}
+
+func (r *ConfigReloader) statuses() []ConfigStatus {
+	statuses := make([]ConfigStatus, 0, len(r.modules))
+	for _, module := range r.modules {
+		statuses = append(statuses, module.status)
+	}
+	return statuses
+}
+
+func moduleName(module environments.ReloadableConfigModule) string {
+	t := reflect.TypeOf(module)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// checksum returns the sha256 checksum of the names and contents of the given files. A missing file is part of the
+// checksum too, as some config modules fall back to a default configuration when their file is missing.
+func checksum(files []string) string {
+	h := sha256.New()
+	for _, file := range files {
+		h.Write([]byte(file))
+		h.Write([]byte{0})
+		content, err := shared.ReadFile(file)
+		if err != nil {
+			h.Write([]byte("error: " + err.Error()))
+		} else {
+			h.Write([]byte(content))
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
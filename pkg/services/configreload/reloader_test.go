@@ -0,0 +1,125 @@
+package configreload
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/spf13/pflag"
+
+	. "github.com/onsi/gomega"
+)
+
+type testConfig struct {
+	file    string
+	value   string
+	reloads int
+}
+
+var _ environments.ReloadableConfigModule = &testConfig{}
+
+func (c *testConfig) AddFlags(fs *pflag.FlagSet) {}
+
+func (c *testConfig) ReadFiles() error {
+	content, err := ioutil.ReadFile(c.file)
+	c.value = string(content)
+	return err
+}
+
+func (c *testConfig) ConfigFiles() []string {
+	return []string{c.file}
+}
+
+func (c *testConfig) Reload() error {
+	c.reloads++
+	content, err := ioutil.ReadFile(c.file)
+	if err != nil {
+		return err
+	}
+	if string(content) == "invalid" {
+		return errors.New("invalid configuration")
+	}
+	c.value = string(content)
+	return nil
+}
+
+type notReloadableConfig struct{}
+
+func (c *notReloadableConfig) AddFlags(fs *pflag.FlagSet) {}
+
+func (c *notReloadableConfig) ReadFiles() error {
+	return nil
+}
+
+func TestConfigReloader(t *testing.T) {
+	g := NewWithT(t)
+
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	g.Expect(ioutil.WriteFile(file, []byte("a"), 0600)).To(Succeed())
+	module := &testConfig{file: file}
+	g.Expect(module.ReadFiles()).To(Succeed())
+
+	reloader := NewConfigReloader(ConfigReloaderOptions{
+		Config:        NewConfig(),
+		ConfigModules: []environments.ConfigModule{module, &notReloadableConfig{}},
+	})
+
+	// only the reloadable modules are reported
+	statuses := reloader.Status()
+	g.Expect(statuses).To(HaveLen(1))
+	g.Expect(statuses[0].Name).To(Equal("testConfig"))
+	g.Expect(statuses[0].Files).To(Equal([]string{file}))
+	initialChecksum := statuses[0].Checksum
+	g.Expect(initialChecksum).To(Equal(checksum([]string{file})))
+
+	// the watch does not reload the modules whose files did not change
+	reloader.reloadChanged()
+	g.Expect(module.reloads).To(Equal(0))
+
+	// the watch reloads the modules whose files changed
+	g.Expect(ioutil.WriteFile(file, []byte("b"), 0600)).To(Succeed())
+	reloader.reloadChanged()
+	g.Expect(module.reloads).To(Equal(1))
+	g.Expect(module.value).To(Equal("b"))
+	statuses = reloader.Status()
+	g.Expect(statuses[0].Checksum).ToNot(Equal(initialChecksum))
+	g.Expect(statuses[0].LastReloadError).To(BeEmpty())
+	validChecksum := statuses[0].Checksum
+
+	// a failed reload keeps the previous configuration and its checksum, and the watch doesn't retry it
+	g.Expect(ioutil.WriteFile(file, []byte("invalid"), 0600)).To(Succeed())
+	reloader.reloadChanged()
+	g.Expect(module.reloads).To(Equal(2))
+	g.Expect(module.value).To(Equal("b"))
+	statuses = reloader.Status()
+	g.Expect(statuses[0].Checksum).To(Equal(validChecksum))
+	g.Expect(statuses[0].LastReloadError).To(Equal("invalid configuration"))
+	reloader.reloadChanged()
+	g.Expect(module.reloads).To(Equal(2))
+
+	// an explicit reload reloads all the modules
+	g.Expect(ioutil.WriteFile(file, []byte("c"), 0600)).To(Succeed())
+	statuses = reloader.Reload()
+	g.Expect(module.reloads).To(Equal(3))
+	g.Expect(module.value).To(Equal("c"))
+	g.Expect(statuses[0].Checksum).To(Equal(checksum([]string{file})))
+	g.Expect(statuses[0].LastReloadError).To(BeEmpty())
+}
+
+func Test_checksum(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.yaml")
+	b := filepath.Join(dir, "b.yaml")
+	g.Expect(ioutil.WriteFile(a, []byte("content"), 0600)).To(Succeed())
+	g.Expect(ioutil.WriteFile(b, []byte("content"), 0600)).To(Succeed())
+
+	g.Expect(checksum([]string{a})).To(Equal(checksum([]string{a})))
+	g.Expect(checksum([]string{a})).ToNot(Equal(checksum([]string{b})))
+	g.Expect(checksum([]string{a, b})).ToNot(Equal(checksum([]string{b, a})))
+	// a missing file has a checksum too
+	g.Expect(checksum([]string{filepath.Join(dir, "missing.yaml")})).ToNot(BeEmpty())
+}
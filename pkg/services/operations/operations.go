@@ -0,0 +1,242 @@
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/auth"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate moq -out operations_moq.go . OperationService
+type OperationService interface {
+	// Create records an asynchronous action accepted for its target, in the transaction of the request of the given
+	// ctx if any, so that the operation is only recorded if the request succeeds. An action already in progress for the
+	// target is not recorded twice: the operation tracking it is returned instead.
+	Create(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError)
+	// Get retrieves an operation on a resource of the organisation of the user, or of the user when not in an
+	// organisation. Admins can retrieve any operation.
+	Get(ctx context.Context, id string) (*api.Operation, *errors.ServiceError)
+	// List returns the operations on the resources of the organisation of the user, or of the user when not in an
+	// organisation, most recent first
+	List(ctx context.Context, listArgs *services.ListArguments) (api.OperationList, *api.PagingMeta, *errors.ServiceError)
+	// ListActive returns the operations of the given kind which are not finished yet
+	ListActive(kind api.OperationKind) (api.OperationList, *errors.ServiceError)
+	// UpdateProgress marks the operation of the given kind in progress for the target as running. cause is the error
+	// the worker carrying it out last encountered, if any, as a running operation is retried until it completes.
+	UpdateProgress(kind api.OperationKind, targetId string, progress int32, cause error) *errors.ServiceError
+	// Complete finishes the operation of the given kind in progress for the target, it succeeded unless a cause is given
+	Complete(kind api.OperationKind, targetId string, cause error) *errors.ServiceError
+}
+
+var _ OperationService = &operationService{}
+
+type operationService struct {
+	connectionFactory *db.ConnectionFactory
+}
+
+func NewOperationService(connectionFactory *db.ConnectionFactory) OperationService {
+	return &operationService{
+		connectionFactory: connectionFactory,
+	}
+}
+
+func (s *operationService) Create(ctx context.Context, operation *api.Operation) (*api.Operation, *errors.ServiceError) {
+	dbConn, err := s.connectionFactory.RequestTransaction(ctx)
+	if err != nil {
+		// the operations created by the workers aren't part of a request
+		dbConn = s.connectionFactory.New()
+	}
+
+	existing, serviceErr := s.findActive(dbConn, operation)
+	if serviceErr != nil || existing != nil {
+		return existing, serviceErr
+	}
+
+	operation.ID = api.NewID()
+	if operation.State == "" {
+		operation.State = api.OperationStatePending.String()
+	}
+	if operation.State == api.OperationStateRunning.String() && operation.StartedAt == nil {
+		now := time.Now()
+		operation.StartedAt = &now
+	}
+
+	// the unique index on the active operations of a target makes the insert a no-op when a concurrent request
+	// recorded the same action in the meantime, the operation it recorded is returned then
+	result := dbConn.Clauses(clause.OnConflict{DoNothing: true}).Create(operation)
+	if result.Error != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, result.Error, "failed to create the %s operation of %s %s", operation.Kind, operation.TargetKind, operation.TargetId)
+	}
+	if result.RowsAffected == 0 {
+		existing, serviceErr := s.findActive(dbConn, operation)
+		if serviceErr == nil && existing == nil {
+			serviceErr = errors.GeneralError("failed to create the %s operation of %s %s", operation.Kind, operation.TargetKind, operation.TargetId)
+		}
+		return existing, serviceErr
+	}
+
+	logger.NewUHCLogger(ctx).WithFields(targetFields(operation.TargetKind, operation.TargetId, logger.OperationIDField, operation.ID)...).
+		Infof("%s operation %s of %s %s has been created", operation.Kind, operation.ID, operation.TargetKind, operation.TargetId)
+	return operation, nil
+}
+
+// findActive returns the operation tracking the same action on the same target as the given one if any is in progress
+func (s *operationService) findActive(dbConn *gorm.DB, operation *api.Operation) (*api.Operation, *errors.ServiceError) {
+	var existing api.Operation
+	err := dbConn.Where("kind = ? AND target_id = ? AND state IN ?", operation.Kind, operation.TargetId, api.ActiveOperationStates).
+		Take(&existing).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to find the %s operation of %s %s", operation.Kind, operation.TargetKind, operation.TargetId)
+	}
+	return &existing, nil
+}
+
+func (s *operationService) Get(ctx context.Context, id string) (*api.Operation, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	dbConn, svcErr := s.filterByUser(ctx, s.connectionFactory.New())
+	if svcErr != nil {
+		return nil, svcErr
+	}
+
+	var operation api.Operation
+	if err := dbConn.Where("id = ?", id).First(&operation).Error; err != nil {
+		return nil, services.HandleGetError("Operation", "id", id, err)
+	}
+	return &operation, nil
+}
+
+func (s *operationService) List(ctx context.Context, listArgs *services.ListArguments) (api.OperationList, *api.PagingMeta, *errors.ServiceError) {
+	var operations api.OperationList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn, svcErr := s.filterByUser(ctx, s.connectionFactory.New())
+	if svcErr != nil {
+		return nil, nil, svcErr
+	}
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&operations).Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the operations")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc, id desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&operations).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the operations")
+	}
+
+	return operations, pagingMeta, nil
+}
+
+func (s *operationService) ListActive(kind api.OperationKind) (api.OperationList, *errors.ServiceError) {
+	var operations api.OperationList
+	if err := s.connectionFactory.New().
+		Where("kind = ? AND state IN ?", kind.String(), api.ActiveOperationStates).
+		Order("created_at").
+		Find(&operations).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the active %s operations", kind)
+	}
+	return operations, nil
+}
+
+func (s *operationService) UpdateProgress(kind api.OperationKind, targetId string, progress int32, cause error) *errors.ServiceError {
+	var lastError string
+	if cause != nil {
+		lastError = cause.Error()
+	}
+	return s.update(kind, targetId, map[string]interface{}{
+		"state":      api.OperationStateRunning.String(),
+		"progress":   progress,
+		"error":      lastError,
+		"started_at": gorm.Expr("COALESCE(started_at, ?)", time.Now()),
+	})
+}
+
+func (s *operationService) Complete(kind api.OperationKind, targetId string, cause error) *errors.ServiceError {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"state":        api.OperationStateSucceeded.String(),
+		"progress":     100,
+		"error":        "",
+		"started_at":   gorm.Expr("COALESCE(started_at, ?)", now),
+		"completed_at": now,
+	}
+	if cause != nil {
+		updates["state"] = api.OperationStateFailed.String()
+		updates["progress"] = gorm.Expr("progress")
+		updates["error"] = cause.Error()
+	}
+	if err := s.update(kind, targetId, updates); err != nil {
+		return err
+	}
+	logger.Logger.WithFields(targetFields(kind.TargetKind(), targetId)...).V(10).Infof("%s operation of %s has completed, state = %s", kind, targetId, updates["state"])
+	return nil
+}
+
+// update updates the operation of the given kind in progress for the target. The actions started before the
+// operations were recorded have none, which is not an error.
+func (s *operationService) update(kind api.OperationKind, targetId string, updates map[string]interface{}) *errors.ServiceError {
+	if err := s.connectionFactory.New().Model(&api.Operation{}).
+		Where("kind = ? AND target_id = ? AND state IN ?", kind.String(), targetId, api.ActiveOperationStates).
+		Updates(updates).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update the %s operation of %s", kind, targetId)
+	}
+	return nil
+}
+
+// targetFields returns the log fields identifying the target of an operation, followed by the given ones
+func targetFields(targetKind string, targetId string, keysAndValues ...interface{}) []interface{} {
+	switch targetKind {
+	case api.OperationTargetKafka:
+		return append([]interface{}{logger.KafkaIDField, targetId}, keysAndValues...)
+	case api.OperationTargetCluster:
+		return append([]interface{}{logger.ClusterIDField, targetId}, keysAndValues...)
+	case api.OperationTargetConnector:
+		return append([]interface{}{logger.ConnectorIDField, targetId}, keysAndValues...)
+	default:
+		return keysAndValues
+	}
+}
+
+// filterByUser restricts the operations to the ones on the resources of the organisation of the user, or of the user
+// when not in an organisation. Admins see all the operations.
+func (s *operationService) filterByUser(ctx context.Context, dbConn *gorm.DB) (*gorm.DB, *errors.ServiceError) {
+	if auth.GetIsAdminFromContext(ctx) {
+		return dbConn, nil
+	}
+
+	claims, err := auth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, errors.NewWithCause(errors.ErrorUnauthenticated, err, "user not authenticated")
+	}
+	user, _ := claims.GetUsername()
+	if user == "" {
+		return nil, errors.Unauthenticated("user not authenticated")
+	}
+
+	if orgId, _ := claims.GetOrgId(); orgId != "" {
+		return dbConn.Where("organisation_id = ?", orgId), nil
+	}
+	return dbConn.Where("owner = ?", user), nil
+}
@@ -0,0 +1,87 @@
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	. "github.com/onsi/gomega"
+	mocket "github.com/selvatico/go-mocket"
+)
+
+func Test_operationService_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupFn     func()
+		operation   *api.Operation
+		wantErr     bool
+		wantId      string
+		wantState   string
+		wantStarted bool
+	}{
+		{
+			name: "should return the operation already in progress for the target",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "operations" WHERE (kind = $1 AND target_id = $2`).
+					WithReply([]map[string]interface{}{{"id": "operation-id", "kind": api.OperationKindKafkaDeletion.String(), "target_id": "kafka-id", "state": api.OperationStatePending.String()}})
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			operation: &api.Operation{Kind: api.OperationKindKafkaDeletion.String(), TargetId: "kafka-id"},
+			wantId:    "operation-id",
+			wantState: api.OperationStatePending.String(),
+		},
+		{
+			name: "should create a pending operation when none is in progress for the target",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "operations" WHERE (kind = $1 AND target_id = $2`).
+					WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "operations"`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			operation: &api.Operation{Kind: api.OperationKindKafkaDeletion.String(), TargetId: "kafka-id"},
+			wantState: api.OperationStatePending.String(),
+		},
+		{
+			name: "should start an operation created as running",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithQuery(`SELECT * FROM "operations" WHERE (kind = $1 AND target_id = $2`).
+					WithReply([]map[string]interface{}{})
+				mocket.Catcher.NewMock().WithQuery(`INSERT INTO "operations"`)
+				mocket.Catcher.NewMock().WithExecException().WithQueryException()
+			},
+			operation:   &api.Operation{Kind: api.OperationKindClusterScaleUp.String(), TargetId: "cluster-id", State: api.OperationStateRunning.String()},
+			wantState:   api.OperationStateRunning.String(),
+			wantStarted: true,
+		},
+		{
+			name: "should fail when the operations cannot be queried",
+			setupFn: func() {
+				mocket.Catcher.Reset().NewMock().WithExecException().WithQueryException()
+			},
+			operation: &api.Operation{Kind: api.OperationKindKafkaDeletion.String(), TargetId: "kafka-id"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			tt.setupFn()
+
+			s := NewOperationService(db.NewMockConnectionFactory(nil))
+			operation, err := s.Create(context.Background(), tt.operation)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if tt.wantErr {
+				return
+			}
+			if tt.wantId != "" {
+				g.Expect(operation.ID).To(Equal(tt.wantId))
+			} else {
+				g.Expect(operation.ID).ToNot(BeEmpty())
+			}
+			g.Expect(operation.State).To(Equal(tt.wantState))
+			g.Expect(operation.StartedAt != nil).To(Equal(tt.wantStarted))
+		})
+	}
+}
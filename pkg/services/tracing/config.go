@@ -0,0 +1,35 @@
+package tracing
+
+import (
+	"github.com/spf13/pflag"
+)
+
+type Config struct {
+	Enabled       bool    `json:"enabled"`
+	Endpoint      string  `json:"endpoint"`
+	Insecure      bool    `json:"insecure"`
+	SamplingRatio float64 `json:"sampling_ratio"`
+	ServiceName   string  `json:"service_name"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Enabled:       false,
+		Endpoint:      "localhost:4318",
+		Insecure:      false,
+		SamplingRatio: 1,
+		ServiceName:   "kas-fleet-manager",
+	}
+}
+
+func (c *Config) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-tracing", c.Enabled, "Enable OpenTelemetry tracing of API requests, reconcilers, database queries and outbound requests")
+	fs.StringVar(&c.Endpoint, "tracing-otlp-endpoint", c.Endpoint, "Host and port of the OTLP/HTTP collector traces are exported to")
+	fs.BoolVar(&c.Insecure, "tracing-otlp-insecure", c.Insecure, "Export traces to the OTLP collector over plain HTTP instead of HTTPS")
+	fs.Float64Var(&c.SamplingRatio, "tracing-sampling-ratio", c.SamplingRatio, "Ratio of traces to sample, between 0 and 1. Requests that are part of a sampled trace are always sampled")
+	fs.StringVar(&c.ServiceName, "tracing-service-name", c.ServiceName, "Service name reported with the exported traces")
+}
+
+func (c *Config) ReadFiles() error {
+	return nil
+}
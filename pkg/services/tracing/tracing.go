@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager"
+	shutdownTimeout     = 10 * time.Second
+)
+
+// Tracer returns the tracer used to instrument kas-fleet-manager.
+// Spans started with it are dropped until a TracerProvider is created with tracing enabled.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// TracerProvider installs the OpenTelemetry tracer provider exporting the traces over OTLP.
+// It is a BootService so that the spans still buffered are exported when the env is stopped.
+type TracerProvider struct {
+	provider *sdktrace.TracerProvider
+}
+
+var _ environments.BootService = &TracerProvider{}
+
+func NewTracerProvider(envName environments.EnvName, c *Config) (*TracerProvider, error) {
+	if !c.Enabled {
+		glog.Infof("Disabling OpenTelemetry tracing")
+		return &TracerProvider{}, nil
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(c.Endpoint),
+	}
+	if c.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(c.ServiceName),
+		semconv.DeploymentEnvironmentKey.String(string(envName)),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the tracing resource: %w", err)
+	}
+
+	glog.Infof("OpenTelemetry tracing enabled to %s with a sampling ratio of %v", c.Endpoint, c.SamplingRatio)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return &TracerProvider{provider: provider}, nil
+}
+
+func (p *TracerProvider) Start() {
+}
+
+// Stop flushes the spans that have not been exported yet
+func (p *TracerProvider) Stop() {
+	if p.provider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := p.provider.Shutdown(ctx); err != nil {
+		glog.Warningf("Unable to shut down the tracer provider: %s", err)
+	}
+}
+
+// Middleware starts a server span for each request, named after the method and the path template of the matched route
+func Middleware(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, "api", otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		if route := mux.CurrentRoute(r); route != nil {
+			if path, err := route.GetPathTemplate(); err == nil {
+				return r.Method + " " + path
+			}
+		}
+		return r.Method + " " + operation
+	}))
+}
+
+// Transport wraps the given round tripper so that a client span is started for each outbound request and the trace
+// context is propagated to the called service. The http.DefaultTransport is wrapped when base is nil.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupInMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return exporter
+}
+
+func Test_Middleware(t *testing.T) {
+	g := NewWithT(t)
+	exporter := setupInMemoryExporter(t)
+
+	router := mux.NewRouter()
+	router.Use(Middleware)
+	router.Use(logger.OperationIDMiddleware)
+	router.HandleFunc("/api/kafkas_mgmt/v1/kafkas/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodGet)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/kafkas_mgmt/v1/kafkas/123", nil))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].Name).To(Equal("GET /api/kafkas_mgmt/v1/kafkas/{id}"))
+	g.Expect(spans[0].SpanKind).To(Equal(trace.SpanKindServer))
+	g.Expect(spans[0].Attributes).To(ContainElement(attribute.String("operation_id", rec.Header().Get(string(logger.OpIDHeader)))))
+}
+
+func Test_Transport(t *testing.T) {
+	g := NewWithT(t)
+	exporter := setupInMemoryExporter(t)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport(nil)}
+	resp, err := client.Get(server.URL)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp.Body.Close()).To(Succeed())
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].SpanKind).To(Equal(trace.SpanKindClient))
+	g.Expect(traceparent).To(ContainSubstring(spans[0].SpanContext.TraceID().String()))
+}
+
+func Test_NewTracerProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	provider, err := NewTracerProvider("testing", NewConfig())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(provider.provider).To(BeNil())
+	// stopping a disabled provider is a no-op
+	provider.Stop()
+}
@@ -0,0 +1,91 @@
+package webhooks
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared"
+	"github.com/spf13/pflag"
+)
+
+const secretEncryptionKeyLength = 32
+
+type Config struct {
+	// Enabled turns on the delivery of the outbox events to the webhooks. Events are written to the outbox regardless,
+	// so that the events of the changes made while deliveries are disabled are delivered once they are enabled.
+	Enabled bool
+	// MaxAttempts is the number of attempts after which a delivery is moved to the dead letter state
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry of a failed delivery, doubled for every retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Timeout bounds each attempt to deliver an event
+	Timeout time.Duration
+	// BatchSize is the number of events dispatched and deliveries attempted at most in each reconcile
+	BatchSize int
+	// AllowInsecureUrls allows plain HTTP webhook urls, for development
+	AllowInsecureUrls bool
+	// AllowPrivateNetworkUrls allows webhook urls resolving to loopback, private, link-local or unspecified
+	// addresses, for development. Otherwise they are rejected on subscription and on each delivery.
+	AllowPrivateNetworkUrls bool
+	// SecretEncryptionKeyFile contains the base64 encoded AES key encrypting the stored subscription secrets
+	SecretEncryptionKeyFile string
+	SecretEncryptionKey     []byte
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Enabled:        false,
+		MaxAttempts:    10,
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     6 * time.Hour,
+		Timeout:        10 * time.Second,
+		BatchSize:      100,
+
+		SecretEncryptionKeyFile: "secrets/webhook-secret-encryption.key",
+	}
+}
+
+func (c *Config) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&c.Enabled, "enable-webhooks", c.Enabled, "Enable the delivery of resource lifecycle events to the webhook subscriptions of the organisations")
+	fs.IntVar(&c.MaxAttempts, "webhook-delivery-max-attempts", c.MaxAttempts, "Number of attempts to deliver an event to a webhook before the delivery is moved to the dead letter state")
+	fs.DurationVar(&c.InitialBackoff, "webhook-delivery-initial-backoff", c.InitialBackoff, "Delay before the first retry of a failed webhook delivery, doubled for every retry")
+	fs.DurationVar(&c.MaxBackoff, "webhook-delivery-max-backoff", c.MaxBackoff, "Maximum delay between two attempts to deliver an event to a webhook")
+	fs.DurationVar(&c.Timeout, "webhook-delivery-timeout", c.Timeout, "Timeout of each attempt to deliver an event to a webhook")
+	fs.IntVar(&c.BatchSize, "webhook-delivery-batch-size", c.BatchSize, "Maximum number of events dispatched and webhook deliveries attempted in each reconcile")
+	fs.BoolVar(&c.AllowInsecureUrls, "webhook-allow-insecure-urls", c.AllowInsecureUrls, "Allow webhook subscriptions with plain HTTP urls")
+	fs.BoolVar(&c.AllowPrivateNetworkUrls, "webhook-allow-private-network-urls", c.AllowPrivateNetworkUrls, "Allow webhook subscriptions with urls resolving to loopback, private, link-local or unspecified addresses")
+	fs.StringVar(&c.SecretEncryptionKeyFile, "webhook-secret-encryption-key-file", c.SecretEncryptionKeyFile, "File containing the base64 encoded 32 bytes AES key used to encrypt the stored webhook subscription secrets")
+}
+
+func (c *Config) ReadFiles() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	var secretEncryptionKey string
+	if err := shared.ReadFileValueString(c.SecretEncryptionKeyFile, &secretEncryptionKey); err != nil {
+		return err
+	}
+	var err error
+	if c.SecretEncryptionKey, err = base64.StdEncoding.DecodeString(secretEncryptionKey); err != nil {
+		return fmt.Errorf("webhook secret encryption key in %s must be base64 encoded: %v", c.SecretEncryptionKeyFile, err)
+	}
+	if len(c.SecretEncryptionKey) != secretEncryptionKeyLength {
+		return fmt.Errorf("webhook secret encryption key must be %d bytes long, got %d", secretEncryptionKeyLength, len(c.SecretEncryptionKey))
+	}
+	return nil
+}
+
+// Backoff returns the delay before the next attempt of a delivery that failed the given number of attempts
+func (c *Config) Backoff(attempts int) time.Duration {
+	backoff := c.InitialBackoff
+	for i := 1; i < attempts && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxBackoff {
+		backoff = c.MaxBackoff
+	}
+	return backoff
+}
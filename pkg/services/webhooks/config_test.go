@@ -0,0 +1,113 @@
+package webhooks
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig_Backoff(t *testing.T) {
+	config := &Config{
+		InitialBackoff: 30 * time.Second,
+		MaxBackoff:     5 * time.Minute,
+	}
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "should wait the initial backoff after the first attempt",
+			attempts: 1,
+			want:     30 * time.Second,
+		},
+		{
+			name:     "should double the backoff for every retry",
+			attempts: 3,
+			want:     2 * time.Minute,
+		},
+		{
+			name:     "should not wait longer than the max backoff",
+			attempts: 5,
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "should not overflow after many attempts",
+			attempts: 100,
+			want:     5 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(config.Backoff(tt.attempts)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestConfig_ReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name string, content []byte) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	encryptionKeyFile := writeFile("encryption.key", []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	shortKeyFile := writeFile("short.key", []byte(base64.StdEncoding.EncodeToString(make([]byte, 16))))
+	invalidFile := writeFile("invalid", []byte("invalid !"))
+
+	tests := []struct {
+		name     string
+		modifyFn func(config *Config)
+		wantErr  bool
+	}{
+		{
+			name: "should not read files when the webhooks are disabled",
+			modifyFn: func(config *Config) {
+				config.SecretEncryptionKeyFile = "missing"
+			},
+		},
+		{
+			name: "should read the secret encryption key",
+			modifyFn: func(config *Config) {
+				config.Enabled = true
+				config.SecretEncryptionKeyFile = encryptionKeyFile
+			},
+		},
+		{
+			name: "should return an error when the secret encryption key is not base64 encoded",
+			modifyFn: func(config *Config) {
+				config.Enabled = true
+				config.SecretEncryptionKeyFile = invalidFile
+			},
+			wantErr: true,
+		},
+		{
+			name: "should return an error when the secret encryption key is not 32 bytes long",
+			modifyFn: func(config *Config) {
+				config.Enabled = true
+				config.SecretEncryptionKeyFile = shortKeyFile
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewConfig()
+			tt.modifyFn(config)
+			err := config.ReadFiles()
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			if !tt.wantErr && config.Enabled {
+				g.Expect(config.SecretEncryptionKey).To(HaveLen(32))
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+const (
+	// HeaderSignature holds the hex encoded HMAC-SHA256 of the body of a delivery, keyed with the secret of the subscription
+	HeaderSignature = "Webhook-Signature"
+	// HeaderDeliveryId identifies a delivery, the id of the CloudEvent identifies the event across the retries of its delivery
+	HeaderDeliveryId = "Webhook-Delivery-Id"
+
+	cloudEventsContentType = "application/cloudevents+json"
+	cloudEventsSpecVersion = "1.0"
+	signaturePrefix        = "sha256="
+)
+
+// CloudEvent is the structured mode JSON encoding of an event, as defined by the CloudEvents 1.0 specification
+type CloudEvent struct {
+	SpecVersion     string    `json:"specversion"`
+	Id              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            api.JSON  `json:"data,omitempty"`
+}
+
+func NewCloudEvent(event *OutboxEvent) CloudEvent {
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		Id:              event.ID,
+		Source:          event.Source,
+		Type:            event.Type,
+		Subject:         event.Subject,
+		Time:            event.CreatedAt.UTC(),
+		DataContentType: "application/json",
+		Data:            event.Data,
+	}
+}
+
+// Sign returns the value of the signature header of a delivery with the given body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// post delivers an event to a webhook, returning the status code of the response when the webhook could be reached
+func post(ctx context.Context, client *http.Client, subscription *WebhookSubscription, delivery *WebhookDelivery, event *OutboxEvent) (int, error) {
+	body, err := json.Marshal(NewCloudEvent(event))
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal event %s: %v", event.ID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create the request: %v", err)
+	}
+	req.Header.Set("Content-Type", cloudEventsContentType)
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, body))
+	req.Header.Set(HeaderDeliveryId, delivery.ID)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// the body of the response is not kept in the delivery log, it may contain anything the webhook replied with
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
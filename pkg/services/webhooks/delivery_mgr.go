@@ -0,0 +1,68 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// WebhookDeliveryManager dispatches the outbox events to the webhook subscriptions and delivers them
+type WebhookDeliveryManager struct {
+	workers.BaseWorker
+	webhookService WebhookService
+	config         *Config
+}
+
+var _ workers.Worker = &WebhookDeliveryManager{}
+
+func NewWebhookDeliveryManager(webhookService WebhookService, config *Config, reconciler workers.Reconciler) *WebhookDeliveryManager {
+	return &WebhookDeliveryManager{
+		BaseWorker: workers.BaseWorker{
+			Id:         uuid.New().String(),
+			WorkerType: "webhook_deliveries",
+			Reconciler: reconciler,
+		},
+		webhookService: webhookService,
+		config:         config,
+	}
+}
+
+func (m *WebhookDeliveryManager) Start() {
+	m.StartWorker(m)
+}
+
+func (m *WebhookDeliveryManager) Stop() {
+	m.StopWorker(m)
+}
+
+func (m *WebhookDeliveryManager) Reconcile(ctx context.Context) []error {
+	if !m.config.Enabled {
+		glog.V(5).Infoln("webhooks are disabled, skip reconciling webhook deliveries")
+		return nil
+	}
+
+	var errs []error
+	dispatched, svcErr := m.webhookService.DispatchEvents()
+	if svcErr != nil {
+		errs = append(errs, svcErr)
+	} else if dispatched > 0 {
+		glog.Infof("dispatched %d outbox events to the webhook subscriptions", dispatched)
+	}
+
+	deliveries, svcErr := m.webhookService.ListDeliveriesDue()
+	if svcErr != nil {
+		return append(errs, errors.Wrap(svcErr, "failed to list the webhook deliveries due"))
+	}
+	glog.V(5).Infof("webhook deliveries due count = %d", len(deliveries))
+
+	for _, delivery := range deliveries {
+		if svcErr := m.webhookService.Deliver(ctx, delivery); svcErr != nil {
+			errs = append(errs, svcErr)
+		}
+	}
+
+	return errs
+}
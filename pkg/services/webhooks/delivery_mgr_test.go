@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	. "github.com/onsi/gomega"
+)
+
+func TestWebhookDeliveryManager_Reconcile(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       bool
+		service       *WebhookServiceMock
+		wantErrCount  int
+		wantDelivered int
+	}{
+		{
+			name:    "should not dispatch nor deliver the events when webhooks are disabled",
+			enabled: false,
+			service: &WebhookServiceMock{},
+		},
+		{
+			name:    "should dispatch the events and attempt the deliveries due",
+			enabled: true,
+			service: &WebhookServiceMock{
+				DispatchEventsFunc: func() (int, *errors.ServiceError) {
+					return 1, nil
+				},
+				ListDeliveriesDueFunc: func() (WebhookDeliveryList, *errors.ServiceError) {
+					return WebhookDeliveryList{{}, {}}, nil
+				},
+				DeliverFunc: func(ctx context.Context, delivery *WebhookDelivery) *errors.ServiceError {
+					return nil
+				},
+			},
+			wantDelivered: 2,
+		},
+		{
+			name:    "should still attempt the deliveries due when the dispatch fails",
+			enabled: true,
+			service: &WebhookServiceMock{
+				DispatchEventsFunc: func() (int, *errors.ServiceError) {
+					return 0, errors.GeneralError("test")
+				},
+				ListDeliveriesDueFunc: func() (WebhookDeliveryList, *errors.ServiceError) {
+					return WebhookDeliveryList{{}}, nil
+				},
+				DeliverFunc: func(ctx context.Context, delivery *WebhookDelivery) *errors.ServiceError {
+					return errors.GeneralError("test")
+				},
+			},
+			wantErrCount:  2,
+			wantDelivered: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config := NewConfig()
+			config.Enabled = tt.enabled
+			m := NewWebhookDeliveryManager(tt.service, config, workers.Reconciler{})
+			g.Expect(m.Reconcile(context.Background())).To(HaveLen(tt.wantErrCount))
+			g.Expect(tt.service.DeliverCalls()).To(HaveLen(tt.wantDelivered))
+		})
+	}
+}
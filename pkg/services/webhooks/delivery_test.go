@@ -0,0 +1,117 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	. "github.com/onsi/gomega"
+)
+
+func TestSign(t *testing.T) {
+	g := NewWithT(t)
+	// echo -n '{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	g.Expect(Sign("secret", []byte(`{"id":"1"}`))).To(Equal("sha256=6146142a2ce0159e84c0767881e4ec80bc397da62526e7d19f70795eb79460c0"))
+	g.Expect(Sign("secret", []byte("a"))).ToNot(Equal(Sign("another secret", []byte("a"))))
+}
+
+func Test_post(t *testing.T) {
+	event := &OutboxEvent{
+		Meta: api.Meta{
+			ID:        "event-id",
+			CreatedAt: time.Date(2022, 6, 15, 9, 0, 0, 0, time.UTC),
+		},
+		OrganisationId: "org-1",
+		Type:           EventTypeKafkaStatusChanged,
+		Source:         "/api/kafkas_mgmt/v1/kafkas/kafka-id",
+		Subject:        "kafka-id",
+		Data:           api.JSON(`{"id":"kafka-id","status":"ready"}`),
+	}
+	delivery := &WebhookDelivery{
+		Meta: api.Meta{
+			ID: "delivery-id",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		responseCode int
+		wantErr      bool
+	}{
+		{
+			name:         "should post the signed cloud event to the webhook",
+			responseCode: http.StatusNoContent,
+			wantErr:      false,
+		},
+		{
+			name:         "should return an error with the status code when the webhook does not respond with a 2xx status",
+			responseCode: http.StatusServiceUnavailable,
+			wantErr:      true,
+		},
+		{
+			name:         "should return an error when the webhook redirects",
+			responseCode: http.StatusFound,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := ioutil.ReadAll(r.Body)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(r.Method).To(Equal(http.MethodPost))
+				g.Expect(r.Header.Get("Content-Type")).To(Equal(cloudEventsContentType))
+				g.Expect(r.Header.Get(HeaderDeliveryId)).To(Equal(delivery.ID))
+				g.Expect(r.Header.Get(HeaderSignature)).To(Equal(Sign("secret", body)))
+
+				var cloudEvent CloudEvent
+				g.Expect(json.Unmarshal(body, &cloudEvent)).To(Succeed())
+				g.Expect(cloudEvent).To(Equal(NewCloudEvent(event)))
+
+				if tt.responseCode == http.StatusFound {
+					w.Header().Set("Location", "https://example.com")
+				}
+				w.WriteHeader(tt.responseCode)
+			}))
+			defer server.Close()
+
+			subscription := &WebhookSubscription{
+				Url:    server.URL,
+				Secret: "secret",
+			}
+			config := NewConfig()
+			config.AllowPrivateNetworkUrls = true
+			service := NewWebhookService(nil, config).(*webhookService)
+			responseCode, err := post(context.Background(), service.client, subscription, delivery, event)
+			g.Expect(err != nil).To(Equal(tt.wantErr))
+			g.Expect(responseCode).To(Equal(tt.responseCode))
+		})
+	}
+}
+
+func Test_post_privateNetwork(t *testing.T) {
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the webhook on the loopback address should not have been reached")
+	}))
+	defer server.Close()
+
+	subscription := &WebhookSubscription{
+		Url:    server.URL,
+		Secret: "secret",
+	}
+	event := &OutboxEvent{Meta: api.Meta{ID: "event-id"}}
+	delivery := &WebhookDelivery{Meta: api.Meta{ID: "delivery-id"}}
+	service := NewWebhookService(nil, NewConfig()).(*webhookService)
+	responseCode, err := post(context.Background(), service.client, subscription, delivery, event)
+	g.Expect(err).To(MatchError(ContainSubstring("is not a public address")))
+	g.Expect(responseCode).To(Equal(0))
+}
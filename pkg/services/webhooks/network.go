@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// nonPublicNetworks are the IPv4 networks that are not public but not reported by the net.IP predicates: "this"
+// network, the carrier-grade NAT shared address space, the IETF protocol assignments, the benchmarking networks and
+// the reserved networks, including the limited broadcast address
+var nonPublicNetworks = parseCIDRs("0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4")
+
+// nat64Network is the well-known prefix of the IPv6 addresses translated to the IPv4 address of their last 32 bits
+var nat64Network = parseCIDRs("64:ff9b::/96")[0]
+
+// IsPublicIP returns whether the address can be reached by the webhooks. Loopback, private, link-local, multicast,
+// unspecified and reserved addresses are rejected, so that subscriptions cannot make the service post to its own
+// network, e.g. to the cloud metadata endpoint. The IPv4 addresses embedded in IPv4-mapped and NAT64 addresses are
+// checked as such.
+func IsPublicIP(ip net.IP) bool {
+	if nat64Network.Contains(ip) {
+		ip = ip[len(ip)-net.IPv4len:]
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+// ValidateHost resolves the host of a webhook url and returns an error when any of its addresses is not public
+func ValidateHost(ctx context.Context, host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("host %s is not a public address", host)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("unable to resolve host %s: %v", host, err)
+	}
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("host %s resolves to %s which is not a public address", host, addr.IP)
+		}
+	}
+	return nil
+}
+
+// publicTransport returns a transport that refuses to connect to addresses that are not public. The check is
+// made on the address being dialled, after resolution, so that the host of a subscription cannot be re-pointed
+// to a private address once it has been validated. The environment proxy is not used, as the dialled address
+// would be the one of the proxy.
+func publicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("refusing to connect to %s which is not a public address", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
@@ -0,0 +1,61 @@
+package webhooks
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "203.0.113.10", want: true},
+		{ip: "2001:db8::1", want: true},
+		{ip: "127.0.0.1", want: false},
+		{ip: "::1", want: false},
+		{ip: "10.1.2.3", want: false},
+		{ip: "172.16.0.1", want: false},
+		{ip: "192.168.1.1", want: false},
+		{ip: "fd00::1", want: false},
+		{ip: "169.254.169.254", want: false},
+		{ip: "fe80::1", want: false},
+		{ip: "224.0.0.1", want: false},
+		{ip: "0.0.0.0", want: false},
+		{ip: "::", want: false},
+		{ip: "0.1.2.3", want: false},
+		{ip: "100.64.0.1", want: false},
+		{ip: "100.127.255.254", want: false},
+		{ip: "100.128.0.1", want: true},
+		{ip: "192.0.0.8", want: false},
+		{ip: "198.18.0.1", want: false},
+		{ip: "198.19.255.254", want: false},
+		{ip: "240.0.0.1", want: false},
+		{ip: "255.255.255.255", want: false},
+		{ip: "::ffff:10.1.2.3", want: false},
+		{ip: "::ffff:169.254.169.254", want: false},
+		{ip: "::ffff:100.64.0.1", want: false},
+		{ip: "::ffff:203.0.113.10", want: true},
+		{ip: "64:ff9b::a01:203", want: false},
+		{ip: "64:ff9b::a9fe:a9fe", want: false},
+		{ip: "64:ff9b::7f00:1", want: false},
+		{ip: "64:ff9b::cb00:710a", want: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.ip, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IsPublicIP(net.ParseIP(tt.ip))).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestValidateHost(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(ValidateHost(context.Background(), "203.0.113.10")).To(Succeed())
+	g.Expect(ValidateHost(context.Background(), "169.254.169.254")).To(MatchError("host 169.254.169.254 is not a public address"))
+	g.Expect(ValidateHost(context.Background(), "localhost")).ToNot(Succeed())
+}
@@ -0,0 +1,38 @@
+package webhooks
+
+import (
+	"encoding/json"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// RecordEvent writes an event to the outbox with the given tx, which must be the transaction of the change the
+// event describes: the event is only dispatched to the webhooks if the change is committed. The events of the
+// resources without an organisation are not recorded, as webhooks are subscribed per organisation.
+func RecordEvent(tx *gorm.DB, organisationId string, eventType string, source string, subject string, data interface{}) error {
+	if organisationId == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the data of %s event of %s", eventType, source)
+	}
+
+	event := &OutboxEvent{
+		Meta: api.Meta{
+			ID: api.NewID(),
+		},
+		OrganisationId: organisationId,
+		Type:           eventType,
+		Source:         source,
+		Subject:        subject,
+		Data:           payload,
+	}
+	if err := tx.Create(event).Error; err != nil {
+		return errors.Wrapf(err, "failed to record %s event of %s", eventType, source)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package webhooks
+
+import (
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/workers"
+	"github.com/goava/di"
+)
+
+func ConfigProviders() di.Option {
+	return di.Options(
+		di.Provide(NewConfig, di.As(new(environments.ConfigModule))),
+		di.Provide(environments.Func(ServiceProviders)),
+	)
+}
+
+func ServiceProviders() di.Option {
+	return di.Options(
+		di.Provide(NewWebhookService),
+		di.Provide(NewWebhookDeliveryManager, di.As(new(workers.Worker))),
+	)
+}
@@ -0,0 +1,119 @@
+package webhooks
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+)
+
+const (
+	// EventTypeKafkaStatusChanged is published every time the status of a kafka changes
+	EventTypeKafkaStatusChanged = "kafka.status_changed"
+	// EventTypeConnectorStatusChanged is published every time the phase of a connector changes
+	EventTypeConnectorStatusChanged = "connector.status_changed"
+	// EventTypeConnectorNamespacePhaseChanged is published every time the phase of a connector namespace changes
+	EventTypeConnectorNamespacePhaseChanged = "connector_namespace.phase_changed"
+)
+
+// EventTypes are the types of the events webhooks can subscribe to
+var EventTypes = []string{
+	EventTypeKafkaStatusChanged,
+	EventTypeConnectorStatusChanged,
+	EventTypeConnectorNamespacePhaseChanged,
+}
+
+type DeliveryStatus string
+
+const (
+	// DeliveryStatusPending deliveries are attempted once their NextAttemptAt is reached
+	DeliveryStatusPending DeliveryStatus = "pending"
+	// DeliveryStatusDelivered deliveries have been acknowledged by the webhook with a 2xx status
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	// DeliveryStatusDeadLetter deliveries failed every attempt and are not retried anymore
+	DeliveryStatusDeadLetter DeliveryStatus = "dead_letter"
+)
+
+func (s DeliveryStatus) String() string {
+	return string(s)
+}
+
+// OutboxEvent is a resource lifecycle event, written in the transaction of the change it describes and
+// dispatched to the webhook subscriptions of the organisation of the resource once committed
+type OutboxEvent struct {
+	api.Meta
+	OrganisationId string `gorm:"index"`
+	// Type is the CloudEvents type of the event, e.g. kafka.status_changed
+	Type string
+	// Source is the path of the resource, Subject its id
+	Source  string
+	Subject string
+	Data    api.JSON `gorm:"type:jsonb"`
+	// DispatchedAt is set once a delivery has been created for each subscription matching the event
+	DispatchedAt *time.Time `gorm:"index"`
+}
+
+type OutboxEventList []*OutboxEvent
+
+// WebhookSubscription is a webhook receiving the lifecycle events of the resources of an organisation
+type WebhookSubscription struct {
+	api.Meta
+	OrganisationId string `gorm:"index"`
+	Owner          string
+	Url            string
+	// Secret is the key of the HMAC signature of the deliveries. It is only stored encrypted, in EncryptedSecret.
+	Secret          string `gorm:"-"`
+	EncryptedSecret string
+	// EventTypes is the comma separated list of the types of the events the webhook receives, all events when empty
+	EventTypes string
+}
+
+type WebhookSubscriptionList []*WebhookSubscription
+
+// GetEventTypes returns the types of the events the webhook receives, or nil when it receives all events
+func (s *WebhookSubscription) GetEventTypes() []string {
+	if s.EventTypes == "" {
+		return nil
+	}
+	return strings.Split(s.EventTypes, ",")
+}
+
+// SetEventTypes restricts the events the webhook receives to the given types, or to all events when none is given
+func (s *WebhookSubscription) SetEventTypes(eventTypes []string) {
+	s.EventTypes = strings.Join(eventTypes, ",")
+}
+
+// Matches returns true if the webhook receives the given event
+func (s *WebhookSubscription) Matches(event *OutboxEvent) bool {
+	if s.OrganisationId != event.OrganisationId {
+		return false
+	}
+	eventTypes := s.GetEventTypes()
+	if len(eventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range eventTypes {
+		if eventType == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery tracks the delivery of an event to a webhook
+type WebhookDelivery struct {
+	api.Meta
+	SubscriptionId string `gorm:"index"`
+	EventId        string `gorm:"index"`
+	EventType      string
+	Status         string `gorm:"index"`
+	Attempts       int
+	NextAttemptAt  time.Time `gorm:"index"`
+	LastAttemptAt  *time.Time
+	// ResponseCode and Error describe the outcome of the last attempt
+	ResponseCode int
+	Error        string
+	DeliveredAt  *time.Time
+}
+
+type WebhookDeliveryList []*WebhookDelivery
@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	type fields struct {
+		organisationId string
+		eventTypes     []string
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		event  *OutboxEvent
+		want   bool
+	}{
+		{
+			name: "should match any event of its organisation when it has no event types",
+			fields: fields{
+				organisationId: "org-1",
+			},
+			event: &OutboxEvent{OrganisationId: "org-1", Type: EventTypeConnectorStatusChanged},
+			want:  true,
+		},
+		{
+			name: "should match the events of its event types",
+			fields: fields{
+				organisationId: "org-1",
+				eventTypes:     []string{EventTypeConnectorStatusChanged, EventTypeKafkaStatusChanged},
+			},
+			event: &OutboxEvent{OrganisationId: "org-1", Type: EventTypeKafkaStatusChanged},
+			want:  true,
+		},
+		{
+			name: "should not match the events of other event types",
+			fields: fields{
+				organisationId: "org-1",
+				eventTypes:     []string{EventTypeKafkaStatusChanged},
+			},
+			event: &OutboxEvent{OrganisationId: "org-1", Type: EventTypeConnectorNamespacePhaseChanged},
+			want:  false,
+		},
+		{
+			name: "should not match the events of another organisation",
+			fields: fields{
+				organisationId: "org-1",
+			},
+			event: &OutboxEvent{OrganisationId: "org-2", Type: EventTypeKafkaStatusChanged},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			subscription := &WebhookSubscription{OrganisationId: tt.fields.organisationId}
+			subscription.SetEventTypes(tt.fields.eventTypes)
+			g.Expect(subscription.GetEventTypes()).To(Equal(tt.fields.eventTypes))
+			g.Expect(subscription.Matches(tt.event)).To(Equal(tt.want))
+		})
+	}
+}
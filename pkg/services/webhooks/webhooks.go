@@ -0,0 +1,300 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/errors"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/shared/secrets"
+	"github.com/golang/glog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// secretLength is the number of random bytes of the generated subscription secrets
+const secretLength = 32
+
+//go:generate moq -out webhooks_moq.go . WebhookService
+type WebhookService interface {
+	// CreateSubscription registers the webhook of the subscription for its organisation and generates its secret
+	CreateSubscription(subscription *WebhookSubscription) *errors.ServiceError
+	// GetSubscription retrieves a webhook subscription of the given organisation
+	GetSubscription(organisationId string, id string) (*WebhookSubscription, *errors.ServiceError)
+	// ListSubscriptions returns the webhook subscriptions of the given organisation, most recent first
+	ListSubscriptions(organisationId string, listArgs *services.ListArguments) (WebhookSubscriptionList, *api.PagingMeta, *errors.ServiceError)
+	// DeleteSubscription unregisters a webhook of the given organisation. Its pending deliveries are not attempted anymore.
+	DeleteSubscription(organisationId string, id string) *errors.ServiceError
+	// ListDeliveries returns the delivery log of a webhook subscription, most recent first
+	ListDeliveries(subscriptionId string, listArgs *services.ListArguments) (WebhookDeliveryList, *api.PagingMeta, *errors.ServiceError)
+	// DispatchEvents creates a delivery for each webhook subscribed to the outbox events not dispatched yet,
+	// and returns the number of events dispatched
+	DispatchEvents() (int, *errors.ServiceError)
+	// ListDeliveriesDue returns the pending deliveries of the active subscriptions whose next attempt is due
+	ListDeliveriesDue() (WebhookDeliveryList, *errors.ServiceError)
+	// Deliver attempts to post the event of the delivery to its webhook. A failed delivery is retried with an
+	// exponential backoff until it runs out of attempts and is moved to the dead letter state.
+	Deliver(ctx context.Context, delivery *WebhookDelivery) *errors.ServiceError
+}
+
+var _ WebhookService = &webhookService{}
+
+type webhookService struct {
+	connectionFactory *db.ConnectionFactory
+	config            *Config
+	client            *http.Client
+}
+
+func NewWebhookService(connectionFactory *db.ConnectionFactory, config *Config) WebhookService {
+	var transport http.RoundTripper = http.DefaultTransport
+	if !config.AllowPrivateNetworkUrls {
+		transport = publicTransport()
+	}
+	return &webhookService{
+		connectionFactory: connectionFactory,
+		config:            config,
+		client: &http.Client{
+			Transport: tracing.Transport(transport),
+			// a redirect would send the signed event to another url than the one registered
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *webhookService) CreateSubscription(subscription *WebhookSubscription) *errors.ServiceError {
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to generate the secret of the webhook subscription")
+	}
+
+	subscription.ID = api.NewID()
+	subscription.Secret = hex.EncodeToString(secret)
+	encryptedSecret, err := secrets.Encrypt(s.config.SecretEncryptionKey, subscription.Secret)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to encrypt the secret of the webhook subscription")
+	}
+	subscription.EncryptedSecret = encryptedSecret
+	if err := s.connectionFactory.New().Create(subscription).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to create the webhook subscription")
+	}
+
+	glog.Infof("webhook subscription %s to %s has been created for organisation %s", subscription.ID, subscription.Url, subscription.OrganisationId)
+	return nil
+}
+
+func (s *webhookService) GetSubscription(organisationId string, id string) (*WebhookSubscription, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is undefined")
+	}
+
+	var subscription WebhookSubscription
+	if err := s.connectionFactory.New().
+		Where("id = ? AND organisation_id = ?", id, organisationId).
+		First(&subscription).Error; err != nil {
+		return nil, services.HandleGetError("WebhookSubscription", "id", id, err)
+	}
+	return &subscription, nil
+}
+
+func (s *webhookService) ListSubscriptions(organisationId string, listArgs *services.ListArguments) (WebhookSubscriptionList, *api.PagingMeta, *errors.ServiceError) {
+	var subscriptions WebhookSubscriptionList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := s.connectionFactory.New().Where("organisation_id = ?", organisationId)
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&subscriptions).Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the webhook subscriptions")
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	if err := dbConn.Order("created_at desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&subscriptions).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the webhook subscriptions")
+	}
+
+	return subscriptions, pagingMeta, nil
+}
+
+func (s *webhookService) DeleteSubscription(organisationId string, id string) *errors.ServiceError {
+	subscription, svcErr := s.GetSubscription(organisationId, id)
+	if svcErr != nil {
+		return svcErr
+	}
+
+	if err := s.connectionFactory.New().Delete(subscription).Error; err != nil {
+		return services.HandleDeleteError("WebhookSubscription", "id", id, err)
+	}
+
+	glog.Infof("webhook subscription %s of organisation %s has been deleted", id, organisationId)
+	return nil
+}
+
+func (s *webhookService) ListDeliveries(subscriptionId string, listArgs *services.ListArguments) (WebhookDeliveryList, *api.PagingMeta, *errors.ServiceError) {
+	var deliveries WebhookDeliveryList
+	pagingMeta := &api.PagingMeta{
+		Page: listArgs.Page,
+		Size: listArgs.Size,
+	}
+
+	dbConn := s.connectionFactory.New().Where("subscription_id = ?", subscriptionId)
+
+	total := int64(pagingMeta.Total)
+	if err := dbConn.Model(&deliveries).Count(&total).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to count the deliveries of webhook subscription %s", subscriptionId)
+	}
+	pagingMeta.Total = int(total)
+	if pagingMeta.Size > pagingMeta.Total {
+		pagingMeta.Size = pagingMeta.Total
+	}
+
+	// ids are sortable by creation time, ordering by id as well keeps the order of the deliveries created at the same time stable
+	if err := dbConn.Order("created_at desc, id desc").
+		Offset((pagingMeta.Page - 1) * pagingMeta.Size).
+		Limit(pagingMeta.Size).
+		Find(&deliveries).Error; err != nil {
+		return nil, nil, errors.NewWithCause(errors.ErrorGeneral, err, "unable to list the deliveries of webhook subscription %s", subscriptionId)
+	}
+
+	return deliveries, pagingMeta, nil
+}
+
+func (s *webhookService) DispatchEvents() (int, *errors.ServiceError) {
+	var dispatched int
+	if err := s.connectionFactory.New().Transaction(func(tx *gorm.DB) error {
+		var events OutboxEventList
+		// the events are locked so that they are only dispatched once, even if dispatches were to overlap
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("dispatched_at IS NULL").
+			Order("created_at, id").
+			Limit(s.config.BatchSize).
+			Find(&events).Error; err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		organisationIds := make([]string, 0, len(events))
+		for _, event := range events {
+			organisationIds = append(organisationIds, event.OrganisationId)
+		}
+		var subscriptions WebhookSubscriptionList
+		if err := tx.Where("organisation_id IN (?)", organisationIds).Find(&subscriptions).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var deliveries WebhookDeliveryList
+		eventIds := make([]string, 0, len(events))
+		for _, event := range events {
+			eventIds = append(eventIds, event.ID)
+			for _, subscription := range subscriptions {
+				if subscription.Matches(event) {
+					deliveries = append(deliveries, &WebhookDelivery{
+						Meta: api.Meta{
+							ID: api.NewID(),
+						},
+						SubscriptionId: subscription.ID,
+						EventId:        event.ID,
+						EventType:      event.Type,
+						Status:         DeliveryStatusPending.String(),
+						NextAttemptAt:  now,
+					})
+				}
+			}
+		}
+
+		if len(deliveries) > 0 {
+			if err := tx.Create(&deliveries).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&OutboxEvent{}).Where("id IN (?)", eventIds).Update("dispatched_at", now).Error; err != nil {
+			return err
+		}
+		dispatched = len(events)
+		return nil
+	}); err != nil {
+		return 0, errors.NewWithCause(errors.ErrorGeneral, err, "failed to dispatch the outbox events")
+	}
+	return dispatched, nil
+}
+
+func (s *webhookService) ListDeliveriesDue() (WebhookDeliveryList, *errors.ServiceError) {
+	var deliveries WebhookDeliveryList
+	if err := s.connectionFactory.New().
+		Joins("JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id AND webhook_subscriptions.deleted_at IS NULL").
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", DeliveryStatusPending, time.Now()).
+		Order("webhook_deliveries.next_attempt_at").
+		Limit(s.config.BatchSize).
+		Find(&deliveries).Error; err != nil {
+		return nil, errors.NewWithCause(errors.ErrorGeneral, err, "failed to list the webhook deliveries due")
+	}
+	return deliveries, nil
+}
+
+func (s *webhookService) Deliver(ctx context.Context, delivery *WebhookDelivery) *errors.ServiceError {
+	dbConn := s.connectionFactory.New()
+
+	var subscription WebhookSubscription
+	if err := dbConn.Where("id = ?", delivery.SubscriptionId).First(&subscription).Error; err != nil {
+		return services.HandleGetError("WebhookSubscription", "id", delivery.SubscriptionId, err)
+	}
+	var event OutboxEvent
+	if err := dbConn.Where("id = ?", delivery.EventId).First(&event).Error; err != nil {
+		return services.HandleGetError("OutboxEvent", "id", delivery.EventId, err)
+	}
+	secret, err := secrets.Decrypt(s.config.SecretEncryptionKey, subscription.EncryptedSecret)
+	if err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to decrypt the secret of webhook subscription %s", subscription.ID)
+	}
+	subscription.Secret = secret
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	responseCode, err := post(ctx, s.client, &subscription, delivery, &event)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseCode = responseCode
+	delivery.Error = ""
+	switch {
+	case err == nil:
+		delivery.Status = DeliveryStatusDelivered.String()
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= s.config.MaxAttempts:
+		delivery.Status = DeliveryStatusDeadLetter.String()
+		delivery.Error = err.Error()
+		glog.Warningf("delivery %s of event %s to webhook subscription %s has been moved to the dead letter state after %d attempts: %v",
+			delivery.ID, delivery.EventId, delivery.SubscriptionId, delivery.Attempts, err)
+	default:
+		delivery.NextAttemptAt = now.Add(s.config.Backoff(delivery.Attempts))
+		delivery.Error = err.Error()
+	}
+
+	// Select updates the zero values as well, e.g. clearing the error of the previous attempt
+	if err := dbConn.Model(delivery).
+		Select("status", "attempts", "next_attempt_at", "last_attempt_at", "response_code", "error", "delivered_at").
+		Updates(delivery).Error; err != nil {
+		return errors.NewWithCause(errors.ErrorGeneral, err, "failed to update webhook delivery %s", delivery.ID)
+	}
+	return nil
+}
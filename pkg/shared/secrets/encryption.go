@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypt encrypts a value with AES-GCM and the given 16, 24 or 32 bytes key for it to be stored.
+// The random nonce is prepended to the sealed value and the result is base64 encoded.
+func Encrypt(encryptionKey []byte, value string) (string, error) {
+	gcm, err := newCipher(encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+// Decrypt returns the value encrypted by Encrypt with the same key
+func Decrypt(encryptionKey []byte, encryptedValue string) (string, error) {
+	gcm, err := newCipher(encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(encryptedValue)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("encrypted value is too short")
+	}
+	value, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func newCipher(encryptionKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,31 @@
+package secrets
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEncrypt(t *testing.T) {
+	g := NewWithT(t)
+	encryptionKey := []byte("0123456789abcdef0123456789abcdef")
+
+	encrypted, err := Encrypt(encryptionKey, "private-key")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(encrypted).ToNot(ContainSubstring("private-key"))
+
+	other, err := Encrypt(encryptionKey, "private-key")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(other).ToNot(Equal(encrypted))
+
+	decrypted, err := Decrypt(encryptionKey, encrypted)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(decrypted).To(Equal("private-key"))
+
+	_, err = Decrypt([]byte("fedcba9876543210fedcba9876543210"), encrypted)
+	g.Expect(err).To(HaveOccurred())
+	_, err = Decrypt(encryptionKey, "c2hvcnQ=")
+	g.Expect(err).To(MatchError("encrypted value is too short"))
+	_, err = Encrypt(nil, "private-key")
+	g.Expect(err).To(HaveOccurred())
+}
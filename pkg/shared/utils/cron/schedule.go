@@ -0,0 +1,142 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed standard 5 field cron expression: minute, hour, day of month, month and day of week
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// true if day of month or day of week was restricted, see https://man7.org/linux/man-pages/man5/crontab.5.html
+	domRestricted, dowRestricted bool
+}
+
+type fieldBounds struct {
+	name     string
+	min, max int
+}
+
+var fields = []fieldBounds{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// maxSearchYears bounds the search for the next activation of schedules that can never match, e.g. `0 0 30 2 *`
+const maxSearchYears = 5
+
+// Parse parses a standard 5 field cron expression, fields support `*`, lists, ranges and steps, e.g. `*/15 8-18 * * 1,3,5`
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expected %d fields, found %d", expr, len(fields), len(parts))
+	}
+
+	values := make([]uint64, len(fields))
+	for i, part := range parts {
+		bits, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expr, err)
+		}
+		values[i] = bits
+	}
+
+	// sunday can be either 0 or 7
+	if values[4]&(1<<7) != 0 {
+		values[4] |= 1
+	}
+
+	return &Schedule{
+		minute:        values[0],
+		hour:          values[1],
+		dom:           values[2],
+		month:         values[3],
+		dow:           values[4],
+		domRestricted: parts[2] != "*",
+		dowRestricted: parts[4] != "*",
+	}, nil
+}
+
+func parseField(field string, bounds fieldBounds) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rangeExpr, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			var err error
+			rangeExpr = item[:i]
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q in %s field", item[i+1:], bounds.name)
+			}
+		}
+
+		start, end := bounds.min, bounds.max
+		if rangeExpr != "*" {
+			var err error
+			startEnd := strings.SplitN(rangeExpr, "-", 2)
+			if start, err = strconv.Atoi(startEnd[0]); err != nil {
+				return 0, fmt.Errorf("invalid value %q in %s field", startEnd[0], bounds.name)
+			}
+			end = start
+			if len(startEnd) == 2 {
+				if end, err = strconv.Atoi(startEnd[1]); err != nil {
+					return 0, fmt.Errorf("invalid value %q in %s field", startEnd[1], bounds.name)
+				}
+			} else if step > 1 {
+				// a step without a range applies until the end of the field, e.g. 5/15
+				end = bounds.max
+			}
+		}
+
+		if start < bounds.min || end > bounds.max || start > end {
+			return 0, fmt.Errorf("value %q out of range [%d-%d] in %s field", rangeExpr, bounds.min, bounds.max, bounds.name)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first activation time of the schedule after the given time,
+// or a zero time if the schedule doesn't match any time in the next few years
+func (s *Schedule) Next(t time.Time) time.Time {
+	// start from the next whole minute
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	// if both day of month and day of week are restricted, either of them matching is enough
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
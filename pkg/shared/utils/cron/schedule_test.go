@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "every minute", expr: "* * * * *"},
+		{name: "lists, ranges and steps", expr: "*/15 8-18 1,15 * 1-5"},
+		{name: "sunday as 7", expr: "0 0 * * 7"},
+		{name: "too few fields", expr: "* * * *", wantErr: true},
+		{name: "too many fields", expr: "* * * * * *", wantErr: true},
+		{name: "minute out of range", expr: "60 * * * *", wantErr: true},
+		{name: "inverted range", expr: "* 10-8 * * *", wantErr: true},
+		{name: "invalid step", expr: "*/0 * * * *", wantErr: true},
+		{name: "invalid value", expr: "a * * * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			_, err := Parse(tt.expr)
+			Expect(err != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
+
+func Test_Schedule_Next(t *testing.T) {
+	// a Wednesday
+	from := time.Date(2022, time.June, 15, 10, 30, 45, 0, time.UTC)
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{name: "every minute", expr: "* * * * *", want: time.Date(2022, time.June, 15, 10, 31, 0, 0, time.UTC)},
+		{name: "every 15 minutes", expr: "*/15 * * * *", want: time.Date(2022, time.June, 15, 10, 45, 0, 0, time.UTC)},
+		{name: "nightly", expr: "0 20 * * *", want: time.Date(2022, time.June, 15, 20, 0, 0, 0, time.UTC)},
+		{name: "next morning", expr: "0 8 * * *", want: time.Date(2022, time.June, 16, 8, 0, 0, 0, time.UTC)},
+		{name: "weekend", expr: "0 0 * * 6", want: time.Date(2022, time.June, 18, 0, 0, 0, 0, time.UTC)},
+		{name: "monday as 1", expr: "0 7 * * 1", want: time.Date(2022, time.June, 20, 7, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "0 7 * * 7", want: time.Date(2022, time.June, 19, 7, 0, 0, 0, time.UTC)},
+		{name: "next month", expr: "0 0 1 * *", want: time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or week", expr: "0 0 1 * 5", want: time.Date(2022, time.June, 17, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 0 29 2 *", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "never", expr: "0 0 30 2 *", want: time.Time{}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			schedule, err := Parse(tt.expr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(from)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/environments"
+)
+
+// NewLeaderElectionHealthCheck returns the liveness check of the leader election of the instance: it fails when the
+// leader election loop stopped running, since the instance then keeps the leases of its workers until they expire,
+// and neither it nor another instance runs them
+func NewLeaderElectionHealthCheck(leaderElectionManager *LeaderElectionManager) *environments.FuncHealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        "leader_election",
+		Criticality: environments.HealthCheckCritical,
+		Liveness:    true,
+	}, func(ctx context.Context) error {
+		return leaderElectionManager.checkElectionRound(time.Now())
+	})
+}
+
+// NewLeaderLeaseHealthCheck returns the health check of the freshness of the leader leases of the workers: a lease
+// which expired without being acquired by any instance means that no instance reconciles its worker type
+func NewLeaderLeaseHealthCheck(leaderElectionManager *LeaderElectionManager) *environments.FuncHealthCheck {
+	return environments.NewFuncHealthCheck(environments.HealthCheckOptions{
+		Name:        "leader_leases",
+		Criticality: environments.HealthCheckNonCritical,
+	}, leaderElectionManager.checkLeaderLeases)
+}
+
+func (s *LeaderElectionManager) checkElectionRound(now time.Time) error {
+	lastElectionRound := atomic.LoadInt64(&s.lastElectionRound)
+	// the leader election has not started yet
+	if lastElectionRound == 0 {
+		return nil
+	}
+	elapsed := now.Sub(time.Unix(0, lastElectionRound))
+	if elapsed > s.leaderLeaseExpirationTime+s.leaderElectionReconcilerRepeatInterval {
+		return fmt.Errorf("the last leader election round ran %s ago", elapsed.Round(time.Second))
+	}
+	return nil
+}
+
+func (s *LeaderElectionManager) checkLeaderLeases(ctx context.Context) error {
+	workerTypes := map[string]struct{}{}
+	var leaseTypes []string
+	for _, worker := range s.workers {
+		if _, ok := workerTypes[worker.GetWorkerType()]; !ok {
+			workerTypes[worker.GetWorkerType()] = struct{}{}
+			leaseTypes = append(leaseTypes, worker.GetWorkerType())
+		}
+	}
+	if len(leaseTypes) == 0 {
+		return nil
+	}
+
+	// an expired lease is acquired in the next leader election round, so it is only stale after the repeat interval
+	var staleLeaseTypes []string
+	if err := s.connectionFactory.New().WithContext(ctx).
+		Raw("SELECT lease_type FROM leader_leases WHERE deleted_at IS NULL AND lease_type IN ? AND (leader = '' OR expires < ?)",
+			leaseTypes, time.Now().Add(-s.leaderElectionReconcilerRepeatInterval)).
+		Scan(&staleLeaseTypes).Error; err != nil {
+		return fmt.Errorf("failed to retrieve leader leases: %v", err)
+	}
+	if len(staleLeaseTypes) > 0 {
+		return fmt.Errorf("the leader leases of %v are expired", staleLeaseTypes)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package workers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestLeaderElectionManager_checkElectionRound(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name              string
+		lastElectionRound int64
+		wantErr           bool
+	}{
+		{
+			name:              "should succeed when the leader election has not started",
+			lastElectionRound: 0,
+			wantErr:           false,
+		},
+		{
+			name:              "should succeed when the last election round is recent",
+			lastElectionRound: now.Add(-20 * time.Second).UnixNano(),
+			wantErr:           false,
+		},
+		{
+			name:              "should fail when the last election round is older than a lease",
+			lastElectionRound: now.Add(-2 * time.Minute).UnixNano(),
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &LeaderElectionManager{
+				leaderElectionReconcilerRepeatInterval: 15 * time.Second,
+				leaderLeaseExpirationTime:              time.Minute,
+				lastElectionRound:                      tt.lastElectionRound,
+			}
+			g.Expect(s.checkElectionRound(now) != nil).To(Equal(tt.wantErr))
+		})
+	}
+}
@@ -1,17 +1,20 @@
 package workers
 
 import (
-	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/api"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/db"
-	"github.com/golang/glog"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+// leaderElectionComponent is the logging component of the leader election
+const leaderElectionComponent = "leader_election"
+
 type LeaderElectionManager struct {
 	workers                                []Worker
 	connectionFactory                      *db.ConnectionFactory
@@ -19,6 +22,8 @@ type LeaderElectionManager struct {
 	leaderElectionReconcilerRepeatInterval time.Duration
 	leaderLeaseExpirationTime              time.Duration
 	workerGrp                              sync.WaitGroup
+	// lastElectionRound is the unix time in nanoseconds when the workers were last started or stopped
+	lastElectionRound int64
 }
 
 // leaderLeaseAcquisition a wrapper for a lease and whether it's been acquired/is owned by another worker
@@ -39,7 +44,7 @@ func NewLeaderElectionManager(workers []Worker, connectionFactory *db.Connection
 }
 
 func (s *LeaderElectionManager) Start() {
-	glog.V(1).Infoln("Starting LeaderElectionManager")
+	logger.Logger.WithComponent(leaderElectionComponent).V(1).Infof("Starting LeaderElectionManager")
 
 	s.tearDown = make(chan struct{})
 	waitWorkersStart := make(chan struct{})
@@ -87,14 +92,15 @@ func (s *LeaderElectionManager) Stop() {
 }
 
 func (s *LeaderElectionManager) startWorkers() {
+	defer atomic.StoreInt64(&s.lastElectionRound, time.Now().UnixNano())
 	for _, worker := range s.workers {
 		isLeader := s.isWorkerLeader(worker)
 		if isLeader && !worker.IsRunning() {
-			glog.V(1).Infoln(fmt.Sprintf("Running as the leader and starting worker %T [%s]", worker, worker.GetID()))
+			NewWorkerLogger(worker).V(1).Infof("Running as the leader and starting worker %T [%s]", worker, worker.GetID())
 			worker.Start()
 			s.workerGrp.Add(1) //a new worker is added to the group
 		} else if !isLeader && worker.IsRunning() {
-			glog.V(1).Infoln(fmt.Sprintf("No longer the leader and stopping worker %T [%s]", worker, worker.GetID()))
+			NewWorkerLogger(worker).V(1).Infof("No longer the leader and stopping worker %T [%s]", worker, worker.GetID())
 			worker.Stop()
 			s.workerGrp.Done() //a worker is removed from the group
 		}
@@ -107,12 +113,12 @@ func (s *LeaderElectionManager) isWorkerLeader(worker Worker) bool {
 	if err != nil {
 		// we don't know whether we're the leader or not, set metric to false for now
 		//metrics.UpdateLeaderStatusMetric(false)
-		glog.V(5).Infof("failed to acquire leader lease: %s", err)
+		NewWorkerLogger(worker).V(5).Infof("failed to acquire leader lease: %s", err)
 		return false
 	}
 
 	if !leaderLeaseAcquisition.acquired {
-		glog.V(5).Infof("not currently leader, skipping reconcile %T [%s]", worker, worker.GetID())
+		NewWorkerLogger(worker).V(5).Infof("not currently leader, skipping reconcile %T [%s]", worker, worker.GetID())
 		return false
 	}
 
@@ -162,7 +168,7 @@ func (s *LeaderElectionManager) acquireLeaderLease(workerId string, workerType s
 		  continue the reconcile
 		*/
 		if len(leaseList) == 0 && isExpired(lease) {
-			glog.V(1).Infof("failed to acquire lock on leader lease for update, skipping")
+			logger.Logger.WithComponent(leaderElectionComponent).WithFields(logger.WorkerTypeField, workerType).V(1).Infof("failed to acquire lock on leader lease for update, skipping")
 			leaderTx.Rollback()
 			return &leaderLeaseAcquisition{
 				acquired:     false,
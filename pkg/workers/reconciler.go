@@ -1,17 +1,21 @@
 package workers
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/tracing"
 	"github.com/goava/di"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/logger"
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
 
-	"github.com/golang/glog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Reconciler struct {
@@ -48,29 +52,30 @@ func (r *Reconciler) Start(worker Worker) {
 	sub := r.SignalBus.Subscribe("reconcile:" + worker.GetWorkerType())
 	ticker := time.NewTicker(r.ReconcilerConfig.ReconcilerRepeatInterval)
 
+	log := NewWorkerLogger(worker)
 	go func() {
 		defer sub.Close()
 		//starts reconcile immediately and then on every repeat interval
-		glog.V(1).Infoln(fmt.Sprintf("Initial reconciliation loop for %T [%s]", worker, worker.GetID()))
+		log.V(1).Infof("Initial reconciliation loop for %T [%s]", worker, worker.GetID())
 		r.runReconcile(worker)
 		for {
 			select {
 			case wg := <-r.wakeup: //we were asked to wake up...
-				glog.V(1).Infoln(fmt.Sprintf("Wakeup triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
+				log.V(1).Infof("Wakeup triggered reconciliation loop for %T [%s]", worker, worker.GetID())
 				r.runReconcile(worker)
 				if wg != nil {
 					wg.Done()
 				}
 			case <-ticker.C: //time out
-				glog.V(1).Infoln(fmt.Sprintf("Timeout triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
+				log.V(1).Infof("Timeout triggered reconciliation loop for %T [%s]", worker, worker.GetID())
 				r.runReconcile(worker)
 			case <-sub.Signal():
-				glog.V(1).Infoln(fmt.Sprintf("Signalbus triggered reconciliation loop for %T [%s]", worker, worker.GetID()))
+				log.V(1).Infof("Signalbus triggered reconciliation loop for %T [%s]", worker, worker.GetID())
 				r.runReconcile(worker)
 			case <-*worker.GetStopChan():
 				ticker.Stop()
 				defer worker.GetSyncGroup().Done()
-				glog.V(1).Infoln(fmt.Sprintf("Stopping reconciliation loop for %T [%s]", worker, worker.GetID()))
+				log.V(1).Infof("Stopping reconciliation loop for %T [%s]", worker, worker.GetID())
 				return
 			}
 		}
@@ -78,20 +83,34 @@ func (r *Reconciler) Start(worker Worker) {
 }
 
 func (r *Reconciler) runReconcile(worker Worker) {
+	ctx, span := tracing.Tracer().Start(context.Background(), "reconcile "+worker.GetWorkerType(), trace.WithAttributes(
+		attribute.String("worker.type", worker.GetWorkerType()),
+		attribute.String("worker.id", worker.GetID()),
+	))
+	defer span.End()
+
 	start := time.Now()
-	errors := worker.Reconcile()
+	errors := worker.Reconcile(ctx)
 	if len(errors) == 0 {
 		metrics.IncreaseReconcilerSuccessCount(worker.GetWorkerType())
 	} else {
 		metrics.IncreaseReconcilerFailureCount(worker.GetWorkerType())
 		metrics.IncreaseReconcilerErrorsCount(worker.GetWorkerType(), len(errors))
+		span.SetStatus(codes.Error, fmt.Sprintf("%d reconcile errors", len(errors)))
 	}
 	metrics.UpdateReconcilerDurationMetric(worker.GetWorkerType(), time.Since(start))
 	for _, e := range errors {
-		logger.Logger.Error(e)
+		span.RecordError(e)
+		NewWorkerLogger(worker).Error(e)
 	}
 }
 
+// NewWorkerLogger returns the logger of the worker, whose records are attributed to the component named after the
+// worker type so that its verbosity can be adjusted on its own
+func NewWorkerLogger(worker Worker) logger.UHCLogger {
+	return logger.Logger.WithComponent(worker.GetWorkerType()).WithFields(logger.WorkerTypeField, worker.GetWorkerType())
+}
+
 func (r *Reconciler) Stop(worker Worker) {
 	defer worker.SetIsRunning(false)
 	select {
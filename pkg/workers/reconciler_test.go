@@ -2,11 +2,17 @@ package workers
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/services/signalbus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	. "github.com/onsi/gomega"
 )
@@ -36,7 +42,7 @@ func TestReconciler_Wakeup(t *testing.T) {
 		GetWorkerTypeFunc: func() string {
 			return "test"
 		},
-		ReconcileFunc: func() []error {
+		ReconcileFunc: func(ctx context.Context) []error {
 			var errors []error
 			reconcileChan <- time.Now()
 			return errors
@@ -79,3 +85,32 @@ func TestReconciler_Wakeup(t *testing.T) {
 	// We can use a 0 timeout here because Wakeup will wait for the reconcile to occur first.
 	Expect(waitForReconcile(0)).Should(Equal(false))
 }
+
+func TestReconciler_runReconcileTracing(t *testing.T) {
+	g := NewWithT(t)
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(previousProvider)
+
+	worker := &WorkerMock{
+		GetIDFunc: func() string {
+			return "test-id"
+		},
+		GetWorkerTypeFunc: func() string {
+			return "test"
+		},
+		ReconcileFunc: func(ctx context.Context) []error {
+			return []error{fmt.Errorf("reconcile failed")}
+		},
+	}
+	r := Reconciler{}
+	r.runReconcile(worker)
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].Name).To(Equal("reconcile test"))
+	g.Expect(spans[0].Attributes).To(ContainElements(attribute.String("worker.type", "test"), attribute.String("worker.id", "test-id")))
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Error))
+	g.Expect(spans[0].Events).To(HaveLen(1))
+}
@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"context"
 	"sync"
 
 	"github.com/bf2fc6cc711aee1a0c2a/kas-fleet-manager/pkg/metrics"
@@ -12,7 +13,7 @@ type Worker interface {
 	GetWorkerType() string
 	Start()
 	Stop()
-	Reconcile() []error
+	Reconcile(ctx context.Context) []error
 	GetStopChan() *chan struct{}
 	GetSyncGroup() *sync.WaitGroup
 	IsRunning() bool
@@ -4,6 +4,7 @@
 package workers
 
 import (
+	"context"
 	"sync"
 )
 
@@ -32,7 +33,7 @@ var _ Worker = &WorkerMock{}
 // 			IsRunningFunc: func() bool {
 // 				panic("mock out the IsRunning method")
 // 			},
-// 			ReconcileFunc: func() []error {
+// 			ReconcileFunc: func(ctx context.Context) []error {
 // 				panic("mock out the Reconcile method")
 // 			},
 // 			SetIsRunningFunc: func(val bool)  {
@@ -67,7 +68,7 @@ type WorkerMock struct {
 	IsRunningFunc func() bool
 
 	// ReconcileFunc mocks the Reconcile method.
-	ReconcileFunc func() []error
+	ReconcileFunc func(ctx context.Context) []error
 
 	// SetIsRunningFunc mocks the SetIsRunning method.
 	SetIsRunningFunc func(val bool)
@@ -97,6 +98,8 @@ type WorkerMock struct {
 		}
 		// Reconcile holds details about calls to the Reconcile method.
 		Reconcile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 		}
 		// SetIsRunning holds details about calls to the SetIsRunning method.
 		SetIsRunning []struct {
@@ -252,24 +255,29 @@ func (mock *WorkerMock) IsRunningCalls() []struct {
 }
 
 // Reconcile calls ReconcileFunc.
-func (mock *WorkerMock) Reconcile() []error {
+func (mock *WorkerMock) Reconcile(ctx context.Context) []error {
 	if mock.ReconcileFunc == nil {
 		panic("WorkerMock.ReconcileFunc: method is nil but Worker.Reconcile was just called")
 	}
 	callInfo := struct {
-	}{}
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
 	mock.lockReconcile.Lock()
 	mock.calls.Reconcile = append(mock.calls.Reconcile, callInfo)
 	mock.lockReconcile.Unlock()
-	return mock.ReconcileFunc()
+	return mock.ReconcileFunc(ctx)
 }
 
 // ReconcileCalls gets all the calls that were made to Reconcile.
 // Check the length with:
 //     len(mockedWorker.ReconcileCalls())
 func (mock *WorkerMock) ReconcileCalls() []struct {
+	Ctx context.Context
 } {
 	var calls []struct {
+		Ctx context.Context
 	}
 	mock.lockReconcile.RLock()
 	calls = mock.calls.Reconcile